@@ -112,13 +112,48 @@ func main() {
 		QoSServices: qosInstances,
 	}
 
+	// Setup the endpoint affinity store, if enabled.
+	// It routes a client's requests for a service to the same endpoint.
+	var endpointAffinityStore *gateway.EndpointAffinityStore
+	if config.EndpointAffinity.Enabled {
+		endpointAffinityStore = gateway.NewEndpointAffinityStore(config.EndpointAffinity.IdleTimeout)
+	}
+
+	// Setup the request coalescer, if enabled.
+	// It shares a single relay among concurrent identical requests.
+	var requestCoalescer *gateway.RequestCoalescer
+	if config.RequestCoalescing.Enabled {
+		requestCoalescer = gateway.NewRequestCoalescer()
+	}
+
+	// Setup the gateway plugins enabled for each service, if any.
+	// They can transform a service's requests and responses.
+	var servicePlugins *gateway.ServicePlugins
+	if len(config.Plugins.Services) > 0 {
+		servicePlugins, err = gateway.NewServicePlugins(config.Plugins.Services)
+		if err != nil {
+			log.Fatalf(`{"level":"fatal","error":"%v","message":"failed to setup gateway plugins"}`, err)
+		}
+	}
+
+	// Setup the debug response headers, if enabled.
+	// They expose the gateway's routing decisions to allowlisted clients.
+	var debugHeaders *gateway.DebugHeaders
+	if config.DebugHeaders.IsEnabled() {
+		debugHeaders = gateway.NewDebugHeaders(config.DebugHeaders.AllowAllClients, config.DebugHeaders.APIKeys)
+	}
+
 	// NOTE: the gateway uses the requestParser to get the correct QoS instance for any incoming request.
 	gateway := &gateway.Gateway{
-		Logger:            logger,
-		HTTPRequestParser: requestParser,
-		Protocol:          protocol,
-		MetricsReporter:   metricsReporter,
-		DataReporter:      dataReporter,
+		Logger:                logger,
+		HTTPRequestParser:     requestParser,
+		Protocol:              protocol,
+		MetricsReporter:       metricsReporter,
+		DataReporter:          dataReporter,
+		EndpointAffinityStore: endpointAffinityStore,
+		RequestCoalescer:      requestCoalescer,
+		Plugins:               servicePlugins,
+		DebugHeaders:          debugHeaders,
 	}
 
 	// Until all components are ready, the `/healthz` endpoint will return a 503 Service
@@ -129,10 +164,19 @@ func main() {
 	if hydrator != nil {
 		components = append(components, hydrator)
 	}
+	// Report the services whose chain has stopped advancing, for QoS instances supporting stall detection.
+	stallReporters := make(map[protocolPkg.ServiceID]health.StallReporter)
+	for serviceID, qosService := range qosInstances {
+		if stallReporter, ok := qosService.(health.StallReporter); ok {
+			stallReporters[serviceID] = stallReporter
+		}
+	}
+
 	healthChecker := &health.Checker{
 		Logger:            logger,
 		Components:        components,
 		ServiceIDReporter: protocol,
+		StallReporters:    stallReporters,
 	}
 
 	// Convert qosInstances to DataReporter map to satisfy the QoSDisqualifiedEndpointsReporter interface.
@@ -11,7 +11,12 @@ import (
 	"github.com/buildwithgrove/path/protocol"
 	"github.com/buildwithgrove/path/qos/cosmos"
 	"github.com/buildwithgrove/path/qos/evm"
+	"github.com/buildwithgrove/path/qos/genericjsonrpc"
+	"github.com/buildwithgrove/path/qos/genericrest"
+	"github.com/buildwithgrove/path/qos/move"
+	"github.com/buildwithgrove/path/qos/near"
 	"github.com/buildwithgrove/path/qos/solana"
+	"github.com/buildwithgrove/path/qos/utxo"
 )
 
 // getServiceQoSInstances returns all QoS instances to be used by the Gateway and the EndpointHydrator.
@@ -99,6 +104,61 @@ func getServiceQoSInstances(
 			qosServices[serviceID] = solanaQoS
 
 			hydratedLogger.With("service_id", serviceID).Debug().Msg("Added Solana QoS instance for the service ID.")
+
+		case genericjsonrpc.QoSType:
+			genericJSONRPCServiceQoSConfig, ok := qosServiceConfig.(genericjsonrpc.GenericJSONRPCServiceQoSConfig)
+			if !ok {
+				return nil, fmt.Errorf("SHOULD NEVER HAPPEN: error building QoS instances: service ID %q is not a generic JSON-RPC service", serviceID)
+			}
+
+			genericJSONRPCQoS := genericjsonrpc.NewQoSInstance(qosLogger, genericJSONRPCServiceQoSConfig)
+			qosServices[serviceID] = genericJSONRPCQoS
+
+			hydratedLogger.With("service_id", serviceID).Debug().Msg("Added generic JSON-RPC QoS instance for the service ID.")
+
+		case genericrest.QoSType:
+			genericRESTServiceQoSConfig, ok := qosServiceConfig.(genericrest.GenericRESTServiceQoSConfig)
+			if !ok {
+				return nil, fmt.Errorf("SHOULD NEVER HAPPEN: error building QoS instances: service ID %q is not a generic REST service", serviceID)
+			}
+
+			genericRESTQoS := genericrest.NewQoSInstance(qosLogger, genericRESTServiceQoSConfig)
+			qosServices[serviceID] = genericRESTQoS
+
+			hydratedLogger.With("service_id", serviceID).Debug().Msg("Added generic REST QoS instance for the service ID.")
+
+		case utxo.QoSType:
+			utxoServiceQoSConfig, ok := qosServiceConfig.(utxo.UTXOServiceQoSConfig)
+			if !ok {
+				return nil, fmt.Errorf("SHOULD NEVER HAPPEN: error building QoS instances: service ID %q is not a UTXO service", serviceID)
+			}
+
+			utxoQoS := utxo.NewQoSInstance(qosLogger, utxoServiceQoSConfig)
+			qosServices[serviceID] = utxoQoS
+
+			hydratedLogger.With("service_id", serviceID).Debug().Msg("Added UTXO QoS instance for the service ID.")
+
+		case near.QoSType:
+			nearServiceQoSConfig, ok := qosServiceConfig.(near.NearServiceQoSConfig)
+			if !ok {
+				return nil, fmt.Errorf("SHOULD NEVER HAPPEN: error building QoS instances: service ID %q is not a NEAR service", serviceID)
+			}
+
+			nearQoS := near.NewQoSInstance(qosLogger, nearServiceQoSConfig)
+			qosServices[serviceID] = nearQoS
+
+			hydratedLogger.With("service_id", serviceID).Debug().Msg("Added NEAR QoS instance for the service ID.")
+
+		case move.QoSType:
+			moveServiceQoSConfig, ok := qosServiceConfig.(move.MoveServiceQoSConfig)
+			if !ok {
+				return nil, fmt.Errorf("SHOULD NEVER HAPPEN: error building QoS instances: service ID %q is not a Move service", serviceID)
+			}
+
+			moveQoS := move.NewQoSInstance(qosLogger, moveServiceQoSConfig)
+			qosServices[serviceID] = moveQoS
+
+			hydratedLogger.With("service_id", serviceID).Debug().Msg("Added Move QoS instance for the service ID.")
 		default:
 			return nil, fmt.Errorf("SHOULD NEVER HAPPEN: error building QoS instances: service ID %q not supported by PATH", serviceID)
 		}
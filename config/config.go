@@ -20,6 +20,11 @@ type GatewayConfig struct {
 	HydratorConfig     EndpointHydratorConfig        `yaml:"hydrator_config"`
 	MessagingConfig    MessagingConfig               `yaml:"messaging_config"`
 	DataReporterConfig HTTPDataReporterConfig        `yaml:"data_reporter_config"`
+	EndpointAffinity   EndpointAffinityConfig        `yaml:"endpoint_affinity_config"`
+	RequestCoalescing  RequestCoalescingConfig       `yaml:"request_coalescing_config"`
+	Plugins            PluginsConfig                 `yaml:"plugins_config"`
+	DebugHeaders       DebugHeadersConfig            `yaml:"debug_headers_config"`
+	QoSConfig          QoSConfig                     `yaml:"qos_config"`
 }
 
 // LoadGatewayConfigFromYAML reads a YAML configuration file from the specified path
@@ -56,6 +61,7 @@ func (c *GatewayConfig) hydrateDefaults() {
 	c.Router.hydrateRouterDefaults()
 	c.Logger.hydrateLoggerDefaults()
 	c.HydratorConfig.hydrateHydratorDefaults()
+	c.EndpointAffinity.hydrateEndpointAffinityDefaults()
 	c.ShannonConfig.FullNodeConfig.HydrateDefaults()
 }
 
@@ -68,6 +74,9 @@ func (c GatewayConfig) validate() error {
 	if err := c.Logger.Validate(); err != nil {
 		return err
 	}
+	if err := c.QoSConfig.validate(); err != nil {
+		return err
+	}
 	return nil
 }
 
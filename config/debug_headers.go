@@ -0,0 +1,20 @@
+package config
+
+/* --------------------------------- Debug Headers Config Struct -------------------------------- */
+
+// DebugHeadersConfig stores configuration settings for returning debug response headers,
+// which expose the gateway's routing decisions, to requests setting the `Path-Debug` header.
+// Debug headers are disabled if neither field is set.
+type DebugHeadersConfig struct {
+	// AllowAllClients returns debug headers to any request setting the `Path-Debug` header.
+	// Intended for local and test environments only.
+	AllowAllClients bool `yaml:"allow_all_clients"`
+
+	// APIKeys returns debug headers to requests setting the `Path-Debug` header to one of the API keys.
+	APIKeys []string `yaml:"api_keys"`
+}
+
+// IsEnabled returns true if debug headers can be returned to any request.
+func (c DebugHeadersConfig) IsEnabled() bool {
+	return c.AllowAllClients || len(c.APIKeys) > 0
+}
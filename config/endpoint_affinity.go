@@ -0,0 +1,30 @@
+package config
+
+import "time"
+
+/* --------------------------------- Endpoint Affinity Config Defaults -------------------------------- */
+
+// defaultEndpointAffinityIdleTimeout specifies the duration after which an unused affinity endpoint is dropped.
+// It is set to 5 minutes to roughly match the session duration.
+var defaultEndpointAffinityIdleTimeout = 5 * time.Minute
+
+/* --------------------------------- Endpoint Affinity Config Struct -------------------------------- */
+
+// EndpointAffinityConfig stores configuration settings for routing a client's requests for a service to the same endpoint.
+// The client is identified by the `Endpoint-Affinity-Key` HTTP header, or the portal application ID.
+type EndpointAffinityConfig struct {
+	// Enables endpoint affinity: disabled by default.
+	Enabled bool `yaml:"enabled"`
+
+	// Duration after which an unused affinity endpoint is dropped.
+	IdleTimeout time.Duration `yaml:"idle_timeout_ms"`
+}
+
+/* --------------------------------- Endpoint Affinity Config Private Helpers -------------------------------- */
+
+// hydrateEndpointAffinityDefaults assigns default values to EndpointAffinityConfig fields if they are not set.
+func (c *EndpointAffinityConfig) hydrateEndpointAffinityDefaults() {
+	if c.IdleTimeout == 0 {
+		c.IdleTimeout = defaultEndpointAffinityIdleTimeout
+	}
+}
@@ -0,0 +1,12 @@
+package config
+
+import "github.com/buildwithgrove/path/protocol"
+
+/* --------------------------------- Plugins Config Struct -------------------------------- */
+
+// PluginsConfig stores the gateway plugins enabled for each service.
+// Plugins are registered in Go under a unique name: see the gateway.Plugin interface.
+type PluginsConfig struct {
+	// Maps a service ID to the names of the plugins enabled for the service, in the order they are called.
+	Services map[protocol.ServiceID][]string `yaml:"services"`
+}
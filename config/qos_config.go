@@ -0,0 +1,913 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos"
+	"github.com/buildwithgrove/path/qos/cosmos"
+	"github.com/buildwithgrove/path/qos/evm"
+	"github.com/buildwithgrove/path/qos/genericjsonrpc"
+	"github.com/buildwithgrove/path/qos/genericrest"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+	"github.com/buildwithgrove/path/qos/move"
+	"github.com/buildwithgrove/path/qos/near"
+	"github.com/buildwithgrove/path/qos/solana"
+	"github.com/buildwithgrove/path/qos/utxo"
+)
+
+// maxMethodTimeout is the longest relay timeout which can be configured for a JSON-RPC method.
+// It is kept below the HTTP server's default write timeout and the relay HTTP client's timeouts: see network/http.
+const maxMethodTimeout = 100 * time.Second
+
+// maxTxBroadcastNumEndpoints caps the number of endpoints a transaction submission can be broadcast to,
+// to prevent a single user request from fanning out an excessive number of relays.
+const maxTxBroadcastNumEndpoints = 10
+
+// maxBatchNumEndpoints caps the number of endpoints the members of a JSON-RPC batch request can be distributed across.
+const maxBatchNumEndpoints = 10
+
+/* --------------------------------- QoS Config Struct -------------------------------- */
+
+// QoSConfig stores the QoS service registrations declared in the gateway config YAML.
+// They are merged with the compiled-in service registrations, i.e. shannonServices:
+//   - A service whose ID matches a compiled-in service of the same QoS type overrides the settings it declares:
+//     settings it does not declare, e.g. the archival check, keep their compiled-in value.
+//   - A service whose ID matches a compiled-in service of a different QoS type replaces it entirely.
+//   - A service with a new ID is added to the list of services with QoS.
+type QoSConfig struct {
+	Services []QoSServiceConfig `yaml:"services"`
+}
+
+// QoSServiceConfig declares the QoS settings of a single service.
+type QoSServiceConfig struct {
+	ServiceID protocol.ServiceID `yaml:"service_id"`
+
+	// QoSType is the QoS implementation used for the service: one of "evm", "cosmossdk", "solana", "utxo", "near", "move", "generic_jsonrpc" or "generic_rest".
+	QoSType string `yaml:"qos_type"`
+
+	// ChainID is the chain ID of the service:
+	//   - EVM: the hex-encoded chain ID, e.g. "0x1".
+	//   - CosmosSDK: the Cosmos chain ID, e.g. "cosmoshub-4".
+	//   - Solana: the chain ID set by the preprocessor, e.g. "solana".
+	//   - UTXO: the chain name reported by endpoints' `getblockchaininfo` responses, e.g. "main".
+	//   - NEAR: the chain ID reported by endpoints' `status` responses, e.g. "mainnet".
+	//   - Move: the chain identifier returned by `sui_getChainIdentifier` for JSON-RPC (Sui) services, e.g. "35834a8a",
+	//     or the numeric chain ID returned by `GET /v1` for REST (Aptos) services, e.g. "1".
+	//   - Generic JSON-RPC and generic REST: not used.
+	ChainID string `yaml:"chain_id"`
+
+	// EVMChainID is the EVM chain ID of CosmosSDK services with native EVM support, e.g. XRPLEVM.
+	EVMChainID string `yaml:"evm_chain_id"`
+
+	// SupportedAPIs are the RPC types supported by the service, e.g. "json_rpc", "rest", "comet_bft".
+	// Defaults to "json_rpc" for EVM, Solana, UTXO, NEAR, Move and generic JSON-RPC services, to "rest" for generic REST services,
+	// and to "rest" and "comet_bft" for CosmosSDK services.
+	SupportedAPIs []string `yaml:"supported_apis"`
+
+	// SyncAllowance is the number of blocks an endpoint may be behind the perceived block number and still be considered valid.
+	// Move services use checkpoints (JSON-RPC) or ledger versions (REST) instead of blocks.
+	// Solana services use slots: it is the slot lag tolerance, applied at each commitment level.
+	// The QoS implementation's default is used if not set.
+	SyncAllowance uint64 `yaml:"sync_allowance"`
+
+	// ArchivalCheck enables the archival check of EVM, Solana and CosmosSDK services.
+	ArchivalCheck *QoSArchivalCheckConfig `yaml:"archival_check"`
+
+	// ExpectedBlockTime enables stall detection of EVM, Solana and CosmosSDK services, e.g. "12s".
+	// The service is reported as stalled if its perceived block height does not advance for several expected block times.
+	// Stall detection is disabled if not set.
+	ExpectedBlockTime time.Duration `yaml:"expected_block_time"`
+
+	// MinPeerCount enables the `net_peerCount` check of EVM services.
+	// Endpoints connected to fewer peers are disqualified. The check is disabled if not set.
+	MinPeerCount uint64 `yaml:"min_peer_count"`
+
+	// MethodTimeouts sets the relay timeout of JSON-RPC methods of EVM and Solana services, e.g. `eth_getLogs: 90s`.
+	// Methods not listed use the gateway's default relay timeout. Must not exceed maxMethodTimeout.
+	MethodTimeouts map[string]time.Duration `yaml:"method_timeouts"`
+
+	// GetLogsBlockRangeChunkSize enables splitting of `eth_getLogs` requests of EVM services:
+	// block ranges larger than the chunk size are split into chunks sent to different endpoints, and the logs are merged.
+	// Splitting is disabled if not set.
+	GetLogsBlockRangeChunkSize uint64 `yaml:"get_logs_block_range_chunk_size"`
+
+	// BlockTagPinning enables pinning of the `latest` block tag of EVM services' requests to a concrete block number.
+	BlockTagPinning *QoSBlockTagPinningConfig `yaml:"block_tag_pinning"`
+
+	// ConsensusRead enables consensus-read mode of EVM services, for the configured methods and/or using the client header.
+	ConsensusRead *QoSConsensusReadConfig `yaml:"consensus_read"`
+
+	// TxBroadcastNumEndpoints is the number of endpoints transaction submissions of EVM, Solana and CosmosSDK services are broadcast to.
+	// Defaults to qos.DefaultTxBroadcastNumEndpoints if not set. Set to 1 to send transactions to a single endpoint.
+	TxBroadcastNumEndpoints uint `yaml:"tx_broadcast_num_endpoints"`
+
+	// BatchNumEndpoints is the maximum number of endpoints the members of JSON-RPC batch requests of EVM, Solana and CosmosSDK services are distributed across.
+	// Defaults to qos.DefaultBatchNumEndpoints if not set. Set to 1 to send batch requests to a single endpoint.
+	BatchNumEndpoints uint `yaml:"batch_num_endpoints"`
+
+	// CoalescedMethods is the allowlist of JSON-RPC methods of EVM and Solana services eligible for request coalescing.
+	// Replaces the QoS type's default allowlist if set: an empty list disables request coalescing for the service.
+	CoalescedMethods []string `yaml:"coalesced_methods"`
+
+	// Checks are the synthetic checks run against every endpoint of a generic JSON-RPC service.
+	Checks []QoSCheckConfig `yaml:"checks"`
+
+	// Probes are the health probes run against every endpoint of a generic REST service.
+	Probes []QoSProbeConfig `yaml:"probes"`
+
+	// MaxServerErrorRate is the maximum rate of organic requests an endpoint of a generic REST service may fail with a 5xx.
+	// Defaults to genericrest.DefaultMaxServerErrorRate if not set.
+	MaxServerErrorRate float64 `yaml:"max_server_error_rate"`
+
+	// MaxBatchSize is the maximum number of requests in a JSONRPC batch request.
+	MaxBatchSize int `yaml:"max_batch_size"`
+
+	// MaxRequestBodyBytes is the maximum size of a request body, in bytes.
+	MaxRequestBodyBytes int64 `yaml:"max_request_body_bytes"`
+}
+
+// QoSArchivalCheckConfig declares the archival check settings of an EVM, Solana or CosmosSDK service.
+// See: https://path.grove.city/learn/qos/adding_new_archival
+type QoSArchivalCheckConfig struct {
+	// ContractAddress is the address of a contract with frequent transactions and a large balance.
+	// EVM only.
+	ContractAddress string `yaml:"contract_address"`
+
+	// ContractStartBlock is the block at which the contract first had a balance.
+	// EVM only.
+	ContractStartBlock uint64 `yaml:"contract_start_block"`
+
+	// Threshold is the number of blocks (EVM) or slots (Solana) below the perceived block number or finalized slot considered "archival" data.
+	// Defaults to evm.DefaultEVMArchivalThreshold or solana.DefaultArchivalThreshold if not set.
+	Threshold uint64 `yaml:"threshold"`
+
+	// BlockHeight is a known historical block height, queried by the archival check.
+	// CosmosSDK only.
+	BlockHeight uint64 `yaml:"block_height"`
+
+	// Probes are the archival probes run against every endpoint, in addition to the
+	// `eth_getBalance` probe of ContractAddress, if set.
+	// EVM only.
+	Probes []QoSArchivalProbeConfig `yaml:"probes"`
+
+	// ConsensusThreshold is the number of endpoints which must agree on a probe's value for it to be the expected value.
+	// Defaults to evm.DefaultEVMArchivalConsensusThreshold if not set.
+	// EVM only.
+	ConsensusThreshold int `yaml:"consensus_threshold"`
+}
+
+// QoSBlockTagPinningConfig declares the block-tag pinning settings of an EVM service.
+// See evm.WithBlockTagPinning for details.
+type QoSBlockTagPinningConfig struct {
+	// Lag is the number of blocks below the perceived block number the `latest` block tag is pinned to.
+	Lag uint64 `yaml:"lag"`
+}
+
+// QoSConsensusReadConfig declares the consensus-read settings of an EVM service:
+// requests are sent to multiple endpoints, and the result agreed on by a quorum of the endpoints is returned.
+type QoSConsensusReadConfig struct {
+	// Methods are the JSON-RPC methods always served in consensus-read mode, e.g. "eth_call".
+	Methods []string `yaml:"methods"`
+
+	// NumEndpoints is the number of endpoints requests with one of the methods are sent to.
+	// Required if methods are set: must be between qos.MinConsensusReadNumEndpoints and qos.MaxConsensusReadNumEndpoints.
+	NumEndpoints uint `yaml:"num_endpoints"`
+
+	// Quorum is the number of endpoints which must agree on the result. Defaults to the majority of the endpoints.
+	Quorum uint `yaml:"quorum"`
+
+	// AllowClientHeader allows clients to opt any request into consensus-read mode using the qos.HTTPHeaderConsensusRead header.
+	// Disabled by default: every opted-in request costs multiple relays.
+	AllowClientHeader bool `yaml:"allow_client_header"`
+}
+
+// QoSArchivalProbeConfig declares an archival probe of an EVM service.
+// See evm.ArchivalProbe for details on each field.
+type QoSArchivalProbeConfig struct {
+	Name string `yaml:"name"`
+
+	// Method is one of "eth_getBalance", "eth_getCode" or "eth_call".
+	Method string `yaml:"method"`
+
+	ContractAddress    string `yaml:"contract_address"`
+	ContractStartBlock uint64 `yaml:"contract_start_block"`
+
+	// Data is the hex-encoded call data of an "eth_call" probe.
+	Data string `yaml:"data"`
+}
+
+// QoSCheckConfig declares a synthetic check of a generic JSON-RPC service.
+// See genericjsonrpc.Check for details on each field.
+type QoSCheckConfig struct {
+	Name string `yaml:"name"`
+
+	// Method is the JSON-RPC method of the check's request.
+	Method string `yaml:"method"`
+
+	// Params are the JSON-RPC params of the check's request, as a YAML sequence or mapping.
+	Params any `yaml:"params"`
+
+	// ResultPath is the dot-separated path into the JSON-RPC result of the value to assert on.
+	ResultPath string `yaml:"result_path"`
+
+	// Assertion is one of "equals", "non_empty" or "hex_number_near_max".
+	Assertion string `yaml:"assertion"`
+
+	// ExpectedValue is the value required by the "equals" assertion.
+	ExpectedValue string `yaml:"expected_value"`
+
+	// Tolerance is the number of units (e.g. blocks) a number may be behind the perceived max for the "hex_number_near_max" assertion.
+	Tolerance uint64 `yaml:"tolerance"`
+}
+
+// QoSProbeConfig declares a health probe of a generic REST service.
+// See genericrest.Probe for details on each field.
+type QoSProbeConfig struct {
+	Name string `yaml:"name"`
+
+	// Path is the URL path, including any query, of the probe's GET request.
+	Path string `yaml:"path"`
+
+	// ExpectedStatusCodes are the HTTP status codes of a healthy response. Defaults to 200.
+	ExpectedStatusCodes []int `yaml:"expected_status_codes"`
+
+	// FieldAssertions are the assertions on the fields of the probe's JSON response.
+	FieldAssertions []QoSFieldAssertionConfig `yaml:"field_assertions"`
+
+	// HeightField is the dot-separated path into the JSON response of a height used to disqualify lagging endpoints.
+	HeightField string `yaml:"height_field"`
+
+	// HeightTolerance is the number of units (e.g. blocks) an endpoint may lag the perceived latest height.
+	HeightTolerance uint64 `yaml:"height_tolerance"`
+}
+
+// QoSFieldAssertionConfig declares an assertion on a field of a probe's JSON response.
+type QoSFieldAssertionConfig struct {
+	// Path is the dot-separated path into the JSON response of the field.
+	Path string `yaml:"path"`
+
+	// ExpectedValue is the value the field must equal. The field is only required to be non-empty if not set.
+	ExpectedValue string `yaml:"expected_value"`
+}
+
+/* --------------------------------- QoS Config Validation -------------------------------- */
+
+// validate checks that every declared service is complete and that service IDs are unique.
+func (c QoSConfig) validate() error {
+	seenServiceIDs := make(map[protocol.ServiceID]struct{}, len(c.Services))
+	for _, service := range c.Services {
+		if err := service.validate(); err != nil {
+			return fmt.Errorf("invalid qos_config for service %q: %w", service.ServiceID, err)
+		}
+
+		if _, found := seenServiceIDs[service.ServiceID]; found {
+			return fmt.Errorf("invalid qos_config: duplicate service ID %q", service.ServiceID)
+		}
+		seenServiceIDs[service.ServiceID] = struct{}{}
+	}
+	return nil
+}
+
+func (c QoSServiceConfig) validate() error {
+	if c.ServiceID == "" {
+		return fmt.Errorf("service_id is required")
+	}
+
+	switch c.QoSType {
+	case evm.QoSType, cosmos.QoSType, solana.QoSType, utxo.QoSType, near.QoSType, move.QoSType:
+		if c.ChainID == "" {
+			return fmt.Errorf("chain_id is required")
+		}
+	case genericjsonrpc.QoSType:
+		if len(c.Checks) == 0 {
+			return fmt.Errorf("at least one check is required for %q services", genericjsonrpc.QoSType)
+		}
+		if _, err := c.buildChecks(); err != nil {
+			return err
+		}
+	case genericrest.QoSType:
+		if len(c.Probes) == 0 {
+			return fmt.Errorf("at least one probe is required for %q services", genericrest.QoSType)
+		}
+		if _, err := c.buildProbes(); err != nil {
+			return err
+		}
+		if c.MaxBatchSize != 0 {
+			return fmt.Errorf("max_batch_size is not supported for %q services", genericrest.QoSType)
+		}
+	default:
+		return fmt.Errorf("unsupported qos_type %q: must be one of %q, %q, %q, %q, %q, %q, %q or %q",
+			c.QoSType, evm.QoSType, cosmos.QoSType, solana.QoSType, utxo.QoSType, near.QoSType, move.QoSType, genericjsonrpc.QoSType, genericrest.QoSType)
+	}
+
+	if len(c.Checks) > 0 && c.QoSType != genericjsonrpc.QoSType {
+		return fmt.Errorf("checks are only supported for %q services", genericjsonrpc.QoSType)
+	}
+
+	if (len(c.Probes) > 0 || c.MaxServerErrorRate != 0) && c.QoSType != genericrest.QoSType {
+		return fmt.Errorf("probes and max_server_error_rate are only supported for %q services", genericrest.QoSType)
+	}
+
+	if c.MaxServerErrorRate < 0 || c.MaxServerErrorRate > 1 {
+		return fmt.Errorf("max_server_error_rate must be between 0 and 1")
+	}
+
+	if c.EVMChainID != "" && c.QoSType != cosmos.QoSType {
+		return fmt.Errorf("evm_chain_id is only supported for %q services", cosmos.QoSType)
+	}
+
+	supportedAPIs, err := c.getSupportedAPIs()
+	if err != nil {
+		return err
+	}
+
+	// Move services are served either through JSON-RPC (e.g. Sui) or REST (e.g. Aptos).
+	if c.QoSType == move.QoSType {
+		for rpcType := range supportedAPIs {
+			if rpcType != sharedtypes.RPCType_JSON_RPC && rpcType != sharedtypes.RPCType_REST {
+				return fmt.Errorf("unsupported RPC type %q in supported_apis of %q services: must be json_rpc or rest", rpcType, move.QoSType)
+			}
+		}
+	}
+
+	if c.ArchivalCheck != nil {
+		switch c.QoSType {
+		case evm.QoSType:
+			if (c.ArchivalCheck.ContractAddress == "") != (c.ArchivalCheck.ContractStartBlock == 0) {
+				return fmt.Errorf("archival_check requires both contract_address and contract_start_block")
+			}
+			if c.ArchivalCheck.ContractAddress == "" && len(c.ArchivalCheck.Probes) == 0 {
+				return fmt.Errorf("archival_check requires contract_address and contract_start_block, or at least one probe")
+			}
+			if c.ArchivalCheck.BlockHeight != 0 {
+				return fmt.Errorf("archival_check of %q services does not support block_height", evm.QoSType)
+			}
+			if c.ArchivalCheck.ConsensusThreshold < 0 {
+				return fmt.Errorf("archival_check consensus_threshold must not be negative")
+			}
+			if _, err := c.ArchivalCheck.buildArchivalProbes(); err != nil {
+				return err
+			}
+		// Solana archival checks use a block at a random historical slot: no contract is required.
+		case solana.QoSType:
+			if c.ArchivalCheck.ContractAddress != "" || c.ArchivalCheck.ContractStartBlock != 0 || c.ArchivalCheck.BlockHeight != 0 ||
+				len(c.ArchivalCheck.Probes) != 0 || c.ArchivalCheck.ConsensusThreshold != 0 {
+				return fmt.Errorf("archival_check of %q services only supports threshold", solana.QoSType)
+			}
+		// CosmosSDK archival checks request the CometBFT block at a known historical height.
+		case cosmos.QoSType:
+			if c.ArchivalCheck.BlockHeight == 0 {
+				return fmt.Errorf("archival_check of %q services requires block_height", cosmos.QoSType)
+			}
+			if c.ArchivalCheck.ContractAddress != "" || c.ArchivalCheck.ContractStartBlock != 0 || c.ArchivalCheck.Threshold != 0 ||
+				len(c.ArchivalCheck.Probes) != 0 || c.ArchivalCheck.ConsensusThreshold != 0 {
+				return fmt.Errorf("archival_check of %q services only supports block_height", cosmos.QoSType)
+			}
+			if _, ok := supportedAPIs[sharedtypes.RPCType_COMET_BFT]; !ok {
+				return fmt.Errorf("archival_check of %q services requires comet_bft in supported_apis", cosmos.QoSType)
+			}
+		default:
+			return fmt.Errorf("archival_check is only supported for %q, %q and %q services", evm.QoSType, solana.QoSType, cosmos.QoSType)
+		}
+	}
+
+	if c.SyncAllowance != 0 && c.QoSType != evm.QoSType && c.QoSType != cosmos.QoSType && c.QoSType != utxo.QoSType && c.QoSType != near.QoSType && c.QoSType != move.QoSType && c.QoSType != solana.QoSType {
+		return fmt.Errorf("sync_allowance is not supported for %q services", c.QoSType)
+	}
+
+	if c.ExpectedBlockTime != 0 && c.QoSType != evm.QoSType && c.QoSType != cosmos.QoSType && c.QoSType != solana.QoSType {
+		return fmt.Errorf("expected_block_time is only supported for %q, %q and %q services", evm.QoSType, solana.QoSType, cosmos.QoSType)
+	}
+
+	if c.ExpectedBlockTime < 0 {
+		return fmt.Errorf("expected_block_time must not be negative")
+	}
+
+	if c.MinPeerCount != 0 && c.QoSType != evm.QoSType {
+		return fmt.Errorf("min_peer_count is only supported for %q services", evm.QoSType)
+	}
+
+	if len(c.MethodTimeouts) > 0 && c.QoSType != evm.QoSType && c.QoSType != solana.QoSType {
+		return fmt.Errorf("method_timeouts is only supported for %q and %q services", evm.QoSType, solana.QoSType)
+	}
+
+	for method, timeout := range c.MethodTimeouts {
+		if timeout <= 0 || timeout > maxMethodTimeout {
+			return fmt.Errorf("method_timeouts: timeout of method %q must be positive and at most %s, got %s", method, maxMethodTimeout, timeout)
+		}
+	}
+
+	if c.GetLogsBlockRangeChunkSize != 0 && c.QoSType != evm.QoSType {
+		return fmt.Errorf("get_logs_block_range_chunk_size is only supported for %q services", evm.QoSType)
+	}
+
+	if c.BlockTagPinning != nil && c.QoSType != evm.QoSType {
+		return fmt.Errorf("block_tag_pinning is only supported for %q services", evm.QoSType)
+	}
+
+	if c.ConsensusRead != nil {
+		if c.QoSType != evm.QoSType {
+			return fmt.Errorf("consensus_read is only supported for %q services", evm.QoSType)
+		}
+		if err := c.ConsensusRead.validate(); err != nil {
+			return fmt.Errorf("invalid consensus_read: %w", err)
+		}
+	}
+
+	if c.TxBroadcastNumEndpoints != 0 && c.QoSType != evm.QoSType && c.QoSType != solana.QoSType && c.QoSType != cosmos.QoSType {
+		return fmt.Errorf("tx_broadcast_num_endpoints is only supported for %q, %q and %q services", evm.QoSType, solana.QoSType, cosmos.QoSType)
+	}
+
+	if c.TxBroadcastNumEndpoints > maxTxBroadcastNumEndpoints {
+		return fmt.Errorf("tx_broadcast_num_endpoints must be at most %d", maxTxBroadcastNumEndpoints)
+	}
+
+	if c.BatchNumEndpoints != 0 && c.QoSType != evm.QoSType && c.QoSType != solana.QoSType && c.QoSType != cosmos.QoSType {
+		return fmt.Errorf("batch_num_endpoints is only supported for %q, %q and %q services", evm.QoSType, solana.QoSType, cosmos.QoSType)
+	}
+
+	if c.BatchNumEndpoints > maxBatchNumEndpoints {
+		return fmt.Errorf("batch_num_endpoints must be at most %d", maxBatchNumEndpoints)
+	}
+
+	if c.CoalescedMethods != nil && c.QoSType != evm.QoSType && c.QoSType != solana.QoSType {
+		return fmt.Errorf("coalesced_methods is only supported for %q and %q services", evm.QoSType, solana.QoSType)
+	}
+
+	if slices.Contains(c.CoalescedMethods, "") {
+		return fmt.Errorf("coalesced_methods must not contain an empty method")
+	}
+
+	// NEAR nodes do not support JSON-RPC batch requests.
+	if c.MaxBatchSize != 0 && c.QoSType == near.QoSType {
+		return fmt.Errorf("max_batch_size is not supported for %q services", near.QoSType)
+	}
+
+	if c.MaxBatchSize < 0 || c.MaxRequestBodyBytes < 0 {
+		return fmt.Errorf("max_batch_size and max_request_body_bytes must not be negative")
+	}
+
+	return nil
+}
+
+func (c QoSConsensusReadConfig) validate() error {
+	if len(c.Methods) == 0 {
+		if !c.AllowClientHeader {
+			return fmt.Errorf("at least one method, or allow_client_header, is required")
+		}
+		if c.NumEndpoints != 0 || c.Quorum != 0 {
+			return fmt.Errorf("num_endpoints and quorum require at least one method")
+		}
+		return nil
+	}
+
+	if c.NumEndpoints < qos.MinConsensusReadNumEndpoints || c.NumEndpoints > qos.MaxConsensusReadNumEndpoints {
+		return fmt.Errorf("num_endpoints must be between %d and %d", qos.MinConsensusReadNumEndpoints, qos.MaxConsensusReadNumEndpoints)
+	}
+
+	if c.Quorum > c.NumEndpoints {
+		return fmt.Errorf("quorum must not exceed num_endpoints")
+	}
+
+	return nil
+}
+
+/* --------------------------------- QoS Config Helpers -------------------------------- */
+
+// getSupportedAPIs returns the RPC types supported by the service, with defaults applied.
+func (c QoSServiceConfig) getSupportedAPIs() (map[sharedtypes.RPCType]struct{}, error) {
+	supportedAPIs := make(map[sharedtypes.RPCType]struct{})
+
+	if len(c.SupportedAPIs) == 0 {
+		switch c.QoSType {
+		case cosmos.QoSType:
+			supportedAPIs[sharedtypes.RPCType_REST] = struct{}{}
+			supportedAPIs[sharedtypes.RPCType_COMET_BFT] = struct{}{}
+		case genericrest.QoSType:
+			supportedAPIs[sharedtypes.RPCType_REST] = struct{}{}
+		default:
+			supportedAPIs[sharedtypes.RPCType_JSON_RPC] = struct{}{}
+		}
+		return supportedAPIs, nil
+	}
+
+	for _, api := range c.SupportedAPIs {
+		rpcType, found := sharedtypes.RPCType_value[strings.ToUpper(api)]
+		if !found || sharedtypes.RPCType(rpcType) == sharedtypes.RPCType_UNKNOWN_RPC {
+			return nil, fmt.Errorf("unsupported RPC type %q in supported_apis", api)
+		}
+		supportedAPIs[sharedtypes.RPCType(rpcType)] = struct{}{}
+	}
+
+	return supportedAPIs, nil
+}
+
+// buildArchivalProbes returns the archival probes of an EVM service:
+//   - An `eth_getBalance` probe of the contract address, if set.
+//   - The declared probes, in the order they are declared.
+//
+// Returns an error if any of the probes is invalid, or if probe names are not unique.
+func (c QoSArchivalCheckConfig) buildArchivalProbes() ([]evm.ArchivalProbe, error) {
+	var probes []evm.ArchivalProbe
+	if c.ContractAddress != "" {
+		probes = append(probes, evm.NewEVMBalanceArchivalProbe(c.ContractAddress, c.ContractStartBlock))
+	}
+
+	for _, probeConfig := range c.Probes {
+		probes = append(probes, evm.ArchivalProbe{
+			Name:               probeConfig.Name,
+			Method:             jsonrpc.Method(probeConfig.Method),
+			ContractAddress:    probeConfig.ContractAddress,
+			ContractStartBlock: probeConfig.ContractStartBlock,
+			CallData:           probeConfig.Data,
+		})
+	}
+
+	seenProbeNames := make(map[string]struct{}, len(probes))
+	for _, probe := range probes {
+		if err := probe.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid archival probe %q: %w", probe.Name, err)
+		}
+
+		if _, found := seenProbeNames[probe.Name]; found {
+			return nil, fmt.Errorf("duplicate archival probe name %q", probe.Name)
+		}
+		seenProbeNames[probe.Name] = struct{}{}
+	}
+
+	return probes, nil
+}
+
+// buildChecks returns the synthetic checks of a generic JSON-RPC service.
+// Returns an error if any of the checks is invalid, or if check names are not unique.
+func (c QoSServiceConfig) buildChecks() ([]genericjsonrpc.Check, error) {
+	checks := make([]genericjsonrpc.Check, 0, len(c.Checks))
+	seenCheckNames := make(map[string]struct{}, len(c.Checks))
+	for _, checkConfig := range c.Checks {
+		check := genericjsonrpc.Check{
+			Name:          checkConfig.Name,
+			Method:        jsonrpc.Method(checkConfig.Method),
+			ResultPath:    checkConfig.ResultPath,
+			Assertion:     genericjsonrpc.AssertionType(checkConfig.Assertion),
+			ExpectedValue: checkConfig.ExpectedValue,
+			Tolerance:     checkConfig.Tolerance,
+		}
+
+		if checkConfig.Params != nil {
+			paramsBz, err := json.Marshal(checkConfig.Params)
+			if err != nil {
+				return nil, fmt.Errorf("invalid params for check %q: %w", checkConfig.Name, err)
+			}
+			check.Params = paramsBz
+		}
+
+		if err := check.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid check %q: %w", checkConfig.Name, err)
+		}
+
+		if _, found := seenCheckNames[check.Name]; found {
+			return nil, fmt.Errorf("duplicate check name %q", check.Name)
+		}
+		seenCheckNames[check.Name] = struct{}{}
+
+		checks = append(checks, check)
+	}
+
+	return checks, nil
+}
+
+// buildProbes returns the health probes of a generic REST service.
+// Returns an error if any of the probes is invalid, or if probe names are not unique.
+func (c QoSServiceConfig) buildProbes() ([]genericrest.Probe, error) {
+	probes := make([]genericrest.Probe, 0, len(c.Probes))
+	seenProbeNames := make(map[string]struct{}, len(c.Probes))
+	for _, probeConfig := range c.Probes {
+		probe := genericrest.Probe{
+			Name:                probeConfig.Name,
+			Path:                probeConfig.Path,
+			ExpectedStatusCodes: probeConfig.ExpectedStatusCodes,
+			HeightField:         probeConfig.HeightField,
+			HeightTolerance:     probeConfig.HeightTolerance,
+		}
+
+		for _, assertionConfig := range probeConfig.FieldAssertions {
+			probe.FieldAssertions = append(probe.FieldAssertions, genericrest.FieldAssertion{
+				Path:          assertionConfig.Path,
+				ExpectedValue: assertionConfig.ExpectedValue,
+			})
+		}
+
+		if err := probe.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid probe %q: %w", probeConfig.Name, err)
+		}
+
+		if _, found := seenProbeNames[probe.Name]; found {
+			return nil, fmt.Errorf("duplicate probe name %q", probe.Name)
+		}
+		seenProbeNames[probe.Name] = struct{}{}
+
+		probes = append(probes, probe)
+	}
+
+	return probes, nil
+}
+
+// buildServiceQoSConfig builds the QoS service config of the service.
+// The service config must be validated beforehand.
+func (c QoSServiceConfig) buildServiceQoSConfig() ServiceQoSConfig {
+	// Supported APIs are checked during validation.
+	supportedAPIs, _ := c.getSupportedAPIs()
+
+	switch c.QoSType {
+	case cosmos.QoSType:
+		return cosmos.NewCosmosSDKServiceQoSConfig(c.ServiceID, c.ChainID, c.EVMChainID, supportedAPIs, c.buildCosmosSDKOptions()...)
+
+	case genericjsonrpc.QoSType:
+		// Checks are validated during validation.
+		checks, _ := c.buildChecks()
+		return genericjsonrpc.NewGenericJSONRPCServiceQoSConfig(
+			c.ServiceID,
+			checks,
+			genericjsonrpc.WithMaxBatchSize(c.MaxBatchSize),
+			genericjsonrpc.WithMaxRequestBodyBytes(c.MaxRequestBodyBytes),
+		)
+
+	case genericrest.QoSType:
+		// Probes are validated during validation.
+		probes, _ := c.buildProbes()
+		return genericrest.NewGenericRESTServiceQoSConfig(
+			c.ServiceID,
+			probes,
+			genericrest.WithMaxServerErrorRate(c.MaxServerErrorRate),
+			genericrest.WithMaxRequestBodyBytes(c.MaxRequestBodyBytes),
+		)
+
+	case utxo.QoSType:
+		return utxo.NewUTXOServiceQoSConfig(
+			c.ServiceID,
+			c.ChainID,
+			utxo.WithSyncAllowance(c.SyncAllowance),
+			utxo.WithMaxBatchSize(c.MaxBatchSize),
+			utxo.WithMaxRequestBodyBytes(c.MaxRequestBodyBytes),
+		)
+
+	case near.QoSType:
+		return near.NewNearServiceQoSConfig(c.ServiceID, c.ChainID, c.buildNearOptions()...)
+
+	case move.QoSType:
+		return move.NewMoveServiceQoSConfig(
+			c.ServiceID,
+			c.ChainID,
+			supportedAPIs,
+			move.WithSyncAllowance(c.SyncAllowance),
+			move.WithMaxBatchSize(c.MaxBatchSize),
+			move.WithMaxRequestBodyBytes(c.MaxRequestBodyBytes),
+		)
+
+	case solana.QoSType:
+		return solana.NewSolanaServiceQoSConfig(c.ServiceID, c.ChainID, c.buildSolanaOptions()...)
+
+	default:
+		return evm.NewEVMServiceQoSConfig(c.ServiceID, c.ChainID, nil, supportedAPIs, c.buildEVMOptions()...)
+	}
+}
+
+// buildEVMOptions returns the options of an EVM service, for the settings declared in the service config.
+// Settings which are not declared are left out, so the options can be applied on top of a compiled-in service config.
+func (c QoSServiceConfig) buildEVMOptions() []evm.EVMServiceQoSConfigOption {
+	var opts []evm.EVMServiceQoSConfigOption
+
+	if c.ChainID != "" {
+		opts = append(opts, evm.WithEVMChainID(c.ChainID))
+	}
+	if len(c.SupportedAPIs) > 0 {
+		// Supported APIs are checked during validation.
+		supportedAPIs, _ := c.getSupportedAPIs()
+		opts = append(opts, evm.WithSupportedAPIs(supportedAPIs))
+	}
+	if c.SyncAllowance != 0 {
+		opts = append(opts, evm.WithSyncAllowance(c.SyncAllowance))
+	}
+	if c.MaxBatchSize != 0 {
+		opts = append(opts, evm.WithMaxBatchSize(c.MaxBatchSize))
+	}
+	if c.MaxRequestBodyBytes != 0 {
+		opts = append(opts, evm.WithMaxRequestBodyBytes(c.MaxRequestBodyBytes))
+	}
+	if c.ExpectedBlockTime != 0 {
+		opts = append(opts, evm.WithExpectedBlockTime(c.ExpectedBlockTime))
+	}
+	if c.MinPeerCount != 0 {
+		opts = append(opts, evm.WithMinPeerCount(c.MinPeerCount))
+	}
+	if len(c.MethodTimeouts) > 0 {
+		opts = append(opts, evm.WithMethodTimeouts(c.MethodTimeouts))
+	}
+	if c.GetLogsBlockRangeChunkSize != 0 {
+		opts = append(opts, evm.WithGetLogsBlockRangeChunkSize(c.GetLogsBlockRangeChunkSize))
+	}
+	if c.TxBroadcastNumEndpoints != 0 {
+		opts = append(opts, evm.WithTxBroadcastNumEndpoints(c.TxBroadcastNumEndpoints))
+	}
+	if c.BatchNumEndpoints != 0 {
+		opts = append(opts, evm.WithBatchNumEndpoints(c.BatchNumEndpoints))
+	}
+
+	if c.BlockTagPinning != nil {
+		opts = append(opts, evm.WithBlockTagPinning(c.BlockTagPinning.Lag))
+	}
+
+	if c.CoalescedMethods != nil {
+		opts = append(opts, evm.WithCoalescedMethods(c.CoalescedMethods...))
+	}
+
+	if c.ConsensusRead != nil {
+		if len(c.ConsensusRead.Methods) > 0 {
+			opts = append(opts, evm.WithConsensusReadMethods(c.ConsensusRead.NumEndpoints, c.ConsensusRead.Quorum, c.ConsensusRead.Methods...))
+		}
+		if c.ConsensusRead.AllowClientHeader {
+			opts = append(opts, evm.WithConsensusReadHeader())
+		}
+	}
+
+	if c.ArchivalCheck != nil {
+		// The archival probes are validated by Validate: no error is expected here.
+		archivalProbes, _ := c.ArchivalCheck.buildArchivalProbes()
+
+		// The threshold options must follow the archival check they apply to.
+		opts = append(opts,
+			evm.WithArchivalCheck(evm.NewEVMArchivalProbesCheckConfig(archivalProbes...)),
+			evm.WithArchivalThreshold(c.ArchivalCheck.Threshold),
+			evm.WithArchivalConsensusThreshold(c.ArchivalCheck.ConsensusThreshold),
+		)
+	}
+
+	return opts
+}
+
+// buildCosmosSDKOptions returns the options of a CosmosSDK service, for the settings declared in the service config.
+// Settings which are not declared are left out, so the options can be applied on top of a compiled-in service config.
+func (c QoSServiceConfig) buildCosmosSDKOptions() []cosmos.CosmosSDKServiceQoSConfigOption {
+	var opts []cosmos.CosmosSDKServiceQoSConfigOption
+
+	if c.ChainID != "" {
+		opts = append(opts, cosmos.WithCosmosSDKChainID(c.ChainID))
+	}
+	if c.EVMChainID != "" {
+		opts = append(opts, cosmos.WithEVMChainID(c.EVMChainID))
+	}
+	if len(c.SupportedAPIs) > 0 {
+		// Supported APIs are checked during validation.
+		supportedAPIs, _ := c.getSupportedAPIs()
+		opts = append(opts, cosmos.WithSupportedAPIs(supportedAPIs))
+	}
+	if c.SyncAllowance != 0 {
+		opts = append(opts, cosmos.WithSyncAllowance(c.SyncAllowance))
+	}
+	if c.MaxBatchSize != 0 {
+		opts = append(opts, cosmos.WithMaxBatchSize(c.MaxBatchSize))
+	}
+	if c.MaxRequestBodyBytes != 0 {
+		opts = append(opts, cosmos.WithMaxRequestBodyBytes(c.MaxRequestBodyBytes))
+	}
+	if c.ExpectedBlockTime != 0 {
+		opts = append(opts, cosmos.WithExpectedBlockTime(c.ExpectedBlockTime))
+	}
+	if c.TxBroadcastNumEndpoints != 0 {
+		opts = append(opts, cosmos.WithTxBroadcastNumEndpoints(c.TxBroadcastNumEndpoints))
+	}
+	if c.BatchNumEndpoints != 0 {
+		opts = append(opts, cosmos.WithBatchNumEndpoints(c.BatchNumEndpoints))
+	}
+
+	if c.ArchivalCheck != nil {
+		opts = append(opts, cosmos.WithArchivalCheck(cosmos.NewCosmosArchivalCheckConfig(c.ArchivalCheck.BlockHeight)))
+	}
+
+	return opts
+}
+
+// buildSolanaOptions returns the options of a Solana service, for the settings declared in the service config.
+// Settings which are not declared are left out, so the options can be applied on top of a compiled-in service config.
+func (c QoSServiceConfig) buildSolanaOptions() []solana.SolanaServiceQoSConfigOption {
+	var opts []solana.SolanaServiceQoSConfigOption
+
+	if c.ChainID != "" {
+		opts = append(opts, solana.WithChainID(c.ChainID))
+	}
+	if c.SyncAllowance != 0 {
+		opts = append(opts, solana.WithSlotLagTolerance(c.SyncAllowance))
+	}
+	if c.MaxBatchSize != 0 {
+		opts = append(opts, solana.WithMaxBatchSize(c.MaxBatchSize))
+	}
+	if c.MaxRequestBodyBytes != 0 {
+		opts = append(opts, solana.WithMaxRequestBodyBytes(c.MaxRequestBodyBytes))
+	}
+	if c.ExpectedBlockTime != 0 {
+		opts = append(opts, solana.WithExpectedBlockTime(c.ExpectedBlockTime))
+	}
+	if len(c.MethodTimeouts) > 0 {
+		opts = append(opts, solana.WithMethodTimeouts(c.MethodTimeouts))
+	}
+	if c.TxBroadcastNumEndpoints != 0 {
+		opts = append(opts, solana.WithTxBroadcastNumEndpoints(c.TxBroadcastNumEndpoints))
+	}
+	if c.BatchNumEndpoints != 0 {
+		opts = append(opts, solana.WithBatchNumEndpoints(c.BatchNumEndpoints))
+	}
+
+	if c.ArchivalCheck != nil {
+		opts = append(opts, solana.WithArchivalCheck(c.ArchivalCheck.Threshold))
+	}
+
+	if c.CoalescedMethods != nil {
+		opts = append(opts, solana.WithCoalescedMethods(c.CoalescedMethods...))
+	}
+
+	return opts
+}
+
+// buildNearOptions returns the options of a NEAR service, for the settings declared in the service config.
+// Settings which are not declared are left out, so the options can be applied on top of a compiled-in service config.
+func (c QoSServiceConfig) buildNearOptions() []near.NearServiceQoSConfigOption {
+	var opts []near.NearServiceQoSConfigOption
+
+	if c.ChainID != "" {
+		opts = append(opts, near.WithChainID(c.ChainID))
+	}
+	if c.SyncAllowance != 0 {
+		opts = append(opts, near.WithSyncAllowance(c.SyncAllowance))
+	}
+	if c.MaxRequestBodyBytes != 0 {
+		opts = append(opts, near.WithMaxRequestBodyBytes(c.MaxRequestBodyBytes))
+	}
+
+	return opts
+}
+
+// mergeOverServiceQoSConfig returns the compiled-in service config, with the settings declared in the service config applied on top:
+//   - Settings which are not declared keep their compiled-in value, e.g. the archival check or sync allowance.
+//   - The compiled-in service config is replaced entirely if its QoS type differs from the declared one.
+//
+// The service config must be validated beforehand.
+func (c QoSServiceConfig) mergeOverServiceQoSConfig(builtInService ServiceQoSConfig) ServiceQoSConfig {
+	if builtInService.GetServiceQoSType() != c.QoSType {
+		return c.buildServiceQoSConfig()
+	}
+
+	switch builtInService := builtInService.(type) {
+	case evm.EVMServiceQoSConfig:
+		return evm.OverrideEVMServiceQoSConfig(builtInService, c.buildEVMOptions()...)
+	case cosmos.CosmosSDKServiceQoSConfig:
+		return cosmos.OverrideCosmosSDKServiceQoSConfig(builtInService, c.buildCosmosSDKOptions()...)
+	case solana.SolanaServiceQoSConfig:
+		return solana.OverrideSolanaServiceQoSConfig(builtInService, c.buildSolanaOptions()...)
+	case near.NearServiceQoSConfig:
+		return near.OverrideNearServiceQoSConfig(builtInService, c.buildNearOptions()...)
+	default:
+		// No compiled-in services of the other QoS types: nothing to merge with.
+		return c.buildServiceQoSConfig()
+	}
+}
+
+// mergeServiceQoSConfigs merges the services declared in the QoS config with the compiled-in services:
+//   - Compiled-in services overridden by the QoS config keep their position, with the declared settings merged over them.
+//   - New services are appended, in the order they are declared.
+func mergeServiceQoSConfigs(builtInServices []ServiceQoSConfig, qosConfig QoSConfig) []ServiceQoSConfig {
+	if len(qosConfig.Services) == 0 {
+		return builtInServices
+	}
+
+	declaredServices := make(map[protocol.ServiceID]QoSServiceConfig, len(qosConfig.Services))
+	for _, service := range qosConfig.Services {
+		declaredServices[service.ServiceID] = service
+	}
+
+	mergedServices := make([]ServiceQoSConfig, 0, len(builtInServices)+len(qosConfig.Services))
+	for _, builtInService := range builtInServices {
+		serviceID := builtInService.GetServiceID()
+		if declaredService, found := declaredServices[serviceID]; found {
+			mergedServices = append(mergedServices, declaredService.mergeOverServiceQoSConfig(builtInService))
+			delete(declaredServices, serviceID)
+			continue
+		}
+		mergedServices = append(mergedServices, builtInService)
+	}
+
+	// Append the new services in declaration order.
+	for _, service := range qosConfig.Services {
+		if declaredService, found := declaredServices[service.ServiceID]; found {
+			mergedServices = append(mergedServices, declaredService.buildServiceQoSConfig())
+		}
+	}
+
+	return mergedServices
+}
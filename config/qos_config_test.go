@@ -0,0 +1,1004 @@
+package config
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/buildwithgrove/path/gateway"
+	"github.com/buildwithgrove/path/qos"
+	"github.com/buildwithgrove/path/qos/cosmos"
+	"github.com/buildwithgrove/path/qos/evm"
+	"github.com/buildwithgrove/path/qos/near"
+	"github.com/buildwithgrove/path/qos/solana"
+)
+
+func Test_QoSConfig_validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		yamlData string
+		wantErr  bool
+	}{
+		{
+			name: "should accept valid services of every QoS type",
+			yamlData: `
+services:
+  - service_id: eth
+    qos_type: evm
+    chain_id: "0x1"
+    sync_allowance: 10
+    expected_block_time: 12s
+    min_peer_count: 3
+    method_timeouts:
+      eth_getLogs: 90s
+      eth_blockNumber: 5s
+    get_logs_block_range_chunk_size: 2000
+    tx_broadcast_num_endpoints: 5
+    batch_num_endpoints: 2
+    coalesced_methods: ["eth_blockNumber", "eth_chainId"]
+    block_tag_pinning:
+      lag: 2
+    consensus_read:
+      methods: ["eth_call"]
+      num_endpoints: 3
+      allow_client_header: true
+    archival_check:
+      contract_address: "0x28C6c06298d514Db089934071355E5743bf21d60"
+      contract_start_block: 12300000
+      consensus_threshold: 3
+      probes:
+        - name: usdc_code
+          method: eth_getCode
+          contract_address: "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+          contract_start_block: 6082465
+        - name: usdc_total_supply
+          method: eth_call
+          contract_address: "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+          contract_start_block: 6082465
+          data: "0x18160ddd"
+  - service_id: base
+    qos_type: evm
+    chain_id: "0x2105"
+    archival_check:
+      probes:
+        - name: weth_balance
+          method: eth_getBalance
+          contract_address: "0x4200000000000000000000000000000000000006"
+          contract_start_block: 1
+  - service_id: xrplevm
+    qos_type: cosmossdk
+    chain_id: xrplevm_1440000-1
+    evm_chain_id: "0x15f900"
+    supported_apis: ["json_rpc", "rest", "comet_bft"]
+    archival_check:
+      block_height: 1000000
+  - service_id: solana
+    qos_type: solana
+    chain_id: solana
+    sync_allowance: 150
+    method_timeouts:
+      getProgramAccounts: 30s
+    coalesced_methods: ["getSlot"]
+    archival_check:
+      threshold: 432000
+  - service_id: ltc
+    qos_type: utxo
+    chain_id: main
+    sync_allowance: 3
+  - service_id: near
+    qos_type: near
+    chain_id: mainnet
+  - service_id: sui
+    qos_type: move
+    chain_id: 35834a8a
+  - service_id: aptos
+    qos_type: move
+    chain_id: "1"
+    supported_apis: ["rest"]
+  - service_id: btc
+    qos_type: generic_jsonrpc
+    checks:
+      - name: block_height
+        method: getblockcount
+        assertion: hex_number_near_max
+        tolerance: 2
+      - name: chain
+        method: getblockchaininfo
+        params: []
+        result_path: chain
+        assertion: equals
+        expected_value: main
+  - service_id: indexer
+    qos_type: generic_rest
+    max_server_error_rate: 0.3
+    probes:
+      - name: status
+        path: /status
+        field_assertions:
+          - path: sync_info.catching_up
+            expected_value: "false"
+        height_field: sync_info.latest_block_height
+        height_tolerance: 5
+`,
+		},
+		{
+			name: "should return error for unsupported QoS type",
+			yamlData: `
+services:
+  - service_id: eth
+    qos_type: bitcoin
+    chain_id: "0x1"
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for missing chain ID",
+			yamlData: `
+services:
+  - service_id: eth
+    qos_type: evm
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for a NEAR service with a max batch size",
+			yamlData: `
+services:
+  - service_id: near
+    qos_type: near
+    chain_id: mainnet
+    max_batch_size: 10
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for a Move service with an unsupported RPC type",
+			yamlData: `
+services:
+  - service_id: sui
+    qos_type: move
+    chain_id: 35834a8a
+    supported_apis: ["json_rpc", "comet_bft"]
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for a UTXO service with no chain name",
+			yamlData: `
+services:
+  - service_id: btc
+    qos_type: utxo
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for unsupported RPC type",
+			yamlData: `
+services:
+  - service_id: eth
+    qos_type: evm
+    chain_id: "0x1"
+    supported_apis: ["graphql"]
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for incomplete archival check",
+			yamlData: `
+services:
+  - service_id: eth
+    qos_type: evm
+    chain_id: "0x1"
+    archival_check:
+      contract_address: "0x28C6c06298d514Db089934071355E5743bf21d60"
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for an archival probe with an unsupported method",
+			yamlData: `
+services:
+  - service_id: eth
+    qos_type: evm
+    chain_id: "0x1"
+    archival_check:
+      probes:
+        - name: logs
+          method: eth_getLogs
+          contract_address: "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+          contract_start_block: 6082465
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for an eth_call archival probe without call data",
+			yamlData: `
+services:
+  - service_id: eth
+    qos_type: evm
+    chain_id: "0x1"
+    archival_check:
+      probes:
+        - name: usdc_total_supply
+          method: eth_call
+          contract_address: "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+          contract_start_block: 6082465
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for duplicate archival probe names",
+			yamlData: `
+services:
+  - service_id: eth
+    qos_type: evm
+    chain_id: "0x1"
+    archival_check:
+      contract_address: "0x28C6c06298d514Db089934071355E5743bf21d60"
+      contract_start_block: 12300000
+      probes:
+        - name: balance
+          method: eth_getCode
+          contract_address: "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+          contract_start_block: 6082465
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for Solana archival check with a consensus threshold",
+			yamlData: `
+services:
+  - service_id: solana
+    qos_type: solana
+    chain_id: solana
+    archival_check:
+      consensus_threshold: 3
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for CosmosSDK archival check with a contract",
+			yamlData: `
+services:
+  - service_id: osmosis
+    qos_type: cosmossdk
+    chain_id: osmosis-1
+    archival_check:
+      contract_address: "0x28C6c06298d514Db089934071355E5743bf21d60"
+      contract_start_block: 1
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for CosmosSDK archival check without comet_bft support",
+			yamlData: `
+services:
+  - service_id: osmosis
+    qos_type: cosmossdk
+    chain_id: osmosis-1
+    supported_apis: ["rest"]
+    archival_check:
+      block_height: 1000000
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for archival check on an unsupported service",
+			yamlData: `
+services:
+  - service_id: ltc
+    qos_type: utxo
+    chain_id: main
+    archival_check:
+      block_height: 1000000
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for Solana archival check with a contract",
+			yamlData: `
+services:
+  - service_id: solana
+    qos_type: solana
+    chain_id: solana
+    archival_check:
+      contract_address: "0x28C6c06298d514Db089934071355E5743bf21d60"
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for expected block time on an unsupported service",
+			yamlData: `
+services:
+  - service_id: ltc
+    qos_type: utxo
+    chain_id: main
+    expected_block_time: 150s
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for min peer count on a non-EVM service",
+			yamlData: `
+services:
+  - service_id: solana
+    qos_type: solana
+    chain_id: solana
+    min_peer_count: 3
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for method timeouts on an unsupported service",
+			yamlData: `
+services:
+  - service_id: ltc
+    qos_type: utxo
+    chain_id: main
+    method_timeouts:
+      getblock: 30s
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for a method timeout above the maximum",
+			yamlData: `
+services:
+  - service_id: eth
+    qos_type: evm
+    chain_id: "0x1"
+    method_timeouts:
+      debug_traceTransaction: 5m
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for eth_getLogs splitting on a non-EVM service",
+			yamlData: `
+services:
+  - service_id: solana
+    qos_type: solana
+    chain_id: solana
+    get_logs_block_range_chunk_size: 2000
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for tx broadcast endpoints on an unsupported service",
+			yamlData: `
+services:
+  - service_id: ltc
+    qos_type: utxo
+    chain_id: main
+    tx_broadcast_num_endpoints: 5
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for batch endpoints on an unsupported service",
+			yamlData: `
+services:
+  - service_id: sui
+    qos_type: move
+    chain_id: 35834a8a
+    batch_num_endpoints: 2
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for too many batch endpoints",
+			yamlData: `
+services:
+  - service_id: eth
+    qos_type: evm
+    chain_id: "0x1"
+    batch_num_endpoints: 50
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for coalesced methods on an unsupported service",
+			yamlData: `
+services:
+  - service_id: ltc
+    qos_type: utxo
+    chain_id: main
+    coalesced_methods: ["getblockcount"]
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for an empty coalesced method",
+			yamlData: `
+services:
+  - service_id: eth
+    qos_type: evm
+    chain_id: "0x1"
+    coalesced_methods: [""]
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for too many tx broadcast endpoints",
+			yamlData: `
+services:
+  - service_id: eth
+    qos_type: evm
+    chain_id: "0x1"
+    tx_broadcast_num_endpoints: 50
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for consensus-read methods without a number of endpoints",
+			yamlData: `
+services:
+  - service_id: eth
+    qos_type: evm
+    chain_id: "0x1"
+    consensus_read:
+      methods: ["eth_call"]
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for consensus-read with a quorum above the number of endpoints",
+			yamlData: `
+services:
+  - service_id: eth
+    qos_type: evm
+    chain_id: "0x1"
+    consensus_read:
+      methods: ["eth_call"]
+      num_endpoints: 3
+      quorum: 4
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for consensus-read on a non-EVM service",
+			yamlData: `
+services:
+  - service_id: solana
+    qos_type: solana
+    chain_id: solana
+    consensus_read:
+      allow_client_header: true
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for block tag pinning on a non-EVM service",
+			yamlData: `
+services:
+  - service_id: osmosis
+    qos_type: cosmossdk
+    chain_id: osmosis-1
+    block_tag_pinning:
+      lag: 2
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for generic JSON-RPC service with no checks",
+			yamlData: `
+services:
+  - service_id: btc
+    qos_type: generic_jsonrpc
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for generic JSON-RPC check with unsupported assertion",
+			yamlData: `
+services:
+  - service_id: btc
+    qos_type: generic_jsonrpc
+    checks:
+      - name: block_height
+        method: getblockcount
+        assertion: greater_than
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for duplicate generic JSON-RPC check names",
+			yamlData: `
+services:
+  - service_id: btc
+    qos_type: generic_jsonrpc
+    checks:
+      - name: block_height
+        method: getblockcount
+        assertion: non_empty
+      - name: block_height
+        method: getblockchaininfo
+        assertion: non_empty
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for checks on a non-generic JSON-RPC service",
+			yamlData: `
+services:
+  - service_id: eth
+    qos_type: evm
+    chain_id: "0x1"
+    checks:
+      - name: block_height
+        method: eth_blockNumber
+        assertion: non_empty
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for generic REST service with no probes",
+			yamlData: `
+services:
+  - service_id: indexer
+    qos_type: generic_rest
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for generic REST probe with a relative path",
+			yamlData: `
+services:
+  - service_id: indexer
+    qos_type: generic_rest
+    probes:
+      - name: status
+        path: status
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for max server error rate above 1",
+			yamlData: `
+services:
+  - service_id: indexer
+    qos_type: generic_rest
+    max_server_error_rate: 1.5
+    probes:
+      - name: status
+        path: /status
+`,
+			wantErr: true,
+		},
+		{
+			name: "should return error for duplicate service IDs",
+			yamlData: `
+services:
+  - service_id: eth
+    qos_type: evm
+    chain_id: "0x1"
+  - service_id: eth
+    qos_type: evm
+    chain_id: "0x1"
+`,
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := require.New(t)
+
+			var qosConfig QoSConfig
+			c.NoError(yaml.Unmarshal([]byte(test.yamlData), &qosConfig))
+
+			err := qosConfig.validate()
+			if test.wantErr {
+				c.Error(err)
+			} else {
+				c.NoError(err)
+			}
+		})
+	}
+}
+
+func Test_mergeServiceQoSConfigs(t *testing.T) {
+	c := require.New(t)
+
+	builtInServices := []ServiceQoSConfig{
+		evm.NewEVMServiceQoSConfig("eth", "0x1", nil, nil),
+		cosmos.NewCosmosSDKServiceQoSConfig("osmosis", "osmosis-1", "", nil),
+		solana.NewSolanaServiceQoSConfig("solana", "solana"),
+	}
+
+	qosConfig := QoSConfig{
+		Services: []QoSServiceConfig{
+			{ServiceID: "new-chain", QoSType: evm.QoSType, ChainID: "0x2a"},
+			{ServiceID: "osmosis", QoSType: cosmos.QoSType, ChainID: "osmosis-2"},
+		},
+	}
+	c.NoError(qosConfig.validate())
+
+	mergedServices := mergeServiceQoSConfigs(builtInServices, qosConfig)
+	c.Len(mergedServices, 4)
+
+	// Overridden services keep their position, new services are appended.
+	c.Equal(builtInServices[0], mergedServices[0])
+	c.Equal(cosmos.NewCosmosSDKServiceQoSConfig("osmosis", "osmosis-2", "", nil), mergedServices[1])
+	c.Equal(builtInServices[2], mergedServices[2])
+	c.EqualValues("new-chain", mergedServices[3].GetServiceID())
+	c.Equal(evm.QoSType, mergedServices[3].GetServiceQoSType())
+
+	// The compiled-in services are returned as-is if no services are declared.
+	c.Equal(builtInServices, mergeServiceQoSConfigs(builtInServices, QoSConfig{}))
+}
+
+func Test_mergeServiceQoSConfigs_Override(t *testing.T) {
+	jsonRPC := map[sharedtypes.RPCType]struct{}{sharedtypes.RPCType_JSON_RPC: {}}
+
+	tests := []struct {
+		name            string
+		builtInService  ServiceQoSConfig
+		declaredService QoSServiceConfig
+		expectedService ServiceQoSConfig
+	}{
+		{
+			name: "overriding a single field of an EVM service keeps its archival check, sync allowance and supported APIs",
+			builtInService: evm.NewEVMServiceQoSConfig(
+				"eth", "0x1",
+				evm.NewEVMArchivalCheckConfig("0x28C6c06298d514Db089934071355E5743bf21d60", 12_300_000),
+				jsonRPC,
+				evm.WithSyncAllowance(10),
+			),
+			declaredService: QoSServiceConfig{
+				ServiceID:       "eth",
+				QoSType:         evm.QoSType,
+				ChainID:         "0x1",
+				BlockTagPinning: &QoSBlockTagPinningConfig{Lag: 2},
+			},
+			expectedService: evm.NewEVMServiceQoSConfig(
+				"eth", "0x1",
+				evm.NewEVMArchivalCheckConfig("0x28C6c06298d514Db089934071355E5743bf21d60", 12_300_000),
+				jsonRPC,
+				evm.WithSyncAllowance(10),
+				evm.WithBlockTagPinning(2),
+			),
+		},
+		{
+			name: "declared fields replace the compiled-in values of a CosmosSDK service",
+			builtInService: cosmos.NewCosmosSDKServiceQoSConfig(
+				"osmosis", "osmosis-1", "", nil,
+				cosmos.WithSyncAllowance(10),
+				cosmos.WithArchivalCheck(cosmos.NewCosmosArchivalCheckConfig(100)),
+			),
+			declaredService: QoSServiceConfig{
+				ServiceID:     "osmosis",
+				QoSType:       cosmos.QoSType,
+				ChainID:       "osmosis-1",
+				SyncAllowance: 20,
+			},
+			expectedService: cosmos.NewCosmosSDKServiceQoSConfig(
+				"osmosis", "osmosis-1", "", nil,
+				cosmos.WithSyncAllowance(20),
+				cosmos.WithArchivalCheck(cosmos.NewCosmosArchivalCheckConfig(100)),
+			),
+		},
+		{
+			name:           "overriding a single field of a Solana service keeps its archival check",
+			builtInService: solana.NewSolanaServiceQoSConfig("solana", "solana", solana.WithArchivalCheck(0)),
+			declaredService: QoSServiceConfig{
+				ServiceID:               "solana",
+				QoSType:                 solana.QoSType,
+				ChainID:                 "solana",
+				TxBroadcastNumEndpoints: 5,
+			},
+			expectedService: solana.NewSolanaServiceQoSConfig("solana", "solana", solana.WithArchivalCheck(0), solana.WithTxBroadcastNumEndpoints(5)),
+		},
+		{
+			name:           "a service declared with a different QoS type replaces the compiled-in service",
+			builtInService: evm.NewEVMServiceQoSConfig("eth", "0x1", evm.NewEVMArchivalCheckConfig("0x28C6c06298d514Db089934071355E5743bf21d60", 12_300_000), jsonRPC),
+			declaredService: QoSServiceConfig{
+				ServiceID: "eth",
+				QoSType:   near.QoSType,
+				ChainID:   "mainnet",
+			},
+			expectedService: near.NewNearServiceQoSConfig("eth", "mainnet"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			qosConfig := QoSConfig{Services: []QoSServiceConfig{tt.declaredService}}
+			c.NoError(qosConfig.validate())
+
+			mergedServices := mergeServiceQoSConfigs([]ServiceQoSConfig{tt.builtInService}, qosConfig)
+			c.Equal([]ServiceQoSConfig{tt.expectedService}, mergedServices)
+		})
+	}
+}
+
+func Test_QoSServiceConfig_buildServiceQoSConfig_EVM(t *testing.T) {
+	tests := []struct {
+		name                      string
+		yamlData                  string
+		requestHeaders            map[string]string
+		requestBody               string
+		wantNumPayloads           int
+		wantBroadcastNumEndpoints uint
+		wantConsensusNumEndpoints uint
+		wantBatchNumEndpoints     uint
+		wantCoalescable           bool
+	}{
+		{
+			name: "should not split eth_getLogs requests by default",
+			yamlData: `
+service_id: eth
+qos_type: evm
+chain_id: "0x1"
+`,
+			requestBody:     `{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[{"fromBlock":"0x1","toBlock":"0x1388"}]}`,
+			wantNumPayloads: 1,
+		},
+		{
+			name: "should split eth_getLogs requests into chunks of the configured block range",
+			yamlData: `
+service_id: eth
+qos_type: evm
+chain_id: "0x1"
+get_logs_block_range_chunk_size: 2000
+`,
+			requestBody:     `{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[{"fromBlock":"0x1","toBlock":"0x1388"}]}`,
+			wantNumPayloads: 3,
+		},
+		{
+			name: "should broadcast transactions to the default number of endpoints",
+			yamlData: `
+service_id: eth
+qos_type: evm
+chain_id: "0x1"
+`,
+			requestBody:               `{"jsonrpc":"2.0","id":1,"method":"eth_sendRawTransaction","params":["0x02f8"]}`,
+			wantNumPayloads:           1,
+			wantBroadcastNumEndpoints: qos.DefaultTxBroadcastNumEndpoints,
+		},
+		{
+			name: "should broadcast transactions to the configured number of endpoints",
+			yamlData: `
+service_id: eth
+qos_type: evm
+chain_id: "0x1"
+tx_broadcast_num_endpoints: 5
+`,
+			requestBody:               `{"jsonrpc":"2.0","id":1,"method":"eth_sendRawTransaction","params":["0x02f8"]}`,
+			wantNumPayloads:           1,
+			wantBroadcastNumEndpoints: 5,
+		},
+		{
+			name: "should send configured methods in consensus-read mode",
+			yamlData: `
+service_id: eth
+qos_type: evm
+chain_id: "0x1"
+consensus_read:
+  methods: ["eth_call"]
+  num_endpoints: 3
+`,
+			requestBody:               `{"jsonrpc":"2.0","id":1,"method":"eth_call","params":[{"to":"0xdead"},"0x10"]}`,
+			wantNumPayloads:           1,
+			wantConsensusNumEndpoints: 3,
+		},
+		{
+			name: "should ignore the consensus-read header by default",
+			yamlData: `
+service_id: eth
+qos_type: evm
+chain_id: "0x1"
+`,
+			requestHeaders:  map[string]string{qos.HTTPHeaderConsensusRead: "7"},
+			requestBody:     `{"jsonrpc":"2.0","id":1,"method":"eth_getBalance","params":["0xdead","0x10"]}`,
+			wantNumPayloads: 1,
+		},
+		{
+			name: "should honor the consensus-read header if allowed for the service",
+			yamlData: `
+service_id: eth
+qos_type: evm
+chain_id: "0x1"
+consensus_read:
+  allow_client_header: true
+`,
+			requestHeaders:            map[string]string{qos.HTTPHeaderConsensusRead: "5"},
+			requestBody:               `{"jsonrpc":"2.0","id":1,"method":"eth_getBalance","params":["0xdead","0x10"]}`,
+			wantNumPayloads:           1,
+			wantConsensusNumEndpoints: 5,
+		},
+		{
+			name: "should distribute batch requests across the default number of endpoints",
+			yamlData: `
+service_id: eth
+qos_type: evm
+chain_id: "0x1"
+`,
+			requestBody:           `[{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},{"jsonrpc":"2.0","id":2,"method":"eth_chainId"},{"jsonrpc":"2.0","id":3,"method":"eth_chainId"},{"jsonrpc":"2.0","id":4,"method":"eth_chainId"}]`,
+			wantNumPayloads:       4,
+			wantBatchNumEndpoints: qos.DefaultBatchNumEndpoints,
+		},
+		{
+			name: "should send batch requests to a single endpoint if configured",
+			yamlData: `
+service_id: eth
+qos_type: evm
+chain_id: "0x1"
+batch_num_endpoints: 1
+`,
+			requestBody:           `[{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},{"jsonrpc":"2.0","id":2,"method":"eth_chainId"},{"jsonrpc":"2.0","id":3,"method":"eth_chainId"},{"jsonrpc":"2.0","id":4,"method":"eth_chainId"}]`,
+			wantNumPayloads:       4,
+			wantBatchNumEndpoints: 1,
+		},
+		{
+			name: "should coalesce the default methods",
+			yamlData: `
+service_id: eth
+qos_type: evm
+chain_id: "0x1"
+`,
+			requestBody:     `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`,
+			wantNumPayloads: 1,
+			wantCoalescable: true,
+		},
+		{
+			name: "should coalesce the configured methods",
+			yamlData: `
+service_id: eth
+qos_type: evm
+chain_id: "0x1"
+coalesced_methods: ["eth_getBalance"]
+`,
+			requestBody:     `{"jsonrpc":"2.0","id":1,"method":"eth_getBalance","params":["0xdead","0x10"]}`,
+			wantNumPayloads: 1,
+			wantCoalescable: true,
+		},
+		{
+			name: "should not coalesce default methods missing from the configured methods",
+			yamlData: `
+service_id: eth
+qos_type: evm
+chain_id: "0x1"
+coalesced_methods: ["eth_getBalance"]
+`,
+			requestBody:     `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`,
+			wantNumPayloads: 1,
+		},
+		{
+			name: "should not coalesce any method if the configured methods are empty",
+			yamlData: `
+service_id: eth
+qos_type: evm
+chain_id: "0x1"
+coalesced_methods: []
+`,
+			requestBody:     `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`,
+			wantNumPayloads: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := require.New(t)
+
+			var serviceConfig QoSServiceConfig
+			c.NoError(yaml.Unmarshal([]byte(test.yamlData), &serviceConfig))
+			c.NoError(serviceConfig.validate())
+
+			evmConfig, ok := serviceConfig.buildServiceQoSConfig().(evm.EVMServiceQoSConfig)
+			c.True(ok)
+			evmQoS := evm.NewQoSInstance(polyzero.NewLogger(), evmConfig)
+
+			httpReq, err := http.NewRequest(http.MethodPost, "/v1", strings.NewReader(test.requestBody))
+			c.NoError(err)
+			for key, value := range test.requestHeaders {
+				httpReq.Header.Set(key, value)
+			}
+
+			requestQoSCtx, ok := evmQoS.ParseHTTPRequest(context.Background(), httpReq)
+			c.True(ok)
+			c.Len(requestQoSCtx.GetServicePayloads(), test.wantNumPayloads)
+
+			broadcastCtx, ok := requestQoSCtx.(gateway.BroadcastQoSContext)
+			c.True(ok)
+			c.Equal(test.wantBroadcastNumEndpoints, broadcastCtx.GetBroadcastNumEndpoints())
+
+			consensusCtx, ok := requestQoSCtx.(gateway.ConsensusQoSContext)
+			c.True(ok)
+			c.Equal(test.wantConsensusNumEndpoints, consensusCtx.GetConsensusNumEndpoints())
+
+			batchCtx, ok := requestQoSCtx.(gateway.BatchQoSContext)
+			c.True(ok)
+			c.Equal(test.wantBatchNumEndpoints, batchCtx.GetBatchNumEndpoints())
+
+			coalescableCtx, ok := requestQoSCtx.(gateway.CoalescableQoSContext)
+			c.True(ok)
+			_, coalescable := coalescableCtx.GetCoalescingKey()
+			c.Equal(test.wantCoalescable, coalescable)
+		})
+	}
+}
+
+func Test_QoSServiceConfig_buildServiceQoSConfig_Solana(t *testing.T) {
+	tests := []struct {
+		name            string
+		yamlData        string
+		requestBody     string
+		wantCoalescable bool
+	}{
+		{
+			name: "should coalesce the default methods",
+			yamlData: `
+service_id: solana
+qos_type: solana
+chain_id: solana
+`,
+			requestBody:     `{"jsonrpc":"2.0","id":1,"method":"getSlot"}`,
+			wantCoalescable: true,
+		},
+		{
+			name: "should coalesce the configured methods",
+			yamlData: `
+service_id: solana
+qos_type: solana
+chain_id: solana
+coalesced_methods: ["getBalance"]
+`,
+			requestBody:     `{"jsonrpc":"2.0","id":1,"method":"getBalance","params":["83astBRguLMdt2h5U1Tpdq5tjFoJ6noeGwaY3mDLVcri"]}`,
+			wantCoalescable: true,
+		},
+		{
+			name: "should not coalesce default methods missing from the configured methods",
+			yamlData: `
+service_id: solana
+qos_type: solana
+chain_id: solana
+coalesced_methods: ["getBalance"]
+`,
+			requestBody: `{"jsonrpc":"2.0","id":1,"method":"getSlot"}`,
+		},
+		{
+			name: "should not coalesce any method if the configured methods are empty",
+			yamlData: `
+service_id: solana
+qos_type: solana
+chain_id: solana
+coalesced_methods: []
+`,
+			requestBody: `{"jsonrpc":"2.0","id":1,"method":"getSlot"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := require.New(t)
+
+			var serviceConfig QoSServiceConfig
+			c.NoError(yaml.Unmarshal([]byte(test.yamlData), &serviceConfig))
+			c.NoError(serviceConfig.validate())
+
+			solanaConfig, ok := serviceConfig.buildServiceQoSConfig().(solana.SolanaServiceQoSConfig)
+			c.True(ok)
+			solanaQoS := solana.NewQoSInstance(polyzero.NewLogger(), solanaConfig)
+
+			httpReq, err := http.NewRequest(http.MethodPost, "/v1", strings.NewReader(test.requestBody))
+			c.NoError(err)
+
+			requestQoSCtx, ok := solanaQoS.ParseHTTPRequest(context.Background(), httpReq)
+			c.True(ok)
+
+			coalescableCtx, ok := requestQoSCtx.(gateway.CoalescableQoSContext)
+			c.True(ok)
+			_, coalescable := coalescableCtx.GetCoalescingKey()
+			c.Equal(test.wantCoalescable, coalescable)
+		})
+	}
+}
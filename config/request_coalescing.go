@@ -0,0 +1,10 @@
+package config
+
+/* --------------------------------- Request Coalescing Config Struct -------------------------------- */
+
+// RequestCoalescingConfig stores configuration settings for sharing a single relay among concurrent identical requests.
+// The methods eligible for coalescing are determined by each service's QoS instance.
+type RequestCoalescingConfig struct {
+	// Enables request coalescing: disabled by default.
+	Enabled bool `yaml:"enabled"`
+}
@@ -6,17 +6,28 @@ import (
 	"github.com/buildwithgrove/path/protocol"
 	"github.com/buildwithgrove/path/qos/cosmos"
 	"github.com/buildwithgrove/path/qos/evm"
+	"github.com/buildwithgrove/path/qos/genericjsonrpc"
+	"github.com/buildwithgrove/path/qos/genericrest"
+	"github.com/buildwithgrove/path/qos/move"
+	"github.com/buildwithgrove/path/qos/near"
 	"github.com/buildwithgrove/path/qos/solana"
+	"github.com/buildwithgrove/path/qos/utxo"
 )
 
 // How to add archival checks: https://path.grove.city/learn/qos/adding_new_archival
 
-// IMPORTANT: PATH requires service IDs to be registered here for Quality of Service (QoS) endpoint checks.
+// IMPORTANT: PATH requires service IDs to be registered here, or in the `qos_config` section of the
+// gateway config YAML, for Quality of Service (QoS) endpoint checks.
 // Unregistered services use NoOp QoS type with random endpoint selection and no monitoring.
 
 var _ ServiceQoSConfig = (evm.EVMServiceQoSConfig)(nil)
 var _ ServiceQoSConfig = (cosmos.CosmosSDKServiceQoSConfig)(nil)
 var _ ServiceQoSConfig = (solana.SolanaServiceQoSConfig)(nil)
+var _ ServiceQoSConfig = (genericjsonrpc.GenericJSONRPCServiceQoSConfig)(nil)
+var _ ServiceQoSConfig = (genericrest.GenericRESTServiceQoSConfig)(nil)
+var _ ServiceQoSConfig = (utxo.UTXOServiceQoSConfig)(nil)
+var _ ServiceQoSConfig = (near.NearServiceQoSConfig)(nil)
+var _ ServiceQoSConfig = (move.MoveServiceQoSConfig)(nil)
 
 type ServiceQoSConfig interface {
 	GetServiceID() protocol.ServiceID
@@ -29,8 +40,9 @@ type qosServiceConfigs struct {
 }
 
 // GetServiceConfigs returns the service configs for the provided protocol supported by the Gateway.
+// The compiled-in service configs are merged with, and overridden by, the services declared in the `qos_config` section.
 func (c qosServiceConfigs) GetServiceConfigs(config GatewayConfig) []ServiceQoSConfig {
-	return shannonServices
+	return mergeServiceQoSConfigs(c.shannonServices, config.QoSConfig)
 }
 
 // The QoSServiceConfigs map associates each supported service ID with a specific
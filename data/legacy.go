@@ -35,6 +35,14 @@ type legacyRecord struct {
 	NodeAddress            string  `json:"pokt_node_address"`    // Address of the endpoint that served the request.
 	NodeDomain             string  `json:"pokt_node_domain"`     // URL domain of the endpoint that served the request.
 
+	// Timing breakdown of the HTTP request to the endpoint that served the request, in seconds.
+	// Connection setup timings (DNS lookup, connect, TLS handshake) are 0 if an idle connection was reused.
+	NodeDNSLookupTime    float64 `json:"node_dns_lookup_time"`
+	NodeConnectTime      float64 `json:"node_connect_time"`
+	NodeTLSHandshakeTime float64 `json:"node_tls_handshake_time"`
+	NodeTimeToFirstByte  float64 `json:"node_time_to_first_byte"`
+	NodeConnectionReused bool    `json:"node_connection_reused"`
+
 	// internal fields used for tracking protocol and QoS data.
 	endpointTripTime float64 // endpoint response timestamp - endpoint query time, in seconds.
 }
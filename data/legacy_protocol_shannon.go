@@ -17,6 +17,7 @@ import (
 // - Request errors
 // - Endpoint observations and errors
 // - Timestamps for queries and responses
+// - HTTP request timing breakdown, e.g. DNS lookup, TLS handshake
 // - Endpoint location information
 //
 // Parameters:
@@ -106,6 +107,15 @@ func setLegacyFieldsFromHTTPObservations(
 	// track time spent waiting for the endpoint: required for calculating the `PortalTripTime` legacy field.
 	legacyRecord.endpointTripTime = endpointObservation.EndpointResponseTimestamp.AsTime().Sub(endpointObservation.EndpointQueryTimestamp.AsTime()).Seconds()
 
+	// Set the timing breakdown of the HTTP request to the endpoint, if available.
+	if httpTimings := endpointObservation.GetHttpTimings(); httpTimings != nil {
+		legacyRecord.NodeDNSLookupTime = httpTimings.GetDnsLookupSeconds()
+		legacyRecord.NodeConnectTime = httpTimings.GetConnectSeconds()
+		legacyRecord.NodeTLSHandshakeTime = httpTimings.GetTlsHandshakeSeconds()
+		legacyRecord.NodeTimeToFirstByte = httpTimings.GetTimeToFirstByteSeconds()
+		legacyRecord.NodeConnectionReused = httpTimings.GetConnectionReused()
+	}
+
 	// Set endpoint address to the supplier address.
 	// Will be "fallback" in the case of a request sent to a fallback endpoint.
 	legacyRecord.NodeAddress = endpointObservation.GetSupplier()
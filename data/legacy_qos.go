@@ -16,6 +16,11 @@ import (
 // - EVM observations (returns multiple records based on RequestObservations)
 // - Solana observations (returns single record)
 // - Cosmos SDK observations (returns multiple records based on RequestProfiles)
+// - Generic JSON-RPC observations (returns single record)
+// - Generic REST observations (returns single record)
+// - UTXO observations (returns single record)
+// - NEAR observations (returns single record)
+// - Move observations (returns single record)
 //
 // Parameters:
 // - logger: logging interface
@@ -45,6 +50,52 @@ func setLegacyFieldsFromQoSObservations(
 		return setLegacyFieldsFromQoSCosmosObservations(logger, baseLegacyRecord, cosmosObservations)
 	}
 
+	// Use generic JSON-RPC observations to update the legacy record's fields.
+	if genericJSONRPCObservations := observations.GetGenericJsonrpc(); genericJSONRPCObservations != nil {
+		// In bytes: the length of the request: float64 type is for compatibility with the legacy data pipeline.
+		baseLegacyRecord.RequestDataSize = float64(genericJSONRPCObservations.GetRequestPayloadLength())
+		// Empty for batch requests: the batch is relayed as a single payload.
+		baseLegacyRecord.ChainMethod = genericJSONRPCObservations.GetJsonrpcRequest().GetMethod()
+		return []*legacyRecord{baseLegacyRecord}
+	}
+
+	// Use generic REST observations to update the legacy record's fields.
+	if genericRESTObservations := observations.GetGenericRest(); genericRESTObservations != nil {
+		// In bytes: the length of the request: float64 type is for compatibility with the legacy data pipeline.
+		baseLegacyRecord.RequestDataSize = float64(genericRESTObservations.GetRequestPayloadLength())
+		baseLegacyRecord.ChainMethod = genericRESTObservations.GetRequestPath()
+		return []*legacyRecord{baseLegacyRecord}
+	}
+
+	// Use UTXO observations to update the legacy record's fields.
+	if utxoObservations := observations.GetUtxo(); utxoObservations != nil {
+		// In bytes: the length of the request: float64 type is for compatibility with the legacy data pipeline.
+		baseLegacyRecord.RequestDataSize = float64(utxoObservations.GetRequestPayloadLength())
+		// Empty for batch requests: the batch is relayed as a single payload.
+		baseLegacyRecord.ChainMethod = utxoObservations.GetJsonrpcRequest().GetMethod()
+		return []*legacyRecord{baseLegacyRecord}
+	}
+
+	// Use NEAR observations to update the legacy record's fields.
+	if nearObservations := observations.GetNear(); nearObservations != nil {
+		// In bytes: the length of the request: float64 type is for compatibility with the legacy data pipeline.
+		baseLegacyRecord.RequestDataSize = float64(nearObservations.GetRequestPayloadLength())
+		baseLegacyRecord.ChainMethod = nearObservations.GetJsonrpcRequest().GetMethod()
+		return []*legacyRecord{baseLegacyRecord}
+	}
+
+	// Use Move observations to update the legacy record's fields.
+	if moveObservations := observations.GetMove(); moveObservations != nil {
+		// In bytes: the length of the request: float64 type is for compatibility with the legacy data pipeline.
+		baseLegacyRecord.RequestDataSize = float64(moveObservations.GetRequestPayloadLength())
+		// The JSON-RPC method, or the path of REST requests: empty for JSON-RPC batch requests.
+		baseLegacyRecord.ChainMethod = moveObservations.GetJsonrpcRequest().GetMethod()
+		if restRequest := moveObservations.GetRestRequest(); restRequest != nil {
+			baseLegacyRecord.ChainMethod = restRequest.GetApiPath()
+		}
+		return []*legacyRecord{baseLegacyRecord}
+	}
+
 	// For all other services, expect a single record.
 	return []*legacyRecord{baseLegacyRecord}
 }
@@ -0,0 +1,272 @@
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+)
+
+// HTTPHeaderDebug is the HTTP header a client sets to request debug response headers, e.g. "Path-Debug: <api_key>".
+// Its value must be one of the allowlisted debug API keys, unless debug headers are allowed for all clients.
+const HTTPHeaderDebug = "Path-Debug"
+
+// The debug response headers, describing the routing decisions made to serve the request.
+// Headers are only set if the corresponding data is available, e.g. no endpoint headers for a request rejected by QoS.
+const (
+	// httpHeaderDebugEndpoint is the address of the endpoint which served the request.
+	httpHeaderDebugEndpoint = "Path-Debug-Endpoint"
+	// httpHeaderDebugSupplier is the supplier of the endpoint which served the request.
+	httpHeaderDebugSupplier = "Path-Debug-Supplier"
+	// httpHeaderDebugSessionID is the session of the endpoint which served the request.
+	httpHeaderDebugSessionID = "Path-Debug-Session-Id"
+	// httpHeaderDebugFallback is set to "true" if a fallback endpoint was used to serve the request.
+	httpHeaderDebugFallback = "Path-Debug-Fallback"
+	// httpHeaderDebugAttempts is the JSON array of the relays sent to endpoints, in order: see debugRelayAttempt.
+	httpHeaderDebugAttempts = "Path-Debug-Attempts"
+	// httpHeaderDebugParallelRequests is the JSON summary of the parallel requests sent, if any: see debugParallelRequests.
+	httpHeaderDebugParallelRequests = "Path-Debug-Parallel-Requests"
+	// httpHeaderDebugEndpointSelection is the JSON summary of the QoS endpoint selection: see debugEndpointSelection.
+	httpHeaderDebugEndpointSelection = "Path-Debug-Endpoint-Selection"
+	// httpHeaderServerTiming holds the duration of each phase of request processing.
+	// See: https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Server-Timing
+	httpHeaderServerTiming = "Server-Timing"
+)
+
+// The phases of request processing reported in the Server-Timing debug header.
+// The total duration, from receiving the request to writing the response, is reported as the "total" phase.
+const (
+	debugPhaseQoS               = "qos"
+	debugPhaseEndpointSelection = "endpoint_selection"
+	debugPhaseRelay             = "relay"
+	debugPhaseTotal             = "total"
+)
+
+// DebugHeaders determines which requests are returned debug response headers.
+//
+// Debug headers expose the gateway's routing decisions, e.g. the endpoint which served the request,
+// to help investigate a client's reports of bad responses without going through the logs.
+// They are only returned to requests which set HTTPHeaderDebug.
+type DebugHeaders struct {
+	// allowAllClients, if set, returns debug headers to any request setting HTTPHeaderDebug, regardless of its value.
+	allowAllClients bool
+	// apiKeys is the set of API keys allowed to request debug headers.
+	apiKeys map[string]struct{}
+}
+
+// NewDebugHeaders returns the debug headers settings:
+//   - allowAllClients: return debug headers to any request setting HTTPHeaderDebug.
+//   - apiKeys: return debug headers to requests setting HTTPHeaderDebug to one of the API keys.
+func NewDebugHeaders(allowAllClients bool, apiKeys []string) *DebugHeaders {
+	apiKeySet := make(map[string]struct{}, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		apiKeySet[apiKey] = struct{}{}
+	}
+
+	return &DebugHeaders{
+		allowAllClients: allowAllClients,
+		apiKeys:         apiKeySet,
+	}
+}
+
+// isDebugRequest returns true if debug headers should be returned to the HTTP request.
+// Debug headers are disabled if not set.
+func (d *DebugHeaders) isDebugRequest(httpReq *http.Request) bool {
+	if d == nil {
+		return false
+	}
+
+	headerValue := httpReq.Header.Get(HTTPHeaderDebug)
+	if headerValue == "" {
+		return false
+	}
+
+	if d.allowAllClients {
+		return true
+	}
+
+	_, found := d.apiKeys[headerValue]
+	return found
+}
+
+// debugPhaseTiming is the duration of a phase of request processing.
+type debugPhaseTiming struct {
+	phase    string
+	duration time.Duration
+}
+
+// debugRelayAttempt is the debug summary of a relay sent to an endpoint.
+type debugRelayAttempt struct {
+	Endpoint  string `json:"endpoint"`
+	SessionID string `json:"session_id,omitempty"`
+	Fallback  bool   `json:"fallback,omitempty"`
+	LatencyMs int64  `json:"latency_ms"`
+	Error     string `json:"error,omitempty"`
+}
+
+// debugParallelRequests is the debug summary of the parallel requests sent to endpoints.
+type debugParallelRequests struct {
+	NumRequests   int32 `json:"num_requests"`
+	NumSuccessful int32 `json:"num_successful"`
+	NumFailed     int32 `json:"num_failed"`
+	NumCanceled   int32 `json:"num_canceled"`
+}
+
+// debugEndpointSelection is the debug summary of the QoS endpoint selection.
+type debugEndpointSelection struct {
+	RandomEndpointFallback bool   `json:"random_endpoint_fallback"`
+	AvailableEndpoints     int    `json:"available_endpoints"`
+	ValidEndpoints         int    `json:"valid_endpoints"`
+	EndpointPool           string `json:"endpoint_pool,omitempty"`
+}
+
+// markDebugPhase records the end of a phase of request processing, for the Server-Timing debug header.
+// The phase started at the end of the previous phase, or when the request was received.
+// It is a no-op unless debug headers were requested.
+func (rc *requestContext) markDebugPhase(phase string) {
+	if !rc.isDebugRequest {
+		return
+	}
+
+	now := time.Now()
+	phaseStart := rc.gatewayObservations.GetReceivedTime().AsTime()
+	if len(rc.debugPhaseTimings) > 0 {
+		phaseStart = rc.debugPhaseEnd
+	}
+
+	rc.debugPhaseTimings = append(rc.debugPhaseTimings, debugPhaseTiming{phase: phase, duration: now.Sub(phaseStart)})
+	rc.debugPhaseEnd = now
+}
+
+// setDebugHeaders sets the debug response headers, if requested by the client, on the supplied response writer.
+// It must be called before the response's status code is written.
+//
+// DEV_NOTE: it does not wait for relays still in flight, e.g. the remaining relays of a fan-out request,
+// to avoid delaying the user response. The headers only cover the relays completed at response time.
+func (rc *requestContext) setDebugHeaders(w http.ResponseWriter) {
+	if !rc.isDebugRequest {
+		return
+	}
+
+	header := w.Header()
+	rc.setDebugRelayHeaders(header)
+
+	// Relays still in flight, e.g. of a fan-out request, update the parallel request observations under the QoS context lock.
+	rc.qosCtxMutex.Lock()
+	parallelRequests := rc.gatewayObservations.GetGatewayParallelRequestObservations()
+	rc.qosCtxMutex.Unlock()
+
+	if parallelRequests != nil {
+		setDebugJSONHeader(header, httpHeaderDebugParallelRequests, debugParallelRequests{
+			NumRequests:   parallelRequests.GetNumRequests(),
+			NumSuccessful: parallelRequests.GetNumSuccessful(),
+			NumFailed:     parallelRequests.GetNumFailed(),
+			NumCanceled:   parallelRequests.GetNumCanceled(),
+		})
+	}
+
+	if endpointSelection := rc.getDebugEndpointSelection(); endpointSelection != nil {
+		setDebugJSONHeader(header, httpHeaderDebugEndpointSelection, endpointSelection)
+	}
+
+	totalTiming := debugPhaseTiming{phase: debugPhaseTotal, duration: time.Since(rc.gatewayObservations.GetReceivedTime().AsTime())}
+	serverTimings := make([]string, 0, len(rc.debugPhaseTimings)+1)
+	for _, timing := range append(rc.debugPhaseTimings, totalTiming) {
+		serverTimings = append(serverTimings, fmt.Sprintf("%s;dur=%.1f", timing.phase, float64(timing.duration.Microseconds())/1000))
+	}
+	header.Set(httpHeaderServerTiming, strings.Join(serverTimings, ", "))
+}
+
+// setDebugRelayHeaders sets the debug headers describing the relays sent to endpoints, using the protocol contexts' observations.
+// Protocol contexts whose relay is still in flight are skipped: their observations are incomplete, and not safe to read.
+func (rc *requestContext) setDebugRelayHeaders(header http.Header) {
+	var (
+		attempts     []debugRelayAttempt
+		usedFallback bool
+	)
+	for protocolCtxIdx, protocolCtx := range rc.protocolContexts {
+		if rc.isRelayInFlight(protocolCtxIdx) {
+			continue
+		}
+
+		protocolObservations := protocolCtx.GetObservations()
+		for _, requestObs := range protocolObservations.GetShannon().GetObservations() {
+			for _, endpointObs := range requestObs.GetHttpObservations().GetEndpointObservations() {
+				attempt := debugRelayAttempt{
+					Endpoint:  string(protocol.NewEndpointAddr(endpointObs.GetSupplier(), endpointObs.GetEndpointUrl())),
+					SessionID: endpointObs.GetSessionId(),
+					Fallback:  endpointObs.GetIsFallbackEndpoint(),
+				}
+				if endpointObs.EndpointResponseTimestamp != nil {
+					attempt.LatencyMs = endpointObs.GetEndpointResponseTimestamp().AsTime().Sub(endpointObs.GetEndpointQueryTimestamp().AsTime()).Milliseconds()
+				}
+				if endpointObs.ErrorType != nil {
+					attempt.Error = endpointObs.GetErrorType().String()
+				}
+				attempts = append(attempts, attempt)
+
+				usedFallback = usedFallback || attempt.Fallback
+
+				// The last successful relay is the one which served the request.
+				if attempt.Error == "" {
+					header.Set(httpHeaderDebugEndpoint, attempt.Endpoint)
+					header.Set(httpHeaderDebugSupplier, endpointObs.GetSupplier())
+					header.Set(httpHeaderDebugSessionID, attempt.SessionID)
+				}
+			}
+		}
+	}
+
+	if len(attempts) == 0 {
+		return
+	}
+
+	header.Set(httpHeaderDebugFallback, strconv.FormatBool(usedFallback))
+	setDebugJSONHeader(header, httpHeaderDebugAttempts, attempts)
+}
+
+// getDebugEndpointSelection returns the debug summary of the QoS endpoint selection.
+// Returns nil if the service's QoS does not report endpoint selection metadata.
+func (rc *requestContext) getDebugEndpointSelection() *debugEndpointSelection {
+	if rc.qosCtx == nil {
+		return nil
+	}
+
+	rc.qosCtxMutex.Lock()
+	qosObservations := rc.qosCtx.GetObservations()
+	rc.qosCtxMutex.Unlock()
+
+	selectionMetadata := qosObservations.GetEvm().GetEndpointSelectionMetadata()
+	if selectionMetadata == nil {
+		return nil
+	}
+
+	endpointSelection := &debugEndpointSelection{
+		RandomEndpointFallback: selectionMetadata.GetRandomEndpointFallback(),
+		AvailableEndpoints:     len(selectionMetadata.GetValidationResults()),
+	}
+	for _, validationResult := range selectionMetadata.GetValidationResults() {
+		if validationResult.GetSuccess() {
+			endpointSelection.ValidEndpoints++
+		}
+	}
+	if endpointPool := selectionMetadata.GetEndpointPool(); endpointPool != qosobservations.EndpointPool_ENDPOINT_POOL_UNSPECIFIED {
+		endpointSelection.EndpointPool = endpointPool.String()
+	}
+
+	return endpointSelection
+}
+
+// setDebugJSONHeader sets the supplied header to the JSON encoding of the supplied value.
+func setDebugJSONHeader(header http.Header, key string, value any) {
+	valueBz, err := json.Marshal(value)
+	if err != nil {
+		return
+	}
+	header.Set(key, string(valueBz))
+}
@@ -0,0 +1,189 @@
+package gateway
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/buildwithgrove/path/observation"
+	protocolobservations "github.com/buildwithgrove/path/observation/protocol"
+)
+
+// testObservationsProtocolContext is a protocol request context which only returns observations.
+type testObservationsProtocolContext struct {
+	ProtocolRequestContext
+	endpointObservations []*protocolobservations.ShannonEndpointObservation
+}
+
+func (c testObservationsProtocolContext) GetObservations() protocolobservations.Observations {
+	return protocolobservations.Observations{
+		Shannon: &protocolobservations.ShannonObservationsList{
+			Observations: []*protocolobservations.ShannonRequestObservations{{
+				ObservationData: &protocolobservations.ShannonRequestObservations_HttpObservations{
+					HttpObservations: &protocolobservations.ShannonHTTPEndpointObservations{
+						EndpointObservations: c.endpointObservations,
+					},
+				},
+			}},
+		},
+	}
+}
+
+func TestDebugHeaders_IsDebugRequest(t *testing.T) {
+	tests := []struct {
+		name         string
+		debugHeaders *DebugHeaders
+		headerValue  string
+		want         bool
+	}{
+		{
+			name:        "should not return debug headers if disabled",
+			headerValue: "api_key_1",
+		},
+		{
+			name:         "should not return debug headers if not requested",
+			debugHeaders: NewDebugHeaders(true, nil),
+		},
+		{
+			name:         "should return debug headers to an allowlisted API key",
+			debugHeaders: NewDebugHeaders(false, []string{"api_key_1"}),
+			headerValue:  "api_key_1",
+			want:         true,
+		},
+		{
+			name:         "should not return debug headers to an unknown API key",
+			debugHeaders: NewDebugHeaders(false, []string{"api_key_1"}),
+			headerValue:  "api_key_2",
+		},
+		{
+			name:         "should return debug headers to any client if allowed",
+			debugHeaders: NewDebugHeaders(true, nil),
+			headerValue:  "true",
+			want:         true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			httpReq, err := http.NewRequest(http.MethodPost, "/v1", nil)
+			require.NoError(t, err)
+			if test.headerValue != "" {
+				httpReq.Header.Set(HTTPHeaderDebug, test.headerValue)
+			}
+
+			require.Equal(t, test.want, test.debugHeaders.isDebugRequest(httpReq))
+		})
+	}
+}
+
+func TestRequestContext_SetDebugHeaders(t *testing.T) {
+	queryTime := time.Now()
+	timeoutErr := protocolobservations.ShannonEndpointErrorType_SHANNON_ENDPOINT_ERROR_TIMEOUT
+
+	rc := &requestContext{
+		gatewayObservations: &observation.GatewayObservations{ReceivedTime: timestamppb.New(queryTime)},
+		isDebugRequest:      true,
+		protocolContexts: []ProtocolRequestContext{testObservationsProtocolContext{
+			endpointObservations: []*protocolobservations.ShannonEndpointObservation{
+				{
+					Supplier:                  "supplier_1",
+					EndpointUrl:               "https://endpoint_1",
+					SessionId:                 "session_1",
+					EndpointQueryTimestamp:    timestamppb.New(queryTime),
+					EndpointResponseTimestamp: timestamppb.New(queryTime.Add(time.Second)),
+					ErrorType:                 &timeoutErr,
+				},
+				{
+					Supplier:                  "supplier_2",
+					EndpointUrl:               "https://endpoint_2",
+					SessionId:                 "session_1",
+					EndpointQueryTimestamp:    timestamppb.New(queryTime),
+					EndpointResponseTimestamp: timestamppb.New(queryTime.Add(100 * time.Millisecond)),
+				},
+			},
+		}},
+	}
+	rc.markDebugPhase(debugPhaseQoS)
+	rc.markDebugPhase(debugPhaseRelay)
+
+	w := httptest.NewRecorder()
+	rc.setDebugHeaders(w)
+
+	header := w.Header()
+	require.Equal(t, "supplier_2-https://endpoint_2", header.Get(httpHeaderDebugEndpoint))
+	require.Equal(t, "supplier_2", header.Get(httpHeaderDebugSupplier))
+	require.Equal(t, "session_1", header.Get(httpHeaderDebugSessionID))
+	require.Equal(t, "false", header.Get(httpHeaderDebugFallback))
+	require.JSONEq(t, `[
+		{"endpoint":"supplier_1-https://endpoint_1","session_id":"session_1","latency_ms":1000,"error":"SHANNON_ENDPOINT_ERROR_TIMEOUT"},
+		{"endpoint":"supplier_2-https://endpoint_2","session_id":"session_1","latency_ms":100}
+	]`, header.Get(httpHeaderDebugAttempts))
+	require.Empty(t, header.Get(httpHeaderDebugParallelRequests))
+	require.Regexp(t, `^qos;dur=[0-9.]+, relay;dur=[0-9.]+, total;dur=[0-9.]+$`, header.Get(httpHeaderServerTiming))
+
+	// No debug headers are set unless requested.
+	rc.isDebugRequest = false
+	w = httptest.NewRecorder()
+	rc.setDebugHeaders(w)
+	require.Empty(t, w.Header())
+}
+
+func TestRequestContext_SetDebugHeaders_SkipsInFlightRelays(t *testing.T) {
+	queryTime := time.Now()
+
+	newProtocolCtx := func(supplier string) testObservationsProtocolContext {
+		return testObservationsProtocolContext{
+			endpointObservations: []*protocolobservations.ShannonEndpointObservation{{
+				Supplier:                  supplier,
+				EndpointUrl:               "https://" + supplier,
+				EndpointQueryTimestamp:    timestamppb.New(queryTime),
+				EndpointResponseTimestamp: timestamppb.New(queryTime.Add(100 * time.Millisecond)),
+			}},
+		}
+	}
+
+	tests := []struct {
+		name                 string
+		inFlightProtocolCtxs []int
+		wantEndpoint         string
+		wantAttempts         string
+	}{
+		{
+			name:         "should include the relays of all completed protocol contexts",
+			wantEndpoint: "supplier_2-https://supplier_2",
+			wantAttempts: `[
+				{"endpoint":"supplier_1-https://supplier_1","latency_ms":100},
+				{"endpoint":"supplier_2-https://supplier_2","latency_ms":100}
+			]`,
+		},
+		{
+			name:                 "should skip the relays still in flight",
+			inFlightProtocolCtxs: []int{1},
+			wantEndpoint:         "supplier_1-https://supplier_1",
+			wantAttempts:         `[{"endpoint":"supplier_1-https://supplier_1","latency_ms":100}]`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rc := &requestContext{
+				gatewayObservations: &observation.GatewayObservations{ReceivedTime: timestamppb.New(queryTime)},
+				isDebugRequest:      true,
+				protocolContexts:    []ProtocolRequestContext{newProtocolCtx("supplier_1"), newProtocolCtx("supplier_2")},
+			}
+			for _, protocolCtxIdx := range test.inFlightProtocolCtxs {
+				rc.startRelay(protocolCtxIdx)
+			}
+
+			w := httptest.NewRecorder()
+			rc.setDebugHeaders(w)
+
+			require.Equal(t, test.wantEndpoint, w.Header().Get(httpHeaderDebugEndpoint))
+			require.JSONEq(t, test.wantAttempts, w.Header().Get(httpHeaderDebugAttempts))
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package gateway
+
+import (
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/buildwithgrove/path/observation"
+	"github.com/buildwithgrove/path/protocol"
+)
+
+// HTTPHeaderEndpointAffinityKey is the HTTP header a client can set to route its requests to the same endpoint.
+//   - Useful for stateful workflows, e.g. EVM filters through `eth_newFilter`/`eth_getFilterChanges`.
+//   - If not set, the portal application ID is used as the affinity key, if available.
+const HTTPHeaderEndpointAffinityKey = "Endpoint-Affinity-Key"
+
+// EndpointAffinityStore tracks the endpoint each client's requests are routed to, per service.
+//
+// A client's affinity endpoint is used for subsequent requests to the same service as long as:
+//   - The endpoint is available, i.e. part of the current session and not sanctioned by the protocol.
+//   - The endpoint is valid according to the service's QoS instance.
+//
+// The affinity endpoint is dropped on session rollover, on a failed relay, or after the idle timeout.
+type EndpointAffinityStore struct {
+	// idleTimeout is the duration after which an unused affinity entry is dropped.
+	idleTimeout time.Duration
+
+	mu      sync.Mutex
+	entries map[endpointAffinityKey]endpointAffinityEntry
+	// lastPruneTime is the last time expired entries were removed from the store.
+	lastPruneTime time.Time
+}
+
+// endpointAffinityKey identifies a client's affinity for a specific service.
+type endpointAffinityKey struct {
+	serviceID protocol.ServiceID
+	clientKey string
+}
+
+// endpointAffinityEntry is the endpoint a client's requests to a service are routed to.
+type endpointAffinityEntry struct {
+	endpointAddr protocol.EndpointAddr
+	// sessionID is the ID of the session the endpoint was last used in.
+	sessionID    string
+	lastUsedTime time.Time
+}
+
+// NewEndpointAffinityStore returns an endpoint affinity store which drops entries unused for idleTimeout.
+func NewEndpointAffinityStore(idleTimeout time.Duration) *EndpointAffinityStore {
+	return &EndpointAffinityStore{
+		idleTimeout:   idleTimeout,
+		entries:       make(map[endpointAffinityKey]endpointAffinityEntry),
+		lastPruneTime: time.Now(),
+	}
+}
+
+// getEndpointAffinityKey returns the affinity key of the client sending the HTTP request.
+// Returns an empty string if the request has no affinity key.
+func getEndpointAffinityKey(httpReq *http.Request) string {
+	if affinityKey := httpReq.Header.Get(HTTPHeaderEndpointAffinityKey); affinityKey != "" {
+		return affinityKey
+	}
+	return httpReq.Header.Get(HttpHeaderPortalAppID)
+}
+
+// getEntry returns the client's affinity entry for the service, if one exists and has not expired.
+func (s *EndpointAffinityStore) getEntry(key endpointAffinityKey) (endpointAffinityEntry, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, found := s.entries[key]
+	if !found {
+		return endpointAffinityEntry{}, false
+	}
+
+	if time.Since(entry.lastUsedTime) > s.idleTimeout {
+		delete(s.entries, key)
+		return endpointAffinityEntry{}, false
+	}
+
+	return entry, true
+}
+
+// setEntry records the endpoint, and its session, as the client's affinity endpoint for the service.
+func (s *EndpointAffinityStore) setEntry(key endpointAffinityKey, endpointAddr protocol.EndpointAddr, sessionID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.entries[key] = endpointAffinityEntry{
+		endpointAddr: endpointAddr,
+		sessionID:    sessionID,
+		lastUsedTime: now,
+	}
+
+	// Periodically remove expired entries to bound the size of the store.
+	if now.Sub(s.lastPruneTime) < s.idleTimeout {
+		return
+	}
+	for entryKey, entry := range s.entries {
+		if now.Sub(entry.lastUsedTime) > s.idleTimeout {
+			delete(s.entries, entryKey)
+		}
+	}
+	s.lastPruneTime = now
+}
+
+// deleteEntry drops the client's affinity endpoint for the service.
+func (s *EndpointAffinityStore) deleteEntry(key endpointAffinityKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+}
+
+// selectAffinityEndpoint returns the client's affinity endpoint, if it can serve the request.
+//   - Only applies to requests sent to a single endpoint.
+//   - Records the outcome of the lookup in the gateway observations.
+func (rc *requestContext) selectAffinityEndpoint(
+	httpReq *http.Request,
+	availableEndpoints protocol.EndpointAddrList,
+	numEndpointsToSelect uint,
+) (protocol.EndpointAddr, bool) {
+	if rc.endpointAffinityStore == nil || numEndpointsToSelect != 1 {
+		return "", false
+	}
+
+	clientKey := getEndpointAffinityKey(httpReq)
+	if clientKey == "" {
+		return "", false
+	}
+	rc.endpointAffinityKey = &endpointAffinityKey{serviceID: rc.serviceID, clientKey: clientKey}
+
+	endpointAddr, result := rc.lookupAffinityEndpoint(availableEndpoints)
+	rc.gatewayObservations.EndpointAffinity = &observation.GatewayEndpointAffinityObservation{
+		Result: result,
+	}
+
+	return endpointAddr, result == observation.EndpointAffinityResult_ENDPOINT_AFFINITY_RESULT_HIT
+}
+
+// lookupAffinityEndpoint checks whether the client's affinity endpoint can serve the request.
+func (rc *requestContext) lookupAffinityEndpoint(
+	availableEndpoints protocol.EndpointAddrList,
+) (protocol.EndpointAddr, observation.EndpointAffinityResult) {
+	entry, found := rc.endpointAffinityStore.getEntry(*rc.endpointAffinityKey)
+	if !found {
+		return "", observation.EndpointAffinityResult_ENDPOINT_AFFINITY_RESULT_MISS_NO_ENTRY
+	}
+
+	// Available endpoints are limited to the current session(s), and exclude sanctioned endpoints.
+	if !slices.Contains(availableEndpoints, entry.endpointAddr) {
+		rc.endpointAffinityStore.deleteEntry(*rc.endpointAffinityKey)
+		return "", observation.EndpointAffinityResult_ENDPOINT_AFFINITY_RESULT_MISS_ENDPOINT_UNAVAILABLE
+	}
+
+	// Selecting all the available endpoints returns the subset considered valid by the QoS instance.
+	validEndpoints, err := rc.qosCtx.GetEndpointSelector().SelectMultiple(availableEndpoints, uint(len(availableEndpoints)))
+	if err != nil || !slices.Contains(validEndpoints, entry.endpointAddr) {
+		rc.endpointAffinityStore.deleteEntry(*rc.endpointAffinityKey)
+		return "", observation.EndpointAffinityResult_ENDPOINT_AFFINITY_RESULT_MISS_ENDPOINT_INVALID
+	}
+
+	return entry.endpointAddr, observation.EndpointAffinityResult_ENDPOINT_AFFINITY_RESULT_HIT
+}
+
+// updateEndpointAffinity records the endpoint which served the request as the client's affinity endpoint.
+// The affinity endpoint is dropped instead if:
+//   - The relay failed.
+//   - The endpoint's session rolled over since the affinity endpoint was recorded.
+//
+// Must be called after the protocol observations are set.
+func (rc *requestContext) updateEndpointAffinity() {
+	if rc.endpointAffinityKey == nil || len(rc.protocolContexts) != 1 {
+		return
+	}
+
+	var endpointAddr protocol.EndpointAddr
+	var sessionID string
+	var relayFailed bool
+	// The last endpoint observation is the one which served the request.
+	for _, requestObs := range rc.protocolObservations.GetShannon().GetObservations() {
+		for _, endpointObs := range requestObs.GetHttpObservations().GetEndpointObservations() {
+			endpointAddr = protocol.NewEndpointAddr(endpointObs.GetSupplier(), endpointObs.GetEndpointUrl())
+			sessionID = endpointObs.GetSessionId()
+			relayFailed = endpointObs.ErrorType != nil
+		}
+	}
+	if endpointAddr == "" {
+		return
+	}
+
+	var dropReason *observation.EndpointAffinityDropReason
+	switch entry, found := rc.endpointAffinityStore.getEntry(*rc.endpointAffinityKey); {
+	case relayFailed:
+		dropReason = observation.EndpointAffinityDropReason_ENDPOINT_AFFINITY_DROP_REASON_RELAY_FAILURE.Enum()
+	case found && entry.endpointAddr == endpointAddr && entry.sessionID != sessionID:
+		dropReason = observation.EndpointAffinityDropReason_ENDPOINT_AFFINITY_DROP_REASON_SESSION_ROLLOVER.Enum()
+	}
+
+	if dropReason == nil {
+		rc.endpointAffinityStore.setEntry(*rc.endpointAffinityKey, endpointAddr, sessionID)
+		return
+	}
+
+	rc.logger.Debug().Msgf("Dropping the affinity endpoint %s: %s", endpointAddr, dropReason.String())
+	rc.endpointAffinityStore.deleteEntry(*rc.endpointAffinityKey)
+	if rc.gatewayObservations.EndpointAffinity != nil {
+		rc.gatewayObservations.EndpointAffinity.DropReason = dropReason
+	}
+}
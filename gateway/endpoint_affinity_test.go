@@ -0,0 +1,85 @@
+package gateway
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildwithgrove/path/protocol"
+)
+
+func TestGetEndpointAffinityKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		headers     map[string]string
+		expectedKey string
+	}{
+		{
+			name:        "no affinity key",
+			headers:     map[string]string{},
+			expectedKey: "",
+		},
+		{
+			name:        "portal application ID is used as the affinity key",
+			headers:     map[string]string{HttpHeaderPortalAppID: "app_1"},
+			expectedKey: "app_1",
+		},
+		{
+			name: "affinity key header takes precedence over the portal application ID",
+			headers: map[string]string{
+				HttpHeaderPortalAppID:         "app_1",
+				HTTPHeaderEndpointAffinityKey: "session_1",
+			},
+			expectedKey: "session_1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			httpReq, err := http.NewRequest(http.MethodPost, "/", nil)
+			require.NoError(t, err)
+			for key, value := range test.headers {
+				httpReq.Header.Set(key, value)
+			}
+
+			require.Equal(t, test.expectedKey, getEndpointAffinityKey(httpReq))
+		})
+	}
+}
+
+func TestEndpointAffinityStore(t *testing.T) {
+	c := require.New(t)
+
+	store := NewEndpointAffinityStore(time.Minute)
+	key := endpointAffinityKey{serviceID: "eth", clientKey: "app_1"}
+	endpointAddr := protocol.EndpointAddr("supplier_1-https://endpoint_1")
+
+	_, found := store.getEntry(key)
+	c.False(found)
+
+	store.setEntry(key, endpointAddr, "session_1")
+	entry, found := store.getEntry(key)
+	c.True(found)
+	c.Equal(endpointAddr, entry.endpointAddr)
+	c.Equal("session_1", entry.sessionID)
+
+	// Affinity is per service.
+	_, found = store.getEntry(endpointAffinityKey{serviceID: "poly", clientKey: "app_1"})
+	c.False(found)
+
+	// Expired entries are dropped.
+	store.entries[key] = endpointAffinityEntry{
+		endpointAddr: endpointAddr,
+		sessionID:    "session_1",
+		lastUsedTime: time.Now().Add(-2 * time.Minute),
+	}
+	_, found = store.getEntry(key)
+	c.False(found)
+
+	store.setEntry(key, endpointAddr, "session_2")
+	store.deleteEntry(key)
+	_, found = store.getEntry(key)
+	c.False(found)
+}
@@ -16,6 +16,9 @@ var (
 	// e.g. HTTP payload could not be unmarshaled into a JSONRPC request.
 	errGatewayRejectedByQoS = errors.New("QoS instance rejected the request")
 
+	// No successful endpoint response was received before the request's relay timeout.
+	errGatewayRelayTimeout = errors.New("relay timeout expired before a successful endpoint response")
+
 	// Error building protocol contexts from HTTP request.
 	errBuildProtocolContextsFromHTTPRequest = errors.New("error building protocol contexts from HTTP request")
 
@@ -26,4 +29,10 @@ var (
 	// Websocket connection establishment failed.
 	// e.g. Failed to upgrade HTTP connection to Websocket or connect to endpoint.
 	errWebsocketConnectionFailed = errors.New("websocket connection establishment failed")
+
+	// Gateway plugin's BeforeRelay hook did not return exactly one payload for each service payload.
+	errPluginPayloadCountMismatch = errors.New("plugin returned a different number of payloads than the service payloads")
+
+	// Gateway plugin's AfterResponse hook returned a nil HTTP response without an error.
+	errPluginNilResponse = errors.New("plugin returned a nil HTTP response")
 )
@@ -17,6 +17,7 @@ package gateway
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
 	"github.com/pokt-network/poktroll/pkg/polylog"
@@ -51,6 +52,22 @@ type Gateway struct {
 	// It is declared separately from the `MetricsReporter` to be consistent with the gateway package's role
 	// of explicitly defining PATH gateway's components and their interactions.
 	DataReporter RequestResponseReporter
+
+	// EndpointAffinityStore, if set, routes a client's requests for a service to the same endpoint.
+	// See the EndpointAffinityStore struct for details.
+	EndpointAffinityStore *EndpointAffinityStore
+
+	// RequestCoalescer, if set, shares a single relay among concurrent identical requests.
+	// See the RequestCoalescer struct for details.
+	RequestCoalescer *RequestCoalescer
+
+	// Plugins, if set, holds the plugins enabled for each service: they can transform the service's requests and responses.
+	// See the Plugin interface for details.
+	Plugins *ServicePlugins
+
+	// DebugHeaders, if set, determines which requests are returned debug response headers exposing the gateway's routing decisions.
+	// See the DebugHeaders struct for details.
+	DebugHeaders *DebugHeaders
 }
 
 // HandleServiceRequest implements PATH gateway's service request processing.
@@ -96,13 +113,17 @@ func (g Gateway) handleHTTPServiceRequest(
 
 	// Build a gatewayRequestContext with components necessary to process requests.
 	gatewayRequestCtx := &requestContext{
-		logger:              g.Logger,
-		context:             ctx,
-		gatewayObservations: getUserRequestGatewayObservations(httpReq),
-		protocol:            g.Protocol,
-		httpRequestParser:   g.HTTPRequestParser,
-		metricsReporter:     g.MetricsReporter,
-		dataReporter:        g.DataReporter,
+		logger:                g.Logger,
+		context:               ctx,
+		gatewayObservations:   getUserRequestGatewayObservations(httpReq),
+		protocol:              g.Protocol,
+		httpRequestParser:     g.HTTPRequestParser,
+		metricsReporter:       g.MetricsReporter,
+		dataReporter:          g.DataReporter,
+		endpointAffinityStore: g.EndpointAffinityStore,
+		requestCoalescer:      g.RequestCoalescer,
+		servicePlugins:        g.Plugins,
+		isDebugRequest:        g.DebugHeaders.isDebugRequest(httpReq),
 	}
 
 	defer func() {
@@ -127,6 +148,24 @@ func (g Gateway) handleHTTPServiceRequest(
 		logger.Error().Err(err).Msg("❌ Error building QoS context for HTTP request")
 		return
 	}
+	gatewayRequestCtx.markDebugPhase(debugPhaseQoS)
+
+	// Set the deadline of the request's relays: the service's timeout for the request, shortened by the client if requested.
+	// The deadline is propagated to the protocol through the request context's context.
+	cancelRelayTimeout := gatewayRequestCtx.applyRelayTimeout(httpReq)
+	defer cancelRelayTimeout()
+
+	// Serve the request using the relay of an identical in-flight request, if possible.
+	if gatewayRequestCtx.joinCoalescedRelay() {
+		gatewayRequestCtx.markDebugPhase(debugPhaseRelay)
+		logger.Debug().Msg("Served HTTP request using the relay of an identical in-flight request")
+		return
+	}
+	// Release any identical requests waiting on this request's relay, even if the relay is never sent.
+	defer gatewayRequestCtx.completeCoalescedRelay(nil, errCoalescedRelayNotSent)
+
+	// Allow the service's plugins to process the service payloads before they are relayed.
+	gatewayRequestCtx.applyBeforeRelayPlugins()
 
 	// TODO_TECHDEBT(@adshmh): Build a single protocol context to handle a request.
 	// - Obtaining a response to the user's request is protocol context's main responsibility.
@@ -147,12 +186,18 @@ func (g Gateway) handleHTTPServiceRequest(
 		logger.Error().Err(err).Msg("❌ Error building protocol context for HTTP request")
 		return
 	}
+	gatewayRequestCtx.markDebugPhase(debugPhaseEndpointSelection)
 
 	// Use the gateway request context to process the relay(s) corresponding to the HTTP request.
 	// Any returned errors are ignored here and processed by the gateway context in the deferred calls.
 	// See the `BroadcastAllObservations` method of `gateway.requestContext` struct for details.
 	err = gatewayRequestCtx.HandleRelayRequest()
+	gatewayRequestCtx.markDebugPhase(debugPhaseRelay)
 	if err != nil {
+		// Record relays which failed due to the relay timeout as a distinct gateway-level error.
+		if gatewayRequestCtx.isRelayDeadlineExceeded() {
+			gatewayRequestCtx.updateGatewayObservations(fmt.Errorf("%w: %w", errGatewayRelayTimeout, err))
+		}
 		logger.Error().Err(err).Msg("❌ Error processing relay request")
 		return
 	}
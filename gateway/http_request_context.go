@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/pokt-network/poktroll/pkg/polylog"
@@ -34,7 +35,8 @@ const (
 	// - Enable parallel requests for gateways that maintain their own backend nodes as a special config
 	maxParallelRequests = 1
 
-	// RelayRequestTimeout is the timeout for relay requests
+	// RelayRequestTimeout is the default timeout for relay requests.
+	// It can be overridden per service and method by the QoS, and shortened by the client: see applyRelayTimeout.
 	// TODO_TECHDEBT: Look into whether we can remove this variable altogether and consolidate
 	// it with HTTP level timeouts.
 	RelayRequestTimeout = 60 * time.Second
@@ -101,6 +103,64 @@ type requestContext struct {
 	// Tracks whether the request was rejected by the QoS.
 	// This is needed for handling the observations: there will be no protocol context/observations in this case.
 	requestRejectedByQoS bool
+
+	// qosCtxMutex serializes access to the QoS context while relays are in flight.
+	// e.g. the remaining relays of a broadcast can complete after the user response is written.
+	qosCtxMutex sync.Mutex
+
+	// inFlightRelays tracks relays still in flight after the user response was determined.
+	// Observations are broadcast only after all in-flight relays complete.
+	inFlightRelays sync.WaitGroup
+
+	// inFlightProtocolCtxsMu guards inFlightProtocolCtxs.
+	inFlightProtocolCtxsMu sync.Mutex
+	// inFlightProtocolCtxs holds the indexes of the protocol contexts whose relay is still in flight,
+	// e.g. the remaining relays of a fan-out or parallel request after the user response was determined.
+	// The observations of a protocol context are not safe to read until its relay completes.
+	inFlightProtocolCtxs map[int]struct{}
+
+	// endpointAffinityStore tracks clients' affinity endpoints.
+	// Endpoint affinity is disabled if not set.
+	endpointAffinityStore *EndpointAffinityStore
+
+	// endpointAffinityKey identifies the client's affinity for the requested service.
+	// Only set if endpoint affinity is enabled and the request has an affinity key.
+	endpointAffinityKey *endpointAffinityKey
+
+	// requestCoalescer shares a single relay among concurrent identical requests.
+	// Request coalescing is disabled if not set.
+	requestCoalescer *RequestCoalescer
+
+	// coalescingKey and coalescedRelay are set if the request sends a relay shared with identical requests.
+	coalescingKey  *requestCoalescingKey
+	coalescedRelay *coalescedRelay
+
+	// isCoalescedRequest is set if the request was served using the relay of an identical request.
+	// No protocol context is built for the request in this case.
+	isCoalescedRequest bool
+
+	// servicePlugins holds the plugins enabled for each service.
+	// Plugins are disabled if not set.
+	servicePlugins *ServicePlugins
+
+	// plugins are the plugins enabled for the requested service, in the order they are called.
+	plugins []Plugin
+
+	// relayPayloads are the QoS context's service payloads, as transformed by the BeforeRelay plugins.
+	// Only set if the requested service has a BeforeRelay plugin.
+	relayPayloads []protocol.Payload
+	// relayPayloadsByData maps the data of each of the QoS context's service payloads to its transformed payload.
+	relayPayloadsByData map[string]protocol.Payload
+
+	// relayTimeout is the timeout of the request's relays: see applyRelayTimeout.
+	relayTimeout time.Duration
+
+	// isDebugRequest is set if the client requested, and is allowed, debug response headers: see DebugHeaders.
+	isDebugRequest bool
+	// debugPhaseTimings holds the duration of the request's processing phases, reported in the debug headers.
+	debugPhaseTimings []debugPhaseTiming
+	// debugPhaseEnd is the end time of the last recorded processing phase.
+	debugPhaseEnd time.Time
 }
 
 // InitFromHTTPRequest builds the required context for serving an HTTP request.
@@ -128,6 +188,7 @@ func (rc *requestContext) InitFromHTTPRequest(httpReq *http.Request) error {
 	}
 
 	rc.serviceQoS = serviceQoS
+	rc.plugins = rc.servicePlugins.getServicePlugins(serviceID)
 	return nil
 }
 
@@ -139,6 +200,9 @@ func (rc *requestContext) BuildQoSContextFromHTTP(httpReq *http.Request) error {
 	//	2. Read HTTP request body in `request` package and return struct for QoS Service
 	//	3. Export HTTP observations from `request` package when reading body
 
+	// Allow the service's plugins to process the HTTP request before it is parsed.
+	rc.applyBeforeQoSPlugins(httpReq)
+
 	// Build the payload for the requested service using the incoming HTTP request.
 	// This payload will be sent to an endpoint matching the requested service.
 	qosCtx, isValid := rc.serviceQoS.ParseHTTPRequest(rc.context, httpReq)
@@ -182,8 +246,29 @@ func (rc *requestContext) BuildProtocolContextsFromHTTPRequest(httpReq *http.Req
 		return fmt.Errorf("%w: no available endpoints could be found for the request: %w", errBuildProtocolContextsFromHTTPRequest, err)
 	}
 
-	// Select multiple endpoints for parallel relay attempts
-	selectedEndpoints, err := rc.qosCtx.GetEndpointSelector().SelectMultiple(availableEndpoints, maxParallelRequests)
+	// Select multiple endpoints for parallel relay attempts.
+	// - Service payloads distributed across endpoints need one endpoint per payload.
+	// - Service payloads fanned out to multiple endpoints, e.g. a broadcast, need the number of endpoints specified by the QoS context.
+	// - Members of a batch request distributed across endpoints need the number of endpoints specified by the QoS context.
+	numEndpointsToSelect := uint(maxParallelRequests)
+	if rc.distributesPayloadsAcrossEndpoints() {
+		numEndpointsToSelect = uint(len(rc.getServicePayloads()))
+	}
+	if batchNumEndpoints := rc.getBatchNumEndpoints(); batchNumEndpoints > 1 {
+		numEndpointsToSelect = batchNumEndpoints
+	}
+	if fanOutNumEndpoints := rc.getFanOutNumEndpoints(); fanOutNumEndpoints > 1 {
+		numEndpointsToSelect = fanOutNumEndpoints
+	}
+
+	// Use the client's affinity endpoint, if it can serve the request.
+	// Otherwise, select the endpoints using the QoS instance.
+	var selectedEndpoints protocol.EndpointAddrList
+	if affinityEndpointAddr, ok := rc.selectAffinityEndpoint(httpReq, availableEndpoints, numEndpointsToSelect); ok {
+		selectedEndpoints = protocol.EndpointAddrList{affinityEndpointAddr}
+	} else {
+		selectedEndpoints, err = rc.qosCtx.GetEndpointSelector().SelectMultiple(availableEndpoints, numEndpointsToSelect)
+	}
 	if err != nil || len(selectedEndpoints) == 0 {
 		// no protocol context will be built: use the endpointLookup observation.
 		rc.updateProtocolObservations(&endpointLookupObs)
@@ -231,6 +316,7 @@ func (rc *requestContext) WriteHTTPUserResponse(w http.ResponseWriter) {
 	// If the HTTP request was invalid, write a generic response.
 	// e.g. if the specified target service ID was invalid.
 	if rc.presetFailureHTTPResponse != nil {
+		rc.setDebugHeaders(w)
 		rc.writeHTTPResponse(rc.presetFailureHTTPResponse, w)
 		return
 	}
@@ -244,7 +330,19 @@ func (rc *requestContext) WriteHTTPUserResponse(w http.ResponseWriter) {
 	//      E.g. a JSONRPC error response.
 	//   3. Protocol relay was sent successfully: QoS returns the endpoint's response.
 	//      E.g. the chain ID for a `eth_chainId` request.
-	rc.writeHTTPResponse(rc.qosCtx.GetHTTPResponse(), w)
+	//
+	// Lock the QoS context: relays may still be in flight, e.g. for a broadcast or consensus-read request.
+	rc.qosCtxMutex.Lock()
+	httpResponse := rc.qosCtx.GetHTTPResponse()
+	rc.qosCtxMutex.Unlock()
+
+	// Allow the service's plugins to process the HTTP response before it is written.
+	httpResponse = rc.applyAfterResponsePlugins(httpResponse)
+
+	// Expose the gateway's routing decisions, if requested by the client.
+	rc.setDebugHeaders(w)
+
+	rc.writeHTTPResponse(httpResponse, w)
 }
 
 // writeResponse uses the supplied http.ResponseWriter to write the supplied HTTP response.
@@ -294,10 +392,16 @@ func (rc *requestContext) writeHTTPResponse(response pathhttp.HTTPResponse, w ht
 func (rc *requestContext) BroadcastAllObservations() {
 	// observation-related tasks are called in Goroutines to avoid potentially blocking the HTTP handler.
 	go func() {
+		// Wait for any relays still in flight, e.g. remaining relays of a broadcast or consensus-read request.
+		// This ensures the observations of all endpoints are included.
+		rc.inFlightRelays.Wait()
+
 		// update gateway-level observations: no request error encountered.
 		rc.updateGatewayObservations(nil)
 		// update protocol-level observations: no errors encountered setting up the protocol context.
 		rc.updateProtocolObservations(nil)
+		// update the client's affinity endpoint using the protocol-level observations.
+		rc.updateEndpointAffinity()
 		if rc.protocolObservations != nil {
 			err := rc.protocol.ApplyHTTPObservations(rc.protocolObservations)
 			if err != nil {
@@ -312,8 +416,12 @@ func (rc *requestContext) BroadcastAllObservations() {
 		var qosObservations qosobservations.Observations
 		if rc.qosCtx != nil {
 			qosObservations = rc.qosCtx.GetObservations()
-			if err := rc.serviceQoS.ApplyObservations(&qosObservations); err != nil {
-				rc.logger.Warn().Err(err).Msg("error applying QoS observations.")
+			// Observations of a coalesced request are not applied: the endpoint's response
+			// was already applied through the observations of the request which sent the relay.
+			if !rc.isCoalescedRequest {
+				if err := rc.serviceQoS.ApplyObservations(&qosObservations); err != nil {
+					rc.logger.Warn().Err(err).Msg("error applying QoS observations.")
+				}
 			}
 		}
 
@@ -369,6 +477,13 @@ func (rc *requestContext) updateProtocolObservations(protocolContextSetupErrorOb
 		return
 	}
 
+	// Service payloads were distributed or fanned out across multiple protocol contexts:
+	// aggregate the endpoint observations of all the contexts.
+	if len(rc.protocolContexts) > 1 && (rc.distributesPayloadsAcrossEndpoints() || rc.getBatchNumEndpoints() > 1 || rc.getFanOutNumEndpoints() > 1) {
+		rc.protocolObservations = aggregateProtocolContextsObservations(rc.protocolContexts)
+		return
+	}
+
 	// Check if we have multiple protocol contexts and use the first successful one
 	if len(rc.protocolContexts) > 0 {
 		// TODO_TECHDEBT: Aggregate observations from all protocol contexts for better insights.
@@ -384,6 +499,11 @@ func (rc *requestContext) updateProtocolObservations(protocolContextSetupErrorOb
 		return
 	}
 
+	// The request was served using the relay of an identical request: there is no protocol context/observation.
+	if rc.isCoalescedRequest {
+		return
+	}
+
 	// This should never happen: either protocol context is setup, or an observation is reported to use directly for the request.
 	rc.logger.
 		With("service_id", rc.serviceID).
@@ -391,6 +511,107 @@ func (rc *requestContext) updateProtocolObservations(protocolContextSetupErrorOb
 		Msg("SHOULD NEVER HAPPEN: protocol context is nil, but no protocol setup observation have been reported.")
 }
 
+// distributesPayloadsAcrossEndpoints returns true if the QoS context requires
+// each of its service payloads to be sent to a different endpoint.
+func (rc *requestContext) distributesPayloadsAcrossEndpoints() bool {
+	if rc.qosCtx == nil {
+		return false
+	}
+
+	distributedPayloadsCtx, ok := rc.qosCtx.(DistributedPayloadsQoSContext)
+	if !ok {
+		return false
+	}
+
+	return distributedPayloadsCtx.DistributePayloadsAcrossEndpoints()
+}
+
+// getBatchNumEndpoints returns the number of endpoints the QoS context requires the members of a batch request to be distributed across.
+// Returns 0 if the request is not a batch, or the batch should be sent to a single endpoint.
+func (rc *requestContext) getBatchNumEndpoints() uint {
+	if rc.qosCtx == nil {
+		return 0
+	}
+
+	batchCtx, ok := rc.qosCtx.(BatchQoSContext)
+	if !ok {
+		return 0
+	}
+
+	return batchCtx.GetBatchNumEndpoints()
+}
+
+// getFanOutNumEndpoints returns the number of endpoints the QoS context requires the same service payloads to be sent to.
+// e.g. a transaction broadcast, or a consensus-read.
+// Returns 0 if the request does not need to be sent to multiple endpoints.
+func (rc *requestContext) getFanOutNumEndpoints() uint {
+	if rc.qosCtx == nil {
+		return 0
+	}
+
+	if broadcastCtx, ok := rc.qosCtx.(BroadcastQoSContext); ok {
+		if numEndpoints := broadcastCtx.GetBroadcastNumEndpoints(); numEndpoints > 1 {
+			return numEndpoints
+		}
+	}
+
+	if consensusCtx, ok := rc.qosCtx.(ConsensusQoSContext); ok {
+		if numEndpoints := consensusCtx.GetConsensusNumEndpoints(); numEndpoints > 1 {
+			return numEndpoints
+		}
+	}
+
+	return 0
+}
+
+// isFanOutComplete returns true if the responses received for a fanned-out request are sufficient to respond to the user.
+// e.g. an endpoint accepted a broadcast transaction, or a consensus-read reached its quorum.
+// NOT safe for concurrent use: the caller must hold the QoS context lock.
+func (rc *requestContext) isFanOutComplete() bool {
+	if broadcastCtx, ok := rc.qosCtx.(BroadcastQoSContext); ok && broadcastCtx.GetBroadcastNumEndpoints() > 1 {
+		return broadcastCtx.HasSuccessfulBroadcastResponse()
+	}
+
+	if consensusCtx, ok := rc.qosCtx.(ConsensusQoSContext); ok && consensusCtx.GetConsensusNumEndpoints() > 1 {
+		return consensusCtx.HasConsensusResponse()
+	}
+
+	return false
+}
+
+// aggregateProtocolContextsObservations merges the observations of all the supplied protocol contexts.
+// - The first context's observations are used as the base, i.e. a single observation set per service request.
+// - HTTP endpoint observations of all other contexts are appended to the base observation set.
+func aggregateProtocolContextsObservations(protocolContexts []ProtocolRequestContext) *protocolobservations.Observations {
+	aggregated := protocolContexts[0].GetObservations()
+
+	baseObservations := aggregated.GetShannon().GetObservations()
+	if len(baseObservations) == 0 {
+		return &aggregated
+	}
+	baseHTTPObservations := baseObservations[0].GetHttpObservations()
+	if baseHTTPObservations == nil {
+		return &aggregated
+	}
+
+	for _, protocolCtx := range protocolContexts[1:] {
+		observations := protocolCtx.GetObservations()
+		for _, requestObservations := range observations.GetShannon().GetObservations() {
+			httpObservations := requestObservations.GetHttpObservations()
+			if httpObservations == nil {
+				continue
+			}
+
+			baseHTTPObservations.EndpointObservations = append(
+				baseHTTPObservations.EndpointObservations,
+				httpObservations.GetEndpointObservations()...,
+			)
+		}
+	}
+
+	return &aggregated
+}
+
 // updateGatewayObservations
 // - updates the gateway-level observations in the request context with other metadata in the request context.
 // - sets the gateway observation error with the one provided, if not already set
@@ -433,6 +654,17 @@ func (rc *requestContext) updateGatewayObservations(err error) {
 			Details: err.Error(),
 		}
 
+	// No successful endpoint response was received before the request's relay timeout.
+	// e.g. a short per-method timeout, or a client-requested deadline.
+	case errors.Is(err, errGatewayRelayTimeout):
+		rc.logger.Info().Err(err).Msg("Relay timeout expired before a successful endpoint response. Request will fail.")
+		rc.gatewayObservations.RequestError = &observation.GatewayRequestError{
+			// Set the error kind
+			ErrorKind: observation.GatewayRequestErrorKind_GATEWAY_REQUEST_ERROR_KIND_RELAY_TIMEOUT,
+			// Use the error message as error details.
+			Details: err.Error(),
+		}
+
 	default:
 		rc.logger.Warn().Err(err).Msg("SHOULD NEVER HAPPEN: unrecognized gateway-level request error.")
 		// Set a generic request error observation
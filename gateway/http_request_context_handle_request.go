@@ -57,6 +57,24 @@ func (rc *requestContext) HandleRelayRequest() error {
 		With("method", "HandleRelayRequest").
 		With("num_protocol_contexts", len(rc.protocolContexts))
 
+	// Service payloads are independent: send each one to a different endpoint.
+	if rc.distributesPayloadsAcrossEndpoints() {
+		logger.Debug().Msgf("Distributing service payloads across %d protocol contexts", len(rc.protocolContexts))
+		return rc.handleDistributedRelayRequests()
+	}
+
+	// Batch request: distribute the batch members across endpoints, retrying the failed ones on a different endpoint.
+	if rc.getBatchNumEndpoints() > 1 && len(rc.protocolContexts) > 1 {
+		logger.Debug().Msgf("Distributing batch service payloads across %d protocol contexts", len(rc.protocolContexts))
+		return rc.handleDistributedRelayRequests()
+	}
+
+	// Service payloads need to be sent to multiple endpoints: e.g. a transaction submission, or a consensus-read.
+	if rc.getFanOutNumEndpoints() > 1 && len(rc.protocolContexts) > 1 {
+		logger.Debug().Msgf("Fanning out service payloads to %d protocol contexts", len(rc.protocolContexts))
+		return rc.handleFanOutRelayRequests()
+	}
+
 	// Track whether this is a parallel or single request
 	isParallel := len(rc.protocolContexts) > 1
 
@@ -76,9 +94,14 @@ func (rc *requestContext) HandleRelayRequest() error {
 func (rc *requestContext) handleSingleRelayRequest() error {
 	// Send the service request payload, through the protocol context, to the selected endpoint.
 	// In this code path, we are always guaranteed to have exactly one protocol context.
-	endpointResponses, err := rc.protocolContexts[0].HandleServiceRequest(rc.qosCtx.GetServicePayloads())
+	endpointResponses, err := rc.protocolContexts[0].HandleServiceRequest(rc.getServicePayloads())
+
+	// Share the endpoint responses with identical requests waiting on this request's relay, if any.
+	rc.completeCoalescedRelay(endpointResponses, err)
+
 	if err != nil {
 		rc.logger.Warn().Err(err).Msg("Failed to send a single relay request.")
+		rc.reportEndpointErrors(endpointResponses)
 		return err
 	}
 
@@ -95,6 +118,221 @@ func (rc *requestContext) handleSingleRelayRequest() error {
 	return nil
 }
 
+// reportEndpointErrors reports the responses of a failed relay to the QoS context, if it tracks failed relays.
+// Returns false if the QoS context does not implement EndpointErrorQoSContext.
+// The caller is responsible for synchronizing access to the QoS context.
+func (rc *requestContext) reportEndpointErrors(responses []protocol.Response) bool {
+	endpointErrorQoSCtx, ok := rc.qosCtx.(EndpointErrorQoSContext)
+	if !ok {
+		return false
+	}
+
+	for _, response := range responses {
+		endpointErrorQoSCtx.UpdateWithEndpointError(response.EndpointAddr, response.HTTPStatusCode)
+	}
+	return true
+}
+
+// handleDistributedRelayRequests sends the service payloads to the selected endpoints in parallel.
+//   - Payloads are assigned to protocol contexts in round-robin order.
+//   - Every response received from an endpoint is reported to the QoS context.
+//   - The QoS context is responsible for assembling the user response, e.g. merging the responses.
+//   - Batch requests: payloads which failed are retried once, on a different protocol context.
+//   - An error is returned only if none of the protocol contexts succeeded.
+func (rc *requestContext) handleDistributedRelayRequests() error {
+	logger := rc.logger.
+		With("method", "handleDistributedRelayRequests").
+		With("num_protocol_contexts", len(rc.protocolContexts)).
+		With("service_id", rc.serviceID)
+
+	payloads := rc.getServicePayloads()
+	payloadsByProtocolCtx := make([][]protocol.Payload, len(rc.protocolContexts))
+	// Tracks the protocol context each payload was sent to, to retry failed payloads on a different one.
+	protocolCtxIdxByPayload := make(map[string]int, len(payloads))
+	for i, payload := range payloads {
+		protocolCtxIdx := i % len(rc.protocolContexts)
+		payloadsByProtocolCtx[protocolCtxIdx] = append(payloadsByProtocolCtx[protocolCtxIdx], payload)
+		protocolCtxIdxByPayload[payload.Data] = protocolCtxIdx
+	}
+
+	startTime := time.Now()
+	numSucceeded, lastErr := rc.sendDistributedPayloads(logger, payloadsByProtocolCtx)
+
+	numRetried, numRetrySucceeded, retryErr := rc.retryFailedBatchPayloads(logger, protocolCtxIdxByPayload)
+	numSucceeded += numRetrySucceeded
+	if retryErr != nil {
+		lastErr = retryErr
+	}
+
+	logger.Debug().Msgf("Distributed %d service payloads (%d retried): %d protocol contexts succeeded in %dms",
+		len(payloads), numRetried, numSucceeded, time.Since(startTime).Milliseconds())
+
+	if numSucceeded == 0 {
+		return fmt.Errorf("all distributed relay requests failed, last error: %w", lastErr)
+	}
+
+	return nil
+}
+
+// retryFailedBatchPayloads resends the batch payloads which failed, each to a different protocol context than the one it was first sent to.
+// It returns the number of retried payloads, the number of protocol contexts which succeeded, and the last error encountered.
+// It is a no-op if the request is not a batch.
+func (rc *requestContext) retryFailedBatchPayloads(
+	logger polylog.Logger,
+	protocolCtxIdxByPayload map[string]int,
+) (int, int, error) {
+	batchCtx, ok := rc.qosCtx.(BatchQoSContext)
+	if !ok || batchCtx.GetBatchNumEndpoints() <= 1 {
+		return 0, 0, nil
+	}
+
+	failedPayloads := rc.toRelayPayloads(batchCtx.GetFailedServicePayloads())
+	if len(failedPayloads) == 0 {
+		return 0, 0, nil
+	}
+
+	payloadsByProtocolCtx := make([][]protocol.Payload, len(rc.protocolContexts))
+	for i, payload := range failedPayloads {
+		// Payloads not found in the original assignment are spread in round-robin order.
+		protocolCtxIdx := i
+		if originalIdx, found := protocolCtxIdxByPayload[payload.Data]; found {
+			protocolCtxIdx = originalIdx + 1
+		}
+		protocolCtxIdx %= len(rc.protocolContexts)
+		payloadsByProtocolCtx[protocolCtxIdx] = append(payloadsByProtocolCtx[protocolCtxIdx], payload)
+	}
+
+	logger.Debug().Msgf("Retrying %d failed batch service payloads on a different protocol context", len(failedPayloads))
+	numSucceeded, lastErr := rc.sendDistributedPayloads(logger, payloadsByProtocolCtx)
+	return len(failedPayloads), numSucceeded, lastErr
+}
+
+// sendDistributedPayloads sends each protocol context its assigned payloads, in parallel, and reports all the responses to the QoS context.
+// It returns the number of protocol contexts which succeeded, and the last error encountered.
+func (rc *requestContext) sendDistributedPayloads(
+	logger polylog.Logger,
+	payloadsByProtocolCtx [][]protocol.Payload,
+) (int, error) {
+	var (
+		wg sync.WaitGroup
+		// Ensures thread-safety of QoS context operations and shared result tracking.
+		mu           sync.Mutex
+		numSucceeded int
+		lastErr      error
+	)
+
+	for protocolCtxIdx, protocolCtx := range rc.protocolContexts {
+		protocolCtxPayloads := payloadsByProtocolCtx[protocolCtxIdx]
+		if len(protocolCtxPayloads) == 0 {
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			responses, err := protocolCtx.HandleServiceRequest(protocolCtxPayloads)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				logger.Warn().Err(err).Msgf("Distributed relay request to protocol context %d failed", protocolCtxIdx)
+				lastErr = err
+				rc.reportEndpointErrors(responses)
+			} else {
+				numSucceeded++
+			}
+
+			for _, response := range responses {
+				// Skip responses of failed relays: the QoS context treats missing responses as failures.
+				if err != nil && len(response.Bytes) == 0 {
+					continue
+				}
+				rc.qosCtx.UpdateWithResponse(response.EndpointAddr, response.Bytes)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return numSucceeded, lastErr
+}
+
+// handleFanOutRelayRequests sends the same service payloads to all the selected endpoints in parallel.
+//   - Returns as soon as the QoS context reports sufficient responses, e.g. a transaction accepted by an endpoint.
+//   - Relays still in flight are left to complete: their responses are reported to the QoS context for observations.
+//   - An error is returned if the QoS context did not report sufficient responses before the relay timeout.
+func (rc *requestContext) handleFanOutRelayRequests() error {
+	metrics := &parallelRequestMetrics{
+		numRequestsToAttempt: len(rc.protocolContexts),
+		overallStartTime:     time.Now(),
+	}
+
+	logger := rc.logger.
+		With("method", "handleFanOutRelayRequests").
+		With("num_protocol_contexts", len(rc.protocolContexts)).
+		With("service_id", rc.serviceID)
+
+	// Buffered to allow relays completing after the user response to report their result without blocking.
+	fanOutCompleteChan := make(chan bool, len(rc.protocolContexts))
+
+	for protocolCtxIdx, protocolCtx := range rc.protocolContexts {
+		rc.inFlightRelays.Add(1)
+		rc.startRelay(protocolCtxIdx)
+		go func() {
+			defer rc.inFlightRelays.Done()
+
+			startTime := time.Now()
+			responses, err := protocolCtx.HandleServiceRequest(rc.getServicePayloads())
+			duration := time.Since(startTime)
+			rc.finishRelay(protocolCtxIdx)
+
+			rc.qosCtxMutex.Lock()
+			defer rc.qosCtxMutex.Unlock()
+
+			if err != nil {
+				logger.Warn().Err(err).Msgf("Fan-out relay request to protocol context %d failed after %dms", protocolCtxIdx, duration.Milliseconds())
+				metrics.numFailedOrErrored++
+				rc.reportEndpointErrors(responses)
+			} else {
+				metrics.numCompletedSuccessfully++
+			}
+
+			for _, response := range responses {
+				// Skip responses of failed relays: the QoS context treats missing responses as failures.
+				if err != nil && len(response.Bytes) == 0 {
+					continue
+				}
+				rc.qosCtx.UpdateWithResponse(response.EndpointAddr, response.Bytes)
+			}
+
+			// Update the gateway observations on every completed relay: the last update includes all relays.
+			rc.updateParallelRequestMetrics(metrics)
+
+			fanOutCompleteChan <- rc.isFanOutComplete()
+		}()
+	}
+
+	ctx, cancel := context.WithTimeout(rc.context, rc.getRelayTimeout())
+	defer cancel()
+
+	for range rc.protocolContexts {
+		select {
+		case fanOutComplete := <-fanOutCompleteChan:
+			if fanOutComplete {
+				logger.Debug().Msgf("Fan-out relay requests completed after %dms", time.Since(metrics.overallStartTime).Milliseconds())
+				return nil
+			}
+
+		case <-ctx.Done():
+			return fmt.Errorf("fan-out relay requests did not complete after %dms: %w",
+				time.Since(metrics.overallStartTime).Milliseconds(), ctx.Err())
+		}
+	}
+
+	return fmt.Errorf("fan-out relay requests did not complete: insufficient responses from %d endpoints", len(rc.protocolContexts))
+}
+
 // TODO_TECHDEBT(@adshmh): Remove this method:
 // Parallel requests are an internal detail of a protocol integration package
 // As of PR #388, `protocol/shannon` is the only protocol integration package.
@@ -114,7 +352,7 @@ func (rc *requestContext) handleParallelRelayRequests() error {
 	logger.Debug().Msg("Starting parallel relay race")
 
 	// TODO_TECHDEBT: Make sure timed out parallel requests are also sanctioned.
-	ctx, cancel := context.WithTimeout(rc.context, RelayRequestTimeout)
+	ctx, cancel := context.WithTimeout(rc.context, rc.getRelayTimeout())
 	defer cancel()
 
 	resultChan := rc.launchParallelRequests(ctx, logger)
@@ -141,6 +379,7 @@ func (rc *requestContext) launchParallelRequests(ctx context.Context, logger pol
 	qosContextMutex := sync.Mutex{}
 
 	for protocolCtxIdx, protocolCtx := range rc.protocolContexts {
+		rc.startRelay(protocolCtxIdx)
 		go rc.executeOneOfParallelRequests(ctx, logger, protocolCtx, protocolCtxIdx, resultChan, &qosContextMutex)
 	}
 
@@ -157,8 +396,9 @@ func (rc *requestContext) executeOneOfParallelRequests(
 	qosContextMutex *sync.Mutex,
 ) {
 	startTime := time.Now()
-	responses, err := protocolCtx.HandleServiceRequest(rc.qosCtx.GetServicePayloads())
+	responses, err := protocolCtx.HandleServiceRequest(rc.getServicePayloads())
 	duration := time.Since(startTime)
+	rc.finishRelay(index)
 
 	result := parallelRelayResult{
 		responses: responses,
@@ -173,8 +413,10 @@ func (rc *requestContext) executeOneOfParallelRequests(
 		// 1. Ensure parallel requests are handled correctly by the QoS layer: e.g. cannot use the most recent response as best anymore.
 		// 2. Simplify the parallel requests feature: it may be best to fully encapsulate it in the protocol/shannon package.
 		qosContextMutex.Lock()
-		for _, response := range responses {
-			rc.qosCtx.UpdateWithResponse(response.EndpointAddr, response.Bytes)
+		if !rc.reportEndpointErrors(responses) {
+			for _, response := range responses {
+				rc.qosCtx.UpdateWithResponse(response.EndpointAddr, response.Bytes)
+			}
 		}
 		qosContextMutex.Unlock()
 	}
@@ -293,3 +535,32 @@ func (rc *requestContext) handleAllRequestsFailed(
 func (rc *requestContext) formatTimingLog(result parallelRelayResult) string {
 	return fmt.Sprintf("endpoint_%d=%dms", result.index, result.duration.Milliseconds())
 }
+
+// startRelay marks the relay of the protocol context as in flight.
+// It must be called before the relay is sent in a separate goroutine, which may outlive the user response.
+func (rc *requestContext) startRelay(protocolCtxIdx int) {
+	rc.inFlightProtocolCtxsMu.Lock()
+	defer rc.inFlightProtocolCtxsMu.Unlock()
+
+	if rc.inFlightProtocolCtxs == nil {
+		rc.inFlightProtocolCtxs = make(map[int]struct{})
+	}
+	rc.inFlightProtocolCtxs[protocolCtxIdx] = struct{}{}
+}
+
+// finishRelay marks the relay of the protocol context as completed: its observations are safe to read from then on.
+func (rc *requestContext) finishRelay(protocolCtxIdx int) {
+	rc.inFlightProtocolCtxsMu.Lock()
+	defer rc.inFlightProtocolCtxsMu.Unlock()
+
+	delete(rc.inFlightProtocolCtxs, protocolCtxIdx)
+}
+
+// isRelayInFlight returns true if the relay of the protocol context has not completed yet.
+func (rc *requestContext) isRelayInFlight(protocolCtxIdx int) bool {
+	rc.inFlightProtocolCtxsMu.Lock()
+	defer rc.inFlightProtocolCtxsMu.Unlock()
+
+	_, found := rc.inFlightProtocolCtxs[protocolCtxIdx]
+	return found
+}
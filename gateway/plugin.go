@@ -0,0 +1,240 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+
+	pathhttp "github.com/buildwithgrove/path/network/http"
+	"github.com/buildwithgrove/path/observation"
+	"github.com/buildwithgrove/path/protocol"
+)
+
+// Plugin is a gateway-level extension which can inspect and transform a service's requests and responses.
+//
+// A plugin implements one or more of the hook interfaces, which are called at fixed stages of request processing:
+//   - BeforeQoSPlugin: with the HTTP request, before it is parsed by the service's QoS instance.
+//   - BeforeRelayPlugin: with the service payloads, before they are relayed to the selected endpoint(s).
+//   - AfterResponsePlugin: with the HTTP response built by the service's QoS instance, before it is written to the client.
+//
+// Plugins are registered in Go using RegisterPlugin, and enabled per service through the gateway config.
+// The plugins enabled for a service are called in the order they are listed in the config.
+//
+// Plugin errors do not fail the request: the output of the hook which returned the error is discarded,
+// and the error is recorded in the request's gateway observations.
+//
+// Plugins are called concurrently by all the requests to their services: they must be safe for concurrent use.
+// Only HTTP requests are processed by plugins: Websocket requests and hydrator checks are not.
+type Plugin interface {
+	// Name returns the unique name of the plugin, used to enable it in the gateway config.
+	Name() string
+}
+
+// BeforeQoSPlugin is implemented by plugins which process the HTTP request before it is parsed by the service's QoS instance.
+// e.g. to normalize a client's request headers.
+type BeforeQoSPlugin interface {
+	Plugin
+
+	// BeforeQoS can update the HTTP request in place.
+	// DEV_NOTE: changes made to the HTTP request before returning an error are not reverted.
+	BeforeQoS(ctx context.Context, serviceID protocol.ServiceID, httpReq *http.Request) error
+}
+
+// BeforeRelayPlugin is implemented by plugins which process the service payloads before they are relayed to endpoints.
+// e.g. to add a header required by the service's endpoints.
+type BeforeRelayPlugin interface {
+	Plugin
+
+	// BeforeRelay returns the payloads to relay, in place of the supplied ones.
+	// It must return exactly one payload for each supplied payload, in the same order.
+	//
+	// DEV_NOTE: the endpoints' responses are processed by the service's QoS instance as responses to the original payloads,
+	// e.g. a JSONRPC request's ID must not be changed.
+	BeforeRelay(ctx context.Context, serviceID protocol.ServiceID, payloads []protocol.Payload) ([]protocol.Payload, error)
+}
+
+// AfterResponsePlugin is implemented by plugins which process the HTTP response before it is written to the client.
+// e.g. to add a response header.
+type AfterResponsePlugin interface {
+	Plugin
+
+	// AfterResponse returns the HTTP response to write to the client, in place of the supplied one.
+	AfterResponse(ctx context.Context, serviceID protocol.ServiceID, httpResponse pathhttp.HTTPResponse) (pathhttp.HTTPResponse, error)
+}
+
+var (
+	// pluginsMu protects the plugins registry.
+	pluginsMu sync.RWMutex
+	// plugins maps a plugin's name to the registered plugin.
+	plugins = make(map[string]Plugin)
+)
+
+// RegisterPlugin makes a plugin available to be enabled for services in the gateway config, under its name.
+// It is intended to be called from the `init` function of the package implementing the plugin.
+//
+// It panics if the plugin is nil, or a plugin with the same name is already registered.
+func RegisterPlugin(plugin Plugin) {
+	pluginsMu.Lock()
+	defer pluginsMu.Unlock()
+
+	if plugin == nil {
+		panic("gateway: RegisterPlugin plugin is nil")
+	}
+	name := plugin.Name()
+	if _, found := plugins[name]; found {
+		panic("gateway: RegisterPlugin called twice for plugin " + name)
+	}
+	plugins[name] = plugin
+}
+
+// ServicePlugins holds the plugins enabled for each service.
+type ServicePlugins struct {
+	pluginsByService map[protocol.ServiceID][]Plugin
+}
+
+// NewServicePlugins returns the plugins enabled for each service, looked up by name among the registered plugins.
+// It returns an error if a plugin name is not registered, or is listed more than once for the same service.
+func NewServicePlugins(pluginNamesByService map[protocol.ServiceID][]string) (*ServicePlugins, error) {
+	pluginsMu.RLock()
+	defer pluginsMu.RUnlock()
+
+	pluginsByService := make(map[protocol.ServiceID][]Plugin, len(pluginNamesByService))
+	for serviceID, pluginNames := range pluginNamesByService {
+		servicePlugins := make([]Plugin, 0, len(pluginNames))
+		for i, pluginName := range pluginNames {
+			plugin, found := plugins[pluginName]
+			if !found {
+				return nil, fmt.Errorf("service %q: plugin %q is not registered", serviceID, pluginName)
+			}
+			if slices.Contains(pluginNames[:i], pluginName) {
+				return nil, fmt.Errorf("service %q: plugin %q is enabled more than once", serviceID, pluginName)
+			}
+			servicePlugins = append(servicePlugins, plugin)
+		}
+		pluginsByService[serviceID] = servicePlugins
+	}
+
+	return &ServicePlugins{pluginsByService: pluginsByService}, nil
+}
+
+// getServicePlugins returns the plugins enabled for the service, in the order they are called.
+func (sp *ServicePlugins) getServicePlugins(serviceID protocol.ServiceID) []Plugin {
+	if sp == nil {
+		return nil
+	}
+	return sp.pluginsByService[serviceID]
+}
+
+// applyBeforeQoSPlugins calls the BeforeQoS hook of the service's plugins with the HTTP request.
+func (rc *requestContext) applyBeforeQoSPlugins(httpReq *http.Request) {
+	for _, plugin := range rc.plugins {
+		beforeQoSPlugin, ok := plugin.(BeforeQoSPlugin)
+		if !ok {
+			continue
+		}
+
+		if err := beforeQoSPlugin.BeforeQoS(rc.context, rc.serviceID, httpReq); err != nil {
+			rc.recordPluginError(plugin, observation.GatewayPluginHook_GATEWAY_PLUGIN_HOOK_BEFORE_QOS, err)
+		}
+	}
+}
+
+// applyBeforeRelayPlugins calls the BeforeRelay hook of the service's plugins with the QoS context's service payloads.
+// The resulting payloads are the ones relayed to the endpoints: see getServicePayloads.
+func (rc *requestContext) applyBeforeRelayPlugins() {
+	var hasBeforeRelayPlugin bool
+	payloads := rc.qosCtx.GetServicePayloads()
+	for _, plugin := range rc.plugins {
+		beforeRelayPlugin, ok := plugin.(BeforeRelayPlugin)
+		if !ok {
+			continue
+		}
+		hasBeforeRelayPlugin = true
+
+		pluginPayloads, err := beforeRelayPlugin.BeforeRelay(rc.context, rc.serviceID, slices.Clone(payloads))
+		if err == nil && len(pluginPayloads) != len(payloads) {
+			err = fmt.Errorf("%w: returned %d payloads for %d service payloads", errPluginPayloadCountMismatch, len(pluginPayloads), len(payloads))
+		}
+		if err != nil {
+			rc.recordPluginError(plugin, observation.GatewayPluginHook_GATEWAY_PLUGIN_HOOK_BEFORE_RELAY, err)
+			continue
+		}
+		payloads = pluginPayloads
+	}
+
+	if !hasBeforeRelayPlugin {
+		return
+	}
+
+	// Map the QoS context's payloads to the transformed ones, e.g. to transform the failed payloads of a batch before retrying them.
+	rc.relayPayloads = payloads
+	rc.relayPayloadsByData = make(map[string]protocol.Payload, len(payloads))
+	for i, payload := range rc.qosCtx.GetServicePayloads() {
+		rc.relayPayloadsByData[payload.Data] = payloads[i]
+	}
+}
+
+// getServicePayloads returns the service payloads to relay to the endpoints.
+// These are the QoS context's payloads, as transformed by the service's BeforeRelay plugins, if any.
+func (rc *requestContext) getServicePayloads() []protocol.Payload {
+	if rc.relayPayloads != nil {
+		return rc.relayPayloads
+	}
+	return rc.qosCtx.GetServicePayloads()
+}
+
+// toRelayPayloads returns the transformed payloads corresponding to the supplied QoS context's payloads.
+// Payloads with no transformed counterpart are returned as-is.
+func (rc *requestContext) toRelayPayloads(payloads []protocol.Payload) []protocol.Payload {
+	if rc.relayPayloadsByData == nil {
+		return payloads
+	}
+
+	relayPayloads := make([]protocol.Payload, 0, len(payloads))
+	for _, payload := range payloads {
+		if relayPayload, found := rc.relayPayloadsByData[payload.Data]; found {
+			payload = relayPayload
+		}
+		relayPayloads = append(relayPayloads, payload)
+	}
+	return relayPayloads
+}
+
+// applyAfterResponsePlugins calls the AfterResponse hook of the service's plugins with the HTTP response.
+// It returns the HTTP response to write to the client.
+func (rc *requestContext) applyAfterResponsePlugins(httpResponse pathhttp.HTTPResponse) pathhttp.HTTPResponse {
+	for _, plugin := range rc.plugins {
+		afterResponsePlugin, ok := plugin.(AfterResponsePlugin)
+		if !ok {
+			continue
+		}
+
+		pluginResponse, err := afterResponsePlugin.AfterResponse(rc.context, rc.serviceID, httpResponse)
+		if err == nil && pluginResponse == nil {
+			err = errPluginNilResponse
+		}
+		if err != nil {
+			rc.recordPluginError(plugin, observation.GatewayPluginHook_GATEWAY_PLUGIN_HOOK_AFTER_RESPONSE, err)
+			continue
+		}
+		httpResponse = pluginResponse
+	}
+
+	return httpResponse
+}
+
+// recordPluginError logs the error returned by a plugin's hook, and adds it to the gateway observations.
+func (rc *requestContext) recordPluginError(plugin Plugin, hook observation.GatewayPluginHook, err error) {
+	rc.logger.Warn().Err(err).
+		Str("plugin_name", plugin.Name()).
+		Str("plugin_hook", hook.String()).
+		Msg("Gateway plugin returned an error: discarding the plugin's output.")
+
+	rc.gatewayObservations.PluginErrors = append(rc.gatewayObservations.PluginErrors, &observation.GatewayPluginError{
+		PluginName: plugin.Name(),
+		Hook:       hook,
+		Error:      err.Error(),
+	})
+}
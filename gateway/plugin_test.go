@@ -0,0 +1,151 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/stretchr/testify/require"
+
+	pathhttp "github.com/buildwithgrove/path/network/http"
+	"github.com/buildwithgrove/path/observation"
+	"github.com/buildwithgrove/path/protocol"
+)
+
+// testPlugin implements all the plugin hooks using the supplied functions.
+type testPlugin struct {
+	name          string
+	beforeQoS     func(*http.Request) error
+	beforeRelay   func([]protocol.Payload) ([]protocol.Payload, error)
+	afterResponse func(pathhttp.HTTPResponse) (pathhttp.HTTPResponse, error)
+}
+
+func (p testPlugin) Name() string { return p.name }
+
+func (p testPlugin) BeforeQoS(_ context.Context, _ protocol.ServiceID, httpReq *http.Request) error {
+	return p.beforeQoS(httpReq)
+}
+
+func (p testPlugin) BeforeRelay(_ context.Context, _ protocol.ServiceID, payloads []protocol.Payload) ([]protocol.Payload, error) {
+	return p.beforeRelay(payloads)
+}
+
+func (p testPlugin) AfterResponse(_ context.Context, _ protocol.ServiceID, httpResponse pathhttp.HTTPResponse) (pathhttp.HTTPResponse, error) {
+	return p.afterResponse(httpResponse)
+}
+
+// testPayloadsQoSContext is a request QoS context which only returns service payloads.
+type testPayloadsQoSContext struct {
+	RequestQoSContext
+	payloads []protocol.Payload
+}
+
+func (c testPayloadsQoSContext) GetServicePayloads() []protocol.Payload { return c.payloads }
+
+// testHTTPResponse is an HTTP response with a fixed payload.
+type testHTTPResponse struct {
+	payload string
+}
+
+func (r testHTTPResponse) GetPayload() []byte                { return []byte(r.payload) }
+func (r testHTTPResponse) GetHTTPStatusCode() int            { return http.StatusOK }
+func (r testHTTPResponse) GetHTTPHeaders() map[string]string { return nil }
+
+func TestNewServicePlugins(t *testing.T) {
+	RegisterPlugin(testPlugin{name: "test_new_service_plugins"})
+	require.Panics(t, func() { RegisterPlugin(testPlugin{name: "test_new_service_plugins"}) })
+
+	servicePlugins, err := NewServicePlugins(map[protocol.ServiceID][]string{"eth": {"test_new_service_plugins"}})
+	require.NoError(t, err)
+	require.Len(t, servicePlugins.getServicePlugins("eth"), 1)
+	require.Empty(t, servicePlugins.getServicePlugins("solana"))
+
+	_, err = NewServicePlugins(map[protocol.ServiceID][]string{"eth": {"unregistered_plugin"}})
+	require.ErrorContains(t, err, "is not registered")
+
+	_, err = NewServicePlugins(map[protocol.ServiceID][]string{"eth": {"test_new_service_plugins", "test_new_service_plugins"}})
+	require.ErrorContains(t, err, "more than once")
+
+	// Plugins are disabled if not set.
+	var noPlugins *ServicePlugins
+	require.Empty(t, noPlugins.getServicePlugins("eth"))
+}
+
+func TestRequestContext_ApplyPlugins(t *testing.T) {
+	errPlugin := errors.New("plugin error")
+
+	addHeaderPlugin := testPlugin{
+		name: "add_header",
+		beforeQoS: func(httpReq *http.Request) error {
+			httpReq.Header.Set("X-Plugin", "true")
+			return nil
+		},
+		beforeRelay: func(payloads []protocol.Payload) ([]protocol.Payload, error) {
+			for i := range payloads {
+				payloads[i].Headers = map[string]string{"X-Plugin": "true"}
+			}
+			return payloads, nil
+		},
+		afterResponse: func(pathhttp.HTTPResponse) (pathhttp.HTTPResponse, error) {
+			return testHTTPResponse{payload: "transformed"}, nil
+		},
+	}
+	failingPlugin := testPlugin{
+		name:      "failing",
+		beforeQoS: func(*http.Request) error { return errPlugin },
+		beforeRelay: func(payloads []protocol.Payload) ([]protocol.Payload, error) {
+			return payloads[:1], nil
+		},
+		afterResponse: func(pathhttp.HTTPResponse) (pathhttp.HTTPResponse, error) {
+			return testHTTPResponse{payload: "discarded"}, errPlugin
+		},
+	}
+
+	payloads := []protocol.Payload{{Data: `{"id":1}`}, {Data: `{"id":2}`}}
+	rc := &requestContext{
+		logger:              polyzero.NewLogger(),
+		context:             context.Background(),
+		serviceID:           "eth",
+		qosCtx:              testPayloadsQoSContext{payloads: payloads},
+		gatewayObservations: &observation.GatewayObservations{},
+		plugins:             []Plugin{addHeaderPlugin, failingPlugin},
+	}
+
+	httpReq, err := http.NewRequest(http.MethodPost, "/v1", nil)
+	require.NoError(t, err)
+	rc.applyBeforeQoSPlugins(httpReq)
+	require.Equal(t, "true", httpReq.Header.Get("X-Plugin"))
+
+	// The failing plugin's payloads are discarded: it returned a single payload for two service payloads.
+	rc.applyBeforeRelayPlugins()
+	relayPayloads := rc.getServicePayloads()
+	require.Len(t, relayPayloads, 2)
+	for _, payload := range relayPayloads {
+		require.Equal(t, "true", payload.Headers["X-Plugin"])
+	}
+	require.Empty(t, payloads[0].Headers, "the QoS context's payloads should not be modified")
+	require.Equal(t, relayPayloads[1:], rc.toRelayPayloads(payloads[1:]))
+
+	httpResponse := rc.applyAfterResponsePlugins(testHTTPResponse{payload: "original"})
+	require.Equal(t, "transformed", string(httpResponse.GetPayload()))
+
+	pluginErrors := rc.gatewayObservations.GetPluginErrors()
+	require.Len(t, pluginErrors, 3)
+	require.Equal(t, observation.GatewayPluginHook_GATEWAY_PLUGIN_HOOK_BEFORE_QOS, pluginErrors[0].GetHook())
+	require.Equal(t, observation.GatewayPluginHook_GATEWAY_PLUGIN_HOOK_BEFORE_RELAY, pluginErrors[1].GetHook())
+	require.Equal(t, observation.GatewayPluginHook_GATEWAY_PLUGIN_HOOK_AFTER_RESPONSE, pluginErrors[2].GetHook())
+	for _, pluginError := range pluginErrors {
+		require.Equal(t, "failing", pluginError.GetPluginName())
+	}
+}
+
+func TestRequestContext_GetServicePayloads_NoPlugins(t *testing.T) {
+	payloads := []protocol.Payload{{Data: `{"id":1}`}}
+	rc := &requestContext{qosCtx: testPayloadsQoSContext{payloads: payloads}}
+
+	rc.applyBeforeRelayPlugins()
+	require.Equal(t, payloads, rc.getServicePayloads())
+	require.Equal(t, payloads, rc.toRelayPayloads(payloads))
+}
@@ -3,6 +3,7 @@ package gateway
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/buildwithgrove/path/metrics/devtools"
 	pathhttp "github.com/buildwithgrove/path/network/http"
@@ -57,6 +58,118 @@ type RequestQoSContext interface {
 	GetEndpointSelector() protocol.EndpointSelector
 }
 
+// DistributedPayloadsQoSContext
+//
+// Optional interface, implemented by request QoS contexts whose service payloads are independent of each other.
+// - Signals the gateway to send each service payload to a different endpoint, in parallel.
+// - All received responses are reported back through UpdateWithResponse.
+// - Example: an EVM `eth_getLogs` request split into multiple block range chunks.
+//
+// TODO_TECHDEBT(@adshmh): Fold into RequestQoSContext once the protocol context can
+// handle sending payloads to multiple endpoints.
+type DistributedPayloadsQoSContext interface {
+	// DistributePayloadsAcrossEndpoints:
+	// - Returns true if the service payloads should be sent to different endpoints.
+	DistributePayloadsAcrossEndpoints() bool
+}
+
+// BatchQoSContext
+//
+// Optional interface, implemented by request QoS contexts of JSON-RPC batch requests.
+// - Signals the gateway to distribute the batch's service payloads across multiple endpoints, in parallel.
+// - Service payloads which failed, e.g. due to an endpoint timing out, are retried on a different endpoint.
+// - All received responses are reported back through UpdateWithResponse.
+// - The QoS context is responsible for reassembling the batch response in request order.
+type BatchQoSContext interface {
+	// GetBatchNumEndpoints:
+	// - Returns the number of endpoints the batch's service payloads should be distributed across.
+	// - A value of 0 or 1 indicates the batch should be sent to a single endpoint.
+	GetBatchNumEndpoints() uint
+
+	// GetFailedServicePayloads:
+	// - Returns the service payloads with no successful response reported through UpdateWithResponse.
+	GetFailedServicePayloads() []protocol.Payload
+}
+
+// BroadcastQoSContext
+//
+// Optional interface, implemented by request QoS contexts which need their service payloads sent to multiple endpoints.
+// - Example: transaction submission requests, e.g. EVM `eth_sendRawTransaction`.
+// - The gateway sends the service payloads to the selected endpoints in parallel.
+// - The user response is returned as soon as the QoS context reports a successful broadcast.
+// - Responses received after the user response are still reported through UpdateWithResponse, for observations.
+type BroadcastQoSContext interface {
+	// GetBroadcastNumEndpoints:
+	// - Returns the number of endpoints the service payloads should be broadcast to.
+	// - A value of 0 or 1 indicates the request is not a broadcast.
+	GetBroadcastNumEndpoints() uint
+
+	// HasSuccessfulBroadcastResponse:
+	// - Returns true if any of the responses reported through UpdateWithResponse indicate a successful broadcast.
+	HasSuccessfulBroadcastResponse() bool
+}
+
+// ConsensusQoSContext
+//
+// Optional interface, implemented by request QoS contexts which need the same service payloads sent to multiple endpoints for comparison.
+// - Example: a high-value read, e.g. EVM `eth_call`, sent in consensus-read mode.
+// - The gateway sends the service payloads to the selected endpoints in parallel.
+// - The user response is returned as soon as the QoS context reports a consensus was reached.
+// - Responses received after the user response are still reported through UpdateWithResponse, for observations.
+type ConsensusQoSContext interface {
+	// GetConsensusNumEndpoints:
+	// - Returns the number of endpoints the service payloads should be sent to.
+	// - A value of 0 or 1 indicates the request is not a consensus-read.
+	GetConsensusNumEndpoints() uint
+
+	// HasConsensusResponse:
+	// - Returns true if the responses reported through UpdateWithResponse meet the required quorum.
+	HasConsensusResponse() bool
+}
+
+// CoalescableQoSContext
+//
+// Optional interface, implemented by request QoS contexts whose requests can share the relay of an identical in-flight request.
+// - Example: bursts of identical EVM `eth_blockNumber` requests from many users.
+// - Only one relay is sent for concurrent requests with the same coalescing key.
+// - The relay's response is reported to each of the identical requests' QoS contexts.
+type CoalescableQoSContext interface {
+	// GetCoalescingKey:
+	// - Returns the key identifying identical requests, e.g. the normalized JSON-RPC method and params.
+	// - Returns false if the request must not be coalesced, e.g. a state-changing method.
+	GetCoalescingKey() (string, bool)
+
+	// UpdateWithCoalescedResponse:
+	// - Informs the request QoS context of the payload returned by an endpoint to an identical request.
+	// - The QoS context adjusts the payload to match its own request, e.g. rewrites the JSON-RPC ID.
+	UpdateWithCoalescedResponse(endpointAddr protocol.EndpointAddr, endpointSerializedResponse []byte)
+}
+
+// EndpointErrorQoSContext
+//
+// Optional interface, implemented by request QoS contexts which track failed relays to endpoints.
+// - Example: a REST service tracking the rate of non-2xx responses returned by each endpoint.
+// - Non-2xx responses are rejected by the protocol and never reported through UpdateWithResponse.
+// - The gateway reports every failed relay through UpdateWithEndpointError instead.
+type EndpointErrorQoSContext interface {
+	// UpdateWithEndpointError:
+	// - Informs the request QoS context of a failed relay to the endpoint.
+	// - httpStatusCode is the HTTP status code returned by the endpoint, or 0 if no response was received, e.g. a timeout.
+	UpdateWithEndpointError(endpointAddr protocol.EndpointAddr, httpStatusCode int)
+}
+
+// RelayTimeoutQoSContext
+//
+// Optional interface, implemented by request QoS contexts whose relays have a service-specific timeout.
+// - Example: EVM `eth_getLogs` requests, which may legitimately take longer than the default relay timeout.
+// - The timeout applies to all relays sent for the request, and may be shortened by the client: see HTTPHeaderRelayTimeout.
+type RelayTimeoutQoSContext interface {
+	// GetRelayTimeout:
+	// - Returns the timeout of the request's relays.
+	// - A value of 0 indicates the default relay timeout, i.e. RelayRequestTimeout, should be used.
+	GetRelayTimeout() time.Duration
+}
+
 // QoSContextBuilder
 //
 // Builds the QoS context required for all steps of a service request.
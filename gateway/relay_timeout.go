@@ -0,0 +1,88 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/buildwithgrove/path/observation"
+)
+
+// HTTPHeaderRelayTimeout is the HTTP header a client can set to request a shorter relay timeout, in milliseconds, e.g. "2000".
+//   - The timeout applies to all the relays sent for the request, including relays to fallback endpoints.
+//   - It can only shorten the relay timeout: a value longer than the service's relay timeout is ignored.
+const HTTPHeaderRelayTimeout = "Relay-Timeout-Ms"
+
+// clientDeadlineContextKey is the key of the context value marking a deadline requested by the client.
+type clientDeadlineContextKey struct{}
+
+// IsClientRequestedDeadline returns true if the context's deadline was requested by the client through HTTPHeaderRelayTimeout.
+// Used by the protocol to avoid sanctioning endpoints which did not respond before a deadline shorter than the service's relay timeout.
+func IsClientRequestedDeadline(ctx context.Context) bool {
+	isClientDeadline, _ := ctx.Value(clientDeadlineContextKey{}).(bool)
+	return isClientDeadline
+}
+
+// getClientRelayTimeoutMs returns the relay timeout, in milliseconds, requested by the client through HTTPHeaderRelayTimeout.
+// Returns false if the request does not set the header, or the header's value is invalid.
+func getClientRelayTimeoutMs(httpReq *http.Request) (uint64, bool) {
+	headerValue := httpReq.Header.Get(HTTPHeaderRelayTimeout)
+	if headerValue == "" {
+		return 0, false
+	}
+
+	timeoutMs, err := strconv.ParseUint(headerValue, 10, 64)
+	if err != nil || timeoutMs == 0 {
+		return 0, false
+	}
+	return timeoutMs, true
+}
+
+// applyRelayTimeout sets the deadline of the request's relays on the request context's context.
+// It returns the function releasing the resources of the context: it must be called once the request is handled.
+//
+// The relay timeout is:
+//   - The service's timeout for the request, e.g. a per-method timeout, if the QoS context specifies one.
+//   - RelayRequestTimeout otherwise.
+//   - Shortened to the timeout requested by the client, if any: see HTTPHeaderRelayTimeout.
+func (rc *requestContext) applyRelayTimeout(httpReq *http.Request) context.CancelFunc {
+	relayTimeout := RelayRequestTimeout
+	source := observation.RelayTimeoutSource_RELAY_TIMEOUT_SOURCE_DEFAULT
+	if relayTimeoutCtx, ok := rc.qosCtx.(RelayTimeoutQoSContext); ok {
+		if serviceRelayTimeout := relayTimeoutCtx.GetRelayTimeout(); serviceRelayTimeout > 0 {
+			relayTimeout = serviceRelayTimeout
+			source = observation.RelayTimeoutSource_RELAY_TIMEOUT_SOURCE_SERVICE_METHOD
+		}
+	}
+
+	ctx := rc.context
+	if clientTimeoutMs, ok := getClientRelayTimeoutMs(httpReq); ok && clientTimeoutMs < uint64(relayTimeout.Milliseconds()) {
+		relayTimeout = time.Duration(clientTimeoutMs) * time.Millisecond
+		source = observation.RelayTimeoutSource_RELAY_TIMEOUT_SOURCE_CLIENT
+		ctx = context.WithValue(ctx, clientDeadlineContextKey{}, true)
+	}
+
+	rc.relayTimeout = relayTimeout
+	rc.gatewayObservations.RelayTimeoutMs = uint64(relayTimeout.Milliseconds())
+	rc.gatewayObservations.RelayTimeoutSource = source
+
+	ctx, cancel := context.WithTimeout(ctx, relayTimeout)
+	rc.context = ctx
+	return cancel
+}
+
+// getRelayTimeout returns the timeout of the request's relays.
+// Defaults to RelayRequestTimeout if no relay timeout was applied, e.g. for hydrator checks.
+func (rc *requestContext) getRelayTimeout() time.Duration {
+	if rc.relayTimeout == 0 {
+		return RelayRequestTimeout
+	}
+	return rc.relayTimeout
+}
+
+// isRelayDeadlineExceeded returns true if the deadline of the request's relays has passed.
+func (rc *requestContext) isRelayDeadlineExceeded() bool {
+	deadline, ok := rc.context.Deadline()
+	return ok && !time.Now().Before(deadline)
+}
@@ -0,0 +1,102 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildwithgrove/path/observation"
+)
+
+// testRelayTimeoutQoSContext is a request QoS context which only returns a relay timeout.
+type testRelayTimeoutQoSContext struct {
+	RequestQoSContext
+	relayTimeout time.Duration
+}
+
+func (c testRelayTimeoutQoSContext) GetRelayTimeout() time.Duration { return c.relayTimeout }
+
+func TestRequestContext_ApplyRelayTimeout(t *testing.T) {
+	tests := []struct {
+		name                string
+		qosCtx              RequestQoSContext
+		clientTimeoutHeader string
+		wantRelayTimeout    time.Duration
+		wantSource          observation.RelayTimeoutSource
+		wantClientDeadline  bool
+	}{
+		{
+			name:             "should use the default relay timeout",
+			qosCtx:           testPayloadsQoSContext{},
+			wantRelayTimeout: RelayRequestTimeout,
+			wantSource:       observation.RelayTimeoutSource_RELAY_TIMEOUT_SOURCE_DEFAULT,
+		},
+		{
+			name:             "should use the default relay timeout if the service does not set one",
+			qosCtx:           testRelayTimeoutQoSContext{},
+			wantRelayTimeout: RelayRequestTimeout,
+			wantSource:       observation.RelayTimeoutSource_RELAY_TIMEOUT_SOURCE_DEFAULT,
+		},
+		{
+			name:             "should use the service's relay timeout",
+			qosCtx:           testRelayTimeoutQoSContext{relayTimeout: 90 * time.Second},
+			wantRelayTimeout: 90 * time.Second,
+			wantSource:       observation.RelayTimeoutSource_RELAY_TIMEOUT_SOURCE_SERVICE_METHOD,
+		},
+		{
+			name:                "should use a shorter relay timeout requested by the client",
+			qosCtx:              testRelayTimeoutQoSContext{relayTimeout: 90 * time.Second},
+			clientTimeoutHeader: "2000",
+			wantRelayTimeout:    2 * time.Second,
+			wantSource:          observation.RelayTimeoutSource_RELAY_TIMEOUT_SOURCE_CLIENT,
+			wantClientDeadline:  true,
+		},
+		{
+			name:                "should ignore a longer relay timeout requested by the client",
+			qosCtx:              testRelayTimeoutQoSContext{relayTimeout: 5 * time.Second},
+			clientTimeoutHeader: "10000",
+			wantRelayTimeout:    5 * time.Second,
+			wantSource:          observation.RelayTimeoutSource_RELAY_TIMEOUT_SOURCE_SERVICE_METHOD,
+		},
+		{
+			name:                "should ignore an invalid relay timeout requested by the client",
+			qosCtx:              testPayloadsQoSContext{},
+			clientTimeoutHeader: "-1",
+			wantRelayTimeout:    RelayRequestTimeout,
+			wantSource:          observation.RelayTimeoutSource_RELAY_TIMEOUT_SOURCE_DEFAULT,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rc := &requestContext{
+				context:             context.Background(),
+				qosCtx:              test.qosCtx,
+				gatewayObservations: &observation.GatewayObservations{},
+			}
+			require.Equal(t, RelayRequestTimeout, rc.getRelayTimeout())
+
+			httpReq, err := http.NewRequest(http.MethodPost, "/v1", nil)
+			require.NoError(t, err)
+			if test.clientTimeoutHeader != "" {
+				httpReq.Header.Set(HTTPHeaderRelayTimeout, test.clientTimeoutHeader)
+			}
+
+			cancel := rc.applyRelayTimeout(httpReq)
+			defer cancel()
+
+			require.Equal(t, test.wantRelayTimeout, rc.getRelayTimeout())
+			require.Equal(t, uint64(test.wantRelayTimeout.Milliseconds()), rc.gatewayObservations.GetRelayTimeoutMs())
+			require.Equal(t, test.wantSource, rc.gatewayObservations.GetRelayTimeoutSource())
+			require.Equal(t, test.wantClientDeadline, IsClientRequestedDeadline(rc.context))
+
+			deadline, ok := rc.context.Deadline()
+			require.True(t, ok)
+			require.WithinDuration(t, time.Now().Add(test.wantRelayTimeout), deadline, time.Second)
+			require.False(t, rc.isRelayDeadlineExceeded())
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/buildwithgrove/path/protocol"
+)
+
+// errCoalescedRelayNotSent is reported to the requests waiting on a coalesced relay which was never sent,
+// e.g. if no endpoints could be selected for the coalesced request.
+var errCoalescedRelayNotSent = errors.New("coalesced relay was not sent")
+
+// RequestCoalescer shares a single relay among concurrent identical requests:
+//   - The first request with a given service ID and coalescing key sends the relay.
+//   - Identical requests received while the relay is in flight wait for, and share, its response.
+//   - Requests are identified as identical by their QoS context: see the CoalescableQoSContext interface.
+type RequestCoalescer struct {
+	mu sync.Mutex
+	// inFlightRelays tracks the coalesced relays currently in flight.
+	inFlightRelays map[requestCoalescingKey]*coalescedRelay
+}
+
+// requestCoalescingKey identifies identical requests to a service.
+type requestCoalescingKey struct {
+	serviceID     protocol.ServiceID
+	coalescingKey string
+}
+
+// coalescedRelay is a relay shared by multiple identical requests.
+type coalescedRelay struct {
+	// done is closed once the relay's responses are available.
+	done      chan struct{}
+	doneOnce  sync.Once
+	responses []protocol.Response
+	err       error
+}
+
+// NewRequestCoalescer returns a request coalescer with no relays in flight.
+func NewRequestCoalescer() *RequestCoalescer {
+	return &RequestCoalescer{
+		inFlightRelays: make(map[requestCoalescingKey]*coalescedRelay),
+	}
+}
+
+// join returns the in-flight relay for the supplied key, creating it if there is none.
+// Returns true if the relay was created, i.e. the caller is responsible for sending the relay and completing it.
+func (c *RequestCoalescer) join(key requestCoalescingKey) (*coalescedRelay, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if relay, found := c.inFlightRelays[key]; found {
+		return relay, false
+	}
+
+	relay := &coalescedRelay{done: make(chan struct{})}
+	c.inFlightRelays[key] = relay
+	return relay, true
+}
+
+// complete stores the relay's responses and releases all the requests waiting on it.
+// Requests received afterward send a new relay.
+func (c *RequestCoalescer) complete(key requestCoalescingKey, relay *coalescedRelay, responses []protocol.Response, err error) {
+	relay.doneOnce.Do(func() {
+		c.mu.Lock()
+		delete(c.inFlightRelays, key)
+		c.mu.Unlock()
+
+		relay.responses = responses
+		relay.err = err
+		close(relay.done)
+	})
+}
+
+// wait blocks until the relay is completed, or the supplied context is done.
+func (r *coalescedRelay) wait(ctx context.Context) ([]protocol.Response, error) {
+	select {
+	case <-r.done:
+		return r.responses, r.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// joinCoalescedRelay shares the relay of an identical in-flight request, if possible.
+//   - Returns true if the request was served using the response of an identical request: no relay should be sent.
+//   - Returns false if the request should send its own relay.
+//     If the request is coalescable, it becomes the one sending the relay for identical requests.
+func (rc *requestContext) joinCoalescedRelay() bool {
+	if rc.requestCoalescer == nil {
+		return false
+	}
+
+	coalescableCtx, ok := rc.qosCtx.(CoalescableQoSContext)
+	if !ok {
+		return false
+	}
+
+	coalescingKey, ok := coalescableCtx.GetCoalescingKey()
+	if !ok {
+		return false
+	}
+
+	key := requestCoalescingKey{serviceID: rc.serviceID, coalescingKey: coalescingKey}
+	relay, isLeader := rc.requestCoalescer.join(key)
+	if isLeader {
+		rc.coalescingKey = &key
+		rc.coalescedRelay = relay
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(rc.context, rc.getRelayTimeout())
+	defer cancel()
+
+	responses, err := relay.wait(ctx)
+	if err != nil || len(responses) == 0 {
+		// The identical request's relay failed: send a separate relay for this request.
+		rc.logger.Debug().Err(err).Msg("Coalesced relay failed: sending a separate relay.")
+		return false
+	}
+
+	for _, response := range responses {
+		coalescableCtx.UpdateWithCoalescedResponse(response.EndpointAddr, response.Bytes)
+	}
+
+	rc.isCoalescedRequest = true
+	rc.gatewayObservations.IsCoalescedRequest = true
+	return true
+}
+
+// completeCoalescedRelay shares the relay's responses with identical requests waiting on it.
+// It is a no-op if the request is not sending a relay for identical requests.
+func (rc *requestContext) completeCoalescedRelay(responses []protocol.Response, err error) {
+	if rc.coalescedRelay == nil {
+		return
+	}
+
+	rc.requestCoalescer.complete(*rc.coalescingKey, rc.coalescedRelay, responses, err)
+}
@@ -0,0 +1,54 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildwithgrove/path/protocol"
+)
+
+func TestRequestCoalescer(t *testing.T) {
+	c := require.New(t)
+
+	coalescer := NewRequestCoalescer()
+	key := requestCoalescingKey{serviceID: "eth", coalescingKey: "eth_blockNumber:"}
+
+	leaderRelay, isLeader := coalescer.join(key)
+	c.True(isLeader)
+
+	waiterRelay, isLeader := coalescer.join(key)
+	c.False(isLeader)
+	c.Same(leaderRelay, waiterRelay)
+
+	otherRelay, isLeader := coalescer.join(requestCoalescingKey{serviceID: "eth", coalescingKey: "eth_chainId:"})
+	c.True(isLeader)
+	c.NotSame(leaderRelay, otherRelay)
+
+	responses := []protocol.Response{{Bytes: []byte(`{"jsonrpc":"2.0","id":1,"result":"0x10"}`), EndpointAddr: "endpoint-1"}}
+	coalescer.complete(key, leaderRelay, responses, nil)
+	// Completing a relay more than once is a no-op.
+	coalescer.complete(key, leaderRelay, nil, errCoalescedRelayNotSent)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	waitResponses, err := waiterRelay.wait(ctx)
+	c.NoError(err)
+	c.Equal(responses, waitResponses)
+
+	// Requests received after the relay is completed send a new relay.
+	_, isLeader = coalescer.join(key)
+	c.True(isLeader)
+}
+
+func TestCoalescedRelayWaitTimeout(t *testing.T) {
+	coalescer := NewRequestCoalescer()
+	relay, _ := coalescer.join(requestCoalescingKey{serviceID: "eth", coalescingKey: "eth_blockNumber:"})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := relay.wait(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -38,6 +38,9 @@ type (
 		Logger            polylog.Logger
 		Components        []Check
 		ServiceIDReporter ServiceIDReporter
+		// StallReporters report whether each service's chain has stopped advancing, e.g. a chain halt.
+		// Only services with stall detection support are included.
+		StallReporters map[protocol.ServiceID]StallReporter
 	}
 
 	// health.Check is an interface that must be implemented
@@ -53,6 +56,12 @@ type (
 	ServiceIDReporter interface {
 		ConfiguredServiceIDs() map[protocol.ServiceID]struct{}
 	}
+
+	// StallReporter is satisfied by QoS instances supporting stall detection.
+	// It reports whether the service's perceived block height has stopped advancing.
+	StallReporter interface {
+		IsStalled() bool
+	}
 )
 
 // healthCheckJSON is the JSON structure of the response body
@@ -68,6 +77,9 @@ type healthCheckJSON struct {
 	ReadyStates map[string]bool `json:"readyStates,omitempty"`
 	// ConfiguredServiceIDs lists the service IDs that the PATH instance is configured for.
 	ConfiguredServiceIDs []protocol.ServiceID `json:"configuredServiceIDs,omitempty"`
+	// StalledServiceIDs lists the service IDs whose chain has stopped advancing, e.g. a chain halt.
+	// A stalled service does not affect the status: PATH itself is still ready to serve traffic.
+	StalledServiceIDs []protocol.ServiceID `json:"stalledServiceIDs,omitempty"`
 }
 
 // healthCheckHandler returns the health status of PATH as a JSON response.
@@ -111,6 +123,7 @@ func (c *Checker) getHealthCheckResponse(status healthCheckStatus, readyStates m
 		ReadyStates:          readyStates,
 		ImageTag:             imageTag,
 		ConfiguredServiceIDs: c.getConfiguredServiceIDs(),
+		StalledServiceIDs:    c.getStalledServiceIDs(),
 	}
 
 	responseBytes, err := json.Marshal(healthCheckJSON)
@@ -145,6 +158,18 @@ func (c *Checker) getConfiguredServiceIDs() []protocol.ServiceID {
 	return configuredServiceIDs
 }
 
+// getStalledServiceIDs returns a sorted slice of the service IDs reported as stalled
+func (c *Checker) getStalledServiceIDs() []protocol.ServiceID {
+	var stalledServiceIDs []protocol.ServiceID
+	for serviceID, stallReporter := range c.StallReporters {
+		if stallReporter.IsStalled() {
+			stalledServiceIDs = append(stalledServiceIDs, serviceID)
+		}
+	}
+	slices.Sort(stalledServiceIDs)
+	return stalledServiceIDs
+}
+
 // getStatus returns false if any component is not ready, otherwise true
 func getStatus(readyStates map[string]bool) healthCheckStatus {
 	for _, ready := range readyStates {
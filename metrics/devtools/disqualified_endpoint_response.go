@@ -55,13 +55,28 @@ type (
 	}
 
 	// QoSLevelDataResponse contains data about disqualified endpoints at the QoS level.
-	// It reports the number of disqualified endpoints, the number of empty response endpoints, the number of chain ID check errors, the number of archival check errors, and the number of block number check errors.
+	// It reports the number of disqualified endpoints, the number of empty response endpoints, the number of chain ID check errors, the number of archival check errors, the number of fork check errors, the number of syncing check errors, the number of peer count check errors, and the number of block number check errors.
+	// For services with archival probes, it also reports each endpoint's pass/fail state for every probe.
 	QoSLevelDataResponse struct {
 		DisqualifiedEndpoints       map[protocol.EndpointAddr]QoSDisqualifiedEndpoint `json:"disqualified_endpoints"`
 		EmptyResponseCount          int                                               `json:"empty_response_count"`
 		ChainIDCheckErrorsCount     int                                               `json:"chain_id_check_errors_count"`
 		ArchivalCheckErrorsCount    int                                               `json:"archival_check_errors_count"`
+		ForkCheckErrorsCount        int                                               `json:"fork_check_errors_count"`
+		SyncingCheckErrorsCount     int                                               `json:"syncing_check_errors_count"`
+		PeerCountCheckErrorsCount   int                                               `json:"peer_count_check_errors_count"`
 		BlockNumberCheckErrorsCount int                                               `json:"block_number_check_errors_count"`
+
+		// A mapping from endpoint address to the endpoint's result for each archival probe, keyed by probe name.
+		ArchivalProbeResults map[protocol.EndpointAddr]map[string]QoSArchivalProbeResult `json:"archival_probe_results,omitempty"`
+	}
+
+	// QoSArchivalProbeResult represents an endpoint's result for a single archival probe.
+	QoSArchivalProbeResult struct {
+		// BlockNumber is the block number of the endpoint's latest observation for the probe.
+		BlockNumber string `json:"block_number"`
+		Passed      bool   `json:"passed"`
+		Reason      string `json:"reason,omitempty"`
 	}
 
 	// SanctionedEndpoint represents an endpoint sanctioned at the protocol level.
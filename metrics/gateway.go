@@ -15,16 +15,20 @@ const (
 	pathProcess = "path"
 
 	// The list of metrics being tracked for gateway-level observations
-	requestsTotalMetricName         = "requests_total" // TODO_TECHDEBT: Align the relays/requests terminology
-	parallelRequestsTotalMetricName = "parallel_requests_total"
-	responseSizeBytesMetricName     = "response_size_bytes"
-	relayDurationSecondsMetricName  = "relay_duration_seconds"
-	versionInfoMetricName           = "version_info"
+	requestsTotalMetricName          = "requests_total" // TODO_TECHDEBT: Align the relays/requests terminology
+	parallelRequestsTotalMetricName  = "parallel_requests_total"
+	endpointAffinityTotalMetricName  = "endpoint_affinity_total"
+	coalescedRequestsTotalMetricName = "coalesced_requests_total"
+	responseSizeBytesMetricName      = "response_size_bytes"
+	relayDurationSecondsMetricName   = "relay_duration_seconds"
+	versionInfoMetricName            = "version_info"
 )
 
 func init() {
 	prometheus.MustRegister(relaysTotal)
 	prometheus.MustRegister(parallelRequestsTotal)
+	prometheus.MustRegister(endpointAffinityTotal)
+	prometheus.MustRegister(coalescedRequestsTotal)
 	prometheus.MustRegister(relaysDurationSeconds)
 	prometheus.MustRegister(relayResponseSizeBytes)
 	prometheus.MustRegister(versionInfo)
@@ -137,6 +141,39 @@ var (
 		},
 		[]string{"service_id", "num_requests", "num_successful", "num_failed", "num_canceled"},
 	)
+
+	// endpointAffinityTotal tracks the outcome of endpoint affinity lookups.
+	// Increment for each request with an affinity key, with labels:
+	//   - service_id: Identifies the service
+	//   - result: Whether the request was sent to the client's affinity endpoint, or the reason it was not.
+	//   - drop_reason: Why the client's affinity endpoint was dropped after the request, if it was.
+	//
+	// Usage:
+	// - Monitor the affinity hit rate
+	// - Track how often affinity is lost, e.g. due to session rollovers or failed relays.
+	endpointAffinityTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: pathProcess,
+			Name:      endpointAffinityTotalMetricName,
+			Help:      "Total requests with an endpoint affinity key, labeled by affinity lookup result and drop reason.",
+		},
+		[]string{"service_id", "result", "drop_reason"},
+	)
+
+	// coalescedRequestsTotal tracks requests served using the relay of an identical in-flight request.
+	// Each increment is one relay saved. Labels:
+	//   - service_id: Identifies the service
+	//
+	// Usage:
+	// - Monitor the number of relays saved by request coalescing.
+	coalescedRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: pathProcess,
+			Name:      coalescedRequestsTotalMetricName,
+			Help:      "Total requests served using the relay of an identical in-flight request, i.e. relays saved.",
+		},
+		[]string{"service_id"},
+	)
 )
 
 // publishGatewayMetrics publishes all metrics related to gateway-level observations.
@@ -201,6 +238,26 @@ func publishGatewayMetrics(
 		}).Inc()
 	}
 
+	// Record the outcome of the endpoint affinity lookup.
+	// Only record if the request had an endpoint affinity key.
+	if endpointAffinityObs := gatewayObservations.GetEndpointAffinity(); endpointAffinityObs != nil {
+		var dropReason string
+		if endpointAffinityObs.DropReason != nil {
+			dropReason = endpointAffinityObs.GetDropReason().String()
+		}
+
+		endpointAffinityTotal.With(prometheus.Labels{
+			"service_id":  serviceID,
+			"result":      endpointAffinityObs.GetResult().String(),
+			"drop_reason": dropReason,
+		}).Inc()
+	}
+
+	// Record the relays saved by request coalescing.
+	if gatewayObservations.GetIsCoalescedRequest() {
+		coalescedRequestsTotal.With(prometheus.Labels{"service_id": serviceID}).Inc()
+	}
+
 	// Return the validity status of the request.
 	return requestErr == nil
 }
@@ -41,6 +41,7 @@ const (
 
 	// Latency metrics (currently HTTP only)
 	endpointLatencyMetric       = "shannon_endpoint_latency_seconds"
+	endpointHTTPPhaseMetric     = "shannon_endpoint_http_phase_duration_seconds"
 	relayMinerErrorsTotalMetric = "shannon_relay_miner_errors_total"
 
 	// The default value for a domain if it cannot be extracted from an endpoint URL
@@ -79,6 +80,7 @@ func init() {
 	prometheus.MustRegister(endpointLatency)
 	prometheus.MustRegister(endpointResponseSize)
 	prometheus.MustRegister(relayMinerErrorsTotal)
+	prometheus.MustRegister(endpointHTTPPhaseDuration)
 }
 
 var (
@@ -338,6 +340,27 @@ var (
 		[]string{"service_id", "endpoint_domain", "success"},
 	)
 
+	// endpointHTTPPhaseDuration tracks the duration of each phase of the HTTP requests sent to endpoints.
+	// Labels:
+	//   - service_id: Target service identifier
+	//   - endpoint_domain: Effective TLD+1 domain extracted from endpoint URL
+	//   - phase: one of dns_lookup, connect, tls_handshake, get_connection, request_write, time_to_first_byte
+	//
+	// Connection setup phases (dns_lookup, connect, tls_handshake) are only recorded if they happened, e.g. not for reused connections.
+	//
+	// Use to analyze:
+	//   - Whether slow relays are due to the endpoint's networking, TLS setup, or backend processing (time_to_first_byte)
+	//   - Connection setup costs by endpoint domain
+	endpointHTTPPhaseDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: pathProcess,
+			Name:      endpointHTTPPhaseMetric,
+			Help:      "Histogram of the duration of each phase of HTTP requests to endpoints in seconds",
+			Buckets:   defaultBuckets,
+		},
+		[]string{"service_id", "endpoint_domain", "phase"},
+	)
+
 	// relayMinerErrorsTotal tracks RelayMinerError occurrences separately from Shannon protocol errors
 	// This metric allows analysis of RelayMinerError patterns independently while including
 	// endpoint error type for cross-referencing with Shannon protocol errors.
@@ -416,6 +439,9 @@ func PublishMetrics(
 			// Process RelayMinerError occurrences separately
 			processRelayMinerErrors(logger, observationSet.GetServiceId(), httpObservations.GetEndpointObservations())
 
+			// Process the timing breakdown of HTTP requests to endpoints
+			processEndpointHTTPTimings(logger, observationSet.GetServiceId(), httpObservations.GetEndpointObservations())
+
 		case *protocolobservations.ShannonRequestObservations_WebsocketConnectionObservation:
 			// Websocket connection observation - new metrics processing
 			wsConnectionObs := obsData.WebsocketConnectionObservation
@@ -769,6 +795,54 @@ func processEndpointLatency(
 	}
 }
 
+// processEndpointHTTPTimings records the duration of each phase of the HTTP requests sent to endpoints.
+// Only records endpoints with HTTP timings, i.e. the HTTP request was sent.
+func processEndpointHTTPTimings(
+	logger polylog.Logger,
+	serviceID string,
+	observations []*protocolobservations.ShannonEndpointObservation,
+) {
+	logger = logger.With("method", "processEndpointHTTPTimings")
+
+	for _, endpointObs := range observations {
+		httpTimings := endpointObs.GetHttpTimings()
+		if httpTimings == nil {
+			continue
+		}
+
+		// Extract effective TLD+1 from endpoint URL.
+		endpointUrl := endpointObs.GetEndpointUrl()
+		endpointDomain, err := ExtractDomainOrHost(endpointUrl)
+		if err != nil {
+			logger.Error().Str("endpoint_url", endpointUrl).Err(err).Msg("Could not extract domain from endpoint URL")
+			endpointDomain = ErrDomain
+		}
+
+		phaseDurations := map[string]float64{
+			"dns_lookup":         httpTimings.GetDnsLookupSeconds(),
+			"connect":            httpTimings.GetConnectSeconds(),
+			"tls_handshake":      httpTimings.GetTlsHandshakeSeconds(),
+			"get_connection":     httpTimings.GetGetConnectionSeconds(),
+			"request_write":      httpTimings.GetRequestWriteSeconds(),
+			"time_to_first_byte": httpTimings.GetTimeToFirstByteSeconds(),
+		}
+
+		for phase, durationSeconds := range phaseDurations {
+			// Skip connection setup phases which did not happen, e.g. an idle connection was reused, or the endpoint does not use TLS.
+			if durationSeconds == 0 && (phase == "dns_lookup" || phase == "connect" || phase == "tls_handshake") {
+				continue
+			}
+
+			endpointHTTPPhaseDuration.With(
+				prometheus.Labels{
+					"service_id":      serviceID,
+					"endpoint_domain": endpointDomain,
+					"phase":           phase,
+				}).Observe(durationSeconds)
+		}
+	}
+}
+
 // processRelayMinerErrors records RelayMinerError occurrences separately from Shannon protocol errors
 func processRelayMinerErrors(
 	logger polylog.Logger,
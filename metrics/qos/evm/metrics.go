@@ -21,6 +21,9 @@ const (
 	validEndpointsMetric           = "evm_valid_endpoints"
 	endpointValidationsTotalMetric = "evm_endpoint_validations_total"
 	jsonrpcErrorsTotalMetric       = "evm_jsonrpc_errors_total"
+	consensusReadsTotalMetric      = "evm_consensus_reads_total"
+	consensusResponsesTotalMetric  = "evm_consensus_responses_total"
+	forkedEndpointsMetric          = "evm_forked_endpoints"
 )
 
 func init() {
@@ -29,6 +32,9 @@ func init() {
 	prometheus.MustRegister(validEndpoints)
 	prometheus.MustRegister(endpointValidationsTotal)
 	prometheus.MustRegister(jsonrpcErrorsTotal)
+	prometheus.MustRegister(consensusReadsTotal)
+	prometheus.MustRegister(consensusResponsesTotal)
+	prometheus.MustRegister(forkedEndpoints)
 }
 
 var (
@@ -131,6 +137,9 @@ var (
 	//   - "ENDPOINT_VALIDATION_FAILURE_REASON_NO_BLOCK_NUMBER_OBSERVATION"
 	//   - "ENDPOINT_VALIDATION_FAILURE_REASON_NO_CHAIN_ID_OBSERVATION"
 	//   - "ENDPOINT_VALIDATION_FAILURE_REASON_ARCHIVAL_CHECK_FAILED"
+	//   - "ENDPOINT_VALIDATION_FAILURE_REASON_BLOCK_HASH_MISMATCH"
+	//   - "ENDPOINT_VALIDATION_FAILURE_REASON_SYNCING"
+	//   - "ENDPOINT_VALIDATION_FAILURE_REASON_PEER_COUNT_BELOW_MINIMUM"
 	//   - "ENDPOINT_VALIDATION_FAILURE_REASON_ENDPOINT_NOT_FOUND"
 	//   - "ENDPOINT_VALIDATION_FAILURE_REASON_UNKNOWN"
 	//
@@ -177,6 +186,62 @@ var (
 		},
 		[]string{"chain_id", "service_id", "request_method", "endpoint_domain", "jsonrpc_error_code"},
 	)
+
+	// consensusReadsTotal tracks the requests served in consensus-read mode, i.e. sent to multiple endpoints for comparison.
+	// Labels:
+	//   - chain_id: Target EVM chain identifier
+	//   - service_id: Service ID of the EVM QoS instance
+	//   - request_method: JSON-RPC method name
+	//   - quorum_reached: Whether the majority result was returned by at least a quorum of the endpoints
+	//   - unanimous: Whether all the endpoint responses agreed
+	//
+	// Use to analyze:
+	//   - Consensus-read failure rate: sum(quorum_reached="false") / sum(all)
+	//   - Rate of requests with at least one disagreeing endpoint: sum(unanimous="false") / sum(all)
+	consensusReadsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: pathProcess,
+			Name:      consensusReadsTotalMetric,
+			Help:      "Total number of requests served in consensus-read mode by EVM QoS instance(s)",
+		},
+		[]string{"chain_id", "service_id", "request_method", "quorum_reached", "unanimous"},
+	)
+
+	// consensusResponsesTotal tracks the endpoint responses received in consensus-read mode.
+	// Labels:
+	//   - chain_id: Target EVM chain identifier
+	//   - service_id: Service ID of the EVM QoS instance
+	//   - endpoint_domain: eTLD+1 of endpoint URL for provider analysis
+	//   - agreed: Whether the endpoint's response matched the majority result
+	//
+	// Only tracked for consensus-reads which reached the quorum: otherwise no response can be considered correct.
+	//
+	// Use to analyze:
+	//   - Disagreement rate by provider: sum(agreed="false") / sum(all) by endpoint_domain
+	consensusResponsesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: pathProcess,
+			Name:      consensusResponsesTotalMetric,
+			Help:      "Total endpoint responses received in consensus-read mode, categorized by agreement with the majority result",
+		},
+		[]string{"chain_id", "service_id", "endpoint_domain", "agreed"},
+	)
+
+	// forkedEndpoints tracks the number of endpoints whose block hash does not match the majority block hash.
+	// Labels:
+	//   - chain_id: Target EVM chain identifier
+	//   - service_id: Service ID of the EVM QoS instance
+	//
+	// Use to analyze:
+	//   - Endpoints on a minority fork, or serving a different network which reuses the chain ID
+	forkedEndpoints = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: pathProcess,
+			Name:      forkedEndpointsMetric,
+			Help:      "Number of endpoints on a minority fork for EVM QoS instance(s)",
+		},
+		[]string{"chain_id", "service_id"},
+	)
 )
 
 // PublishMetrics exports all EVM-related Prometheus metrics using observations reported by EVM QoS service.
@@ -294,6 +359,78 @@ func PublishMetrics(logger polylog.Logger, observations *qos.EVMRequestObservati
 
 	// Publish validation failure metrics using the structured data from metadata
 	publishValidationMetricsFromMetadata(logger, chainID, serviceID, endpointSelectionMetadata)
+
+	// Publish consensus-read metrics, if the request was served in consensus-read mode.
+	publishConsensusReadMetrics(chainID, serviceID, methods, observations)
+
+	// Publish fork check metrics, if the service's fork check block number has been selected.
+	publishForkCheckMetrics(chainID, serviceID, observations)
+}
+
+// publishForkCheckMetrics publishes the number of forked endpoints.
+func publishForkCheckMetrics(chainID, serviceID string, observations *qos.EVMRequestObservations) {
+	forkCheck := observations.GetForkCheck()
+	if forkCheck == nil {
+		return
+	}
+
+	labels := prometheus.Labels{
+		"chain_id":   chainID,
+		"service_id": serviceID,
+	}
+	forkedEndpoints.With(labels).Set(float64(forkCheck.GetNumForkedEndpoints()))
+}
+
+// publishConsensusReadMetrics publishes the outcome of a consensus-read, and the agreement of each endpoint response.
+func publishConsensusReadMetrics(
+	chainID, serviceID string,
+	methods []string,
+	observations *qos.EVMRequestObservations,
+) {
+	consensusRead := observations.GetConsensusRead()
+	if consensusRead == nil {
+		return
+	}
+
+	// Consensus-read only applies to single requests.
+	var method string
+	if len(methods) > 0 {
+		method = methods[0]
+	}
+
+	consensusReadsTotal.With(
+		prometheus.Labels{
+			"chain_id":       chainID,
+			"service_id":     serviceID,
+			"request_method": method,
+			"quorum_reached": fmt.Sprintf("%t", consensusRead.GetQuorumReached()),
+			"unanimous":      fmt.Sprintf("%t", consensusRead.GetNumAgreeingResponses() == consensusRead.GetNumResponses()),
+		}).Inc()
+
+	// No response can be considered correct if the quorum was not reached.
+	if !consensusRead.GetQuorumReached() {
+		return
+	}
+
+	disagreeingEndpointAddrs := make(map[string]struct{}, len(consensusRead.GetDisagreeingEndpointAddrs()))
+	for _, endpointAddr := range consensusRead.GetDisagreeingEndpointAddrs() {
+		disagreeingEndpointAddrs[endpointAddr] = struct{}{}
+	}
+
+	for _, requestObs := range observations.GetRequestObservations() {
+		for _, endpointObs := range requestObs.GetEndpointObservations() {
+			endpointAddr := endpointObs.GetEndpointAddr()
+			_, disagreed := disagreeingEndpointAddrs[endpointAddr]
+
+			consensusResponsesTotal.With(
+				prometheus.Labels{
+					"chain_id":        chainID,
+					"service_id":      serviceID,
+					"endpoint_domain": shannonmetrics.ExtractTLDFromEndpointAddr(endpointAddr),
+					"agreed":          fmt.Sprintf("%t", !disagreed),
+				}).Inc()
+		}
+	}
 }
 
 // publishValidationMetricsFromMetadata publishes validation metrics (both failures and successes)
@@ -0,0 +1,85 @@
+package genericjsonrpc
+
+import (
+	"fmt"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/buildwithgrove/path/observation/qos"
+)
+
+const (
+	// The POSIX process that emits metrics
+	pathProcess = "path"
+
+	// The list of metrics being tracked for generic JSON-RPC QoS
+	requestsTotalMetric = "generic_jsonrpc_requests_total"
+)
+
+func init() {
+	prometheus.MustRegister(requestsTotal)
+}
+
+var (
+	// requestsTotal tracks total requests processed by generic JSON-RPC QoS instances.
+	//
+	// - Labels:
+	//   - service_id: Service ID of the generic JSON-RPC QoS instance
+	//   - request_origin: origin of the request: User or Hydrator.
+	//   - request_method: JSON-RPC method name, empty for batch requests
+	//   - success: Whether a valid response was received
+	//   - http_status_code: HTTP status code of the selected endpoint response
+	//
+	// - Use cases:
+	//   - Analyze request volume by service and method
+	//   - Measure end-to-end request success rates of services with no dedicated QoS
+	requestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: pathProcess,
+			Name:      requestsTotalMetric,
+			Help:      "Total number of requests processed by generic JSON-RPC QoS instance(s)",
+		},
+		[]string{"service_id", "request_origin", "request_method", "success", "http_status_code"},
+	)
+)
+
+// PublishMetrics exports all generic JSON-RPC Prometheus metrics using observations from generic JSON-RPC QoS services.
+func PublishMetrics(logger polylog.Logger, observations *qos.GenericJsonRpcRequestObservations) {
+	logger = logger.With("method", "PublishMetricsGenericJSONRPC")
+
+	// Skip if observations is nil.
+	// This should never happen as PublishQoSMetrics uses nil checks to identify which QoS service produced the observations.
+	if observations == nil {
+		logger.ProbabilisticDebugInfo(polylog.ProbabilisticDebugInfoProb).Msg("SHOULD RARELY HAPPEN: Unable to publish generic JSON-RPC metrics: received nil observations.")
+		return
+	}
+
+	success, httpStatusCode := getRequestStatus(observations)
+
+	requestsTotal.With(
+		prometheus.Labels{
+			"service_id":       observations.GetServiceId(),
+			"request_origin":   observations.GetRequestOrigin().String(),
+			"request_method":   observations.GetJsonrpcRequest().GetMethod(),
+			"success":          fmt.Sprintf("%t", success),
+			"http_status_code": fmt.Sprintf("%d", httpStatusCode),
+		}).Inc()
+}
+
+// getRequestStatus returns whether the request succeeded, and the HTTP status code returned for it.
+// A request succeeds if the most recent endpoint response is a valid JSON-RPC response with no error.
+func getRequestStatus(observations *qos.GenericJsonRpcRequestObservations) (bool, int32) {
+	if requestErr := observations.GetRequestError(); requestErr != nil {
+		return false, requestErr.GetHttpStatusCode()
+	}
+
+	endpointObservations := observations.GetEndpointObservations()
+	if len(endpointObservations) == 0 {
+		return false, 0
+	}
+
+	lastObservation := endpointObservations[len(endpointObservations)-1]
+	success := lastObservation.GetValidationError() == nil && lastObservation.GetJsonrpcResponse().GetError() == nil
+	return success, lastObservation.GetHttpStatusCode()
+}
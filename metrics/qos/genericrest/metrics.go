@@ -0,0 +1,84 @@
+package genericrest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/buildwithgrove/path/observation/qos"
+)
+
+const (
+	// The POSIX process that emits metrics
+	pathProcess = "path"
+
+	// The list of metrics being tracked for generic REST QoS
+	requestsTotalMetric = "generic_rest_requests_total"
+)
+
+func init() {
+	prometheus.MustRegister(requestsTotal)
+}
+
+var (
+	// requestsTotal tracks total requests processed by generic REST QoS instances.
+	//
+	// - Labels:
+	//   - service_id: Service ID of the generic REST QoS instance
+	//   - request_origin: origin of the request: User or Hydrator.
+	//   - request_method: HTTP method of the request
+	//   - success: Whether a 2xx response was received
+	//   - http_status_code: HTTP status code returned by the endpoint: 0 if no response was received
+	//
+	// - Use cases:
+	//   - Analyze request volume by service and HTTP method
+	//   - Track non-2xx and 5xx response rates of services with no dedicated QoS
+	requestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: pathProcess,
+			Name:      requestsTotalMetric,
+			Help:      "Total number of requests processed by generic REST QoS instance(s)",
+		},
+		[]string{"service_id", "request_origin", "request_method", "success", "http_status_code"},
+	)
+)
+
+// PublishMetrics exports all generic REST Prometheus metrics using observations from generic REST QoS services.
+func PublishMetrics(logger polylog.Logger, observations *qos.GenericRestRequestObservations) {
+	logger = logger.With("method", "PublishMetricsGenericREST")
+
+	// Skip if observations is nil.
+	// This should never happen as PublishQoSMetrics uses nil checks to identify which QoS service produced the observations.
+	if observations == nil {
+		logger.ProbabilisticDebugInfo(polylog.ProbabilisticDebugInfoProb).Msg("SHOULD RARELY HAPPEN: Unable to publish generic REST metrics: received nil observations.")
+		return
+	}
+
+	httpStatusCode := getRequestHTTPStatusCode(observations)
+
+	requestsTotal.With(
+		prometheus.Labels{
+			"service_id":       observations.GetServiceId(),
+			"request_origin":   observations.GetRequestOrigin().String(),
+			"request_method":   observations.GetRequestMethod(),
+			"success":          fmt.Sprintf("%t", httpStatusCode >= http.StatusOK && httpStatusCode < http.StatusMultipleChoices),
+			"http_status_code": fmt.Sprintf("%d", httpStatusCode),
+		}).Inc()
+}
+
+// getRequestHTTPStatusCode returns the HTTP status code of the most recent endpoint response.
+// Returns the status code of the request error, if any, or 0 if no endpoint responses were received.
+func getRequestHTTPStatusCode(observations *qos.GenericRestRequestObservations) int32 {
+	if requestErr := observations.GetRequestError(); requestErr != nil {
+		return requestErr.GetHttpStatusCode()
+	}
+
+	endpointObservations := observations.GetEndpointObservations()
+	if len(endpointObservations) == 0 {
+		return 0
+	}
+
+	return endpointObservations[len(endpointObservations)-1].GetHttpStatusCode()
+}
@@ -0,0 +1,116 @@
+package move
+
+import (
+	"fmt"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/buildwithgrove/path/observation/qos"
+)
+
+const (
+	// The POSIX process that emits metrics
+	pathProcess = "path"
+
+	// The list of metrics being tracked for Move QoS
+	requestsTotalMetric = "move_requests_total"
+)
+
+func init() {
+	prometheus.MustRegister(requestsTotal)
+}
+
+var (
+	// requestsTotal tracks total requests processed by Move (e.g. Sui or Aptos) QoS instances.
+	//
+	// - Labels:
+	//   - chain_id: Target chain ID, e.g. "35834a8a" for Sui mainnet or "1" for Aptos mainnet
+	//   - service_id: Service ID of the Move QoS instance
+	//   - request_origin: origin of the request: User or Hydrator.
+	//   - api_type: API of the request: JSON-RPC or REST
+	//   - request_method: JSON-RPC method name, empty for batch requests, or the HTTP method of REST requests
+	//   - success: Whether a valid response was received
+	//   - http_status_code: HTTP status code of the selected endpoint response
+	//
+	// - Use cases:
+	//   - Analyze request volume by chain, API and method
+	//   - Measure end-to-end request success rates
+	//   - Examine HTTP status code distribution
+	requestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: pathProcess,
+			Name:      requestsTotalMetric,
+			Help:      "Total number of requests processed by Move QoS instance(s)",
+		},
+		[]string{"chain_id", "service_id", "request_origin", "api_type", "request_method", "success", "http_status_code"},
+	)
+)
+
+// PublishMetrics exports all Move Prometheus metrics using observations from Move QoS services.
+func PublishMetrics(logger polylog.Logger, observations *qos.MoveRequestObservations) {
+	logger = logger.With("method", "PublishMetricsMove")
+
+	// Skip if observations is nil.
+	// This should never happen as PublishQoSMetrics uses nil checks to identify which QoS service produced the observations.
+	if observations == nil {
+		logger.ProbabilisticDebugInfo(polylog.ProbabilisticDebugInfoProb).Msg("SHOULD RARELY HAPPEN: Unable to publish Move metrics: received nil observations.")
+		return
+	}
+
+	success, httpStatusCode := getRequestStatus(observations)
+
+	requestsTotal.With(
+		prometheus.Labels{
+			"chain_id":         observations.GetChainId(),
+			"service_id":       observations.GetServiceId(),
+			"request_origin":   observations.GetRequestOrigin().String(),
+			"api_type":         observations.GetBackendServiceType().String(),
+			"request_method":   getRequestMethod(observations),
+			"success":          fmt.Sprintf("%t", success),
+			"http_status_code": fmt.Sprintf("%d", httpStatusCode),
+		}).Inc()
+}
+
+// getRequestMethod returns the JSON-RPC method of the request, or the HTTP method of a REST request.
+// The path of REST requests is not used to keep the cardinality of the metric bounded.
+func getRequestMethod(observations *qos.MoveRequestObservations) string {
+	if restRequest := observations.GetRestRequest(); restRequest != nil {
+		return restRequest.GetHttpMethod()
+	}
+	return observations.GetJsonrpcRequest().GetMethod()
+}
+
+// getRequestStatus returns whether the request succeeded, and the HTTP status code returned for it.
+// A request succeeds if the most recent endpoint response is a valid 2xx response, with no JSON-RPC error.
+func getRequestStatus(observations *qos.MoveRequestObservations) (bool, int32) {
+	if requestErr := observations.GetRequestError(); requestErr != nil {
+		return false, requestErr.GetHttpStatusCode()
+	}
+
+	endpointObservations := observations.GetEndpointObservations()
+	if len(endpointObservations) == 0 {
+		return false, 0
+	}
+
+	lastObservation := endpointObservations[len(endpointObservations)-1]
+	httpStatusCode := lastObservation.GetHttpStatusCode()
+
+	var success bool
+	switch {
+	case lastObservation.GetSuiChainIdentifierResponse() != nil:
+		success = !lastObservation.GetSuiChainIdentifierResponse().GetInvalid()
+	case lastObservation.GetSuiLatestCheckpointResponse() != nil:
+		success = !lastObservation.GetSuiLatestCheckpointResponse().GetInvalid()
+	case lastObservation.GetAptosLedgerInfoResponse() != nil:
+		success = !lastObservation.GetAptosLedgerInfoResponse().GetInvalid()
+	case lastObservation.GetUnrecognizedResponse() != nil:
+		unrecognizedResponse := lastObservation.GetUnrecognizedResponse()
+		success = unrecognizedResponse.GetValidationError() == nil && unrecognizedResponse.GetJsonrpcResponse().GetError() == nil
+	default:
+		// Failed relays, e.g. a non-2xx response, have no response observation.
+		success = false
+	}
+
+	return success && httpStatusCode >= 200 && httpStatusCode < 300, httpStatusCode
+}
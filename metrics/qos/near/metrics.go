@@ -0,0 +1,104 @@
+package near
+
+import (
+	"fmt"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/buildwithgrove/path/observation/qos"
+)
+
+const (
+	// The POSIX process that emits metrics
+	pathProcess = "path"
+
+	// The list of metrics being tracked for NEAR QoS
+	requestsTotalMetric = "near_requests_total"
+)
+
+func init() {
+	prometheus.MustRegister(requestsTotal)
+}
+
+var (
+	// requestsTotal tracks total requests processed by NEAR QoS instances.
+	//
+	// - Labels:
+	//   - chain_id: Target NEAR chain ID, e.g. "mainnet"
+	//   - service_id: Service ID of the NEAR QoS instance
+	//   - request_origin: origin of the request: User or Hydrator.
+	//   - request_method: JSON-RPC method name
+	//   - success: Whether a valid response was received
+	//   - http_status_code: HTTP status code of the selected endpoint response
+	//   - near_error_kind: Classification of the NEAR error returned by the selected endpoint, if any: user or endpoint error.
+	//
+	// - Use cases:
+	//   - Analyze request volume by chain and method
+	//   - Measure end-to-end request success rates
+	//   - Distinguish user errors, e.g. unknown accounts, from endpoint errors, e.g. unsynced nodes
+	requestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: pathProcess,
+			Name:      requestsTotalMetric,
+			Help:      "Total number of requests processed by NEAR QoS instance(s)",
+		},
+		[]string{"chain_id", "service_id", "request_origin", "request_method", "success", "http_status_code", "near_error_kind"},
+	)
+)
+
+// PublishMetrics exports all NEAR Prometheus metrics using observations from NEAR QoS services.
+func PublishMetrics(logger polylog.Logger, observations *qos.NearRequestObservations) {
+	logger = logger.With("method", "PublishMetricsNear")
+
+	// Skip if observations is nil.
+	// This should never happen as PublishQoSMetrics uses nil checks to identify which QoS service produced the observations.
+	if observations == nil {
+		logger.ProbabilisticDebugInfo(polylog.ProbabilisticDebugInfoProb).Msg("SHOULD RARELY HAPPEN: Unable to publish NEAR metrics: received nil observations.")
+		return
+	}
+
+	success, httpStatusCode, errorKind := getRequestStatus(observations)
+
+	requestsTotal.With(
+		prometheus.Labels{
+			"chain_id":         observations.GetChainId(),
+			"service_id":       observations.GetServiceId(),
+			"request_origin":   observations.GetRequestOrigin().String(),
+			"request_method":   observations.GetJsonrpcRequest().GetMethod(),
+			"success":          fmt.Sprintf("%t", success),
+			"http_status_code": fmt.Sprintf("%d", httpStatusCode),
+			"near_error_kind":  errorKind,
+		}).Inc()
+}
+
+// getRequestStatus returns whether the request succeeded, the HTTP status code returned for it,
+// and the kind of the NEAR error returned by the endpoint, if any.
+// A request succeeds if the most recent endpoint response is a valid JSON-RPC response with no error.
+func getRequestStatus(observations *qos.NearRequestObservations) (bool, int32, string) {
+	if requestErr := observations.GetRequestError(); requestErr != nil {
+		return false, requestErr.GetHttpStatusCode(), ""
+	}
+
+	endpointObservations := observations.GetEndpointObservations()
+	if len(endpointObservations) == 0 {
+		return false, 0, ""
+	}
+
+	lastObservation := endpointObservations[len(endpointObservations)-1]
+
+	var errorKind string
+	if nearError := lastObservation.GetNearError(); nearError != nil {
+		errorKind = nearError.GetKind().String()
+	}
+
+	var success bool
+	if statusResponse := lastObservation.GetStatusResponse(); statusResponse != nil {
+		success = !statusResponse.GetInvalid()
+	} else {
+		unrecognizedResponse := lastObservation.GetUnrecognizedResponse()
+		success = unrecognizedResponse.GetValidationError() == nil && unrecognizedResponse.GetJsonrpcResponse().GetError() == nil
+	}
+
+	return success, lastObservation.GetHttpStatusCode(), errorKind
+}
@@ -6,7 +6,12 @@ import (
 
 	"github.com/buildwithgrove/path/metrics/qos/cosmos"
 	"github.com/buildwithgrove/path/metrics/qos/evm"
+	"github.com/buildwithgrove/path/metrics/qos/genericjsonrpc"
+	"github.com/buildwithgrove/path/metrics/qos/genericrest"
+	"github.com/buildwithgrove/path/metrics/qos/move"
+	"github.com/buildwithgrove/path/metrics/qos/near"
 	"github.com/buildwithgrove/path/metrics/qos/solana"
+	"github.com/buildwithgrove/path/metrics/qos/utxo"
 	"github.com/buildwithgrove/path/observation/qos"
 )
 
@@ -22,6 +27,9 @@ func PublishQoSMetrics(
 		return
 	}
 
+	// Publish the service's stall state, for QoS services supporting stall detection.
+	publishServiceStallMetric(qosObservations)
+
 	// Publish EVM metrics.
 	if evmObservations := qosObservations.GetEvm(); evmObservations != nil {
 		evm.PublishMetrics(hydratedLogger, evmObservations)
@@ -43,6 +51,41 @@ func PublishQoSMetrics(
 		return
 	}
 
+	// Publish generic JSON-RPC metrics.
+	if genericJSONRPCObservations := qosObservations.GetGenericJsonrpc(); genericJSONRPCObservations != nil {
+		genericjsonrpc.PublishMetrics(hydratedLogger, genericJSONRPCObservations)
+		hydratedLogger.Debug().Msg("published generic JSON-RPC metrics.")
+		return
+	}
+
+	// Publish generic REST metrics.
+	if genericRESTObservations := qosObservations.GetGenericRest(); genericRESTObservations != nil {
+		genericrest.PublishMetrics(hydratedLogger, genericRESTObservations)
+		hydratedLogger.Debug().Msg("published generic REST metrics.")
+		return
+	}
+
+	// Publish UTXO metrics.
+	if utxoObservations := qosObservations.GetUtxo(); utxoObservations != nil {
+		utxo.PublishMetrics(hydratedLogger, utxoObservations)
+		hydratedLogger.Debug().Msg("published UTXO metrics.")
+		return
+	}
+
+	// Publish NEAR metrics.
+	if nearObservations := qosObservations.GetNear(); nearObservations != nil {
+		near.PublishMetrics(hydratedLogger, nearObservations)
+		hydratedLogger.Debug().Msg("published NEAR metrics.")
+		return
+	}
+
+	// Publish Move metrics.
+	if moveObservations := qosObservations.GetMove(); moveObservations != nil {
+		move.PublishMetrics(hydratedLogger, moveObservations)
+		hydratedLogger.Debug().Msg("published Move metrics.")
+		return
+	}
+
 	// Log warning if no matching observation types were found
 	hydratedLogger.Warn().Msgf("SHOULD RARELY HAPPEN: supplied observations do not match any known QoS service: '%+v'", qosObservations)
 }
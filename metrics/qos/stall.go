@@ -0,0 +1,71 @@
+package qos
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/buildwithgrove/path/observation/qos"
+)
+
+const (
+	// The POSIX process that emits metrics
+	pathProcess = "path"
+
+	// serviceStalledMetric tracks whether a service's chain has stopped advancing.
+	serviceStalledMetric = "qos_service_stalled"
+)
+
+func init() {
+	prometheus.MustRegister(serviceStalled)
+}
+
+// serviceStalled reports whether each service's perceived block height has stopped advancing:
+// 1 if stalled, e.g. a chain halt or all endpoints stuck at the same height, 0 otherwise.
+//
+// - Labels:
+//   - service_id: Service ID of the QoS instance
+//
+// - Use cases:
+//   - Alert on chain halts, which PATH cannot route around
+//   - Tell apart a stalled chain from endpoint or PATH issues when error rates rise
+//
+// Only exported for services with stall detection enabled, i.e. with an expected block time configured.
+var serviceStalled = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: pathProcess,
+		Name:      serviceStalledMetric,
+		Help:      "Whether the service's perceived block height has stopped advancing: 1 if stalled, 0 otherwise",
+	},
+	[]string{"service_id"},
+)
+
+// publishServiceStallMetric exports the stall state of the service, if included in the observations.
+// Only EVM, CosmosSDK and Solana QoS services support stall detection.
+func publishServiceStallMetric(qosObservations *qos.Observations) {
+	var (
+		serviceID        string
+		stallObservation *qos.ServiceStallObservation
+	)
+
+	switch {
+	case qosObservations.GetEvm() != nil:
+		serviceID = qosObservations.GetEvm().GetServiceId()
+		stallObservation = qosObservations.GetEvm().GetServiceStall()
+	case qosObservations.GetCosmos() != nil:
+		serviceID = qosObservations.GetCosmos().GetServiceId()
+		stallObservation = qosObservations.GetCosmos().GetServiceStall()
+	case qosObservations.GetSolana() != nil:
+		serviceID = qosObservations.GetSolana().GetServiceId()
+		stallObservation = qosObservations.GetSolana().GetServiceStall()
+	}
+
+	// Stall detection is not enabled for the service.
+	if stallObservation == nil {
+		return
+	}
+
+	var stalled float64
+	if stallObservation.GetStalled() {
+		stalled = 1
+	}
+	serviceStalled.With(prometheus.Labels{"service_id": serviceID}).Set(stalled)
+}
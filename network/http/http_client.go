@@ -81,6 +81,37 @@ type httpRequestMetrics struct {
 	error      error
 }
 
+// HTTPRequestTimings is the timing breakdown of a single HTTP request.
+// Connection setup timings, i.e. DNSLookup, Connect and TLSHandshake, are 0 if an idle connection was reused.
+type HTTPRequestTimings struct {
+	DNSLookup    time.Duration
+	Connect      time.Duration
+	TLSHandshake time.Duration
+	// GetConnection is the time spent obtaining a connection, including the setup of a new connection.
+	GetConnection time.Duration
+	// RequestWrite is the time spent writing the request's headers and body.
+	RequestWrite time.Duration
+	// TimeToFirstByte is the time between writing the request and receiving the first byte of the response.
+	TimeToFirstByte time.Duration
+	// Total is the overall time spent on the request, including reading the response body.
+	Total            time.Duration
+	ConnectionReused bool
+}
+
+// getTimings returns the timing breakdown of the request.
+func (m httpRequestMetrics) getTimings() HTTPRequestTimings {
+	return HTTPRequestTimings{
+		DNSLookup:        m.dnsLookupTime,
+		Connect:          m.connectTime,
+		TLSHandshake:     m.tlsTime,
+		GetConnection:    m.getConnTime,
+		RequestWrite:     m.wroteHeadersTime + m.wroteRequestTime,
+		TimeToFirstByte:  m.firstByteTime,
+		Total:            m.totalTime,
+		ConnectionReused: m.connectionReused,
+	}
+}
+
 // NewDefaultHTTPClientWithDebugMetrics creates a new HTTP client with:
 // - Transport settings configured for high-concurrency usage
 // - Built in request debugging capabilities and metrics tracking
@@ -100,8 +131,8 @@ func NewDefaultHTTPClientWithDebugMetrics() *HTTPClientWithDebugMetrics {
 		IdleConnTimeout:     90 * time.Second,           // Reduced from 300s - shorter idle to free resources
 
 		// Timeout settings optimized for quick failure detection
-		TLSHandshakeTimeout:   5 * time.Second,  // Fast TLS timeout since handshakes typically complete in ~100ms
-		ResponseHeaderTimeout: 70 * time.Second, // Conservative header timeout to allow for server processing time
+		TLSHandshakeTimeout:   5 * time.Second,   // Fast TLS timeout since handshakes typically complete in ~100ms
+		ResponseHeaderTimeout: 110 * time.Second, // Conservative header timeout: above the longest configurable per-method relay timeout
 
 		// Performance optimizations
 		DisableKeepAlives:  false, // Enable connection reuse to reduce connection overhead
@@ -117,7 +148,7 @@ func NewDefaultHTTPClientWithDebugMetrics() *HTTPClientWithDebugMetrics {
 	// Individual requests will use context deadlines for actual timeout control
 	httpClient := &http.Client{
 		Transport: transport,
-		Timeout:   80 * time.Second, // Large fallback timeout (80 seconds)
+		Timeout:   120 * time.Second, // Large fallback timeout (120 seconds): relays are bounded by their context's timeout
 	}
 
 	return &HTTPClientWithDebugMetrics{
@@ -131,7 +162,7 @@ func NewDefaultHTTPClientWithDebugMetrics() *HTTPClientWithDebugMetrics {
 // Uses the provided context for timeout and cancellation control.
 // Logs detailed metrics and debugging information on failure for debugging.
 //
-// Returns: response body, HTTP status code, error
+// Returns: response body, HTTP status code, timing breakdown of the request, error
 func (h *HTTPClientWithDebugMetrics) SendHTTPRelay(
 	ctx context.Context,
 	logger polylog.Logger,
@@ -139,11 +170,11 @@ func (h *HTTPClientWithDebugMetrics) SendHTTPRelay(
 	method string,
 	relayRequestBz []byte,
 	headers map[string]string,
-) ([]byte, int, error) {
+) (_ []byte, _ int, timings HTTPRequestTimings, _ error) {
 	// Acquire concurrency slot before proceeding
 	// TODO: use a predefined error, so we can build a proper observation indicating request failure due to reaching max concurrency.
 	if !h.limiter.Acquire(ctx) {
-		return nil, 0, fmt.Errorf("failed to acquire concurrency slot: context canceled")
+		return nil, 0, timings, fmt.Errorf("failed to acquire concurrency slot: context canceled")
 	}
 	defer h.limiter.Release()
 
@@ -152,14 +183,14 @@ func (h *HTTPClientWithDebugMetrics) SendHTTPRelay(
 
 	var requestErr error
 	defer func() {
-		requestRecorder(requestErr)
+		timings = requestRecorder(requestErr)
 	}()
 
 	// Validate URL format
 	_, err := url.Parse(endpointURL)
 	if err != nil {
 		requestErr = fmt.Errorf("SHOULD NEVER HAPPEN: invalid URL: %w", err)
-		return nil, 0, requestErr
+		return nil, 0, timings, requestErr
 	}
 
 	req, err := http.NewRequestWithContext(
@@ -170,7 +201,7 @@ func (h *HTTPClientWithDebugMetrics) SendHTTPRelay(
 	)
 	if err != nil {
 		requestErr = fmt.Errorf("failed to create HTTP request: %w", err)
-		return nil, 0, requestErr
+		return nil, 0, timings, requestErr
 	}
 
 	// TODO_TECHDEBT(@adshmh): Content-Type HTTP header should be set by the QoS.
@@ -185,22 +216,22 @@ func (h *HTTPClientWithDebugMetrics) SendHTTPRelay(
 	resp, err := h.httpClient.Do(req)
 	if err != nil {
 		requestErr = h.categorizeError(debugCtx, err)
-		return nil, 0, requestErr
+		return nil, 0, timings, requestErr
 	}
 	defer resp.Body.Close()
 
 	// Read and validate response
 	responseBody, err := h.readAndValidateResponse(resp)
-	return responseBody, resp.StatusCode, err
+	return responseBody, resp.StatusCode, timings, err
 }
 
 // setupRequestDebugging initializes request metrics, HTTP debugging context, and atomic counters.
-// Returns the debug context and a cleanup function that accepts an error parameter.
+// Returns the debug context and a cleanup function that accepts an error parameter and returns the request's timing breakdown.
 func (h *HTTPClientWithDebugMetrics) setupRequestDebugging(
 	ctx context.Context,
 	logger polylog.Logger,
 	endpointURL string,
-) (context.Context, func(error)) {
+) (context.Context, func(error) HTTPRequestTimings) {
 	// Update atomic counters
 	h.activeRequests.Add(1)
 	h.totalRequests.Add(1)
@@ -224,7 +255,7 @@ func (h *HTTPClientWithDebugMetrics) setupRequestDebugging(
 	debugCtx := httptrace.WithClientTrace(ctx, trace)
 
 	// Return recorder function that logs request details.
-	requestRecorder := func(err error) {
+	requestRecorder := func(err error) HTTPRequestTimings {
 		h.activeRequests.Add(^uint64(0)) // Atomic decrement
 		metrics.totalTime = time.Since(metrics.startTime)
 		metrics.error = err
@@ -232,6 +263,7 @@ func (h *HTTPClientWithDebugMetrics) setupRequestDebugging(
 		if err != nil {
 			h.logRequestMetrics(logger, *metrics)
 		}
+		return metrics.getTimings()
 	}
 
 	return debugCtx, requestRecorder
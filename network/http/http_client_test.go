@@ -1,9 +1,13 @@
 package http
 
 import (
+	"context"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
 	"github.com/stretchr/testify/require"
 )
 
@@ -114,3 +118,32 @@ func TestEnsureHTTPSuccess(t *testing.T) {
 		})
 	}
 }
+
+func TestHTTPClientWithDebugMetrics_SendHTTPRelay_Timings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		time.Sleep(20 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"result":"0x1"}`))
+	}))
+	defer server.Close()
+
+	client := NewDefaultHTTPClientWithDebugMetrics()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	responseBz, statusCode, timings, err := client.SendHTTPRelay(ctx, polyzero.NewLogger(), server.URL, http.MethodPost, []byte(`{}`), nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, `{"result":"0x1"}`, string(responseBz))
+
+	// The endpoint's processing time is reflected in the time to first byte.
+	require.False(t, timings.ConnectionReused)
+	require.Positive(t, timings.Connect)
+	require.GreaterOrEqual(t, timings.TimeToFirstByte, 20*time.Millisecond)
+	require.GreaterOrEqual(t, timings.Total, timings.TimeToFirstByte)
+
+	// The second request reuses the idle connection: no connection setup.
+	_, _, timings, err = client.SendHTTPRelay(ctx, polyzero.NewLogger(), server.URL, http.MethodPost, []byte(`{}`), nil)
+	require.NoError(t, err)
+	require.True(t, timings.ConnectionReused)
+	require.Zero(t, timings.Connect)
+}
@@ -95,6 +95,9 @@ const (
 	// Websocket connection establishment failed.
 	// e.g. Failed to upgrade HTTP connection to Websocket or connect to endpoint.
 	GatewayRequestErrorKind_GATEWAY_REQUEST_ERROR_KIND_WEBSOCKET_CONNECTION_FAILED GatewayRequestErrorKind = 4
+	// No successful endpoint response was received before the request's relay timeout expired.
+	// e.g. an `eth_blockNumber` request with a short per-method timeout, or a client-requested deadline.
+	GatewayRequestErrorKind_GATEWAY_REQUEST_ERROR_KIND_RELAY_TIMEOUT GatewayRequestErrorKind = 5
 )
 
 // Enum value maps for GatewayRequestErrorKind.
@@ -105,6 +108,7 @@ var (
 		2: "GATEWAY_REQUEST_ERROR_KIND_REJECTED_BY_QOS",
 		3: "GATEWAY_REQUEST_ERROR_KIND_WEBSOCKET_REJECTED_BY_QOS",
 		4: "GATEWAY_REQUEST_ERROR_KIND_WEBSOCKET_CONNECTION_FAILED",
+		5: "GATEWAY_REQUEST_ERROR_KIND_RELAY_TIMEOUT",
 	}
 	GatewayRequestErrorKind_value = map[string]int32{
 		"GATEWAY_REQUEST_ERROR_KIND_UNSPECIFIED":                 0,
@@ -112,6 +116,7 @@ var (
 		"GATEWAY_REQUEST_ERROR_KIND_REJECTED_BY_QOS":             2,
 		"GATEWAY_REQUEST_ERROR_KIND_WEBSOCKET_REJECTED_BY_QOS":   3,
 		"GATEWAY_REQUEST_ERROR_KIND_WEBSOCKET_CONNECTION_FAILED": 4,
+		"GATEWAY_REQUEST_ERROR_KIND_RELAY_TIMEOUT":               5,
 	}
 )
 
@@ -142,6 +147,230 @@ func (GatewayRequestErrorKind) EnumDescriptor() ([]byte, []int) {
 	return file_path_gateway_proto_rawDescGZIP(), []int{1}
 }
 
+// RelayTimeoutSource captures what determined the timeout of a request's relays.
+type RelayTimeoutSource int32
+
+const (
+	RelayTimeoutSource_RELAY_TIMEOUT_SOURCE_UNSPECIFIED RelayTimeoutSource = 0
+	// The gateway's default relay timeout.
+	RelayTimeoutSource_RELAY_TIMEOUT_SOURCE_DEFAULT RelayTimeoutSource = 1
+	// The per-method timeout configured for the service, e.g. a longer timeout for `eth_getLogs`.
+	RelayTimeoutSource_RELAY_TIMEOUT_SOURCE_SERVICE_METHOD RelayTimeoutSource = 2
+	// The shorter deadline requested by the client through the `Relay-Timeout-Ms` HTTP header.
+	RelayTimeoutSource_RELAY_TIMEOUT_SOURCE_CLIENT RelayTimeoutSource = 3
+)
+
+// Enum value maps for RelayTimeoutSource.
+var (
+	RelayTimeoutSource_name = map[int32]string{
+		0: "RELAY_TIMEOUT_SOURCE_UNSPECIFIED",
+		1: "RELAY_TIMEOUT_SOURCE_DEFAULT",
+		2: "RELAY_TIMEOUT_SOURCE_SERVICE_METHOD",
+		3: "RELAY_TIMEOUT_SOURCE_CLIENT",
+	}
+	RelayTimeoutSource_value = map[string]int32{
+		"RELAY_TIMEOUT_SOURCE_UNSPECIFIED":    0,
+		"RELAY_TIMEOUT_SOURCE_DEFAULT":        1,
+		"RELAY_TIMEOUT_SOURCE_SERVICE_METHOD": 2,
+		"RELAY_TIMEOUT_SOURCE_CLIENT":         3,
+	}
+)
+
+func (x RelayTimeoutSource) Enum() *RelayTimeoutSource {
+	p := new(RelayTimeoutSource)
+	*p = x
+	return p
+}
+
+func (x RelayTimeoutSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RelayTimeoutSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_path_gateway_proto_enumTypes[2].Descriptor()
+}
+
+func (RelayTimeoutSource) Type() protoreflect.EnumType {
+	return &file_path_gateway_proto_enumTypes[2]
+}
+
+func (x RelayTimeoutSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RelayTimeoutSource.Descriptor instead.
+func (RelayTimeoutSource) EnumDescriptor() ([]byte, []int) {
+	return file_path_gateway_proto_rawDescGZIP(), []int{2}
+}
+
+// EndpointAffinityResult captures the outcome of looking up a client's affinity endpoint.
+type EndpointAffinityResult int32
+
+const (
+	EndpointAffinityResult_ENDPOINT_AFFINITY_RESULT_UNSPECIFIED EndpointAffinityResult = 0
+	// The request was sent to the client's affinity endpoint.
+	EndpointAffinityResult_ENDPOINT_AFFINITY_RESULT_HIT EndpointAffinityResult = 1
+	// No affinity endpoint was recorded for the client, e.g. the first request of a session.
+	EndpointAffinityResult_ENDPOINT_AFFINITY_RESULT_MISS_NO_ENTRY EndpointAffinityResult = 2
+	// The affinity endpoint is no longer available, e.g. sanctioned or not in the current session.
+	EndpointAffinityResult_ENDPOINT_AFFINITY_RESULT_MISS_ENDPOINT_UNAVAILABLE EndpointAffinityResult = 3
+	// The affinity endpoint failed the QoS validation, e.g. it fell behind the chain.
+	EndpointAffinityResult_ENDPOINT_AFFINITY_RESULT_MISS_ENDPOINT_INVALID EndpointAffinityResult = 4
+)
+
+// Enum value maps for EndpointAffinityResult.
+var (
+	EndpointAffinityResult_name = map[int32]string{
+		0: "ENDPOINT_AFFINITY_RESULT_UNSPECIFIED",
+		1: "ENDPOINT_AFFINITY_RESULT_HIT",
+		2: "ENDPOINT_AFFINITY_RESULT_MISS_NO_ENTRY",
+		3: "ENDPOINT_AFFINITY_RESULT_MISS_ENDPOINT_UNAVAILABLE",
+		4: "ENDPOINT_AFFINITY_RESULT_MISS_ENDPOINT_INVALID",
+	}
+	EndpointAffinityResult_value = map[string]int32{
+		"ENDPOINT_AFFINITY_RESULT_UNSPECIFIED":               0,
+		"ENDPOINT_AFFINITY_RESULT_HIT":                       1,
+		"ENDPOINT_AFFINITY_RESULT_MISS_NO_ENTRY":             2,
+		"ENDPOINT_AFFINITY_RESULT_MISS_ENDPOINT_UNAVAILABLE": 3,
+		"ENDPOINT_AFFINITY_RESULT_MISS_ENDPOINT_INVALID":     4,
+	}
+)
+
+func (x EndpointAffinityResult) Enum() *EndpointAffinityResult {
+	p := new(EndpointAffinityResult)
+	*p = x
+	return p
+}
+
+func (x EndpointAffinityResult) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EndpointAffinityResult) Descriptor() protoreflect.EnumDescriptor {
+	return file_path_gateway_proto_enumTypes[3].Descriptor()
+}
+
+func (EndpointAffinityResult) Type() protoreflect.EnumType {
+	return &file_path_gateway_proto_enumTypes[3]
+}
+
+func (x EndpointAffinityResult) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EndpointAffinityResult.Descriptor instead.
+func (EndpointAffinityResult) EnumDescriptor() ([]byte, []int) {
+	return file_path_gateway_proto_rawDescGZIP(), []int{3}
+}
+
+// EndpointAffinityDropReason captures why a client's affinity endpoint was dropped after a request.
+type EndpointAffinityDropReason int32
+
+const (
+	EndpointAffinityDropReason_ENDPOINT_AFFINITY_DROP_REASON_UNSPECIFIED EndpointAffinityDropReason = 0
+	// The endpoint's session rolled over.
+	EndpointAffinityDropReason_ENDPOINT_AFFINITY_DROP_REASON_SESSION_ROLLOVER EndpointAffinityDropReason = 1
+	// The relay to the endpoint failed.
+	EndpointAffinityDropReason_ENDPOINT_AFFINITY_DROP_REASON_RELAY_FAILURE EndpointAffinityDropReason = 2
+)
+
+// Enum value maps for EndpointAffinityDropReason.
+var (
+	EndpointAffinityDropReason_name = map[int32]string{
+		0: "ENDPOINT_AFFINITY_DROP_REASON_UNSPECIFIED",
+		1: "ENDPOINT_AFFINITY_DROP_REASON_SESSION_ROLLOVER",
+		2: "ENDPOINT_AFFINITY_DROP_REASON_RELAY_FAILURE",
+	}
+	EndpointAffinityDropReason_value = map[string]int32{
+		"ENDPOINT_AFFINITY_DROP_REASON_UNSPECIFIED":      0,
+		"ENDPOINT_AFFINITY_DROP_REASON_SESSION_ROLLOVER": 1,
+		"ENDPOINT_AFFINITY_DROP_REASON_RELAY_FAILURE":    2,
+	}
+)
+
+func (x EndpointAffinityDropReason) Enum() *EndpointAffinityDropReason {
+	p := new(EndpointAffinityDropReason)
+	*p = x
+	return p
+}
+
+func (x EndpointAffinityDropReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EndpointAffinityDropReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_path_gateway_proto_enumTypes[4].Descriptor()
+}
+
+func (EndpointAffinityDropReason) Type() protoreflect.EnumType {
+	return &file_path_gateway_proto_enumTypes[4]
+}
+
+func (x EndpointAffinityDropReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EndpointAffinityDropReason.Descriptor instead.
+func (EndpointAffinityDropReason) EnumDescriptor() ([]byte, []int) {
+	return file_path_gateway_proto_rawDescGZIP(), []int{4}
+}
+
+// GatewayPluginHook identifies the stage of request processing at which a gateway plugin is called.
+type GatewayPluginHook int32
+
+const (
+	GatewayPluginHook_GATEWAY_PLUGIN_HOOK_UNSPECIFIED GatewayPluginHook = 0
+	// Called with the HTTP request, before it is parsed by the service's QoS instance.
+	GatewayPluginHook_GATEWAY_PLUGIN_HOOK_BEFORE_QOS GatewayPluginHook = 1
+	// Called with the service payloads, before they are relayed to the selected endpoint(s).
+	GatewayPluginHook_GATEWAY_PLUGIN_HOOK_BEFORE_RELAY GatewayPluginHook = 2
+	// Called with the HTTP response built by the service's QoS instance, before it is written to the client.
+	GatewayPluginHook_GATEWAY_PLUGIN_HOOK_AFTER_RESPONSE GatewayPluginHook = 3
+)
+
+// Enum value maps for GatewayPluginHook.
+var (
+	GatewayPluginHook_name = map[int32]string{
+		0: "GATEWAY_PLUGIN_HOOK_UNSPECIFIED",
+		1: "GATEWAY_PLUGIN_HOOK_BEFORE_QOS",
+		2: "GATEWAY_PLUGIN_HOOK_BEFORE_RELAY",
+		3: "GATEWAY_PLUGIN_HOOK_AFTER_RESPONSE",
+	}
+	GatewayPluginHook_value = map[string]int32{
+		"GATEWAY_PLUGIN_HOOK_UNSPECIFIED":    0,
+		"GATEWAY_PLUGIN_HOOK_BEFORE_QOS":     1,
+		"GATEWAY_PLUGIN_HOOK_BEFORE_RELAY":   2,
+		"GATEWAY_PLUGIN_HOOK_AFTER_RESPONSE": 3,
+	}
+)
+
+func (x GatewayPluginHook) Enum() *GatewayPluginHook {
+	p := new(GatewayPluginHook)
+	*p = x
+	return p
+}
+
+func (x GatewayPluginHook) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GatewayPluginHook) Descriptor() protoreflect.EnumDescriptor {
+	return file_path_gateway_proto_enumTypes[5].Descriptor()
+}
+
+func (GatewayPluginHook) Type() protoreflect.EnumType {
+	return &file_path_gateway_proto_enumTypes[5]
+}
+
+func (x GatewayPluginHook) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GatewayPluginHook.Descriptor instead.
+func (GatewayPluginHook) EnumDescriptor() ([]byte, []int) {
+	return file_path_gateway_proto_rawDescGZIP(), []int{5}
+}
+
 // GatewayObservations is the set of observations on a service request, made from the perspective of a gateway.
 // Examples include the geographic region of the request, the request type, etc.
 type GatewayObservations struct {
@@ -169,8 +398,21 @@ type GatewayObservations struct {
 	RequestError *GatewayRequestError `protobuf:"bytes,7,opt,name=request_error,json=requestError,proto3,oneof" json:"request_error,omitempty"`
 	// parallel_request_observations tracks the outcome of parallel requests within a batch.
 	GatewayParallelRequestObservations *GatewayParallelRequestObservations `protobuf:"bytes,8,opt,name=gateway_parallel_request_observations,json=gatewayParallelRequestObservations,proto3,oneof" json:"gateway_parallel_request_observations,omitempty"`
-	unknownFields                      protoimpl.UnknownFields
-	sizeCache                          protoimpl.SizeCache
+	// endpoint_affinity tracks the outcome of the endpoint affinity lookup, if the request had an affinity key.
+	EndpointAffinity *GatewayEndpointAffinityObservation `protobuf:"bytes,9,opt,name=endpoint_affinity,json=endpointAffinity,proto3,oneof" json:"endpoint_affinity,omitempty"`
+	// is_coalesced_request is set if the request was served using the relay of an identical in-flight request.
+	// i.e. no relay was sent for this request.
+	IsCoalescedRequest bool `protobuf:"varint,10,opt,name=is_coalesced_request,json=isCoalescedRequest,proto3" json:"is_coalesced_request,omitempty"`
+	// plugin_errors lists the errors returned by the service's gateway plugins, if any.
+	// The output of a plugin hook which returned an error is discarded: the request is processed as if the hook was not run.
+	PluginErrors []*GatewayPluginError `protobuf:"bytes,11,rep,name=plugin_errors,json=pluginErrors,proto3" json:"plugin_errors,omitempty"`
+	// relay_timeout_ms is the timeout, in milliseconds, applied to the request's relays.
+	// Not set if no relay was sent, e.g. the request was rejected by the QoS.
+	RelayTimeoutMs uint64 `protobuf:"varint,12,opt,name=relay_timeout_ms,json=relayTimeoutMs,proto3" json:"relay_timeout_ms,omitempty"`
+	// relay_timeout_source is what determined the timeout of the request's relays.
+	RelayTimeoutSource RelayTimeoutSource `protobuf:"varint,13,opt,name=relay_timeout_source,json=relayTimeoutSource,proto3,enum=path.RelayTimeoutSource" json:"relay_timeout_source,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *GatewayObservations) Reset() {
@@ -259,6 +501,41 @@ func (x *GatewayObservations) GetGatewayParallelRequestObservations() *GatewayPa
 	return nil
 }
 
+func (x *GatewayObservations) GetEndpointAffinity() *GatewayEndpointAffinityObservation {
+	if x != nil {
+		return x.EndpointAffinity
+	}
+	return nil
+}
+
+func (x *GatewayObservations) GetIsCoalescedRequest() bool {
+	if x != nil {
+		return x.IsCoalescedRequest
+	}
+	return false
+}
+
+func (x *GatewayObservations) GetPluginErrors() []*GatewayPluginError {
+	if x != nil {
+		return x.PluginErrors
+	}
+	return nil
+}
+
+func (x *GatewayObservations) GetRelayTimeoutMs() uint64 {
+	if x != nil {
+		return x.RelayTimeoutMs
+	}
+	return 0
+}
+
+func (x *GatewayObservations) GetRelayTimeoutSource() RelayTimeoutSource {
+	if x != nil {
+		return x.RelayTimeoutSource
+	}
+	return RelayTimeoutSource_RELAY_TIMEOUT_SOURCE_UNSPECIFIED
+}
+
 // Tracks any errors encountered at the gateway level.
 // e.g.: No Service ID specified by the request's HTTP headers.
 type GatewayRequestError struct {
@@ -388,11 +665,130 @@ func (x *GatewayParallelRequestObservations) GetNumCanceled() int32 {
 	return 0
 }
 
+// Tracks the endpoint affinity of a request, i.e. routing a client's requests to the same endpoint.
+type GatewayEndpointAffinityObservation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The outcome of looking up the client's affinity endpoint.
+	Result EndpointAffinityResult `protobuf:"varint,1,opt,name=result,proto3,enum=path.EndpointAffinityResult" json:"result,omitempty"`
+	// Set if the client's affinity endpoint was dropped after the request.
+	DropReason    *EndpointAffinityDropReason `protobuf:"varint,2,opt,name=drop_reason,json=dropReason,proto3,enum=path.EndpointAffinityDropReason,oneof" json:"drop_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GatewayEndpointAffinityObservation) Reset() {
+	*x = GatewayEndpointAffinityObservation{}
+	mi := &file_path_gateway_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GatewayEndpointAffinityObservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayEndpointAffinityObservation) ProtoMessage() {}
+
+func (x *GatewayEndpointAffinityObservation) ProtoReflect() protoreflect.Message {
+	mi := &file_path_gateway_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayEndpointAffinityObservation.ProtoReflect.Descriptor instead.
+func (*GatewayEndpointAffinityObservation) Descriptor() ([]byte, []int) {
+	return file_path_gateway_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GatewayEndpointAffinityObservation) GetResult() EndpointAffinityResult {
+	if x != nil {
+		return x.Result
+	}
+	return EndpointAffinityResult_ENDPOINT_AFFINITY_RESULT_UNSPECIFIED
+}
+
+func (x *GatewayEndpointAffinityObservation) GetDropReason() EndpointAffinityDropReason {
+	if x != nil && x.DropReason != nil {
+		return *x.DropReason
+	}
+	return EndpointAffinityDropReason_ENDPOINT_AFFINITY_DROP_REASON_UNSPECIFIED
+}
+
+// Tracks an error returned by a gateway plugin.
+type GatewayPluginError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name the plugin was registered with.
+	PluginName string `protobuf:"bytes,1,opt,name=plugin_name,json=pluginName,proto3" json:"plugin_name,omitempty"`
+	// The hook which returned the error.
+	Hook GatewayPluginHook `protobuf:"varint,2,opt,name=hook,proto3,enum=path.GatewayPluginHook" json:"hook,omitempty"`
+	// The error returned by the plugin.
+	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GatewayPluginError) Reset() {
+	*x = GatewayPluginError{}
+	mi := &file_path_gateway_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GatewayPluginError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayPluginError) ProtoMessage() {}
+
+func (x *GatewayPluginError) ProtoReflect() protoreflect.Message {
+	mi := &file_path_gateway_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayPluginError.ProtoReflect.Descriptor instead.
+func (*GatewayPluginError) Descriptor() ([]byte, []int) {
+	return file_path_gateway_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GatewayPluginError) GetPluginName() string {
+	if x != nil {
+		return x.PluginName
+	}
+	return ""
+}
+
+func (x *GatewayPluginError) GetHook() GatewayPluginHook {
+	if x != nil {
+		return x.Hook
+	}
+	return GatewayPluginHook_GATEWAY_PLUGIN_HOOK_UNSPECIFIED
+}
+
+func (x *GatewayPluginError) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 var File_path_gateway_proto protoreflect.FileDescriptor
 
 const file_path_gateway_proto_rawDesc = "" +
 	"\n" +
-	"\x12path/gateway.proto\x12\x04path\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x0fpath/auth.proto\"\xcd\x04\n" +
+	"\x12path/gateway.proto\x12\x04path\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x0fpath/auth.proto\"\xa6\a\n" +
 	"\x13GatewayObservations\x124\n" +
 	"\frequest_auth\x18\x01 \x01(\v2\x11.path.RequestAuthR\vrequestAuth\x124\n" +
 	"\frequest_type\x18\x02 \x01(\x0e2\x11.path.RequestTypeR\vrequestType\x12\x1d\n" +
@@ -402,9 +798,16 @@ const file_path_gateway_proto_rawDesc = "" +
 	"\x0ecompleted_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rcompletedTime\x12#\n" +
 	"\rresponse_size\x18\x06 \x01(\x04R\fresponseSize\x12C\n" +
 	"\rrequest_error\x18\a \x01(\v2\x19.path.GatewayRequestErrorH\x00R\frequestError\x88\x01\x01\x12\x80\x01\n" +
-	"%gateway_parallel_request_observations\x18\b \x01(\v2(.path.GatewayParallelRequestObservationsH\x01R\"gatewayParallelRequestObservations\x88\x01\x01B\x10\n" +
+	"%gateway_parallel_request_observations\x18\b \x01(\v2(.path.GatewayParallelRequestObservationsH\x01R\"gatewayParallelRequestObservations\x88\x01\x01\x12Z\n" +
+	"\x11endpoint_affinity\x18\t \x01(\v2(.path.GatewayEndpointAffinityObservationH\x02R\x10endpointAffinity\x88\x01\x01\x120\n" +
+	"\x14is_coalesced_request\x18\n" +
+	" \x01(\bR\x12isCoalescedRequest\x12=\n" +
+	"\rplugin_errors\x18\v \x03(\v2\x18.path.GatewayPluginErrorR\fpluginErrors\x12(\n" +
+	"\x10relay_timeout_ms\x18\f \x01(\x04R\x0erelayTimeoutMs\x12J\n" +
+	"\x14relay_timeout_source\x18\r \x01(\x0e2\x18.path.RelayTimeoutSourceR\x12relayTimeoutSourceB\x10\n" +
 	"\x0e_request_errorB(\n" +
-	"&_gateway_parallel_request_observations\"m\n" +
+	"&_gateway_parallel_request_observationsB\x14\n" +
+	"\x12_endpoint_affinity\"m\n" +
 	"\x13GatewayRequestError\x12<\n" +
 	"\n" +
 	"error_kind\x18\x01 \x01(\x0e2\x1d.path.GatewayRequestErrorKindR\terrorKind\x12\x18\n" +
@@ -414,17 +817,48 @@ const file_path_gateway_proto_rawDesc = "" +
 	"\x0enum_successful\x18\x02 \x01(\x05R\rnumSuccessful\x12\x1d\n" +
 	"\n" +
 	"num_failed\x18\x03 \x01(\x05R\tnumFailed\x12!\n" +
-	"\fnum_canceled\x18\x04 \x01(\x05R\vnumCanceled*a\n" +
+	"\fnum_canceled\x18\x04 \x01(\x05R\vnumCanceled\"\xb2\x01\n" +
+	"\"GatewayEndpointAffinityObservation\x124\n" +
+	"\x06result\x18\x01 \x01(\x0e2\x1c.path.EndpointAffinityResultR\x06result\x12F\n" +
+	"\vdrop_reason\x18\x02 \x01(\x0e2 .path.EndpointAffinityDropReasonH\x00R\n" +
+	"dropReason\x88\x01\x01B\x0e\n" +
+	"\f_drop_reason\"x\n" +
+	"\x12GatewayPluginError\x12\x1f\n" +
+	"\vplugin_name\x18\x01 \x01(\tR\n" +
+	"pluginName\x12+\n" +
+	"\x04hook\x18\x02 \x01(\x0e2\x17.path.GatewayPluginHookR\x04hook\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error*a\n" +
 	"\vRequestType\x12\x1c\n" +
 	"\x18REQUEST_TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14REQUEST_TYPE_ORGANIC\x10\x01\x12\x1a\n" +
-	"\x16REQUEST_TYPE_SYNTHETIC\x10\x02*\x9e\x02\n" +
+	"\x16REQUEST_TYPE_SYNTHETIC\x10\x02*\xcc\x02\n" +
 	"\x17GatewayRequestErrorKind\x12*\n" +
 	"&GATEWAY_REQUEST_ERROR_KIND_UNSPECIFIED\x10\x00\x121\n" +
 	"-GATEWAY_REQUEST_ERROR_KIND_MISSING_SERVICE_ID\x10\x01\x12.\n" +
 	"*GATEWAY_REQUEST_ERROR_KIND_REJECTED_BY_QOS\x10\x02\x128\n" +
 	"4GATEWAY_REQUEST_ERROR_KIND_WEBSOCKET_REJECTED_BY_QOS\x10\x03\x12:\n" +
-	"6GATEWAY_REQUEST_ERROR_KIND_WEBSOCKET_CONNECTION_FAILED\x10\x04B,Z*github.com/buildwithgrove/path/observationb\x06proto3"
+	"6GATEWAY_REQUEST_ERROR_KIND_WEBSOCKET_CONNECTION_FAILED\x10\x04\x12,\n" +
+	"(GATEWAY_REQUEST_ERROR_KIND_RELAY_TIMEOUT\x10\x05*\xa6\x01\n" +
+	"\x12RelayTimeoutSource\x12$\n" +
+	" RELAY_TIMEOUT_SOURCE_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cRELAY_TIMEOUT_SOURCE_DEFAULT\x10\x01\x12'\n" +
+	"#RELAY_TIMEOUT_SOURCE_SERVICE_METHOD\x10\x02\x12\x1f\n" +
+	"\x1bRELAY_TIMEOUT_SOURCE_CLIENT\x10\x03*\xfc\x01\n" +
+	"\x16EndpointAffinityResult\x12(\n" +
+	"$ENDPOINT_AFFINITY_RESULT_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cENDPOINT_AFFINITY_RESULT_HIT\x10\x01\x12*\n" +
+	"&ENDPOINT_AFFINITY_RESULT_MISS_NO_ENTRY\x10\x02\x126\n" +
+	"2ENDPOINT_AFFINITY_RESULT_MISS_ENDPOINT_UNAVAILABLE\x10\x03\x122\n" +
+	".ENDPOINT_AFFINITY_RESULT_MISS_ENDPOINT_INVALID\x10\x04*\xb0\x01\n" +
+	"\x1aEndpointAffinityDropReason\x12-\n" +
+	")ENDPOINT_AFFINITY_DROP_REASON_UNSPECIFIED\x10\x00\x122\n" +
+	".ENDPOINT_AFFINITY_DROP_REASON_SESSION_ROLLOVER\x10\x01\x12/\n" +
+	"+ENDPOINT_AFFINITY_DROP_REASON_RELAY_FAILURE\x10\x02*\xaa\x01\n" +
+	"\x11GatewayPluginHook\x12#\n" +
+	"\x1fGATEWAY_PLUGIN_HOOK_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eGATEWAY_PLUGIN_HOOK_BEFORE_QOS\x10\x01\x12$\n" +
+	" GATEWAY_PLUGIN_HOOK_BEFORE_RELAY\x10\x02\x12&\n" +
+	"\"GATEWAY_PLUGIN_HOOK_AFTER_RESPONSE\x10\x03B,Z*github.com/buildwithgrove/path/observationb\x06proto3"
 
 var (
 	file_path_gateway_proto_rawDescOnce sync.Once
@@ -438,30 +872,42 @@ func file_path_gateway_proto_rawDescGZIP() []byte {
 	return file_path_gateway_proto_rawDescData
 }
 
-var file_path_gateway_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_path_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_path_gateway_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_path_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_path_gateway_proto_goTypes = []any{
 	(RequestType)(0),                           // 0: path.RequestType
 	(GatewayRequestErrorKind)(0),               // 1: path.GatewayRequestErrorKind
-	(*GatewayObservations)(nil),                // 2: path.GatewayObservations
-	(*GatewayRequestError)(nil),                // 3: path.GatewayRequestError
-	(*GatewayParallelRequestObservations)(nil), // 4: path.GatewayParallelRequestObservations
-	(*RequestAuth)(nil),                        // 5: path.RequestAuth
-	(*timestamppb.Timestamp)(nil),              // 6: google.protobuf.Timestamp
+	(RelayTimeoutSource)(0),                    // 2: path.RelayTimeoutSource
+	(EndpointAffinityResult)(0),                // 3: path.EndpointAffinityResult
+	(EndpointAffinityDropReason)(0),            // 4: path.EndpointAffinityDropReason
+	(GatewayPluginHook)(0),                     // 5: path.GatewayPluginHook
+	(*GatewayObservations)(nil),                // 6: path.GatewayObservations
+	(*GatewayRequestError)(nil),                // 7: path.GatewayRequestError
+	(*GatewayParallelRequestObservations)(nil), // 8: path.GatewayParallelRequestObservations
+	(*GatewayEndpointAffinityObservation)(nil), // 9: path.GatewayEndpointAffinityObservation
+	(*GatewayPluginError)(nil),                 // 10: path.GatewayPluginError
+	(*RequestAuth)(nil),                        // 11: path.RequestAuth
+	(*timestamppb.Timestamp)(nil),              // 12: google.protobuf.Timestamp
 }
 var file_path_gateway_proto_depIdxs = []int32{
-	5, // 0: path.GatewayObservations.request_auth:type_name -> path.RequestAuth
-	0, // 1: path.GatewayObservations.request_type:type_name -> path.RequestType
-	6, // 2: path.GatewayObservations.received_time:type_name -> google.protobuf.Timestamp
-	6, // 3: path.GatewayObservations.completed_time:type_name -> google.protobuf.Timestamp
-	3, // 4: path.GatewayObservations.request_error:type_name -> path.GatewayRequestError
-	4, // 5: path.GatewayObservations.gateway_parallel_request_observations:type_name -> path.GatewayParallelRequestObservations
-	1, // 6: path.GatewayRequestError.error_kind:type_name -> path.GatewayRequestErrorKind
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	11, // 0: path.GatewayObservations.request_auth:type_name -> path.RequestAuth
+	0,  // 1: path.GatewayObservations.request_type:type_name -> path.RequestType
+	12, // 2: path.GatewayObservations.received_time:type_name -> google.protobuf.Timestamp
+	12, // 3: path.GatewayObservations.completed_time:type_name -> google.protobuf.Timestamp
+	7,  // 4: path.GatewayObservations.request_error:type_name -> path.GatewayRequestError
+	8,  // 5: path.GatewayObservations.gateway_parallel_request_observations:type_name -> path.GatewayParallelRequestObservations
+	9,  // 6: path.GatewayObservations.endpoint_affinity:type_name -> path.GatewayEndpointAffinityObservation
+	10, // 7: path.GatewayObservations.plugin_errors:type_name -> path.GatewayPluginError
+	2,  // 8: path.GatewayObservations.relay_timeout_source:type_name -> path.RelayTimeoutSource
+	1,  // 9: path.GatewayRequestError.error_kind:type_name -> path.GatewayRequestErrorKind
+	3,  // 10: path.GatewayEndpointAffinityObservation.result:type_name -> path.EndpointAffinityResult
+	4,  // 11: path.GatewayEndpointAffinityObservation.drop_reason:type_name -> path.EndpointAffinityDropReason
+	5,  // 12: path.GatewayPluginError.hook:type_name -> path.GatewayPluginHook
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_path_gateway_proto_init() }
@@ -471,13 +917,14 @@ func file_path_gateway_proto_init() {
 	}
 	file_path_auth_proto_init()
 	file_path_gateway_proto_msgTypes[0].OneofWrappers = []any{}
+	file_path_gateway_proto_msgTypes[3].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_path_gateway_proto_rawDesc), len(file_path_gateway_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   3,
+			NumEnums:      6,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
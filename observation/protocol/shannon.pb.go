@@ -185,6 +185,9 @@ const (
 	ShannonEndpointErrorType_SHANNON_ENDPOINT_ERROR_RELAY_MINER_HTTP_4XX ShannonEndpointErrorType = 42
 	// RelayMiner returned a 5XX HTTP status code
 	ShannonEndpointErrorType_SHANNON_ENDPOINT_ERROR_RELAY_MINER_HTTP_5XX ShannonEndpointErrorType = 43
+	// The endpoint did not respond before the deadline requested by the client, which is shorter than the service's relay timeout.
+	// The endpoint is not sanctioned: the deadline is set by the client, not by PATH.
+	ShannonEndpointErrorType_SHANNON_ENDPOINT_ERROR_CLIENT_DEADLINE_EXCEEDED ShannonEndpointErrorType = 44
 )
 
 // Enum value maps for ShannonEndpointErrorType.
@@ -233,6 +236,7 @@ var (
 		41: "SHANNON_ENDPOINT_ERROR_WEBSOCKET_RELAY_RESPONSE_VALIDATION_FAILED",
 		42: "SHANNON_ENDPOINT_ERROR_RELAY_MINER_HTTP_4XX",
 		43: "SHANNON_ENDPOINT_ERROR_RELAY_MINER_HTTP_5XX",
+		44: "SHANNON_ENDPOINT_ERROR_CLIENT_DEADLINE_EXCEEDED",
 	}
 	ShannonEndpointErrorType_value = map[string]int32{
 		"SHANNON_ENDPOINT_ERROR_UNSPECIFIED":                                0,
@@ -278,6 +282,7 @@ var (
 		"SHANNON_ENDPOINT_ERROR_WEBSOCKET_RELAY_RESPONSE_VALIDATION_FAILED": 41,
 		"SHANNON_ENDPOINT_ERROR_RELAY_MINER_HTTP_4XX":                       42,
 		"SHANNON_ENDPOINT_ERROR_RELAY_MINER_HTTP_5XX":                       43,
+		"SHANNON_ENDPOINT_ERROR_CLIENT_DEADLINE_EXCEEDED":                   44,
 	}
 )
 
@@ -1073,8 +1078,12 @@ type ShannonEndpointObservation struct {
 	//
 	// Tracks whether the endpoint is a fallback endpoint.
 	IsFallbackEndpoint bool `protobuf:"varint,16,opt,name=is_fallback_endpoint,json=isFallbackEndpoint,proto3" json:"is_fallback_endpoint,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// Timing breakdown of the HTTP request sent to the endpoint.
+	// Used to tell whether a slow relay is due to the endpoint's network, TLS setup, or backend processing.
+	// Only set if the HTTP request was sent, i.e. not for relays which failed before reaching the HTTP client.
+	HttpTimings   *ShannonEndpointHTTPTimings `protobuf:"bytes,17,opt,name=http_timings,json=httpTimings,proto3,oneof" json:"http_timings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ShannonEndpointObservation) Reset() {
@@ -1219,6 +1228,124 @@ func (x *ShannonEndpointObservation) GetIsFallbackEndpoint() bool {
 	return false
 }
 
+func (x *ShannonEndpointObservation) GetHttpTimings() *ShannonEndpointHTTPTimings {
+	if x != nil {
+		return x.HttpTimings
+	}
+	return nil
+}
+
+// ShannonEndpointHTTPTimings is the timing breakdown, in seconds, of the HTTP request sent to an endpoint.
+// Connection setup phases, i.e. DNS lookup, connect and TLS handshake, are 0 if an idle connection was reused.
+type ShannonEndpointHTTPTimings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Time spent resolving the endpoint's host name.
+	DnsLookupSeconds float64 `protobuf:"fixed64,1,opt,name=dns_lookup_seconds,json=dnsLookupSeconds,proto3" json:"dns_lookup_seconds,omitempty"`
+	// Time spent establishing the TCP connection.
+	ConnectSeconds float64 `protobuf:"fixed64,2,opt,name=connect_seconds,json=connectSeconds,proto3" json:"connect_seconds,omitempty"`
+	// Time spent on the TLS handshake.
+	TlsHandshakeSeconds float64 `protobuf:"fixed64,3,opt,name=tls_handshake_seconds,json=tlsHandshakeSeconds,proto3" json:"tls_handshake_seconds,omitempty"`
+	// Time spent obtaining a connection, either new or from the idle connection pool.
+	// Includes the DNS lookup, connect and TLS handshake of a new connection.
+	GetConnectionSeconds float64 `protobuf:"fixed64,4,opt,name=get_connection_seconds,json=getConnectionSeconds,proto3" json:"get_connection_seconds,omitempty"`
+	// Time spent writing the request, i.e. headers and body, once a connection was obtained.
+	RequestWriteSeconds float64 `protobuf:"fixed64,5,opt,name=request_write_seconds,json=requestWriteSeconds,proto3" json:"request_write_seconds,omitempty"`
+	// Time between writing the request and receiving the first byte of the response, i.e. the endpoint's processing time.
+	TimeToFirstByteSeconds float64 `protobuf:"fixed64,6,opt,name=time_to_first_byte_seconds,json=timeToFirstByteSeconds,proto3" json:"time_to_first_byte_seconds,omitempty"`
+	// Total time spent on the HTTP request, including reading the response body.
+	TotalSeconds float64 `protobuf:"fixed64,7,opt,name=total_seconds,json=totalSeconds,proto3" json:"total_seconds,omitempty"`
+	// Whether an idle connection was reused for the request.
+	ConnectionReused bool `protobuf:"varint,8,opt,name=connection_reused,json=connectionReused,proto3" json:"connection_reused,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ShannonEndpointHTTPTimings) Reset() {
+	*x = ShannonEndpointHTTPTimings{}
+	mi := &file_path_protocol_shannon_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShannonEndpointHTTPTimings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShannonEndpointHTTPTimings) ProtoMessage() {}
+
+func (x *ShannonEndpointHTTPTimings) ProtoReflect() protoreflect.Message {
+	mi := &file_path_protocol_shannon_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShannonEndpointHTTPTimings.ProtoReflect.Descriptor instead.
+func (*ShannonEndpointHTTPTimings) Descriptor() ([]byte, []int) {
+	return file_path_protocol_shannon_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ShannonEndpointHTTPTimings) GetDnsLookupSeconds() float64 {
+	if x != nil {
+		return x.DnsLookupSeconds
+	}
+	return 0
+}
+
+func (x *ShannonEndpointHTTPTimings) GetConnectSeconds() float64 {
+	if x != nil {
+		return x.ConnectSeconds
+	}
+	return 0
+}
+
+func (x *ShannonEndpointHTTPTimings) GetTlsHandshakeSeconds() float64 {
+	if x != nil {
+		return x.TlsHandshakeSeconds
+	}
+	return 0
+}
+
+func (x *ShannonEndpointHTTPTimings) GetGetConnectionSeconds() float64 {
+	if x != nil {
+		return x.GetConnectionSeconds
+	}
+	return 0
+}
+
+func (x *ShannonEndpointHTTPTimings) GetRequestWriteSeconds() float64 {
+	if x != nil {
+		return x.RequestWriteSeconds
+	}
+	return 0
+}
+
+func (x *ShannonEndpointHTTPTimings) GetTimeToFirstByteSeconds() float64 {
+	if x != nil {
+		return x.TimeToFirstByteSeconds
+	}
+	return 0
+}
+
+func (x *ShannonEndpointHTTPTimings) GetTotalSeconds() float64 {
+	if x != nil {
+		return x.TotalSeconds
+	}
+	return 0
+}
+
+func (x *ShannonEndpointHTTPTimings) GetConnectionReused() bool {
+	if x != nil {
+		return x.ConnectionReused
+	}
+	return false
+}
+
 // ShannonObservationsList provides a container for multiple ShannonRequestObservations,
 // allowing them to be embedded in other protocol buffers.
 type ShannonObservationsList struct {
@@ -1230,7 +1357,7 @@ type ShannonObservationsList struct {
 
 func (x *ShannonObservationsList) Reset() {
 	*x = ShannonObservationsList{}
-	mi := &file_path_protocol_shannon_proto_msgTypes[7]
+	mi := &file_path_protocol_shannon_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1242,7 +1369,7 @@ func (x *ShannonObservationsList) String() string {
 func (*ShannonObservationsList) ProtoMessage() {}
 
 func (x *ShannonObservationsList) ProtoReflect() protoreflect.Message {
-	mi := &file_path_protocol_shannon_proto_msgTypes[7]
+	mi := &file_path_protocol_shannon_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1255,7 +1382,7 @@ func (x *ShannonObservationsList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShannonObservationsList.ProtoReflect.Descriptor instead.
 func (*ShannonObservationsList) Descriptor() ([]byte, []int) {
-	return file_path_protocol_shannon_proto_rawDescGZIP(), []int{7}
+	return file_path_protocol_shannon_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ShannonObservationsList) GetObservations() []*ShannonRequestObservations {
@@ -1338,7 +1465,7 @@ const file_path_protocol_shannon_proto_rawDesc = "" +
 	"\x10observation_dataB\x10\n" +
 	"\x0e_request_error\"\x81\x01\n" +
 	"\x1fShannonHTTPEndpointObservations\x12^\n" +
-	"\x15endpoint_observations\x18\x01 \x03(\v2).path.protocol.ShannonEndpointObservationR\x14endpointObservations\"\x8d\n" +
+	"\x15endpoint_observations\x18\x01 \x03(\v2).path.protocol.ShannonEndpointObservationR\x14endpointObservations\"\xf1\n" +
 	"\n" +
 	"\x1aShannonEndpointObservation\x12\x1a\n" +
 	"\bsupplier\x18\x01 \x01(\tR\bsupplier\x12!\n" +
@@ -1359,14 +1486,25 @@ const file_path_protocol_shannon_proto_rawDesc = "" +
 	"\x11relay_miner_error\x18\r \x01(\v2%.path.protocol.ShannonRelayMinerErrorH\x04R\x0frelayMinerError\x88\x01\x01\x12m\n" +
 	"2endpoint_backend_service_http_response_status_code\x18\x0e \x01(\x05H\x05R,endpointBackendServiceHttpResponseStatusCode\x88\x01\x01\x12o\n" +
 	"3endpoint_backend_service_http_response_payload_size\x18\x0f \x01(\x03H\x06R-endpointBackendServiceHttpResponsePayloadSize\x88\x01\x01\x120\n" +
-	"\x14is_fallback_endpoint\x18\x10 \x01(\bR\x12isFallbackEndpointB\x1e\n" +
+	"\x14is_fallback_endpoint\x18\x10 \x01(\bR\x12isFallbackEndpoint\x12Q\n" +
+	"\fhttp_timings\x18\x11 \x01(\v2).path.protocol.ShannonEndpointHTTPTimingsH\aR\vhttpTimings\x88\x01\x01B\x1e\n" +
 	"\x1c_endpoint_response_timestampB\r\n" +
 	"\v_error_typeB\x10\n" +
 	"\x0e_error_detailsB\x17\n" +
 	"\x15_recommended_sanctionB\x14\n" +
 	"\x12_relay_miner_errorB5\n" +
 	"3_endpoint_backend_service_http_response_status_codeB6\n" +
-	"4_endpoint_backend_service_http_response_payload_size\"h\n" +
+	"4_endpoint_backend_service_http_response_payload_sizeB\x0f\n" +
+	"\r_http_timings\"\x9f\x03\n" +
+	"\x1aShannonEndpointHTTPTimings\x12,\n" +
+	"\x12dns_lookup_seconds\x18\x01 \x01(\x01R\x10dnsLookupSeconds\x12'\n" +
+	"\x0fconnect_seconds\x18\x02 \x01(\x01R\x0econnectSeconds\x122\n" +
+	"\x15tls_handshake_seconds\x18\x03 \x01(\x01R\x13tlsHandshakeSeconds\x124\n" +
+	"\x16get_connection_seconds\x18\x04 \x01(\x01R\x14getConnectionSeconds\x122\n" +
+	"\x15request_write_seconds\x18\x05 \x01(\x01R\x13requestWriteSeconds\x12:\n" +
+	"\x1atime_to_first_byte_seconds\x18\x06 \x01(\x01R\x16timeToFirstByteSeconds\x12#\n" +
+	"\rtotal_seconds\x18\a \x01(\x01R\ftotalSeconds\x12+\n" +
+	"\x11connection_reused\x18\b \x01(\bR\x10connectionReused\"h\n" +
 	"\x17ShannonObservationsList\x12M\n" +
 	"\fobservations\x18\x01 \x03(\v2).path.protocol.ShannonRequestObservationsR\fobservations*\x9e\x05\n" +
 	"\x17ShannonRequestErrorType\x12%\n" +
@@ -1381,7 +1519,7 @@ const file_path_protocol_shannon_proto_rawDesc = "" +
 	"2SHANNON_REQUEST_ERROR_INTERNAL_DELEGATED_FETCH_APP\x10\b\x12B\n" +
 	">SHANNON_REQUEST_ERROR_INTERNAL_DELEGATED_APP_DOES_NOT_DELEGATE\x10\t\x125\n" +
 	"1SHANNON_REQUEST_ERROR_INTERNAL_SIGNER_SETUP_ERROR\x10\n" +
-	"*\xd6\x11\n" +
+	"*\x8b\x12\n" +
 	"\x18ShannonEndpointErrorType\x12&\n" +
 	"\"SHANNON_ENDPOINT_ERROR_UNSPECIFIED\x10\x00\x12'\n" +
 	"\x1fSHANNON_ENDPOINT_ERROR_INTERNAL\x10\x01\x1a\x02\b\x01\x12!\n" +
@@ -1426,7 +1564,8 @@ const file_path_protocol_shannon_proto_rawDesc = "" +
 	"7SHANNON_ENDPOINT_ERROR_WEBSOCKET_REQUEST_SIGNING_FAILED\x10(\x12E\n" +
 	"ASHANNON_ENDPOINT_ERROR_WEBSOCKET_RELAY_RESPONSE_VALIDATION_FAILED\x10)\x12/\n" +
 	"+SHANNON_ENDPOINT_ERROR_RELAY_MINER_HTTP_4XX\x10*\x12/\n" +
-	"+SHANNON_ENDPOINT_ERROR_RELAY_MINER_HTTP_5XX\x10+*\x9b\x01\n" +
+	"+SHANNON_ENDPOINT_ERROR_RELAY_MINER_HTTP_5XX\x10+\x123\n" +
+	"/SHANNON_ENDPOINT_ERROR_CLIENT_DEADLINE_EXCEEDED\x10,*\x9b\x01\n" +
 	"\x13ShannonSanctionType\x12 \n" +
 	"\x1cSHANNON_SANCTION_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18SHANNON_SANCTION_SESSION\x10\x01\x12\x1e\n" +
@@ -1446,7 +1585,7 @@ func file_path_protocol_shannon_proto_rawDescGZIP() []byte {
 }
 
 var file_path_protocol_shannon_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_path_protocol_shannon_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_path_protocol_shannon_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_path_protocol_shannon_proto_goTypes = []any{
 	(ShannonRequestErrorType)(0),                                   // 0: path.protocol.ShannonRequestErrorType
 	(ShannonEndpointErrorType)(0),                                  // 1: path.protocol.ShannonEndpointErrorType
@@ -1459,17 +1598,18 @@ var file_path_protocol_shannon_proto_goTypes = []any{
 	(*ShannonRequestObservations)(nil),                             // 8: path.protocol.ShannonRequestObservations
 	(*ShannonHTTPEndpointObservations)(nil),                        // 9: path.protocol.ShannonHTTPEndpointObservations
 	(*ShannonEndpointObservation)(nil),                             // 10: path.protocol.ShannonEndpointObservation
-	(*ShannonObservationsList)(nil),                                // 11: path.protocol.ShannonObservationsList
-	(*timestamppb.Timestamp)(nil),                                  // 12: google.protobuf.Timestamp
+	(*ShannonEndpointHTTPTimings)(nil),                             // 11: path.protocol.ShannonEndpointHTTPTimings
+	(*ShannonObservationsList)(nil),                                // 12: path.protocol.ShannonObservationsList
+	(*timestamppb.Timestamp)(nil),                                  // 13: google.protobuf.Timestamp
 }
 var file_path_protocol_shannon_proto_depIdxs = []int32{
 	0,  // 0: path.protocol.ShannonRequestError.error_type:type_name -> path.protocol.ShannonRequestErrorType
 	1,  // 1: path.protocol.ShannonWebsocketConnectionObservation.error_type:type_name -> path.protocol.ShannonEndpointErrorType
 	2,  // 2: path.protocol.ShannonWebsocketConnectionObservation.recommended_sanction:type_name -> path.protocol.ShannonSanctionType
-	12, // 3: path.protocol.ShannonWebsocketConnectionObservation.connection_established_timestamp:type_name -> google.protobuf.Timestamp
-	12, // 4: path.protocol.ShannonWebsocketConnectionObservation.connection_closed_timestamp:type_name -> google.protobuf.Timestamp
+	13, // 3: path.protocol.ShannonWebsocketConnectionObservation.connection_established_timestamp:type_name -> google.protobuf.Timestamp
+	13, // 4: path.protocol.ShannonWebsocketConnectionObservation.connection_closed_timestamp:type_name -> google.protobuf.Timestamp
 	3,  // 5: path.protocol.ShannonWebsocketConnectionObservation.event_type:type_name -> path.protocol.ShannonWebsocketConnectionObservation.ConnectionEventType
-	12, // 6: path.protocol.ShannonWebsocketMessageObservation.message_timestamp:type_name -> google.protobuf.Timestamp
+	13, // 6: path.protocol.ShannonWebsocketMessageObservation.message_timestamp:type_name -> google.protobuf.Timestamp
 	1,  // 7: path.protocol.ShannonWebsocketMessageObservation.error_type:type_name -> path.protocol.ShannonEndpointErrorType
 	2,  // 8: path.protocol.ShannonWebsocketMessageObservation.recommended_sanction:type_name -> path.protocol.ShannonSanctionType
 	5,  // 9: path.protocol.ShannonWebsocketMessageObservation.relay_miner_error:type_name -> path.protocol.ShannonRelayMinerError
@@ -1478,17 +1618,18 @@ var file_path_protocol_shannon_proto_depIdxs = []int32{
 	6,  // 12: path.protocol.ShannonRequestObservations.websocket_connection_observation:type_name -> path.protocol.ShannonWebsocketConnectionObservation
 	7,  // 13: path.protocol.ShannonRequestObservations.websocket_message_observation:type_name -> path.protocol.ShannonWebsocketMessageObservation
 	10, // 14: path.protocol.ShannonHTTPEndpointObservations.endpoint_observations:type_name -> path.protocol.ShannonEndpointObservation
-	12, // 15: path.protocol.ShannonEndpointObservation.endpoint_query_timestamp:type_name -> google.protobuf.Timestamp
-	12, // 16: path.protocol.ShannonEndpointObservation.endpoint_response_timestamp:type_name -> google.protobuf.Timestamp
+	13, // 15: path.protocol.ShannonEndpointObservation.endpoint_query_timestamp:type_name -> google.protobuf.Timestamp
+	13, // 16: path.protocol.ShannonEndpointObservation.endpoint_response_timestamp:type_name -> google.protobuf.Timestamp
 	1,  // 17: path.protocol.ShannonEndpointObservation.error_type:type_name -> path.protocol.ShannonEndpointErrorType
 	2,  // 18: path.protocol.ShannonEndpointObservation.recommended_sanction:type_name -> path.protocol.ShannonSanctionType
 	5,  // 19: path.protocol.ShannonEndpointObservation.relay_miner_error:type_name -> path.protocol.ShannonRelayMinerError
-	8,  // 20: path.protocol.ShannonObservationsList.observations:type_name -> path.protocol.ShannonRequestObservations
-	21, // [21:21] is the sub-list for method output_type
-	21, // [21:21] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	11, // 20: path.protocol.ShannonEndpointObservation.http_timings:type_name -> path.protocol.ShannonEndpointHTTPTimings
+	8,  // 21: path.protocol.ShannonObservationsList.observations:type_name -> path.protocol.ShannonRequestObservations
+	22, // [22:22] is the sub-list for method output_type
+	22, // [22:22] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_path_protocol_shannon_proto_init() }
@@ -1510,7 +1651,7 @@ func file_path_protocol_shannon_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_path_protocol_shannon_proto_rawDesc), len(file_path_protocol_shannon_proto_rawDesc)),
 			NumEnums:      4,
-			NumMessages:   8,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
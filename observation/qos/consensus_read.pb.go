@@ -0,0 +1,178 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: path/qos/consensus_read.proto
+
+package qos
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ConsensusReadObservation captures the outcome of a request sent to multiple endpoints in consensus-read mode.
+// - The same payload is sent to multiple endpoints.
+// - Responses are compared, ignoring the JSON-RPC ID.
+// - The result returned by at least a quorum of the endpoints is returned to the user.
+type ConsensusReadObservation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of endpoints the request was sent to.
+	NumEndpoints uint32 `protobuf:"varint,1,opt,name=num_endpoints,json=numEndpoints,proto3" json:"num_endpoints,omitempty"`
+	// The minimum number of matching responses required to return a result to the user.
+	Quorum uint32 `protobuf:"varint,2,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// The number of endpoint responses received.
+	NumResponses uint32 `protobuf:"varint,3,opt,name=num_responses,json=numResponses,proto3" json:"num_responses,omitempty"`
+	// The number of endpoint responses matching the majority result.
+	NumAgreeingResponses uint32 `protobuf:"varint,4,opt,name=num_agreeing_responses,json=numAgreeingResponses,proto3" json:"num_agreeing_responses,omitempty"`
+	// Whether the majority result met the quorum.
+	QuorumReached bool `protobuf:"varint,5,opt,name=quorum_reached,json=quorumReached,proto3" json:"quorum_reached,omitempty"`
+	// The endpoints whose responses did not match the majority result.
+	// Only set if the quorum was reached: otherwise, no endpoint response can be considered correct.
+	DisagreeingEndpointAddrs []string `protobuf:"bytes,6,rep,name=disagreeing_endpoint_addrs,json=disagreeingEndpointAddrs,proto3" json:"disagreeing_endpoint_addrs,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ConsensusReadObservation) Reset() {
+	*x = ConsensusReadObservation{}
+	mi := &file_path_qos_consensus_read_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsensusReadObservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsensusReadObservation) ProtoMessage() {}
+
+func (x *ConsensusReadObservation) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_consensus_read_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsensusReadObservation.ProtoReflect.Descriptor instead.
+func (*ConsensusReadObservation) Descriptor() ([]byte, []int) {
+	return file_path_qos_consensus_read_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConsensusReadObservation) GetNumEndpoints() uint32 {
+	if x != nil {
+		return x.NumEndpoints
+	}
+	return 0
+}
+
+func (x *ConsensusReadObservation) GetQuorum() uint32 {
+	if x != nil {
+		return x.Quorum
+	}
+	return 0
+}
+
+func (x *ConsensusReadObservation) GetNumResponses() uint32 {
+	if x != nil {
+		return x.NumResponses
+	}
+	return 0
+}
+
+func (x *ConsensusReadObservation) GetNumAgreeingResponses() uint32 {
+	if x != nil {
+		return x.NumAgreeingResponses
+	}
+	return 0
+}
+
+func (x *ConsensusReadObservation) GetQuorumReached() bool {
+	if x != nil {
+		return x.QuorumReached
+	}
+	return false
+}
+
+func (x *ConsensusReadObservation) GetDisagreeingEndpointAddrs() []string {
+	if x != nil {
+		return x.DisagreeingEndpointAddrs
+	}
+	return nil
+}
+
+var File_path_qos_consensus_read_proto protoreflect.FileDescriptor
+
+const file_path_qos_consensus_read_proto_rawDesc = "" +
+	"\n" +
+	"\x1dpath/qos/consensus_read.proto\x12\bpath.qos\"\x97\x02\n" +
+	"\x18ConsensusReadObservation\x12#\n" +
+	"\rnum_endpoints\x18\x01 \x01(\rR\fnumEndpoints\x12\x16\n" +
+	"\x06quorum\x18\x02 \x01(\rR\x06quorum\x12#\n" +
+	"\rnum_responses\x18\x03 \x01(\rR\fnumResponses\x124\n" +
+	"\x16num_agreeing_responses\x18\x04 \x01(\rR\x14numAgreeingResponses\x12%\n" +
+	"\x0equorum_reached\x18\x05 \x01(\bR\rquorumReached\x12<\n" +
+	"\x1adisagreeing_endpoint_addrs\x18\x06 \x03(\tR\x18disagreeingEndpointAddrsB0Z.github.com/buildwithgrove/path/observation/qosb\x06proto3"
+
+var (
+	file_path_qos_consensus_read_proto_rawDescOnce sync.Once
+	file_path_qos_consensus_read_proto_rawDescData []byte
+)
+
+func file_path_qos_consensus_read_proto_rawDescGZIP() []byte {
+	file_path_qos_consensus_read_proto_rawDescOnce.Do(func() {
+		file_path_qos_consensus_read_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_path_qos_consensus_read_proto_rawDesc), len(file_path_qos_consensus_read_proto_rawDesc)))
+	})
+	return file_path_qos_consensus_read_proto_rawDescData
+}
+
+var file_path_qos_consensus_read_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_path_qos_consensus_read_proto_goTypes = []any{
+	(*ConsensusReadObservation)(nil), // 0: path.qos.ConsensusReadObservation
+}
+var file_path_qos_consensus_read_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_path_qos_consensus_read_proto_init() }
+func file_path_qos_consensus_read_proto_init() {
+	if File_path_qos_consensus_read_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_path_qos_consensus_read_proto_rawDesc), len(file_path_qos_consensus_read_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_path_qos_consensus_read_proto_goTypes,
+		DependencyIndexes: file_path_qos_consensus_read_proto_depIdxs,
+		MessageInfos:      file_path_qos_consensus_read_proto_msgTypes,
+	}.Build()
+	File_path_qos_consensus_read_proto = out.File
+	file_path_qos_consensus_read_proto_goTypes = nil
+	file_path_qos_consensus_read_proto_depIdxs = nil
+}
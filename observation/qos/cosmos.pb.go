@@ -42,8 +42,11 @@ type CosmosRequestObservations struct {
 	RequestLevelError *RequestError `protobuf:"bytes,5,opt,name=request_level_error,json=requestLevelError,proto3,oneof" json:"request_level_error,omitempty"`
 	// Cosmos-specific observations from endpoint(s) that responded to the service request.
 	EndpointObservations []*CosmosEndpointObservation `protobuf:"bytes,6,rep,name=endpoint_observations,json=endpointObservations,proto3" json:"endpoint_observations,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// Stall state of the service, i.e. whether the perceived block height has stopped advancing.
+	// Only set if stall detection is enabled for the service.
+	ServiceStall  *ServiceStallObservation `protobuf:"bytes,10,opt,name=service_stall,json=serviceStall,proto3,oneof" json:"service_stall,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CosmosRequestObservations) Reset() {
@@ -125,6 +128,13 @@ func (x *CosmosRequestObservations) GetEndpointObservations() []*CosmosEndpointO
 	return nil
 }
 
+func (x *CosmosRequestObservations) GetServiceStall() *ServiceStallObservation {
+	if x != nil {
+		return x.ServiceStall
+	}
+	return nil
+}
+
 // CosmosEndpointObservation stores a single observation from an endpoint
 type CosmosEndpointObservation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -187,7 +197,7 @@ var File_path_qos_cosmos_proto protoreflect.FileDescriptor
 
 const file_path_qos_cosmos_proto_rawDesc = "" +
 	"\n" +
-	"\x15path/qos/cosmos.proto\x12\bpath.qos\x1a\x1dpath/qos/cosmos_request.proto\x1a\x1epath/qos/cosmos_response.proto\x1a\x1dpath/qos/request_origin.proto\x1a\x1cpath/qos/request_error.proto\"\xf5\x03\n" +
+	"\x15path/qos/cosmos.proto\x12\bpath.qos\x1a\x1dpath/qos/cosmos_request.proto\x1a\x1epath/qos/cosmos_response.proto\x1a\x1dpath/qos/request_origin.proto\x1a\x1cpath/qos/request_error.proto\x1a\x1cpath/qos/service_stall.proto\"\xd4\x04\n" +
 	"\x19CosmosRequestObservations\x12&\n" +
 	"\x0fcosmos_chain_id\x18\b \x01(\tR\rcosmosChainId\x12 \n" +
 	"\fevm_chain_id\x18\a \x01(\tR\n" +
@@ -197,8 +207,11 @@ const file_path_qos_cosmos_proto_rawDesc = "" +
 	"\x0erequest_origin\x18\x03 \x01(\x0e2\x17.path.qos.RequestOriginR\rrequestOrigin\x12I\n" +
 	"\x10request_profiles\x18\t \x03(\v2\x1e.path.qos.CosmosRequestProfileR\x0frequestProfiles\x12K\n" +
 	"\x13request_level_error\x18\x05 \x01(\v2\x16.path.qos.RequestErrorH\x00R\x11requestLevelError\x88\x01\x01\x12X\n" +
-	"\x15endpoint_observations\x18\x06 \x03(\v2#.path.qos.CosmosEndpointObservationR\x14endpointObservationsB\x16\n" +
-	"\x14_request_level_errorJ\x04\b\x01\x10\x02J\x04\b\x04\x10\x05R\bchain_idR\x0frequest_profile\"\xc1\x01\n" +
+	"\x15endpoint_observations\x18\x06 \x03(\v2#.path.qos.CosmosEndpointObservationR\x14endpointObservations\x12K\n" +
+	"\rservice_stall\x18\n" +
+	" \x01(\v2!.path.qos.ServiceStallObservationH\x01R\fserviceStall\x88\x01\x01B\x16\n" +
+	"\x14_request_level_errorB\x10\n" +
+	"\x0e_service_stallJ\x04\b\x01\x10\x02J\x04\b\x04\x10\x05R\bchain_idR\x0frequest_profile\"\xc1\x01\n" +
 	"\x19CosmosEndpointObservation\x12#\n" +
 	"\rendpoint_addr\x18\x01 \x01(\tR\fendpointAddr\x12\x7f\n" +
 	"#endpoint_response_validation_result\x18\x02 \x01(\v20.path.qos.CosmosEndpointResponseValidationResultR endpointResponseValidationResultB0Z.github.com/buildwithgrove/path/observation/qosb\x06proto3"
@@ -222,19 +235,21 @@ var file_path_qos_cosmos_proto_goTypes = []any{
 	(RequestOrigin)(0),                             // 2: path.qos.RequestOrigin
 	(*CosmosRequestProfile)(nil),                   // 3: path.qos.CosmosRequestProfile
 	(*RequestError)(nil),                           // 4: path.qos.RequestError
-	(*CosmosEndpointResponseValidationResult)(nil), // 5: path.qos.CosmosEndpointResponseValidationResult
+	(*ServiceStallObservation)(nil),                // 5: path.qos.ServiceStallObservation
+	(*CosmosEndpointResponseValidationResult)(nil), // 6: path.qos.CosmosEndpointResponseValidationResult
 }
 var file_path_qos_cosmos_proto_depIdxs = []int32{
 	2, // 0: path.qos.CosmosRequestObservations.request_origin:type_name -> path.qos.RequestOrigin
 	3, // 1: path.qos.CosmosRequestObservations.request_profiles:type_name -> path.qos.CosmosRequestProfile
 	4, // 2: path.qos.CosmosRequestObservations.request_level_error:type_name -> path.qos.RequestError
 	1, // 3: path.qos.CosmosRequestObservations.endpoint_observations:type_name -> path.qos.CosmosEndpointObservation
-	5, // 4: path.qos.CosmosEndpointObservation.endpoint_response_validation_result:type_name -> path.qos.CosmosEndpointResponseValidationResult
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	5, // 4: path.qos.CosmosRequestObservations.service_stall:type_name -> path.qos.ServiceStallObservation
+	6, // 5: path.qos.CosmosEndpointObservation.endpoint_response_validation_result:type_name -> path.qos.CosmosEndpointResponseValidationResult
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_path_qos_cosmos_proto_init() }
@@ -246,6 +261,7 @@ func file_path_qos_cosmos_proto_init() {
 	file_path_qos_cosmos_response_proto_init()
 	file_path_qos_request_origin_proto_init()
 	file_path_qos_request_error_proto_init()
+	file_path_qos_service_stall_proto_init()
 	file_path_qos_cosmos_proto_msgTypes[0].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -158,6 +158,7 @@ type CosmosEndpointResponseValidationResult struct {
 	//	*CosmosEndpointResponseValidationResult_ResponseCometBftStatus
 	//	*CosmosEndpointResponseValidationResult_ResponseCosmosSdkStatus
 	//	*CosmosEndpointResponseValidationResult_ResponseEvmJsonrpcChainId
+	//	*CosmosEndpointResponseValidationResult_ResponseCometBftBlock
 	ParsedResponse isCosmosEndpointResponseValidationResult_ParsedResponse `protobuf_oneof:"parsed_response"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
@@ -282,6 +283,15 @@ func (x *CosmosEndpointResponseValidationResult) GetResponseEvmJsonrpcChainId()
 	return nil
 }
 
+func (x *CosmosEndpointResponseValidationResult) GetResponseCometBftBlock() *CosmosResponseCometBFTBlock {
+	if x != nil {
+		if x, ok := x.ParsedResponse.(*CosmosEndpointResponseValidationResult_ResponseCometBftBlock); ok {
+			return x.ResponseCometBftBlock
+		}
+	}
+	return nil
+}
+
 type isCosmosEndpointResponseValidationResult_ParsedResponse interface {
 	isCosmosEndpointResponseValidationResult_ParsedResponse()
 }
@@ -321,6 +331,12 @@ type CosmosEndpointResponseValidationResult_ResponseEvmJsonrpcChainId struct {
 	ResponseEvmJsonrpcChainId *CosmosResponseEVMJSONRPCChainID `protobuf:"bytes,11,opt,name=response_evm_jsonrpc_chain_id,json=responseEvmJsonrpcChainId,proto3,oneof"`
 }
 
+type CosmosEndpointResponseValidationResult_ResponseCometBftBlock struct {
+	// Response to the CometBFT archival check: a `block` method request at a known historical height.
+	// Reference: https://docs.cometbft.com/v1.0/spec/rpc/#block
+	ResponseCometBftBlock *CosmosResponseCometBFTBlock `protobuf:"bytes,13,opt,name=response_comet_bft_block,json=responseCometBftBlock,proto3,oneof"`
+}
+
 func (*CosmosEndpointResponseValidationResult_ResponseJsonrpc) isCosmosEndpointResponseValidationResult_ParsedResponse() {
 }
 
@@ -339,6 +355,9 @@ func (*CosmosEndpointResponseValidationResult_ResponseCosmosSdkStatus) isCosmosE
 func (*CosmosEndpointResponseValidationResult_ResponseEvmJsonrpcChainId) isCosmosEndpointResponseValidationResult_ParsedResponse() {
 }
 
+func (*CosmosEndpointResponseValidationResult_ResponseCometBftBlock) isCosmosEndpointResponseValidationResult_ParsedResponse() {
+}
+
 // CosmosResponseCometBFTHealth stores the response to a CometBFT `health` method request
 type CosmosResponseCometBFTHealth struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -390,8 +409,12 @@ type CosmosResponseCometBFTStatus struct {
 	ChainId           string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	CatchingUp        bool                   `protobuf:"varint,2,opt,name=catching_up,json=catchingUp,proto3" json:"catching_up,omitempty"`
 	LatestBlockHeight string                 `protobuf:"bytes,3,opt,name=latest_block_height,json=latestBlockHeight,proto3" json:"latest_block_height,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// The lowest block height retained by the endpoint, i.e. the start of its non-pruned range.
+	EarliestBlockHeight string `protobuf:"bytes,4,opt,name=earliest_block_height,json=earliestBlockHeight,proto3" json:"earliest_block_height,omitempty"`
+	// The time of the latest block, in RFC3339 format, e.g. "2024-01-01T00:00:00.000000000Z".
+	LatestBlockTime string `protobuf:"bytes,5,opt,name=latest_block_time,json=latestBlockTime,proto3" json:"latest_block_time,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CosmosResponseCometBFTStatus) Reset() {
@@ -445,6 +468,75 @@ func (x *CosmosResponseCometBFTStatus) GetLatestBlockHeight() string {
 	return ""
 }
 
+func (x *CosmosResponseCometBFTStatus) GetEarliestBlockHeight() string {
+	if x != nil {
+		return x.EarliestBlockHeight
+	}
+	return ""
+}
+
+func (x *CosmosResponseCometBFTStatus) GetLatestBlockTime() string {
+	if x != nil {
+		return x.LatestBlockTime
+	}
+	return ""
+}
+
+// CosmosResponseCometBFTBlock stores the response to the archival check's CometBFT `block` method request.
+type CosmosResponseCometBFTBlock struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The historical block height requested by the archival check.
+	BlockHeight uint64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Whether the endpoint returned the block at the requested height.
+	Available     bool `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CosmosResponseCometBFTBlock) Reset() {
+	*x = CosmosResponseCometBFTBlock{}
+	mi := &file_path_qos_cosmos_response_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CosmosResponseCometBFTBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosmosResponseCometBFTBlock) ProtoMessage() {}
+
+func (x *CosmosResponseCometBFTBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_cosmos_response_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosmosResponseCometBFTBlock.ProtoReflect.Descriptor instead.
+func (*CosmosResponseCometBFTBlock) Descriptor() ([]byte, []int) {
+	return file_path_qos_cosmos_response_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CosmosResponseCometBFTBlock) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *CosmosResponseCometBFTBlock) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
 // CosmosResponseCosmosSDKStatus stores the response to a CosmosSDK `/cosmos/base/node/v1beta1/status` request
 type CosmosResponseCosmosSDKStatus struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
@@ -455,7 +547,7 @@ type CosmosResponseCosmosSDKStatus struct {
 
 func (x *CosmosResponseCosmosSDKStatus) Reset() {
 	*x = CosmosResponseCosmosSDKStatus{}
-	mi := &file_path_qos_cosmos_response_proto_msgTypes[3]
+	mi := &file_path_qos_cosmos_response_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -467,7 +559,7 @@ func (x *CosmosResponseCosmosSDKStatus) String() string {
 func (*CosmosResponseCosmosSDKStatus) ProtoMessage() {}
 
 func (x *CosmosResponseCosmosSDKStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_path_qos_cosmos_response_proto_msgTypes[3]
+	mi := &file_path_qos_cosmos_response_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -480,7 +572,7 @@ func (x *CosmosResponseCosmosSDKStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CosmosResponseCosmosSDKStatus.ProtoReflect.Descriptor instead.
 func (*CosmosResponseCosmosSDKStatus) Descriptor() ([]byte, []int) {
-	return file_path_qos_cosmos_response_proto_rawDescGZIP(), []int{3}
+	return file_path_qos_cosmos_response_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CosmosResponseCosmosSDKStatus) GetLatestBlockHeight() uint64 {
@@ -504,7 +596,7 @@ type CosmosResponseEVMJSONRPCChainID struct {
 
 func (x *CosmosResponseEVMJSONRPCChainID) Reset() {
 	*x = CosmosResponseEVMJSONRPCChainID{}
-	mi := &file_path_qos_cosmos_response_proto_msgTypes[4]
+	mi := &file_path_qos_cosmos_response_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -516,7 +608,7 @@ func (x *CosmosResponseEVMJSONRPCChainID) String() string {
 func (*CosmosResponseEVMJSONRPCChainID) ProtoMessage() {}
 
 func (x *CosmosResponseEVMJSONRPCChainID) ProtoReflect() protoreflect.Message {
-	mi := &file_path_qos_cosmos_response_proto_msgTypes[4]
+	mi := &file_path_qos_cosmos_response_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -529,7 +621,7 @@ func (x *CosmosResponseEVMJSONRPCChainID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CosmosResponseEVMJSONRPCChainID.ProtoReflect.Descriptor instead.
 func (*CosmosResponseEVMJSONRPCChainID) Descriptor() ([]byte, []int) {
-	return file_path_qos_cosmos_response_proto_rawDescGZIP(), []int{4}
+	return file_path_qos_cosmos_response_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CosmosResponseEVMJSONRPCChainID) GetHttpStatusCode() int32 {
@@ -557,7 +649,7 @@ type UnrecognizedResponse struct {
 
 func (x *UnrecognizedResponse) Reset() {
 	*x = UnrecognizedResponse{}
-	mi := &file_path_qos_cosmos_response_proto_msgTypes[5]
+	mi := &file_path_qos_cosmos_response_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -569,7 +661,7 @@ func (x *UnrecognizedResponse) String() string {
 func (*UnrecognizedResponse) ProtoMessage() {}
 
 func (x *UnrecognizedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_path_qos_cosmos_response_proto_msgTypes[5]
+	mi := &file_path_qos_cosmos_response_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -582,7 +674,7 @@ func (x *UnrecognizedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnrecognizedResponse.ProtoReflect.Descriptor instead.
 func (*UnrecognizedResponse) Descriptor() ([]byte, []int) {
-	return file_path_qos_cosmos_response_proto_rawDescGZIP(), []int{5}
+	return file_path_qos_cosmos_response_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UnrecognizedResponse) GetEndpointPayloadLength() uint32 {
@@ -596,7 +688,7 @@ var File_path_qos_cosmos_response_proto protoreflect.FileDescriptor
 
 const file_path_qos_cosmos_response_proto_rawDesc = "" +
 	"\n" +
-	"\x1epath/qos/cosmos_response.proto\x12\bpath.qos\x1a\x16path/qos/jsonrpc.proto\"\x91\b\n" +
+	"\x1epath/qos/cosmos_response.proto\x12\bpath.qos\x1a\x16path/qos/jsonrpc.proto\"\xf3\b\n" +
 	"&CosmosEndpointResponseValidationResult\x12`\n" +
 	"\x18response_validation_type\x18\x01 \x01(\x0e2&.path.qos.CosmosResponseValidationTypeR\x16responseValidationType\x12(\n" +
 	"\x10http_status_code\x18\x02 \x01(\x05R\x0ehttpStatusCode\x12W\n" +
@@ -608,17 +700,23 @@ const file_path_qos_cosmos_response_proto_rawDesc = "" +
 	"\x19response_comet_bft_status\x18\t \x01(\v2&.path.qos.CosmosResponseCometBFTStatusH\x00R\x16responseCometBftStatus\x12f\n" +
 	"\x1aresponse_cosmos_sdk_status\x18\n" +
 	" \x01(\v2'.path.qos.CosmosResponseCosmosSDKStatusH\x00R\x17responseCosmosSdkStatus\x12m\n" +
-	"\x1dresponse_evm_jsonrpc_chain_id\x18\v \x01(\v2).path.qos.CosmosResponseEVMJSONRPCChainIDH\x00R\x19responseEvmJsonrpcChainIdB\x11\n" +
+	"\x1dresponse_evm_jsonrpc_chain_id\x18\v \x01(\v2).path.qos.CosmosResponseEVMJSONRPCChainIDH\x00R\x19responseEvmJsonrpcChainId\x12`\n" +
+	"\x18response_comet_bft_block\x18\r \x01(\v2%.path.qos.CosmosResponseCometBFTBlockH\x00R\x15responseCometBftBlockB\x11\n" +
 	"\x0fparsed_responseB\x13\n" +
 	"\x11_validation_errorB\x18\n" +
 	"\x16_user_jsonrpc_responseJ\x04\b\x05\x10\x06J\x04\b\x06\x10\aR\x0fresponse_healthR\x0fresponse_status\"C\n" +
 	"\x1cCosmosResponseCometBFTHealth\x12#\n" +
-	"\rhealth_status\x18\x01 \x01(\bR\fhealthStatus\"\x8a\x01\n" +
+	"\rhealth_status\x18\x01 \x01(\bR\fhealthStatus\"\xea\x01\n" +
 	"\x1cCosmosResponseCometBFTStatus\x12\x19\n" +
 	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x1f\n" +
 	"\vcatching_up\x18\x02 \x01(\bR\n" +
 	"catchingUp\x12.\n" +
-	"\x13latest_block_height\x18\x03 \x01(\tR\x11latestBlockHeight\"O\n" +
+	"\x13latest_block_height\x18\x03 \x01(\tR\x11latestBlockHeight\x122\n" +
+	"\x15earliest_block_height\x18\x04 \x01(\tR\x13earliestBlockHeight\x12*\n" +
+	"\x11latest_block_time\x18\x05 \x01(\tR\x0flatestBlockTime\"^\n" +
+	"\x1bCosmosResponseCometBFTBlock\x12!\n" +
+	"\fblock_height\x18\x01 \x01(\x04R\vblockHeight\x12\x1c\n" +
+	"\tavailable\x18\x02 \x01(\bR\tavailable\"O\n" +
 	"\x1dCosmosResponseCosmosSDKStatus\x12.\n" +
 	"\x13latest_block_height\x18\x01 \x01(\x04R\x11latestBlockHeight\"m\n" +
 	"\x1fCosmosResponseEVMJSONRPCChainID\x12(\n" +
@@ -651,33 +749,35 @@ func file_path_qos_cosmos_response_proto_rawDescGZIP() []byte {
 }
 
 var file_path_qos_cosmos_response_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_path_qos_cosmos_response_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_path_qos_cosmos_response_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_path_qos_cosmos_response_proto_goTypes = []any{
 	(CosmosResponseValidationError)(0),             // 0: path.qos.CosmosResponseValidationError
 	(CosmosResponseValidationType)(0),              // 1: path.qos.CosmosResponseValidationType
 	(*CosmosEndpointResponseValidationResult)(nil), // 2: path.qos.CosmosEndpointResponseValidationResult
 	(*CosmosResponseCometBFTHealth)(nil),           // 3: path.qos.CosmosResponseCometBFTHealth
 	(*CosmosResponseCometBFTStatus)(nil),           // 4: path.qos.CosmosResponseCometBFTStatus
-	(*CosmosResponseCosmosSDKStatus)(nil),          // 5: path.qos.CosmosResponseCosmosSDKStatus
-	(*CosmosResponseEVMJSONRPCChainID)(nil),        // 6: path.qos.CosmosResponseEVMJSONRPCChainID
-	(*UnrecognizedResponse)(nil),                   // 7: path.qos.UnrecognizedResponse
-	(*JsonRpcResponse)(nil),                        // 8: path.qos.JsonRpcResponse
+	(*CosmosResponseCometBFTBlock)(nil),            // 5: path.qos.CosmosResponseCometBFTBlock
+	(*CosmosResponseCosmosSDKStatus)(nil),          // 6: path.qos.CosmosResponseCosmosSDKStatus
+	(*CosmosResponseEVMJSONRPCChainID)(nil),        // 7: path.qos.CosmosResponseEVMJSONRPCChainID
+	(*UnrecognizedResponse)(nil),                   // 8: path.qos.UnrecognizedResponse
+	(*JsonRpcResponse)(nil),                        // 9: path.qos.JsonRpcResponse
 }
 var file_path_qos_cosmos_response_proto_depIdxs = []int32{
-	1, // 0: path.qos.CosmosEndpointResponseValidationResult.response_validation_type:type_name -> path.qos.CosmosResponseValidationType
-	0, // 1: path.qos.CosmosEndpointResponseValidationResult.validation_error:type_name -> path.qos.CosmosResponseValidationError
-	8, // 2: path.qos.CosmosEndpointResponseValidationResult.user_jsonrpc_response:type_name -> path.qos.JsonRpcResponse
-	8, // 3: path.qos.CosmosEndpointResponseValidationResult.response_jsonrpc:type_name -> path.qos.JsonRpcResponse
-	7, // 4: path.qos.CosmosEndpointResponseValidationResult.response_unrecognized:type_name -> path.qos.UnrecognizedResponse
-	3, // 5: path.qos.CosmosEndpointResponseValidationResult.response_comet_bft_health:type_name -> path.qos.CosmosResponseCometBFTHealth
-	4, // 6: path.qos.CosmosEndpointResponseValidationResult.response_comet_bft_status:type_name -> path.qos.CosmosResponseCometBFTStatus
-	5, // 7: path.qos.CosmosEndpointResponseValidationResult.response_cosmos_sdk_status:type_name -> path.qos.CosmosResponseCosmosSDKStatus
-	6, // 8: path.qos.CosmosEndpointResponseValidationResult.response_evm_jsonrpc_chain_id:type_name -> path.qos.CosmosResponseEVMJSONRPCChainID
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	1,  // 0: path.qos.CosmosEndpointResponseValidationResult.response_validation_type:type_name -> path.qos.CosmosResponseValidationType
+	0,  // 1: path.qos.CosmosEndpointResponseValidationResult.validation_error:type_name -> path.qos.CosmosResponseValidationError
+	9,  // 2: path.qos.CosmosEndpointResponseValidationResult.user_jsonrpc_response:type_name -> path.qos.JsonRpcResponse
+	9,  // 3: path.qos.CosmosEndpointResponseValidationResult.response_jsonrpc:type_name -> path.qos.JsonRpcResponse
+	8,  // 4: path.qos.CosmosEndpointResponseValidationResult.response_unrecognized:type_name -> path.qos.UnrecognizedResponse
+	3,  // 5: path.qos.CosmosEndpointResponseValidationResult.response_comet_bft_health:type_name -> path.qos.CosmosResponseCometBFTHealth
+	4,  // 6: path.qos.CosmosEndpointResponseValidationResult.response_comet_bft_status:type_name -> path.qos.CosmosResponseCometBFTStatus
+	6,  // 7: path.qos.CosmosEndpointResponseValidationResult.response_cosmos_sdk_status:type_name -> path.qos.CosmosResponseCosmosSDKStatus
+	7,  // 8: path.qos.CosmosEndpointResponseValidationResult.response_evm_jsonrpc_chain_id:type_name -> path.qos.CosmosResponseEVMJSONRPCChainID
+	5,  // 9: path.qos.CosmosEndpointResponseValidationResult.response_comet_bft_block:type_name -> path.qos.CosmosResponseCometBFTBlock
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_path_qos_cosmos_response_proto_init() }
@@ -693,6 +793,7 @@ func file_path_qos_cosmos_response_proto_init() {
 		(*CosmosEndpointResponseValidationResult_ResponseCometBftStatus)(nil),
 		(*CosmosEndpointResponseValidationResult_ResponseCosmosSdkStatus)(nil),
 		(*CosmosEndpointResponseValidationResult_ResponseEvmJsonrpcChainId)(nil),
+		(*CosmosEndpointResponseValidationResult_ResponseCometBftBlock)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -700,7 +801,7 @@ func file_path_qos_cosmos_response_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_path_qos_cosmos_response_proto_rawDesc), len(file_path_qos_cosmos_response_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -46,21 +46,30 @@ const (
 	EndpointValidationFailureReason_ENDPOINT_VALIDATION_FAILURE_REASON_ENDPOINT_NOT_FOUND EndpointValidationFailureReason = 8
 	// Unknown or unclassified validation failure
 	EndpointValidationFailureReason_ENDPOINT_VALIDATION_FAILURE_REASON_UNKNOWN EndpointValidationFailureReason = 9
+	// Endpoint's block hash at the fork check's block number doesn't match the majority of endpoints
+	EndpointValidationFailureReason_ENDPOINT_VALIDATION_FAILURE_REASON_BLOCK_HASH_MISMATCH EndpointValidationFailureReason = 10
+	// Endpoint reported it is still syncing in response to an `eth_syncing` request
+	EndpointValidationFailureReason_ENDPOINT_VALIDATION_FAILURE_REASON_SYNCING EndpointValidationFailureReason = 11
+	// Endpoint's peer count is below the service's configured minimum peer count
+	EndpointValidationFailureReason_ENDPOINT_VALIDATION_FAILURE_REASON_PEER_COUNT_BELOW_MINIMUM EndpointValidationFailureReason = 12
 )
 
 // Enum value maps for EndpointValidationFailureReason.
 var (
 	EndpointValidationFailureReason_name = map[int32]string{
-		0: "ENDPOINT_VALIDATION_FAILURE_REASON_UNSPECIFIED",
-		1: "ENDPOINT_VALIDATION_FAILURE_REASON_EMPTY_RESPONSE_HISTORY",
-		2: "ENDPOINT_VALIDATION_FAILURE_REASON_RECENT_INVALID_RESPONSE",
-		3: "ENDPOINT_VALIDATION_FAILURE_REASON_BLOCK_NUMBER_BEHIND",
-		4: "ENDPOINT_VALIDATION_FAILURE_REASON_CHAIN_ID_MISMATCH",
-		5: "ENDPOINT_VALIDATION_FAILURE_REASON_NO_BLOCK_NUMBER_OBSERVATION",
-		6: "ENDPOINT_VALIDATION_FAILURE_REASON_NO_CHAIN_ID_OBSERVATION",
-		7: "ENDPOINT_VALIDATION_FAILURE_REASON_ARCHIVAL_CHECK_FAILED",
-		8: "ENDPOINT_VALIDATION_FAILURE_REASON_ENDPOINT_NOT_FOUND",
-		9: "ENDPOINT_VALIDATION_FAILURE_REASON_UNKNOWN",
+		0:  "ENDPOINT_VALIDATION_FAILURE_REASON_UNSPECIFIED",
+		1:  "ENDPOINT_VALIDATION_FAILURE_REASON_EMPTY_RESPONSE_HISTORY",
+		2:  "ENDPOINT_VALIDATION_FAILURE_REASON_RECENT_INVALID_RESPONSE",
+		3:  "ENDPOINT_VALIDATION_FAILURE_REASON_BLOCK_NUMBER_BEHIND",
+		4:  "ENDPOINT_VALIDATION_FAILURE_REASON_CHAIN_ID_MISMATCH",
+		5:  "ENDPOINT_VALIDATION_FAILURE_REASON_NO_BLOCK_NUMBER_OBSERVATION",
+		6:  "ENDPOINT_VALIDATION_FAILURE_REASON_NO_CHAIN_ID_OBSERVATION",
+		7:  "ENDPOINT_VALIDATION_FAILURE_REASON_ARCHIVAL_CHECK_FAILED",
+		8:  "ENDPOINT_VALIDATION_FAILURE_REASON_ENDPOINT_NOT_FOUND",
+		9:  "ENDPOINT_VALIDATION_FAILURE_REASON_UNKNOWN",
+		10: "ENDPOINT_VALIDATION_FAILURE_REASON_BLOCK_HASH_MISMATCH",
+		11: "ENDPOINT_VALIDATION_FAILURE_REASON_SYNCING",
+		12: "ENDPOINT_VALIDATION_FAILURE_REASON_PEER_COUNT_BELOW_MINIMUM",
 	}
 	EndpointValidationFailureReason_value = map[string]int32{
 		"ENDPOINT_VALIDATION_FAILURE_REASON_UNSPECIFIED":                 0,
@@ -73,6 +82,9 @@ var (
 		"ENDPOINT_VALIDATION_FAILURE_REASON_ARCHIVAL_CHECK_FAILED":       7,
 		"ENDPOINT_VALIDATION_FAILURE_REASON_ENDPOINT_NOT_FOUND":          8,
 		"ENDPOINT_VALIDATION_FAILURE_REASON_UNKNOWN":                     9,
+		"ENDPOINT_VALIDATION_FAILURE_REASON_BLOCK_HASH_MISMATCH":         10,
+		"ENDPOINT_VALIDATION_FAILURE_REASON_SYNCING":                     11,
+		"ENDPOINT_VALIDATION_FAILURE_REASON_PEER_COUNT_BELOW_MINIMUM":    12,
 	}
 )
 
@@ -103,6 +115,58 @@ func (EndpointValidationFailureReason) EnumDescriptor() ([]byte, []int) {
 	return file_path_qos_endpoint_selection_metadata_proto_rawDescGZIP(), []int{0}
 }
 
+// EndpointPool enumerates the pools of valid endpoints a service request may be served from.
+type EndpointPool int32
+
+const (
+	EndpointPool_ENDPOINT_POOL_UNSPECIFIED EndpointPool = 0
+	// All valid endpoints: used for requests which do not need historical data.
+	EndpointPool_ENDPOINT_POOL_ALL_VALID EndpointPool = 1
+	// Valid endpoints which passed the archival check: used for requests which need historical data.
+	EndpointPool_ENDPOINT_POOL_ARCHIVAL EndpointPool = 2
+)
+
+// Enum value maps for EndpointPool.
+var (
+	EndpointPool_name = map[int32]string{
+		0: "ENDPOINT_POOL_UNSPECIFIED",
+		1: "ENDPOINT_POOL_ALL_VALID",
+		2: "ENDPOINT_POOL_ARCHIVAL",
+	}
+	EndpointPool_value = map[string]int32{
+		"ENDPOINT_POOL_UNSPECIFIED": 0,
+		"ENDPOINT_POOL_ALL_VALID":   1,
+		"ENDPOINT_POOL_ARCHIVAL":    2,
+	}
+)
+
+func (x EndpointPool) Enum() *EndpointPool {
+	p := new(EndpointPool)
+	*p = x
+	return p
+}
+
+func (x EndpointPool) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EndpointPool) Descriptor() protoreflect.EnumDescriptor {
+	return file_path_qos_endpoint_selection_metadata_proto_enumTypes[1].Descriptor()
+}
+
+func (EndpointPool) Type() protoreflect.EnumType {
+	return &file_path_qos_endpoint_selection_metadata_proto_enumTypes[1]
+}
+
+func (x EndpointPool) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EndpointPool.Descriptor instead.
+func (EndpointPool) EnumDescriptor() ([]byte, []int) {
+	return file_path_qos_endpoint_selection_metadata_proto_rawDescGZIP(), []int{1}
+}
+
 // EndpointValidationResult represents the result of validating a single endpoint.
 // This captures both successful and failed validation attempts with optional failure details.
 type EndpointValidationResult struct {
@@ -198,8 +262,11 @@ type EndpointSelectionMetadata struct {
 	// - available_endpoints_count = len(validation_results)
 	// - valid_endpoints_count = count(validation_results where success = true)
 	ValidationResults []*EndpointValidationResult `protobuf:"bytes,2,rep,name=validation_results,json=validationResults,proto3" json:"validation_results,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// endpoint_pool is the pool of valid endpoints the endpoint was selected from.
+	// Only set by QoS services which distinguish archival endpoints, e.g. EVM.
+	EndpointPool  EndpointPool `protobuf:"varint,3,opt,name=endpoint_pool,json=endpointPool,proto3,enum=path.qos.EndpointPool" json:"endpoint_pool,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EndpointSelectionMetadata) Reset() {
@@ -246,6 +313,13 @@ func (x *EndpointSelectionMetadata) GetValidationResults() []*EndpointValidation
 	return nil
 }
 
+func (x *EndpointSelectionMetadata) GetEndpointPool() EndpointPool {
+	if x != nil {
+		return x.EndpointPool
+	}
+	return EndpointPool_ENDPOINT_POOL_UNSPECIFIED
+}
+
 var File_path_qos_endpoint_selection_metadata_proto protoreflect.FileDescriptor
 
 const file_path_qos_endpoint_selection_metadata_proto_rawDesc = "" +
@@ -257,10 +331,11 @@ const file_path_qos_endpoint_selection_metadata_proto_rawDesc = "" +
 	"\x0efailure_reason\x18\x03 \x01(\x0e2).path.qos.EndpointValidationFailureReasonH\x00R\rfailureReason\x88\x01\x01\x12,\n" +
 	"\x0ffailure_details\x18\x04 \x01(\tH\x01R\x0efailureDetails\x88\x01\x01B\x11\n" +
 	"\x0f_failure_reasonB\x12\n" +
-	"\x10_failure_details\"\xa8\x01\n" +
+	"\x10_failure_details\"\xe5\x01\n" +
 	"\x19EndpointSelectionMetadata\x128\n" +
 	"\x18random_endpoint_fallback\x18\x01 \x01(\bR\x16randomEndpointFallback\x12Q\n" +
-	"\x12validation_results\x18\x02 \x03(\v2\".path.qos.EndpointValidationResultR\x11validationResults*\xf7\x04\n" +
+	"\x12validation_results\x18\x02 \x03(\v2\".path.qos.EndpointValidationResultR\x11validationResults\x12;\n" +
+	"\rendpoint_pool\x18\x03 \x01(\x0e2\x16.path.qos.EndpointPoolR\fendpointPool*\xa4\x06\n" +
 	"\x1fEndpointValidationFailureReason\x122\n" +
 	".ENDPOINT_VALIDATION_FAILURE_REASON_UNSPECIFIED\x10\x00\x12=\n" +
 	"9ENDPOINT_VALIDATION_FAILURE_REASON_EMPTY_RESPONSE_HISTORY\x10\x01\x12>\n" +
@@ -271,7 +346,15 @@ const file_path_qos_endpoint_selection_metadata_proto_rawDesc = "" +
 	":ENDPOINT_VALIDATION_FAILURE_REASON_NO_CHAIN_ID_OBSERVATION\x10\x06\x12<\n" +
 	"8ENDPOINT_VALIDATION_FAILURE_REASON_ARCHIVAL_CHECK_FAILED\x10\a\x129\n" +
 	"5ENDPOINT_VALIDATION_FAILURE_REASON_ENDPOINT_NOT_FOUND\x10\b\x12.\n" +
-	"*ENDPOINT_VALIDATION_FAILURE_REASON_UNKNOWN\x10\tB0Z.github.com/buildwithgrove/path/observation/qosb\x06proto3"
+	"*ENDPOINT_VALIDATION_FAILURE_REASON_UNKNOWN\x10\t\x12:\n" +
+	"6ENDPOINT_VALIDATION_FAILURE_REASON_BLOCK_HASH_MISMATCH\x10\n" +
+	"\x12.\n" +
+	"*ENDPOINT_VALIDATION_FAILURE_REASON_SYNCING\x10\v\x12?\n" +
+	";ENDPOINT_VALIDATION_FAILURE_REASON_PEER_COUNT_BELOW_MINIMUM\x10\f*f\n" +
+	"\fEndpointPool\x12\x1d\n" +
+	"\x19ENDPOINT_POOL_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17ENDPOINT_POOL_ALL_VALID\x10\x01\x12\x1a\n" +
+	"\x16ENDPOINT_POOL_ARCHIVAL\x10\x02B0Z.github.com/buildwithgrove/path/observation/qosb\x06proto3"
 
 var (
 	file_path_qos_endpoint_selection_metadata_proto_rawDescOnce sync.Once
@@ -285,21 +368,23 @@ func file_path_qos_endpoint_selection_metadata_proto_rawDescGZIP() []byte {
 	return file_path_qos_endpoint_selection_metadata_proto_rawDescData
 }
 
-var file_path_qos_endpoint_selection_metadata_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_path_qos_endpoint_selection_metadata_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
 var file_path_qos_endpoint_selection_metadata_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_path_qos_endpoint_selection_metadata_proto_goTypes = []any{
 	(EndpointValidationFailureReason)(0), // 0: path.qos.EndpointValidationFailureReason
-	(*EndpointValidationResult)(nil),     // 1: path.qos.EndpointValidationResult
-	(*EndpointSelectionMetadata)(nil),    // 2: path.qos.EndpointSelectionMetadata
+	(EndpointPool)(0),                    // 1: path.qos.EndpointPool
+	(*EndpointValidationResult)(nil),     // 2: path.qos.EndpointValidationResult
+	(*EndpointSelectionMetadata)(nil),    // 3: path.qos.EndpointSelectionMetadata
 }
 var file_path_qos_endpoint_selection_metadata_proto_depIdxs = []int32{
 	0, // 0: path.qos.EndpointValidationResult.failure_reason:type_name -> path.qos.EndpointValidationFailureReason
-	1, // 1: path.qos.EndpointSelectionMetadata.validation_results:type_name -> path.qos.EndpointValidationResult
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2, // 1: path.qos.EndpointSelectionMetadata.validation_results:type_name -> path.qos.EndpointValidationResult
+	1, // 2: path.qos.EndpointSelectionMetadata.endpoint_pool:type_name -> path.qos.EndpointPool
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_path_qos_endpoint_selection_metadata_proto_init() }
@@ -313,7 +398,7 @@ func file_path_qos_endpoint_selection_metadata_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_path_qos_endpoint_selection_metadata_proto_rawDesc), len(file_path_qos_endpoint_selection_metadata_proto_rawDesc)),
-			NumEnums:      1,
+			NumEnums:      2,
 			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -108,10 +108,11 @@ func (EVMRequestValidationError) EnumDescriptor() ([]byte, []int) {
 type EVMResponseValidationError int32
 
 const (
-	EVMResponseValidationError_EVM_RESPONSE_VALIDATION_ERROR_UNSPECIFIED EVMResponseValidationError = 0
-	EVMResponseValidationError_EVM_RESPONSE_VALIDATION_ERROR_EMPTY       EVMResponseValidationError = 1 // Response with no data.
-	EVMResponseValidationError_EVM_RESPONSE_VALIDATION_ERROR_UNMARSHAL   EVMResponseValidationError = 2 // Response parsing failed
-	EVMResponseValidationError_EVM_RESPONSE_VALIDATION_ERROR_NO_RESPONSE EVMResponseValidationError = 3 // No response received from any endpoint
+	EVMResponseValidationError_EVM_RESPONSE_VALIDATION_ERROR_UNSPECIFIED            EVMResponseValidationError = 0
+	EVMResponseValidationError_EVM_RESPONSE_VALIDATION_ERROR_EMPTY                  EVMResponseValidationError = 1 // Response with no data.
+	EVMResponseValidationError_EVM_RESPONSE_VALIDATION_ERROR_UNMARSHAL              EVMResponseValidationError = 2 // Response parsing failed
+	EVMResponseValidationError_EVM_RESPONSE_VALIDATION_ERROR_NO_RESPONSE            EVMResponseValidationError = 3 // No response received from any endpoint
+	EVMResponseValidationError_EVM_RESPONSE_VALIDATION_ERROR_CONSENSUS_DISAGREEMENT EVMResponseValidationError = 4 // Response did not match the majority of endpoints in consensus-read mode
 )
 
 // Enum value maps for EVMResponseValidationError.
@@ -121,12 +122,14 @@ var (
 		1: "EVM_RESPONSE_VALIDATION_ERROR_EMPTY",
 		2: "EVM_RESPONSE_VALIDATION_ERROR_UNMARSHAL",
 		3: "EVM_RESPONSE_VALIDATION_ERROR_NO_RESPONSE",
+		4: "EVM_RESPONSE_VALIDATION_ERROR_CONSENSUS_DISAGREEMENT",
 	}
 	EVMResponseValidationError_value = map[string]int32{
-		"EVM_RESPONSE_VALIDATION_ERROR_UNSPECIFIED": 0,
-		"EVM_RESPONSE_VALIDATION_ERROR_EMPTY":       1,
-		"EVM_RESPONSE_VALIDATION_ERROR_UNMARSHAL":   2,
-		"EVM_RESPONSE_VALIDATION_ERROR_NO_RESPONSE": 3,
+		"EVM_RESPONSE_VALIDATION_ERROR_UNSPECIFIED":            0,
+		"EVM_RESPONSE_VALIDATION_ERROR_EMPTY":                  1,
+		"EVM_RESPONSE_VALIDATION_ERROR_UNMARSHAL":              2,
+		"EVM_RESPONSE_VALIDATION_ERROR_NO_RESPONSE":            3,
+		"EVM_RESPONSE_VALIDATION_ERROR_CONSENSUS_DISAGREEMENT": 4,
 	}
 )
 
@@ -174,14 +177,13 @@ type EVMRequestObservations struct {
 	// The length of the client's request payload, in bytes.
 	RequestPayloadLength uint32 `protobuf:"varint,2,opt,name=request_payload_length,json=requestPayloadLength,proto3" json:"request_payload_length,omitempty"`
 	// If this oneof IS SET, then one of the following validation failures happened:
-	//   - Indicates the request failed validation
-	//   - Contains details about the specific failure type
-	//   - The HTTP status code in the selected failure type overrides any status codes from
-	//     endpoint observations and should be returned to the client
-	//
+	//  - Indicates the request failed validation
+	//  - Contains details about the specific failure type
+	//  - The HTTP status code in the selected failure type overrides any status codes from
+	//    endpoint observations and should be returned to the client
 	// If this oneof IS NOT SET, then one of the following occurred:
-	//   - The request passed validation
-	//   - The HTTP status code from the most recent endpoint observation should be used instead
+	//  - The request passed validation
+	//  - The HTTP status code from the most recent endpoint observation should be used instead
 	//
 	// Types that are valid to be assigned to RequestValidationFailure:
 	//
@@ -196,7 +198,15 @@ type EVMRequestObservations struct {
 	// Example: no endpoint responses received.
 	// On single JSONRPC request: applies to the single request.
 	// On batch JSONRPC requests: only set if the entire batch failed (e.g. no endpoint responses for any of the requests of the batch)
-	RequestError  *RequestError `protobuf:"bytes,11,opt,name=request_error,json=requestError,proto3,oneof" json:"request_error,omitempty"`
+	RequestError *RequestError `protobuf:"bytes,11,opt,name=request_error,json=requestError,proto3,oneof" json:"request_error,omitempty"`
+	// Outcome of the consensus-read, if the request was sent to multiple endpoints in consensus-read mode.
+	ConsensusRead *ConsensusReadObservation `protobuf:"bytes,12,opt,name=consensus_read,json=consensusRead,proto3,oneof" json:"consensus_read,omitempty"`
+	// Stall state of the service, i.e. whether the perceived block number has stopped advancing.
+	// Only set if stall detection is enabled for the service.
+	ServiceStall *ServiceStallObservation `protobuf:"bytes,13,opt,name=service_stall,json=serviceStall,proto3,oneof" json:"service_stall,omitempty"`
+	// State of the fork check of the service, i.e. the block hash agreed on by the endpoints at a recent confirmed block number.
+	// Only set once a block number has been selected for the fork check.
+	ForkCheck     *EVMForkCheckObservation `protobuf:"bytes,14,opt,name=fork_check,json=forkCheck,proto3,oneof" json:"fork_check,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -305,6 +315,27 @@ func (x *EVMRequestObservations) GetRequestError() *RequestError {
 	return nil
 }
 
+func (x *EVMRequestObservations) GetConsensusRead() *ConsensusReadObservation {
+	if x != nil {
+		return x.ConsensusRead
+	}
+	return nil
+}
+
+func (x *EVMRequestObservations) GetServiceStall() *ServiceStallObservation {
+	if x != nil {
+		return x.ServiceStall
+	}
+	return nil
+}
+
+func (x *EVMRequestObservations) GetForkCheck() *EVMForkCheckObservation {
+	if x != nil {
+		return x.ForkCheck
+	}
+	return nil
+}
+
 type isEVMRequestObservations_RequestValidationFailure interface {
 	isEVMRequestObservations_RequestValidationFailure()
 }
@@ -325,6 +356,74 @@ func (*EVMRequestObservations_EvmHttpBodyReadFailure) isEVMRequestObservations_R
 func (*EVMRequestObservations_EvmRequestUnmarshalingFailure) isEVMRequestObservations_RequestValidationFailure() {
 }
 
+// EVMForkCheckObservation captures the state of the fork check of an EVM service:
+//   - Endpoints are periodically asked for the block at a recent confirmed block number, using `eth_getBlockByNumber`.
+//   - The block hash returned by the majority of the endpoints is the expected block hash.
+//   - Endpoints returning a different block hash, e.g. on a minority fork or a different network reusing the chain ID, are disqualified.
+type EVMForkCheckObservation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The block number, in hex format, of the block requested by the fork check.
+	BlockNumber string `protobuf:"bytes,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// The block hash agreed on by the majority of the endpoints, at the fork check's block number.
+	// Not set until a majority is reached.
+	ExpectedBlockHash string `protobuf:"bytes,2,opt,name=expected_block_hash,json=expectedBlockHash,proto3" json:"expected_block_hash,omitempty"`
+	// The number of endpoints whose block hash does not match the expected block hash.
+	NumForkedEndpoints uint32 `protobuf:"varint,3,opt,name=num_forked_endpoints,json=numForkedEndpoints,proto3" json:"num_forked_endpoints,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *EVMForkCheckObservation) Reset() {
+	*x = EVMForkCheckObservation{}
+	mi := &file_path_qos_evm_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EVMForkCheckObservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EVMForkCheckObservation) ProtoMessage() {}
+
+func (x *EVMForkCheckObservation) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_evm_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EVMForkCheckObservation.ProtoReflect.Descriptor instead.
+func (*EVMForkCheckObservation) Descriptor() ([]byte, []int) {
+	return file_path_qos_evm_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EVMForkCheckObservation) GetBlockNumber() string {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return ""
+}
+
+func (x *EVMForkCheckObservation) GetExpectedBlockHash() string {
+	if x != nil {
+		return x.ExpectedBlockHash
+	}
+	return ""
+}
+
+func (x *EVMForkCheckObservation) GetNumForkedEndpoints() uint32 {
+	if x != nil {
+		return x.NumForkedEndpoints
+	}
+	return 0
+}
+
 // EVMRequestObservation stores a single observation from an endpoint servicing the protocol response.
 // This is necessary to support batch requests.
 type EVMRequestObservation struct {
@@ -346,7 +445,7 @@ type EVMRequestObservation struct {
 
 func (x *EVMRequestObservation) Reset() {
 	*x = EVMRequestObservation{}
-	mi := &file_path_qos_evm_proto_msgTypes[1]
+	mi := &file_path_qos_evm_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -358,7 +457,7 @@ func (x *EVMRequestObservation) String() string {
 func (*EVMRequestObservation) ProtoMessage() {}
 
 func (x *EVMRequestObservation) ProtoReflect() protoreflect.Message {
-	mi := &file_path_qos_evm_proto_msgTypes[1]
+	mi := &file_path_qos_evm_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -371,7 +470,7 @@ func (x *EVMRequestObservation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EVMRequestObservation.ProtoReflect.Descriptor instead.
 func (*EVMRequestObservation) Descriptor() ([]byte, []int) {
-	return file_path_qos_evm_proto_rawDescGZIP(), []int{1}
+	return file_path_qos_evm_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *EVMRequestObservation) GetJsonrpcRequest() *JsonRpcRequest {
@@ -407,7 +506,7 @@ type EVMHTTPBodyReadFailure struct {
 
 func (x *EVMHTTPBodyReadFailure) Reset() {
 	*x = EVMHTTPBodyReadFailure{}
-	mi := &file_path_qos_evm_proto_msgTypes[2]
+	mi := &file_path_qos_evm_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -419,7 +518,7 @@ func (x *EVMHTTPBodyReadFailure) String() string {
 func (*EVMHTTPBodyReadFailure) ProtoMessage() {}
 
 func (x *EVMHTTPBodyReadFailure) ProtoReflect() protoreflect.Message {
-	mi := &file_path_qos_evm_proto_msgTypes[2]
+	mi := &file_path_qos_evm_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -432,7 +531,7 @@ func (x *EVMHTTPBodyReadFailure) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EVMHTTPBodyReadFailure.ProtoReflect.Descriptor instead.
 func (*EVMHTTPBodyReadFailure) Descriptor() ([]byte, []int) {
-	return file_path_qos_evm_proto_rawDescGZIP(), []int{2}
+	return file_path_qos_evm_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *EVMHTTPBodyReadFailure) GetHttpStatusCode() int32 {
@@ -472,7 +571,7 @@ type EVMRequestUnmarshalingFailure struct {
 
 func (x *EVMRequestUnmarshalingFailure) Reset() {
 	*x = EVMRequestUnmarshalingFailure{}
-	mi := &file_path_qos_evm_proto_msgTypes[3]
+	mi := &file_path_qos_evm_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -484,7 +583,7 @@ func (x *EVMRequestUnmarshalingFailure) String() string {
 func (*EVMRequestUnmarshalingFailure) ProtoMessage() {}
 
 func (x *EVMRequestUnmarshalingFailure) ProtoReflect() protoreflect.Message {
-	mi := &file_path_qos_evm_proto_msgTypes[3]
+	mi := &file_path_qos_evm_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -497,7 +596,7 @@ func (x *EVMRequestUnmarshalingFailure) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EVMRequestUnmarshalingFailure.ProtoReflect.Descriptor instead.
 func (*EVMRequestUnmarshalingFailure) Descriptor() ([]byte, []int) {
-	return file_path_qos_evm_proto_rawDescGZIP(), []int{3}
+	return file_path_qos_evm_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *EVMRequestUnmarshalingFailure) GetHttpStatusCode() int32 {
@@ -537,6 +636,10 @@ type EVMEndpointObservation struct {
 	//	*EVMEndpointObservation_UnrecognizedResponse
 	//	*EVMEndpointObservation_EmptyResponse
 	//	*EVMEndpointObservation_NoResponse
+	//	*EVMEndpointObservation_GetBlockByNumberResponse
+	//	*EVMEndpointObservation_SyncingResponse
+	//	*EVMEndpointObservation_PeerCountResponse
+	//	*EVMEndpointObservation_ArchivalProbeResponse
 	ResponseObservation isEVMEndpointObservation_ResponseObservation `protobuf_oneof:"response_observation"`
 	// Only set if the endpoint returned a valid JSONRPC response.
 	ParsedJsonrpcResponse *JsonRpcResponse `protobuf:"bytes,8,opt,name=parsed_jsonrpc_response,json=parsedJsonrpcResponse,proto3,oneof" json:"parsed_jsonrpc_response,omitempty"`
@@ -546,7 +649,7 @@ type EVMEndpointObservation struct {
 
 func (x *EVMEndpointObservation) Reset() {
 	*x = EVMEndpointObservation{}
-	mi := &file_path_qos_evm_proto_msgTypes[4]
+	mi := &file_path_qos_evm_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -558,7 +661,7 @@ func (x *EVMEndpointObservation) String() string {
 func (*EVMEndpointObservation) ProtoMessage() {}
 
 func (x *EVMEndpointObservation) ProtoReflect() protoreflect.Message {
-	mi := &file_path_qos_evm_proto_msgTypes[4]
+	mi := &file_path_qos_evm_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -571,7 +674,7 @@ func (x *EVMEndpointObservation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EVMEndpointObservation.ProtoReflect.Descriptor instead.
 func (*EVMEndpointObservation) Descriptor() ([]byte, []int) {
-	return file_path_qos_evm_proto_rawDescGZIP(), []int{4}
+	return file_path_qos_evm_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *EVMEndpointObservation) GetEndpointAddr() string {
@@ -642,6 +745,42 @@ func (x *EVMEndpointObservation) GetNoResponse() *EVMNoResponse {
 	return nil
 }
 
+func (x *EVMEndpointObservation) GetGetBlockByNumberResponse() *EVMGetBlockByNumberResponse {
+	if x != nil {
+		if x, ok := x.ResponseObservation.(*EVMEndpointObservation_GetBlockByNumberResponse); ok {
+			return x.GetBlockByNumberResponse
+		}
+	}
+	return nil
+}
+
+func (x *EVMEndpointObservation) GetSyncingResponse() *EVMSyncingResponse {
+	if x != nil {
+		if x, ok := x.ResponseObservation.(*EVMEndpointObservation_SyncingResponse); ok {
+			return x.SyncingResponse
+		}
+	}
+	return nil
+}
+
+func (x *EVMEndpointObservation) GetPeerCountResponse() *EVMPeerCountResponse {
+	if x != nil {
+		if x, ok := x.ResponseObservation.(*EVMEndpointObservation_PeerCountResponse); ok {
+			return x.PeerCountResponse
+		}
+	}
+	return nil
+}
+
+func (x *EVMEndpointObservation) GetArchivalProbeResponse() *EVMArchivalProbeResponse {
+	if x != nil {
+		if x, ok := x.ResponseObservation.(*EVMEndpointObservation_ArchivalProbeResponse); ok {
+			return x.ArchivalProbeResponse
+		}
+	}
+	return nil
+}
+
 func (x *EVMEndpointObservation) GetParsedJsonrpcResponse() *JsonRpcResponse {
 	if x != nil {
 		return x.ParsedJsonrpcResponse
@@ -668,7 +807,8 @@ type EVMEndpointObservation_BlockNumberResponse struct {
 }
 
 type EVMEndpointObservation_GetBalanceResponse struct {
-	// Response to `eth_getBalance` request, which may be used to update archival state.
+	// Response to `eth_getBalance` request.
+	// Responses to archival probes are captured by EVMArchivalProbeResponse instead.
 	// See the EVMGetBalanceResponse message for more details.
 	// References:
 	// * https://ethereum.org/en/developers/docs/apis/json-rpc/#eth_getbalance
@@ -694,6 +834,30 @@ type EVMEndpointObservation_NoResponse struct {
 	NoResponse *EVMNoResponse `protobuf:"bytes,7,opt,name=no_response,json=noResponse,proto3,oneof"`
 }
 
+type EVMEndpointObservation_GetBlockByNumberResponse struct {
+	// Response to `eth_getBlockByNumber` request, which may be used to update the fork check state.
+	// Reference: https://ethereum.org/en/developers/docs/apis/json-rpc/#eth_getblockbynumber
+	GetBlockByNumberResponse *EVMGetBlockByNumberResponse `protobuf:"bytes,9,opt,name=get_block_by_number_response,json=getBlockByNumberResponse,proto3,oneof"`
+}
+
+type EVMEndpointObservation_SyncingResponse struct {
+	// Response to `eth_syncing` request
+	// Reference: https://ethereum.org/en/developers/docs/apis/json-rpc/#eth_syncing
+	SyncingResponse *EVMSyncingResponse `protobuf:"bytes,10,opt,name=syncing_response,json=syncingResponse,proto3,oneof"`
+}
+
+type EVMEndpointObservation_PeerCountResponse struct {
+	// Response to `net_peerCount` request
+	// Reference: https://ethereum.org/en/developers/docs/apis/json-rpc/#net_peercount
+	PeerCountResponse *EVMPeerCountResponse `protobuf:"bytes,11,opt,name=peer_count_response,json=peerCountResponse,proto3,oneof"`
+}
+
+type EVMEndpointObservation_ArchivalProbeResponse struct {
+	// Response to an archival probe request, e.g. `eth_getCode` at a historical block, used to update the archival check state.
+	// Identified by the request context rather than the method, as probe methods (e.g. `eth_call`) are also used by organic requests.
+	ArchivalProbeResponse *EVMArchivalProbeResponse `protobuf:"bytes,12,opt,name=archival_probe_response,json=archivalProbeResponse,proto3,oneof"`
+}
+
 func (*EVMEndpointObservation_ChainIdResponse) isEVMEndpointObservation_ResponseObservation() {}
 
 func (*EVMEndpointObservation_BlockNumberResponse) isEVMEndpointObservation_ResponseObservation() {}
@@ -706,6 +870,15 @@ func (*EVMEndpointObservation_EmptyResponse) isEVMEndpointObservation_ResponseOb
 
 func (*EVMEndpointObservation_NoResponse) isEVMEndpointObservation_ResponseObservation() {}
 
+func (*EVMEndpointObservation_GetBlockByNumberResponse) isEVMEndpointObservation_ResponseObservation() {
+}
+
+func (*EVMEndpointObservation_SyncingResponse) isEVMEndpointObservation_ResponseObservation() {}
+
+func (*EVMEndpointObservation_PeerCountResponse) isEVMEndpointObservation_ResponseObservation() {}
+
+func (*EVMEndpointObservation_ArchivalProbeResponse) isEVMEndpointObservation_ResponseObservation() {}
+
 // TODO_MVP(@adshmh): Implement a consolidated SanctionObservation message structure that:
 //  1. Contains both SanctionType enum and RecommendedSanction field
 //  2. Can be embedded as a single field within all qos/Response.proto messages
@@ -729,7 +902,7 @@ type EVMChainIDResponse struct {
 
 func (x *EVMChainIDResponse) Reset() {
 	*x = EVMChainIDResponse{}
-	mi := &file_path_qos_evm_proto_msgTypes[5]
+	mi := &file_path_qos_evm_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -741,7 +914,7 @@ func (x *EVMChainIDResponse) String() string {
 func (*EVMChainIDResponse) ProtoMessage() {}
 
 func (x *EVMChainIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_path_qos_evm_proto_msgTypes[5]
+	mi := &file_path_qos_evm_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -754,7 +927,7 @@ func (x *EVMChainIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EVMChainIDResponse.ProtoReflect.Descriptor instead.
 func (*EVMChainIDResponse) Descriptor() ([]byte, []int) {
-	return file_path_qos_evm_proto_rawDescGZIP(), []int{5}
+	return file_path_qos_evm_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *EVMChainIDResponse) GetHttpStatusCode() int32 {
@@ -795,7 +968,7 @@ type EVMBlockNumberResponse struct {
 
 func (x *EVMBlockNumberResponse) Reset() {
 	*x = EVMBlockNumberResponse{}
-	mi := &file_path_qos_evm_proto_msgTypes[6]
+	mi := &file_path_qos_evm_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -807,7 +980,7 @@ func (x *EVMBlockNumberResponse) String() string {
 func (*EVMBlockNumberResponse) ProtoMessage() {}
 
 func (x *EVMBlockNumberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_path_qos_evm_proto_msgTypes[6]
+	mi := &file_path_qos_evm_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -820,7 +993,7 @@ func (x *EVMBlockNumberResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EVMBlockNumberResponse.ProtoReflect.Descriptor instead.
 func (*EVMBlockNumberResponse) Descriptor() ([]byte, []int) {
-	return file_path_qos_evm_proto_rawDescGZIP(), []int{6}
+	return file_path_qos_evm_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *EVMBlockNumberResponse) GetHttpStatusCode() int32 {
@@ -844,6 +1017,138 @@ func (x *EVMBlockNumberResponse) GetResponseValidationError() EVMResponseValidat
 	return EVMResponseValidationError_EVM_RESPONSE_VALIDATION_ERROR_UNSPECIFIED
 }
 
+// EVMSyncingResponse stores the response to an `eth_syncing` request.
+// https://ethereum.org/en/developers/docs/apis/json-rpc/#eth_syncing
+type EVMSyncingResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The HTTP status code received from the endpoint
+	HttpStatusCode int32 `protobuf:"varint,1,opt,name=http_status_code,json=httpStatusCode,proto3" json:"http_status_code,omitempty"`
+	// Whether the endpoint reported it is syncing, i.e. returned a sync status object instead of `false`.
+	IsSyncing bool `protobuf:"varint,2,opt,name=is_syncing,json=isSyncing,proto3" json:"is_syncing,omitempty"`
+	// Why the response failed QoS validation
+	// If not set, the response is considered valid
+	ResponseValidationError *EVMResponseValidationError `protobuf:"varint,3,opt,name=response_validation_error,json=responseValidationError,proto3,enum=path.qos.EVMResponseValidationError,oneof" json:"response_validation_error,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *EVMSyncingResponse) Reset() {
+	*x = EVMSyncingResponse{}
+	mi := &file_path_qos_evm_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EVMSyncingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EVMSyncingResponse) ProtoMessage() {}
+
+func (x *EVMSyncingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_evm_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EVMSyncingResponse.ProtoReflect.Descriptor instead.
+func (*EVMSyncingResponse) Descriptor() ([]byte, []int) {
+	return file_path_qos_evm_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EVMSyncingResponse) GetHttpStatusCode() int32 {
+	if x != nil {
+		return x.HttpStatusCode
+	}
+	return 0
+}
+
+func (x *EVMSyncingResponse) GetIsSyncing() bool {
+	if x != nil {
+		return x.IsSyncing
+	}
+	return false
+}
+
+func (x *EVMSyncingResponse) GetResponseValidationError() EVMResponseValidationError {
+	if x != nil && x.ResponseValidationError != nil {
+		return *x.ResponseValidationError
+	}
+	return EVMResponseValidationError_EVM_RESPONSE_VALIDATION_ERROR_UNSPECIFIED
+}
+
+// EVMPeerCountResponse stores the response to a `net_peerCount` request.
+// https://ethereum.org/en/developers/docs/apis/json-rpc/#net_peercount
+type EVMPeerCountResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The HTTP status code received from the endpoint
+	HttpStatusCode int32 `protobuf:"varint,1,opt,name=http_status_code,json=httpStatusCode,proto3" json:"http_status_code,omitempty"`
+	// The hex-encoded peer count returned in the response, e.g. "0x19"
+	PeerCountResponse string `protobuf:"bytes,2,opt,name=peer_count_response,json=peerCountResponse,proto3" json:"peer_count_response,omitempty"`
+	// Why the response failed QoS validation
+	// If not set, the response is considered valid
+	ResponseValidationError *EVMResponseValidationError `protobuf:"varint,3,opt,name=response_validation_error,json=responseValidationError,proto3,enum=path.qos.EVMResponseValidationError,oneof" json:"response_validation_error,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *EVMPeerCountResponse) Reset() {
+	*x = EVMPeerCountResponse{}
+	mi := &file_path_qos_evm_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EVMPeerCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EVMPeerCountResponse) ProtoMessage() {}
+
+func (x *EVMPeerCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_evm_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EVMPeerCountResponse.ProtoReflect.Descriptor instead.
+func (*EVMPeerCountResponse) Descriptor() ([]byte, []int) {
+	return file_path_qos_evm_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EVMPeerCountResponse) GetHttpStatusCode() int32 {
+	if x != nil {
+		return x.HttpStatusCode
+	}
+	return 0
+}
+
+func (x *EVMPeerCountResponse) GetPeerCountResponse() string {
+	if x != nil {
+		return x.PeerCountResponse
+	}
+	return ""
+}
+
+func (x *EVMPeerCountResponse) GetResponseValidationError() EVMResponseValidationError {
+	if x != nil && x.ResponseValidationError != nil {
+		return *x.ResponseValidationError
+	}
+	return EVMResponseValidationError_EVM_RESPONSE_VALIDATION_ERROR_UNSPECIFIED
+}
+
 // EVMGetBalanceResponse stores the response to an `eth_getBalance` request.
 // https://ethereum.org/en/developers/docs/apis/json-rpc/#eth_getbalance
 type EVMGetBalanceResponse struct {
@@ -865,7 +1170,7 @@ type EVMGetBalanceResponse struct {
 
 func (x *EVMGetBalanceResponse) Reset() {
 	*x = EVMGetBalanceResponse{}
-	mi := &file_path_qos_evm_proto_msgTypes[7]
+	mi := &file_path_qos_evm_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -877,7 +1182,7 @@ func (x *EVMGetBalanceResponse) String() string {
 func (*EVMGetBalanceResponse) ProtoMessage() {}
 
 func (x *EVMGetBalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_path_qos_evm_proto_msgTypes[7]
+	mi := &file_path_qos_evm_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -890,7 +1195,7 @@ func (x *EVMGetBalanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EVMGetBalanceResponse.ProtoReflect.Descriptor instead.
 func (*EVMGetBalanceResponse) Descriptor() ([]byte, []int) {
-	return file_path_qos_evm_proto_rawDescGZIP(), []int{7}
+	return file_path_qos_evm_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *EVMGetBalanceResponse) GetHttpStatusCode() int32 {
@@ -928,6 +1233,168 @@ func (x *EVMGetBalanceResponse) GetResponseValidationError() EVMResponseValidati
 	return EVMResponseValidationError_EVM_RESPONSE_VALIDATION_ERROR_UNSPECIFIED
 }
 
+// EVMArchivalProbeResponse stores the response to an archival probe request.
+// The probe's method is one of `eth_getBalance`, `eth_getCode` or `eth_call`.
+type EVMArchivalProbeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The HTTP status code received from the endpoint
+	HttpStatusCode int32 `protobuf:"varint,1,opt,name=http_status_code,json=httpStatusCode,proto3" json:"http_status_code,omitempty"`
+	// The name of the archival probe, as configured for the service.
+	ProbeName string `protobuf:"bytes,2,opt,name=probe_name,json=probeName,proto3" json:"probe_name,omitempty"`
+	// The historical block number at which the probe was run.
+	BlockNumber string `protobuf:"bytes,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// The value returned in the response, e.g. a balance.
+	// Values longer than a 32-byte hex string, e.g. a contract's code, are replaced by their SHA-256 digest.
+	// Empty if the endpoint returned a JSON-RPC error, e.g. a pruned node missing the historical state.
+	Value string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	// Why the response failed QoS validation
+	// If not set, the response is considered valid
+	ResponseValidationError *EVMResponseValidationError `protobuf:"varint,5,opt,name=response_validation_error,json=responseValidationError,proto3,enum=path.qos.EVMResponseValidationError,oneof" json:"response_validation_error,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *EVMArchivalProbeResponse) Reset() {
+	*x = EVMArchivalProbeResponse{}
+	mi := &file_path_qos_evm_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EVMArchivalProbeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EVMArchivalProbeResponse) ProtoMessage() {}
+
+func (x *EVMArchivalProbeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_evm_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EVMArchivalProbeResponse.ProtoReflect.Descriptor instead.
+func (*EVMArchivalProbeResponse) Descriptor() ([]byte, []int) {
+	return file_path_qos_evm_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EVMArchivalProbeResponse) GetHttpStatusCode() int32 {
+	if x != nil {
+		return x.HttpStatusCode
+	}
+	return 0
+}
+
+func (x *EVMArchivalProbeResponse) GetProbeName() string {
+	if x != nil {
+		return x.ProbeName
+	}
+	return ""
+}
+
+func (x *EVMArchivalProbeResponse) GetBlockNumber() string {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return ""
+}
+
+func (x *EVMArchivalProbeResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *EVMArchivalProbeResponse) GetResponseValidationError() EVMResponseValidationError {
+	if x != nil && x.ResponseValidationError != nil {
+		return *x.ResponseValidationError
+	}
+	return EVMResponseValidationError_EVM_RESPONSE_VALIDATION_ERROR_UNSPECIFIED
+}
+
+// EVMGetBlockByNumberResponse stores the response to an `eth_getBlockByNumber` request.
+// https://ethereum.org/en/developers/docs/apis/json-rpc/#eth_getblockbynumber
+type EVMGetBlockByNumberResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The HTTP status code received from the endpoint
+	HttpStatusCode int32 `protobuf:"varint,1,opt,name=http_status_code,json=httpStatusCode,proto3" json:"http_status_code,omitempty"`
+	// The block number, from the request params: a hex block number or a block tag, e.g. "latest".
+	BlockNumber string `protobuf:"bytes,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// The hash of the block returned in the response.
+	// Empty if the endpoint did not return the block.
+	BlockHash string `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// Why the response failed QoS validation
+	// If not set, the response is considered valid
+	ResponseValidationError *EVMResponseValidationError `protobuf:"varint,4,opt,name=response_validation_error,json=responseValidationError,proto3,enum=path.qos.EVMResponseValidationError,oneof" json:"response_validation_error,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *EVMGetBlockByNumberResponse) Reset() {
+	*x = EVMGetBlockByNumberResponse{}
+	mi := &file_path_qos_evm_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EVMGetBlockByNumberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EVMGetBlockByNumberResponse) ProtoMessage() {}
+
+func (x *EVMGetBlockByNumberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_evm_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EVMGetBlockByNumberResponse.ProtoReflect.Descriptor instead.
+func (*EVMGetBlockByNumberResponse) Descriptor() ([]byte, []int) {
+	return file_path_qos_evm_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EVMGetBlockByNumberResponse) GetHttpStatusCode() int32 {
+	if x != nil {
+		return x.HttpStatusCode
+	}
+	return 0
+}
+
+func (x *EVMGetBlockByNumberResponse) GetBlockNumber() string {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return ""
+}
+
+func (x *EVMGetBlockByNumberResponse) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *EVMGetBlockByNumberResponse) GetResponseValidationError() EVMResponseValidationError {
+	if x != nil && x.ResponseValidationError != nil {
+		return *x.ResponseValidationError
+	}
+	return EVMResponseValidationError_EVM_RESPONSE_VALIDATION_ERROR_UNSPECIFIED
+}
+
 // EVMUnrecognizedResponse handles requests with unrecognized/unvalidated response methods for QoS endpoint selection.
 // - Example: eth_call response contents used for endpoint validation (as of PR #72)
 // - Sanctions still apply to endpoints returning invalid responses (e.g. unparsable JSONRPC)
@@ -946,7 +1413,7 @@ type EVMUnrecognizedResponse struct {
 
 func (x *EVMUnrecognizedResponse) Reset() {
 	*x = EVMUnrecognizedResponse{}
-	mi := &file_path_qos_evm_proto_msgTypes[8]
+	mi := &file_path_qos_evm_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -958,7 +1425,7 @@ func (x *EVMUnrecognizedResponse) String() string {
 func (*EVMUnrecognizedResponse) ProtoMessage() {}
 
 func (x *EVMUnrecognizedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_path_qos_evm_proto_msgTypes[8]
+	mi := &file_path_qos_evm_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -971,7 +1438,7 @@ func (x *EVMUnrecognizedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EVMUnrecognizedResponse.ProtoReflect.Descriptor instead.
 func (*EVMUnrecognizedResponse) Descriptor() ([]byte, []int) {
-	return file_path_qos_evm_proto_rawDescGZIP(), []int{8}
+	return file_path_qos_evm_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *EVMUnrecognizedResponse) GetHttpStatusCode() int32 {
@@ -1009,7 +1476,7 @@ type EVMEmptyResponse struct {
 
 func (x *EVMEmptyResponse) Reset() {
 	*x = EVMEmptyResponse{}
-	mi := &file_path_qos_evm_proto_msgTypes[9]
+	mi := &file_path_qos_evm_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1021,7 +1488,7 @@ func (x *EVMEmptyResponse) String() string {
 func (*EVMEmptyResponse) ProtoMessage() {}
 
 func (x *EVMEmptyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_path_qos_evm_proto_msgTypes[9]
+	mi := &file_path_qos_evm_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1034,7 +1501,7 @@ func (x *EVMEmptyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EVMEmptyResponse.ProtoReflect.Descriptor instead.
 func (*EVMEmptyResponse) Descriptor() ([]byte, []int) {
-	return file_path_qos_evm_proto_rawDescGZIP(), []int{9}
+	return file_path_qos_evm_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *EVMEmptyResponse) GetHttpStatusCode() int32 {
@@ -1065,7 +1532,7 @@ type EVMNoResponse struct {
 
 func (x *EVMNoResponse) Reset() {
 	*x = EVMNoResponse{}
-	mi := &file_path_qos_evm_proto_msgTypes[10]
+	mi := &file_path_qos_evm_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1077,7 +1544,7 @@ func (x *EVMNoResponse) String() string {
 func (*EVMNoResponse) ProtoMessage() {}
 
 func (x *EVMNoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_path_qos_evm_proto_msgTypes[10]
+	mi := &file_path_qos_evm_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1090,7 +1557,7 @@ func (x *EVMNoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EVMNoResponse.ProtoReflect.Descriptor instead.
 func (*EVMNoResponse) Descriptor() ([]byte, []int) {
-	return file_path_qos_evm_proto_rawDescGZIP(), []int{10}
+	return file_path_qos_evm_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *EVMNoResponse) GetHttpStatusCode() int32 {
@@ -1111,7 +1578,7 @@ var File_path_qos_evm_proto protoreflect.FileDescriptor
 
 const file_path_qos_evm_proto_rawDesc = "" +
 	"\n" +
-	"\x12path/qos/evm.proto\x12\bpath.qos\x1a\x16path/qos/jsonrpc.proto\x1a\x1dpath/qos/request_origin.proto\x1a*path/qos/endpoint_selection_metadata.proto\x1a\x1cpath/qos/request_error.proto\x1a\x1cpath/metadata/metadata.proto\"\xfb\x05\n" +
+	"\x12path/qos/evm.proto\x12\bpath.qos\x1a\x16path/qos/jsonrpc.proto\x1a\x1dpath/qos/request_origin.proto\x1a*path/qos/endpoint_selection_metadata.proto\x1a\x1cpath/qos/request_error.proto\x1a\x1dpath/qos/consensus_read.proto\x1a\x1cpath/qos/service_stall.proto\x1a\x1cpath/metadata/metadata.proto\"\x93\b\n" +
 	"\x16EVMRequestObservations\x12\x19\n" +
 	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x1d\n" +
 	"\n" +
@@ -1123,9 +1590,20 @@ const file_path_qos_evm_proto_rawDesc = "" +
 	"\x14request_observations\x18\n" +
 	" \x03(\v2\x1f.path.qos.EVMRequestObservationR\x13requestObservations\x12c\n" +
 	"\x1bendpoint_selection_metadata\x18\t \x01(\v2#.path.qos.EndpointSelectionMetadataR\x19endpointSelectionMetadata\x12@\n" +
-	"\rrequest_error\x18\v \x01(\v2\x16.path.qos.RequestErrorH\x01R\frequestError\x88\x01\x01B\x1c\n" +
+	"\rrequest_error\x18\v \x01(\v2\x16.path.qos.RequestErrorH\x01R\frequestError\x88\x01\x01\x12N\n" +
+	"\x0econsensus_read\x18\f \x01(\v2\".path.qos.ConsensusReadObservationH\x02R\rconsensusRead\x88\x01\x01\x12K\n" +
+	"\rservice_stall\x18\r \x01(\v2!.path.qos.ServiceStallObservationH\x03R\fserviceStall\x88\x01\x01\x12E\n" +
+	"\n" +
+	"fork_check\x18\x0e \x01(\v2!.path.qos.EVMForkCheckObservationH\x04R\tforkCheck\x88\x01\x01B\x1c\n" +
 	"\x1arequest_validation_failureB\x10\n" +
-	"\x0e_request_errorJ\x04\b\x05\x10\x06J\x04\b\x06\x10\aR\x0fjsonrpc_requestR\x15endpoint_observations\"\xb1\x01\n" +
+	"\x0e_request_errorB\x11\n" +
+	"\x0f_consensus_readB\x10\n" +
+	"\x0e_service_stallB\r\n" +
+	"\v_fork_checkJ\x04\b\x05\x10\x06J\x04\b\x06\x10\aR\x0fjsonrpc_requestR\x15endpoint_observations\"\xb6\x01\n" +
+	"\x17EVMForkCheckObservation\x12!\n" +
+	"\fblock_number\x18\x01 \x01(\tR\vblockNumber\x12.\n" +
+	"\x13expected_block_hash\x18\x02 \x01(\tR\x11expectedBlockHash\x120\n" +
+	"\x14num_forked_endpoints\x18\x03 \x01(\rR\x12numForkedEndpointsJ\x04\b\x04\x10\x05R\x10last_reorg_depth\"\xb1\x01\n" +
 	"\x15EVMRequestObservation\x12A\n" +
 	"\x0fjsonrpc_request\x18\x05 \x01(\v2\x18.path.qos.JsonRpcRequestR\x0ejsonrpcRequest\x12U\n" +
 	"\x15endpoint_observations\x18\x06 \x03(\v2 .path.qos.EVMEndpointObservationR\x14endpointObservations\"\xce\x01\n" +
@@ -1138,7 +1616,7 @@ const file_path_qos_evm_proto_rawDesc = "" +
 	"\x10http_status_code\x18\x01 \x01(\x05R\x0ehttpStatusCode\x12N\n" +
 	"\x10validation_error\x18\x02 \x01(\x0e2#.path.qos.EVMRequestValidationErrorR\x0fvalidationError\x12(\n" +
 	"\rerror_details\x18\x03 \x01(\tH\x00R\ferrorDetails\x88\x01\x01B\x10\n" +
-	"\x0e_error_details\"\x9d\x05\n" +
+	"\x0e_error_details\"\x81\b\n" +
 	"\x16EVMEndpointObservation\x12#\n" +
 	"\rendpoint_addr\x18\x01 \x01(\tR\fendpointAddr\x12J\n" +
 	"\x11chain_id_response\x18\x02 \x01(\v2\x1c.path.qos.EVMChainIDResponseH\x00R\x0fchainIdResponse\x12V\n" +
@@ -1147,7 +1625,12 @@ const file_path_qos_evm_proto_rawDesc = "" +
 	"\x15unrecognized_response\x18\x05 \x01(\v2!.path.qos.EVMUnrecognizedResponseH\x00R\x14unrecognizedResponse\x12C\n" +
 	"\x0eempty_response\x18\x06 \x01(\v2\x1a.path.qos.EVMEmptyResponseH\x00R\remptyResponse\x12:\n" +
 	"\vno_response\x18\a \x01(\v2\x17.path.qos.EVMNoResponseH\x00R\n" +
-	"noResponse\x12V\n" +
+	"noResponse\x12g\n" +
+	"\x1cget_block_by_number_response\x18\t \x01(\v2%.path.qos.EVMGetBlockByNumberResponseH\x00R\x18getBlockByNumberResponse\x12I\n" +
+	"\x10syncing_response\x18\n" +
+	" \x01(\v2\x1c.path.qos.EVMSyncingResponseH\x00R\x0fsyncingResponse\x12P\n" +
+	"\x13peer_count_response\x18\v \x01(\v2\x1e.path.qos.EVMPeerCountResponseH\x00R\x11peerCountResponse\x12\\\n" +
+	"\x17archival_probe_response\x18\f \x01(\v2\".path.qos.EVMArchivalProbeResponseH\x00R\x15archivalProbeResponse\x12V\n" +
 	"\x17parsed_jsonrpc_response\x18\b \x01(\v2\x19.path.qos.JsonRpcResponseH\x01R\x15parsedJsonrpcResponse\x88\x01\x01B\x16\n" +
 	"\x14response_observationB\x1a\n" +
 	"\x18_parsed_jsonrpc_response\"\x8d\x02\n" +
@@ -1160,6 +1643,17 @@ const file_path_qos_evm_proto_rawDesc = "" +
 	"\x10http_status_code\x18\x01 \x01(\x05R\x0ehttpStatusCode\x122\n" +
 	"\x15block_number_response\x18\x02 \x01(\tR\x13blockNumberResponse\x12\x80\x01\n" +
 	"\x19response_validation_error\x18\x03 \x01(\x0e2$.path.qos.EVMResponseValidationErrorB\x19\x8a\xb5\x18\x15Validity failure typeH\x00R\x17responseValidationError\x88\x01\x01B\x1c\n" +
+	"\x1a_response_validation_error\"\xfe\x01\n" +
+	"\x12EVMSyncingResponse\x12(\n" +
+	"\x10http_status_code\x18\x01 \x01(\x05R\x0ehttpStatusCode\x12\x1d\n" +
+	"\n" +
+	"is_syncing\x18\x02 \x01(\bR\tisSyncing\x12\x80\x01\n" +
+	"\x19response_validation_error\x18\x03 \x01(\x0e2$.path.qos.EVMResponseValidationErrorB\x19\x8a\xb5\x18\x15Validity failure typeH\x00R\x17responseValidationError\x88\x01\x01B\x1c\n" +
+	"\x1a_response_validation_error\"\x91\x02\n" +
+	"\x14EVMPeerCountResponse\x12(\n" +
+	"\x10http_status_code\x18\x01 \x01(\x05R\x0ehttpStatusCode\x12.\n" +
+	"\x13peer_count_response\x18\x02 \x01(\tR\x11peerCountResponse\x12\x80\x01\n" +
+	"\x19response_validation_error\x18\x03 \x01(\x0e2$.path.qos.EVMResponseValidationErrorB\x19\x8a\xb5\x18\x15Validity failure typeH\x00R\x17responseValidationError\x88\x01\x01B\x1c\n" +
 	"\x1a_response_validation_error\"\xca\x02\n" +
 	"\x15EVMGetBalanceResponse\x12(\n" +
 	"\x10http_status_code\x18\x01 \x01(\x05R\x0ehttpStatusCode\x12)\n" +
@@ -1167,6 +1661,21 @@ const file_path_qos_evm_proto_rawDesc = "" +
 	"\fblock_number\x18\x03 \x01(\tR\vblockNumber\x12\x18\n" +
 	"\abalance\x18\x04 \x01(\tR\abalance\x12\x80\x01\n" +
 	"\x19response_validation_error\x18\x05 \x01(\x0e2$.path.qos.EVMResponseValidationErrorB\x19\x8a\xb5\x18\x15Validity failure typeH\x00R\x17responseValidationError\x88\x01\x01B\x1c\n" +
+	"\x1a_response_validation_error\"\xbd\x02\n" +
+	"\x18EVMArchivalProbeResponse\x12(\n" +
+	"\x10http_status_code\x18\x01 \x01(\x05R\x0ehttpStatusCode\x12\x1d\n" +
+	"\n" +
+	"probe_name\x18\x02 \x01(\tR\tprobeName\x12!\n" +
+	"\fblock_number\x18\x03 \x01(\tR\vblockNumber\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\tR\x05value\x12\x80\x01\n" +
+	"\x19response_validation_error\x18\x05 \x01(\x0e2$.path.qos.EVMResponseValidationErrorB\x19\x8a\xb5\x18\x15Validity failure typeH\x00R\x17responseValidationError\x88\x01\x01B\x1c\n" +
+	"\x1a_response_validation_error\"\xac\x02\n" +
+	"\x1bEVMGetBlockByNumberResponse\x12(\n" +
+	"\x10http_status_code\x18\x01 \x01(\x05R\x0ehttpStatusCode\x12!\n" +
+	"\fblock_number\x18\x02 \x01(\tR\vblockNumber\x12\x1d\n" +
+	"\n" +
+	"block_hash\x18\x03 \x01(\tR\tblockHash\x12\x82\x01\n" +
+	"\x19response_validation_error\x18\x04 \x01(\x0e2$.path.qos.EVMResponseValidationErrorB\x1b\x8a\xb5\x18\x17Validation failure typeH\x00R\x17responseValidationError\x88\x01\x01B\x1c\n" +
 	"\x1a_response_validation_error\"\xaa\x02\n" +
 	"\x17EVMUnrecognizedResponse\x12(\n" +
 	"\x10http_status_code\x18\x01 \x01(\x05R\x0ehttpStatusCode\x12D\n" +
@@ -1182,12 +1691,13 @@ const file_path_qos_evm_proto_rawDesc = "" +
 	"\x19EVMRequestValidationError\x12,\n" +
 	"(EVM_REQUEST_VALIDATION_ERROR_UNSPECIFIED\x10\x00\x127\n" +
 	"3EVM_REQUEST_VALIDATION_ERROR_HTTP_BODY_READ_FAILURE\x10\x01\x12=\n" +
-	"9EVM_REQUEST_VALIDATION_ERROR_REQUEST_UNMARSHALING_FAILURE\x10\x02*\xd0\x01\n" +
+	"9EVM_REQUEST_VALIDATION_ERROR_REQUEST_UNMARSHALING_FAILURE\x10\x02*\x8a\x02\n" +
 	"\x1aEVMResponseValidationError\x12-\n" +
 	")EVM_RESPONSE_VALIDATION_ERROR_UNSPECIFIED\x10\x00\x12'\n" +
 	"#EVM_RESPONSE_VALIDATION_ERROR_EMPTY\x10\x01\x12+\n" +
 	"'EVM_RESPONSE_VALIDATION_ERROR_UNMARSHAL\x10\x02\x12-\n" +
-	")EVM_RESPONSE_VALIDATION_ERROR_NO_RESPONSE\x10\x03B0Z.github.com/buildwithgrove/path/observation/qosb\x06proto3"
+	")EVM_RESPONSE_VALIDATION_ERROR_NO_RESPONSE\x10\x03\x128\n" +
+	"4EVM_RESPONSE_VALIDATION_ERROR_CONSENSUS_DISAGREEMENT\x10\x04B0Z.github.com/buildwithgrove/path/observation/qosb\x06proto3"
 
 var (
 	file_path_qos_evm_proto_rawDescOnce sync.Once
@@ -1202,57 +1712,75 @@ func file_path_qos_evm_proto_rawDescGZIP() []byte {
 }
 
 var file_path_qos_evm_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_path_qos_evm_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_path_qos_evm_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_path_qos_evm_proto_goTypes = []any{
 	(EVMRequestValidationError)(0),        // 0: path.qos.EVMRequestValidationError
 	(EVMResponseValidationError)(0),       // 1: path.qos.EVMResponseValidationError
 	(*EVMRequestObservations)(nil),        // 2: path.qos.EVMRequestObservations
-	(*EVMRequestObservation)(nil),         // 3: path.qos.EVMRequestObservation
-	(*EVMHTTPBodyReadFailure)(nil),        // 4: path.qos.EVMHTTPBodyReadFailure
-	(*EVMRequestUnmarshalingFailure)(nil), // 5: path.qos.EVMRequestUnmarshalingFailure
-	(*EVMEndpointObservation)(nil),        // 6: path.qos.EVMEndpointObservation
-	(*EVMChainIDResponse)(nil),            // 7: path.qos.EVMChainIDResponse
-	(*EVMBlockNumberResponse)(nil),        // 8: path.qos.EVMBlockNumberResponse
-	(*EVMGetBalanceResponse)(nil),         // 9: path.qos.EVMGetBalanceResponse
-	(*EVMUnrecognizedResponse)(nil),       // 10: path.qos.EVMUnrecognizedResponse
-	(*EVMEmptyResponse)(nil),              // 11: path.qos.EVMEmptyResponse
-	(*EVMNoResponse)(nil),                 // 12: path.qos.EVMNoResponse
-	(RequestOrigin)(0),                    // 13: path.qos.RequestOrigin
-	(*EndpointSelectionMetadata)(nil),     // 14: path.qos.EndpointSelectionMetadata
-	(*RequestError)(nil),                  // 15: path.qos.RequestError
-	(*JsonRpcRequest)(nil),                // 16: path.qos.JsonRpcRequest
-	(*JsonRpcResponse)(nil),               // 17: path.qos.JsonRpcResponse
+	(*EVMForkCheckObservation)(nil),       // 3: path.qos.EVMForkCheckObservation
+	(*EVMRequestObservation)(nil),         // 4: path.qos.EVMRequestObservation
+	(*EVMHTTPBodyReadFailure)(nil),        // 5: path.qos.EVMHTTPBodyReadFailure
+	(*EVMRequestUnmarshalingFailure)(nil), // 6: path.qos.EVMRequestUnmarshalingFailure
+	(*EVMEndpointObservation)(nil),        // 7: path.qos.EVMEndpointObservation
+	(*EVMChainIDResponse)(nil),            // 8: path.qos.EVMChainIDResponse
+	(*EVMBlockNumberResponse)(nil),        // 9: path.qos.EVMBlockNumberResponse
+	(*EVMSyncingResponse)(nil),            // 10: path.qos.EVMSyncingResponse
+	(*EVMPeerCountResponse)(nil),          // 11: path.qos.EVMPeerCountResponse
+	(*EVMGetBalanceResponse)(nil),         // 12: path.qos.EVMGetBalanceResponse
+	(*EVMArchivalProbeResponse)(nil),      // 13: path.qos.EVMArchivalProbeResponse
+	(*EVMGetBlockByNumberResponse)(nil),   // 14: path.qos.EVMGetBlockByNumberResponse
+	(*EVMUnrecognizedResponse)(nil),       // 15: path.qos.EVMUnrecognizedResponse
+	(*EVMEmptyResponse)(nil),              // 16: path.qos.EVMEmptyResponse
+	(*EVMNoResponse)(nil),                 // 17: path.qos.EVMNoResponse
+	(RequestOrigin)(0),                    // 18: path.qos.RequestOrigin
+	(*EndpointSelectionMetadata)(nil),     // 19: path.qos.EndpointSelectionMetadata
+	(*RequestError)(nil),                  // 20: path.qos.RequestError
+	(*ConsensusReadObservation)(nil),      // 21: path.qos.ConsensusReadObservation
+	(*ServiceStallObservation)(nil),       // 22: path.qos.ServiceStallObservation
+	(*JsonRpcRequest)(nil),                // 23: path.qos.JsonRpcRequest
+	(*JsonRpcResponse)(nil),               // 24: path.qos.JsonRpcResponse
 }
 var file_path_qos_evm_proto_depIdxs = []int32{
-	13, // 0: path.qos.EVMRequestObservations.request_origin:type_name -> path.qos.RequestOrigin
-	4,  // 1: path.qos.EVMRequestObservations.evm_http_body_read_failure:type_name -> path.qos.EVMHTTPBodyReadFailure
-	5,  // 2: path.qos.EVMRequestObservations.evm_request_unmarshaling_failure:type_name -> path.qos.EVMRequestUnmarshalingFailure
-	3,  // 3: path.qos.EVMRequestObservations.request_observations:type_name -> path.qos.EVMRequestObservation
-	14, // 4: path.qos.EVMRequestObservations.endpoint_selection_metadata:type_name -> path.qos.EndpointSelectionMetadata
-	15, // 5: path.qos.EVMRequestObservations.request_error:type_name -> path.qos.RequestError
-	16, // 6: path.qos.EVMRequestObservation.jsonrpc_request:type_name -> path.qos.JsonRpcRequest
-	6,  // 7: path.qos.EVMRequestObservation.endpoint_observations:type_name -> path.qos.EVMEndpointObservation
-	0,  // 8: path.qos.EVMHTTPBodyReadFailure.validation_error:type_name -> path.qos.EVMRequestValidationError
-	0,  // 9: path.qos.EVMRequestUnmarshalingFailure.validation_error:type_name -> path.qos.EVMRequestValidationError
-	7,  // 10: path.qos.EVMEndpointObservation.chain_id_response:type_name -> path.qos.EVMChainIDResponse
-	8,  // 11: path.qos.EVMEndpointObservation.block_number_response:type_name -> path.qos.EVMBlockNumberResponse
-	9,  // 12: path.qos.EVMEndpointObservation.get_balance_response:type_name -> path.qos.EVMGetBalanceResponse
-	10, // 13: path.qos.EVMEndpointObservation.unrecognized_response:type_name -> path.qos.EVMUnrecognizedResponse
-	11, // 14: path.qos.EVMEndpointObservation.empty_response:type_name -> path.qos.EVMEmptyResponse
-	12, // 15: path.qos.EVMEndpointObservation.no_response:type_name -> path.qos.EVMNoResponse
-	17, // 16: path.qos.EVMEndpointObservation.parsed_jsonrpc_response:type_name -> path.qos.JsonRpcResponse
-	1,  // 17: path.qos.EVMChainIDResponse.response_validation_error:type_name -> path.qos.EVMResponseValidationError
-	1,  // 18: path.qos.EVMBlockNumberResponse.response_validation_error:type_name -> path.qos.EVMResponseValidationError
-	1,  // 19: path.qos.EVMGetBalanceResponse.response_validation_error:type_name -> path.qos.EVMResponseValidationError
-	17, // 20: path.qos.EVMUnrecognizedResponse.jsonrpc_response:type_name -> path.qos.JsonRpcResponse
-	1,  // 21: path.qos.EVMUnrecognizedResponse.response_validation_error:type_name -> path.qos.EVMResponseValidationError
-	1,  // 22: path.qos.EVMEmptyResponse.response_validation_error:type_name -> path.qos.EVMResponseValidationError
-	1,  // 23: path.qos.EVMNoResponse.response_validation_error:type_name -> path.qos.EVMResponseValidationError
-	24, // [24:24] is the sub-list for method output_type
-	24, // [24:24] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	18, // 0: path.qos.EVMRequestObservations.request_origin:type_name -> path.qos.RequestOrigin
+	5,  // 1: path.qos.EVMRequestObservations.evm_http_body_read_failure:type_name -> path.qos.EVMHTTPBodyReadFailure
+	6,  // 2: path.qos.EVMRequestObservations.evm_request_unmarshaling_failure:type_name -> path.qos.EVMRequestUnmarshalingFailure
+	4,  // 3: path.qos.EVMRequestObservations.request_observations:type_name -> path.qos.EVMRequestObservation
+	19, // 4: path.qos.EVMRequestObservations.endpoint_selection_metadata:type_name -> path.qos.EndpointSelectionMetadata
+	20, // 5: path.qos.EVMRequestObservations.request_error:type_name -> path.qos.RequestError
+	21, // 6: path.qos.EVMRequestObservations.consensus_read:type_name -> path.qos.ConsensusReadObservation
+	22, // 7: path.qos.EVMRequestObservations.service_stall:type_name -> path.qos.ServiceStallObservation
+	3,  // 8: path.qos.EVMRequestObservations.fork_check:type_name -> path.qos.EVMForkCheckObservation
+	23, // 9: path.qos.EVMRequestObservation.jsonrpc_request:type_name -> path.qos.JsonRpcRequest
+	7,  // 10: path.qos.EVMRequestObservation.endpoint_observations:type_name -> path.qos.EVMEndpointObservation
+	0,  // 11: path.qos.EVMHTTPBodyReadFailure.validation_error:type_name -> path.qos.EVMRequestValidationError
+	0,  // 12: path.qos.EVMRequestUnmarshalingFailure.validation_error:type_name -> path.qos.EVMRequestValidationError
+	8,  // 13: path.qos.EVMEndpointObservation.chain_id_response:type_name -> path.qos.EVMChainIDResponse
+	9,  // 14: path.qos.EVMEndpointObservation.block_number_response:type_name -> path.qos.EVMBlockNumberResponse
+	12, // 15: path.qos.EVMEndpointObservation.get_balance_response:type_name -> path.qos.EVMGetBalanceResponse
+	15, // 16: path.qos.EVMEndpointObservation.unrecognized_response:type_name -> path.qos.EVMUnrecognizedResponse
+	16, // 17: path.qos.EVMEndpointObservation.empty_response:type_name -> path.qos.EVMEmptyResponse
+	17, // 18: path.qos.EVMEndpointObservation.no_response:type_name -> path.qos.EVMNoResponse
+	14, // 19: path.qos.EVMEndpointObservation.get_block_by_number_response:type_name -> path.qos.EVMGetBlockByNumberResponse
+	10, // 20: path.qos.EVMEndpointObservation.syncing_response:type_name -> path.qos.EVMSyncingResponse
+	11, // 21: path.qos.EVMEndpointObservation.peer_count_response:type_name -> path.qos.EVMPeerCountResponse
+	13, // 22: path.qos.EVMEndpointObservation.archival_probe_response:type_name -> path.qos.EVMArchivalProbeResponse
+	24, // 23: path.qos.EVMEndpointObservation.parsed_jsonrpc_response:type_name -> path.qos.JsonRpcResponse
+	1,  // 24: path.qos.EVMChainIDResponse.response_validation_error:type_name -> path.qos.EVMResponseValidationError
+	1,  // 25: path.qos.EVMBlockNumberResponse.response_validation_error:type_name -> path.qos.EVMResponseValidationError
+	1,  // 26: path.qos.EVMSyncingResponse.response_validation_error:type_name -> path.qos.EVMResponseValidationError
+	1,  // 27: path.qos.EVMPeerCountResponse.response_validation_error:type_name -> path.qos.EVMResponseValidationError
+	1,  // 28: path.qos.EVMGetBalanceResponse.response_validation_error:type_name -> path.qos.EVMResponseValidationError
+	1,  // 29: path.qos.EVMArchivalProbeResponse.response_validation_error:type_name -> path.qos.EVMResponseValidationError
+	1,  // 30: path.qos.EVMGetBlockByNumberResponse.response_validation_error:type_name -> path.qos.EVMResponseValidationError
+	24, // 31: path.qos.EVMUnrecognizedResponse.jsonrpc_response:type_name -> path.qos.JsonRpcResponse
+	1,  // 32: path.qos.EVMUnrecognizedResponse.response_validation_error:type_name -> path.qos.EVMResponseValidationError
+	1,  // 33: path.qos.EVMEmptyResponse.response_validation_error:type_name -> path.qos.EVMResponseValidationError
+	1,  // 34: path.qos.EVMNoResponse.response_validation_error:type_name -> path.qos.EVMResponseValidationError
+	35, // [35:35] is the sub-list for method output_type
+	35, // [35:35] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_path_qos_evm_proto_init() }
@@ -1264,31 +1792,41 @@ func file_path_qos_evm_proto_init() {
 	file_path_qos_request_origin_proto_init()
 	file_path_qos_endpoint_selection_metadata_proto_init()
 	file_path_qos_request_error_proto_init()
+	file_path_qos_consensus_read_proto_init()
+	file_path_qos_service_stall_proto_init()
 	file_path_qos_evm_proto_msgTypes[0].OneofWrappers = []any{
 		(*EVMRequestObservations_EvmHttpBodyReadFailure)(nil),
 		(*EVMRequestObservations_EvmRequestUnmarshalingFailure)(nil),
 	}
-	file_path_qos_evm_proto_msgTypes[2].OneofWrappers = []any{}
 	file_path_qos_evm_proto_msgTypes[3].OneofWrappers = []any{}
-	file_path_qos_evm_proto_msgTypes[4].OneofWrappers = []any{
+	file_path_qos_evm_proto_msgTypes[4].OneofWrappers = []any{}
+	file_path_qos_evm_proto_msgTypes[5].OneofWrappers = []any{
 		(*EVMEndpointObservation_ChainIdResponse)(nil),
 		(*EVMEndpointObservation_BlockNumberResponse)(nil),
 		(*EVMEndpointObservation_GetBalanceResponse)(nil),
 		(*EVMEndpointObservation_UnrecognizedResponse)(nil),
 		(*EVMEndpointObservation_EmptyResponse)(nil),
 		(*EVMEndpointObservation_NoResponse)(nil),
+		(*EVMEndpointObservation_GetBlockByNumberResponse)(nil),
+		(*EVMEndpointObservation_SyncingResponse)(nil),
+		(*EVMEndpointObservation_PeerCountResponse)(nil),
+		(*EVMEndpointObservation_ArchivalProbeResponse)(nil),
 	}
-	file_path_qos_evm_proto_msgTypes[5].OneofWrappers = []any{}
 	file_path_qos_evm_proto_msgTypes[6].OneofWrappers = []any{}
 	file_path_qos_evm_proto_msgTypes[7].OneofWrappers = []any{}
 	file_path_qos_evm_proto_msgTypes[8].OneofWrappers = []any{}
+	file_path_qos_evm_proto_msgTypes[9].OneofWrappers = []any{}
+	file_path_qos_evm_proto_msgTypes[10].OneofWrappers = []any{}
+	file_path_qos_evm_proto_msgTypes[11].OneofWrappers = []any{}
+	file_path_qos_evm_proto_msgTypes[12].OneofWrappers = []any{}
+	file_path_qos_evm_proto_msgTypes[13].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_path_qos_evm_proto_rawDesc), len(file_path_qos_evm_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   11,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -35,12 +35,16 @@ type evmResponseInterpreter interface {
 // 3. Add a new case in the getEVMResponseInterpreter function to recognize the type
 // Example: To support a new eth_getBalance response, implement all three steps above
 var responseInterpreters = map[string]evmResponseInterpreter{
-	"chain_id":     &chainIDEVMResponseInterpreter{},
-	"block_number": &blockNumberEVMResponseInterpreter{},
-	"get_balance":  &getBalanceEVMResponseInterpreter{},
-	"unrecognized": &unrecognizedEVMResponseInterpreter{},
-	"empty":        &emptyEVMResponseInterpreter{},
-	"no_response":  &noEVMResponseInterpreter{},
+	"chain_id":            &chainIDEVMResponseInterpreter{},
+	"block_number":        &blockNumberEVMResponseInterpreter{},
+	"get_balance":         &getBalanceEVMResponseInterpreter{},
+	"get_block_by_number": &getBlockByNumberEVMResponseInterpreter{},
+	"syncing":             &syncingEVMResponseInterpreter{},
+	"peer_count":          &peerCountEVMResponseInterpreter{},
+	"archival_probe":      &archivalProbeEVMResponseInterpreter{},
+	"unrecognized":        &unrecognizedEVMResponseInterpreter{},
+	"empty":               &emptyEVMResponseInterpreter{},
+	"no_response":         &noEVMResponseInterpreter{},
 }
 
 // getEVMResponseInterpreter returns the appropriate interpreter for a given observation type.
@@ -69,6 +73,22 @@ func getEVMResponseInterpreter(obs *EVMEndpointObservation) (evmResponseInterpre
 	case obs.GetGetBalanceResponse() != nil:
 		return responseInterpreters["get_balance"], nil
 
+	// eth_getBlockByNumber (used for fork checks)
+	case obs.GetGetBlockByNumberResponse() != nil:
+		return responseInterpreters["get_block_by_number"], nil
+
+	// eth_syncing
+	case obs.GetSyncingResponse() != nil:
+		return responseInterpreters["syncing"], nil
+
+	// net_peerCount
+	case obs.GetPeerCountResponse() != nil:
+		return responseInterpreters["peer_count"], nil
+
+	// archival probe, e.g. eth_getCode at a historical block
+	case obs.GetArchivalProbeResponse() != nil:
+		return responseInterpreters["archival_probe"], nil
+
 	// unrecognized response
 	case obs.GetUnrecognizedResponse() != nil:
 		return responseInterpreters["unrecognized"], nil
@@ -146,6 +166,86 @@ func (i *getBalanceEVMResponseInterpreter) extractValidityStatus(obs *EVMEndpoin
 	return int(response.GetHttpStatusCode()), nil
 }
 
+// getBlockByNumberEVMResponseInterpreter interprets eth_getBlockByNumber response observations.
+// It implements the evmResponseInterpreter interface to translate proto-generated
+// getBlockByNumber response types into standardized status codes and error types.
+type getBlockByNumberEVMResponseInterpreter struct{}
+
+// extractValidityStatus extracts status information from getBlockByNumber response observations.
+// It interprets the getBlockByNumber response-specific proto type and translates it into
+// standardized HTTP status codes and error types for the rest of the system.
+func (i *getBlockByNumberEVMResponseInterpreter) extractValidityStatus(obs *EVMEndpointObservation) (int, *EVMResponseValidationError) {
+	response := obs.GetGetBlockByNumberResponse()
+	validationErr := response.GetResponseValidationError()
+
+	if validationErr != 0 {
+		errType := EVMResponseValidationError(validationErr)
+		return int(response.GetHttpStatusCode()), &errType
+	}
+
+	return int(response.GetHttpStatusCode()), nil
+}
+
+// syncingEVMResponseInterpreter interprets eth_syncing response observations.
+// It implements the evmResponseInterpreter interface to translate proto-generated
+// syncing response types into standardized status codes and error types.
+type syncingEVMResponseInterpreter struct{}
+
+// extractValidityStatus extracts status information from syncing response observations.
+// It interprets the syncing response-specific proto type and translates it into
+// standardized HTTP status codes and error types for the rest of the system.
+func (i *syncingEVMResponseInterpreter) extractValidityStatus(obs *EVMEndpointObservation) (int, *EVMResponseValidationError) {
+	response := obs.GetSyncingResponse()
+	validationErr := response.GetResponseValidationError()
+
+	if validationErr != 0 {
+		errType := EVMResponseValidationError(validationErr)
+		return int(response.GetHttpStatusCode()), &errType
+	}
+
+	return int(response.GetHttpStatusCode()), nil
+}
+
+// peerCountEVMResponseInterpreter interprets net_peerCount response observations.
+// It implements the evmResponseInterpreter interface to translate proto-generated
+// peerCount response types into standardized status codes and error types.
+type peerCountEVMResponseInterpreter struct{}
+
+// extractValidityStatus extracts status information from peerCount response observations.
+// It interprets the peerCount response-specific proto type and translates it into
+// standardized HTTP status codes and error types for the rest of the system.
+func (i *peerCountEVMResponseInterpreter) extractValidityStatus(obs *EVMEndpointObservation) (int, *EVMResponseValidationError) {
+	response := obs.GetPeerCountResponse()
+	validationErr := response.GetResponseValidationError()
+
+	if validationErr != 0 {
+		errType := EVMResponseValidationError(validationErr)
+		return int(response.GetHttpStatusCode()), &errType
+	}
+
+	return int(response.GetHttpStatusCode()), nil
+}
+
+// archivalProbeEVMResponseInterpreter interprets archival probe response observations.
+// It implements the evmResponseInterpreter interface to translate proto-generated
+// archival probe response types into standardized status codes and error types.
+type archivalProbeEVMResponseInterpreter struct{}
+
+// extractValidityStatus extracts status information from archival probe response observations.
+// It interprets the archival probe response-specific proto type and translates it into
+// standardized HTTP status codes and error types for the rest of the system.
+func (i *archivalProbeEVMResponseInterpreter) extractValidityStatus(obs *EVMEndpointObservation) (int, *EVMResponseValidationError) {
+	response := obs.GetArchivalProbeResponse()
+	validationErr := response.GetResponseValidationError()
+
+	if validationErr != 0 {
+		errType := EVMResponseValidationError(validationErr)
+		return int(response.GetHttpStatusCode()), &errType
+	}
+
+	return int(response.GetHttpStatusCode()), nil
+}
+
 // unrecognizedEVMResponseInterpreter interprets unrecognized response observations.
 // It implements the evmResponseInterpreter interface to translate proto-generated
 // unrecognized response types into standardized status codes and error types.
@@ -0,0 +1,378 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: path/qos/generic_jsonrpc.proto
+
+package qos
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GenericJsonRpcRequestObservations captures QoS data for a single request to a JSON-RPC service
+// using the generic JSON-RPC QoS, i.e. a service with config-declared synthetic checks.
+type GenericJsonRpcRequestObservations struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// service_id is the identifier of the service.
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// The length of the client's request payload, in bytes.
+	RequestPayloadLength uint32 `protobuf:"varint,2,opt,name=request_payload_length,json=requestPayloadLength,proto3" json:"request_payload_length,omitempty"`
+	// The origin of the request: user vs. QoS service (requests built by QoS for collecting data on endpoints)
+	RequestOrigin RequestOrigin `protobuf:"varint,3,opt,name=request_origin,json=requestOrigin,proto3,enum=path.qos.RequestOrigin" json:"request_origin,omitempty"`
+	// Tracks request errors, if any.
+	RequestError *RequestError `protobuf:"bytes,4,opt,name=request_error,json=requestError,proto3,oneof" json:"request_error,omitempty"`
+	// JSON-RPC request to the service.
+	// Only set if the HTTP request payload was successfully parsed into JSONRPC.
+	JsonrpcRequest *JsonRpcRequest `protobuf:"bytes,5,opt,name=jsonrpc_request,json=jsonrpcRequest,proto3,oneof" json:"jsonrpc_request,omitempty"`
+	// Multiple observations possible if:
+	// - Original endpoint returns invalid response
+	// - Retry mechanism activates
+	EndpointObservations []*GenericJsonRpcEndpointObservation `protobuf:"bytes,6,rep,name=endpoint_observations,json=endpointObservations,proto3" json:"endpoint_observations,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GenericJsonRpcRequestObservations) Reset() {
+	*x = GenericJsonRpcRequestObservations{}
+	mi := &file_path_qos_generic_jsonrpc_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenericJsonRpcRequestObservations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenericJsonRpcRequestObservations) ProtoMessage() {}
+
+func (x *GenericJsonRpcRequestObservations) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_generic_jsonrpc_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenericJsonRpcRequestObservations.ProtoReflect.Descriptor instead.
+func (*GenericJsonRpcRequestObservations) Descriptor() ([]byte, []int) {
+	return file_path_qos_generic_jsonrpc_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GenericJsonRpcRequestObservations) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *GenericJsonRpcRequestObservations) GetRequestPayloadLength() uint32 {
+	if x != nil {
+		return x.RequestPayloadLength
+	}
+	return 0
+}
+
+func (x *GenericJsonRpcRequestObservations) GetRequestOrigin() RequestOrigin {
+	if x != nil {
+		return x.RequestOrigin
+	}
+	return RequestOrigin_REQUEST_ORIGIN_UNSPECIFIED
+}
+
+func (x *GenericJsonRpcRequestObservations) GetRequestError() *RequestError {
+	if x != nil {
+		return x.RequestError
+	}
+	return nil
+}
+
+func (x *GenericJsonRpcRequestObservations) GetJsonrpcRequest() *JsonRpcRequest {
+	if x != nil {
+		return x.JsonrpcRequest
+	}
+	return nil
+}
+
+func (x *GenericJsonRpcRequestObservations) GetEndpointObservations() []*GenericJsonRpcEndpointObservation {
+	if x != nil {
+		return x.EndpointObservations
+	}
+	return nil
+}
+
+// GenericJsonRpcEndpointObservation captures a single endpoint's response to a request.
+type GenericJsonRpcEndpointObservation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Address of the endpoint handling the request
+	EndpointAddr string `protobuf:"bytes,1,opt,name=endpoint_addr,json=endpointAddr,proto3" json:"endpoint_addr,omitempty"`
+	// HTTP status code returned to the user.
+	// It is derived from either:
+	//   - The endpoint payload parsed as a JSONRPC response.
+	//   - A generic JSONRPC error response if the endpoint payload fails to parse.
+	HttpStatusCode int32 `protobuf:"varint,2,opt,name=http_status_code,json=httpStatusCode,proto3" json:"http_status_code,omitempty"`
+	// The endpoint's response, parsed as a JSON-RPC response.
+	JsonrpcResponse *JsonRpcResponse `protobuf:"bytes,3,opt,name=jsonrpc_response,json=jsonrpcResponse,proto3" json:"jsonrpc_response,omitempty"`
+	// Set if the endpoint's response is not a valid JSON-RPC response.
+	ValidationError *JsonRpcResponseValidationError `protobuf:"bytes,4,opt,name=validation_error,json=validationError,proto3,oneof" json:"validation_error,omitempty"`
+	// Result of the config-declared check the request was built for.
+	// Only set for synthetic requests, i.e. endpoint checks.
+	CheckResult   *GenericJsonRpcCheckResult `protobuf:"bytes,5,opt,name=check_result,json=checkResult,proto3,oneof" json:"check_result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenericJsonRpcEndpointObservation) Reset() {
+	*x = GenericJsonRpcEndpointObservation{}
+	mi := &file_path_qos_generic_jsonrpc_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenericJsonRpcEndpointObservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenericJsonRpcEndpointObservation) ProtoMessage() {}
+
+func (x *GenericJsonRpcEndpointObservation) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_generic_jsonrpc_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenericJsonRpcEndpointObservation.ProtoReflect.Descriptor instead.
+func (*GenericJsonRpcEndpointObservation) Descriptor() ([]byte, []int) {
+	return file_path_qos_generic_jsonrpc_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GenericJsonRpcEndpointObservation) GetEndpointAddr() string {
+	if x != nil {
+		return x.EndpointAddr
+	}
+	return ""
+}
+
+func (x *GenericJsonRpcEndpointObservation) GetHttpStatusCode() int32 {
+	if x != nil {
+		return x.HttpStatusCode
+	}
+	return 0
+}
+
+func (x *GenericJsonRpcEndpointObservation) GetJsonrpcResponse() *JsonRpcResponse {
+	if x != nil {
+		return x.JsonrpcResponse
+	}
+	return nil
+}
+
+func (x *GenericJsonRpcEndpointObservation) GetValidationError() *JsonRpcResponseValidationError {
+	if x != nil {
+		return x.ValidationError
+	}
+	return nil
+}
+
+func (x *GenericJsonRpcEndpointObservation) GetCheckResult() *GenericJsonRpcCheckResult {
+	if x != nil {
+		return x.CheckResult
+	}
+	return nil
+}
+
+// GenericJsonRpcCheckResult captures the result of evaluating a config-declared check against an endpoint's response.
+type GenericJsonRpcCheckResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the config-declared check.
+	CheckName string `protobuf:"bytes,1,opt,name=check_name,json=checkName,proto3" json:"check_name,omitempty"`
+	// Set if the endpoint's response satisfies the check's assertion.
+	// Assertions relative to other endpoints, e.g. "hex number ≥ perceived max - N", are evaluated at endpoint selection time:
+	// they pass here as long as a number could be extracted from the response.
+	Passed bool `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	// Reason the check failed. Empty if the check passed.
+	FailureReason string `protobuf:"bytes,3,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	// The number extracted from the response, for assertions comparing numbers across endpoints.
+	Number        *uint64 `protobuf:"varint,4,opt,name=number,proto3,oneof" json:"number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenericJsonRpcCheckResult) Reset() {
+	*x = GenericJsonRpcCheckResult{}
+	mi := &file_path_qos_generic_jsonrpc_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenericJsonRpcCheckResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenericJsonRpcCheckResult) ProtoMessage() {}
+
+func (x *GenericJsonRpcCheckResult) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_generic_jsonrpc_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenericJsonRpcCheckResult.ProtoReflect.Descriptor instead.
+func (*GenericJsonRpcCheckResult) Descriptor() ([]byte, []int) {
+	return file_path_qos_generic_jsonrpc_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GenericJsonRpcCheckResult) GetCheckName() string {
+	if x != nil {
+		return x.CheckName
+	}
+	return ""
+}
+
+func (x *GenericJsonRpcCheckResult) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *GenericJsonRpcCheckResult) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *GenericJsonRpcCheckResult) GetNumber() uint64 {
+	if x != nil && x.Number != nil {
+		return *x.Number
+	}
+	return 0
+}
+
+var File_path_qos_generic_jsonrpc_proto protoreflect.FileDescriptor
+
+const file_path_qos_generic_jsonrpc_proto_rawDesc = "" +
+	"\n" +
+	"\x1epath/qos/generic_jsonrpc.proto\x12\bpath.qos\x1a\x16path/qos/jsonrpc.proto\x1a\x1dpath/qos/request_origin.proto\x1a\x1cpath/qos/request_error.proto\x1a'path/qos/jsonrpc_validation_error.proto\"\xca\x03\n" +
+	"!GenericJsonRpcRequestObservations\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x124\n" +
+	"\x16request_payload_length\x18\x02 \x01(\rR\x14requestPayloadLength\x12>\n" +
+	"\x0erequest_origin\x18\x03 \x01(\x0e2\x17.path.qos.RequestOriginR\rrequestOrigin\x12@\n" +
+	"\rrequest_error\x18\x04 \x01(\v2\x16.path.qos.RequestErrorH\x00R\frequestError\x88\x01\x01\x12F\n" +
+	"\x0fjsonrpc_request\x18\x05 \x01(\v2\x18.path.qos.JsonRpcRequestH\x01R\x0ejsonrpcRequest\x88\x01\x01\x12`\n" +
+	"\x15endpoint_observations\x18\x06 \x03(\v2+.path.qos.GenericJsonRpcEndpointObservationR\x14endpointObservationsB\x10\n" +
+	"\x0e_request_errorB\x12\n" +
+	"\x10_jsonrpc_request\"\x85\x03\n" +
+	"!GenericJsonRpcEndpointObservation\x12#\n" +
+	"\rendpoint_addr\x18\x01 \x01(\tR\fendpointAddr\x12(\n" +
+	"\x10http_status_code\x18\x02 \x01(\x05R\x0ehttpStatusCode\x12D\n" +
+	"\x10jsonrpc_response\x18\x03 \x01(\v2\x19.path.qos.JsonRpcResponseR\x0fjsonrpcResponse\x12X\n" +
+	"\x10validation_error\x18\x04 \x01(\v2(.path.qos.JsonRpcResponseValidationErrorH\x00R\x0fvalidationError\x88\x01\x01\x12K\n" +
+	"\fcheck_result\x18\x05 \x01(\v2#.path.qos.GenericJsonRpcCheckResultH\x01R\vcheckResult\x88\x01\x01B\x13\n" +
+	"\x11_validation_errorB\x0f\n" +
+	"\r_check_result\"\xa1\x01\n" +
+	"\x19GenericJsonRpcCheckResult\x12\x1d\n" +
+	"\n" +
+	"check_name\x18\x01 \x01(\tR\tcheckName\x12\x16\n" +
+	"\x06passed\x18\x02 \x01(\bR\x06passed\x12%\n" +
+	"\x0efailure_reason\x18\x03 \x01(\tR\rfailureReason\x12\x1b\n" +
+	"\x06number\x18\x04 \x01(\x04H\x00R\x06number\x88\x01\x01B\t\n" +
+	"\a_numberB0Z.github.com/buildwithgrove/path/observation/qosb\x06proto3"
+
+var (
+	file_path_qos_generic_jsonrpc_proto_rawDescOnce sync.Once
+	file_path_qos_generic_jsonrpc_proto_rawDescData []byte
+)
+
+func file_path_qos_generic_jsonrpc_proto_rawDescGZIP() []byte {
+	file_path_qos_generic_jsonrpc_proto_rawDescOnce.Do(func() {
+		file_path_qos_generic_jsonrpc_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_path_qos_generic_jsonrpc_proto_rawDesc), len(file_path_qos_generic_jsonrpc_proto_rawDesc)))
+	})
+	return file_path_qos_generic_jsonrpc_proto_rawDescData
+}
+
+var file_path_qos_generic_jsonrpc_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_path_qos_generic_jsonrpc_proto_goTypes = []any{
+	(*GenericJsonRpcRequestObservations)(nil), // 0: path.qos.GenericJsonRpcRequestObservations
+	(*GenericJsonRpcEndpointObservation)(nil), // 1: path.qos.GenericJsonRpcEndpointObservation
+	(*GenericJsonRpcCheckResult)(nil),         // 2: path.qos.GenericJsonRpcCheckResult
+	(RequestOrigin)(0),                        // 3: path.qos.RequestOrigin
+	(*RequestError)(nil),                      // 4: path.qos.RequestError
+	(*JsonRpcRequest)(nil),                    // 5: path.qos.JsonRpcRequest
+	(*JsonRpcResponse)(nil),                   // 6: path.qos.JsonRpcResponse
+	(*JsonRpcResponseValidationError)(nil),    // 7: path.qos.JsonRpcResponseValidationError
+}
+var file_path_qos_generic_jsonrpc_proto_depIdxs = []int32{
+	3, // 0: path.qos.GenericJsonRpcRequestObservations.request_origin:type_name -> path.qos.RequestOrigin
+	4, // 1: path.qos.GenericJsonRpcRequestObservations.request_error:type_name -> path.qos.RequestError
+	5, // 2: path.qos.GenericJsonRpcRequestObservations.jsonrpc_request:type_name -> path.qos.JsonRpcRequest
+	1, // 3: path.qos.GenericJsonRpcRequestObservations.endpoint_observations:type_name -> path.qos.GenericJsonRpcEndpointObservation
+	6, // 4: path.qos.GenericJsonRpcEndpointObservation.jsonrpc_response:type_name -> path.qos.JsonRpcResponse
+	7, // 5: path.qos.GenericJsonRpcEndpointObservation.validation_error:type_name -> path.qos.JsonRpcResponseValidationError
+	2, // 6: path.qos.GenericJsonRpcEndpointObservation.check_result:type_name -> path.qos.GenericJsonRpcCheckResult
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_path_qos_generic_jsonrpc_proto_init() }
+func file_path_qos_generic_jsonrpc_proto_init() {
+	if File_path_qos_generic_jsonrpc_proto != nil {
+		return
+	}
+	file_path_qos_jsonrpc_proto_init()
+	file_path_qos_request_origin_proto_init()
+	file_path_qos_request_error_proto_init()
+	file_path_qos_jsonrpc_validation_error_proto_init()
+	file_path_qos_generic_jsonrpc_proto_msgTypes[0].OneofWrappers = []any{}
+	file_path_qos_generic_jsonrpc_proto_msgTypes[1].OneofWrappers = []any{}
+	file_path_qos_generic_jsonrpc_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_path_qos_generic_jsonrpc_proto_rawDesc), len(file_path_qos_generic_jsonrpc_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_path_qos_generic_jsonrpc_proto_goTypes,
+		DependencyIndexes: file_path_qos_generic_jsonrpc_proto_depIdxs,
+		MessageInfos:      file_path_qos_generic_jsonrpc_proto_msgTypes,
+	}.Build()
+	File_path_qos_generic_jsonrpc_proto = out.File
+	file_path_qos_generic_jsonrpc_proto_goTypes = nil
+	file_path_qos_generic_jsonrpc_proto_depIdxs = nil
+}
@@ -0,0 +1,355 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: path/qos/generic_rest.proto
+
+package qos
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GenericRestRequestObservations captures QoS data for a single request to a REST service
+// using the generic REST QoS, i.e. a service with config-declared health probes.
+type GenericRestRequestObservations struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// service_id is the identifier of the service.
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// The length of the client's request payload, in bytes.
+	RequestPayloadLength uint32 `protobuf:"varint,2,opt,name=request_payload_length,json=requestPayloadLength,proto3" json:"request_payload_length,omitempty"`
+	// The origin of the request: user vs. QoS service (requests built by QoS for collecting data on endpoints)
+	RequestOrigin RequestOrigin `protobuf:"varint,3,opt,name=request_origin,json=requestOrigin,proto3,enum=path.qos.RequestOrigin" json:"request_origin,omitempty"`
+	// Tracks request errors, if any.
+	RequestError *RequestError `protobuf:"bytes,4,opt,name=request_error,json=requestError,proto3,oneof" json:"request_error,omitempty"`
+	// HTTP method of the request, e.g. "GET".
+	RequestMethod string `protobuf:"bytes,5,opt,name=request_method,json=requestMethod,proto3" json:"request_method,omitempty"`
+	// URL path of the request, e.g. "/v1/status".
+	RequestPath string `protobuf:"bytes,6,opt,name=request_path,json=requestPath,proto3" json:"request_path,omitempty"`
+	// Multiple observations possible if:
+	// - Original endpoint returns invalid response
+	// - Retry mechanism activates
+	EndpointObservations []*GenericRestEndpointObservation `protobuf:"bytes,7,rep,name=endpoint_observations,json=endpointObservations,proto3" json:"endpoint_observations,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GenericRestRequestObservations) Reset() {
+	*x = GenericRestRequestObservations{}
+	mi := &file_path_qos_generic_rest_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenericRestRequestObservations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenericRestRequestObservations) ProtoMessage() {}
+
+func (x *GenericRestRequestObservations) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_generic_rest_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenericRestRequestObservations.ProtoReflect.Descriptor instead.
+func (*GenericRestRequestObservations) Descriptor() ([]byte, []int) {
+	return file_path_qos_generic_rest_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GenericRestRequestObservations) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *GenericRestRequestObservations) GetRequestPayloadLength() uint32 {
+	if x != nil {
+		return x.RequestPayloadLength
+	}
+	return 0
+}
+
+func (x *GenericRestRequestObservations) GetRequestOrigin() RequestOrigin {
+	if x != nil {
+		return x.RequestOrigin
+	}
+	return RequestOrigin_REQUEST_ORIGIN_UNSPECIFIED
+}
+
+func (x *GenericRestRequestObservations) GetRequestError() *RequestError {
+	if x != nil {
+		return x.RequestError
+	}
+	return nil
+}
+
+func (x *GenericRestRequestObservations) GetRequestMethod() string {
+	if x != nil {
+		return x.RequestMethod
+	}
+	return ""
+}
+
+func (x *GenericRestRequestObservations) GetRequestPath() string {
+	if x != nil {
+		return x.RequestPath
+	}
+	return ""
+}
+
+func (x *GenericRestRequestObservations) GetEndpointObservations() []*GenericRestEndpointObservation {
+	if x != nil {
+		return x.EndpointObservations
+	}
+	return nil
+}
+
+// GenericRestEndpointObservation captures a single endpoint's response to a request.
+type GenericRestEndpointObservation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Address of the endpoint handling the request
+	EndpointAddr string `protobuf:"bytes,1,opt,name=endpoint_addr,json=endpointAddr,proto3" json:"endpoint_addr,omitempty"`
+	// HTTP status code returned by the endpoint.
+	// Set to 200 for successful relays: the protocol only reports the response payload of 2xx responses.
+	// Set to 0 if no response was received, e.g. a timeout.
+	HttpStatusCode int32 `protobuf:"varint,2,opt,name=http_status_code,json=httpStatusCode,proto3" json:"http_status_code,omitempty"`
+	// Result of the config-declared probe the request was built for.
+	// Only set for synthetic requests, i.e. endpoint probes.
+	ProbeResult   *GenericRestProbeResult `protobuf:"bytes,3,opt,name=probe_result,json=probeResult,proto3,oneof" json:"probe_result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenericRestEndpointObservation) Reset() {
+	*x = GenericRestEndpointObservation{}
+	mi := &file_path_qos_generic_rest_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenericRestEndpointObservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenericRestEndpointObservation) ProtoMessage() {}
+
+func (x *GenericRestEndpointObservation) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_generic_rest_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenericRestEndpointObservation.ProtoReflect.Descriptor instead.
+func (*GenericRestEndpointObservation) Descriptor() ([]byte, []int) {
+	return file_path_qos_generic_rest_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GenericRestEndpointObservation) GetEndpointAddr() string {
+	if x != nil {
+		return x.EndpointAddr
+	}
+	return ""
+}
+
+func (x *GenericRestEndpointObservation) GetHttpStatusCode() int32 {
+	if x != nil {
+		return x.HttpStatusCode
+	}
+	return 0
+}
+
+func (x *GenericRestEndpointObservation) GetProbeResult() *GenericRestProbeResult {
+	if x != nil {
+		return x.ProbeResult
+	}
+	return nil
+}
+
+// GenericRestProbeResult captures the result of evaluating a config-declared probe against an endpoint's response.
+type GenericRestProbeResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the config-declared probe.
+	ProbeName string `protobuf:"bytes,1,opt,name=probe_name,json=probeName,proto3" json:"probe_name,omitempty"`
+	// Set if the endpoint's response satisfies the probe's expected status codes and field assertions.
+	// The height comparison against other endpoints is evaluated at endpoint selection time.
+	Passed bool `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	// Reason the probe failed. Empty if the probe passed.
+	FailureReason string `protobuf:"bytes,3,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	// The height extracted from the response, if the probe declares a height field.
+	Height        *uint64 `protobuf:"varint,4,opt,name=height,proto3,oneof" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenericRestProbeResult) Reset() {
+	*x = GenericRestProbeResult{}
+	mi := &file_path_qos_generic_rest_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenericRestProbeResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenericRestProbeResult) ProtoMessage() {}
+
+func (x *GenericRestProbeResult) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_generic_rest_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenericRestProbeResult.ProtoReflect.Descriptor instead.
+func (*GenericRestProbeResult) Descriptor() ([]byte, []int) {
+	return file_path_qos_generic_rest_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GenericRestProbeResult) GetProbeName() string {
+	if x != nil {
+		return x.ProbeName
+	}
+	return ""
+}
+
+func (x *GenericRestProbeResult) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *GenericRestProbeResult) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *GenericRestProbeResult) GetHeight() uint64 {
+	if x != nil && x.Height != nil {
+		return *x.Height
+	}
+	return 0
+}
+
+var File_path_qos_generic_rest_proto protoreflect.FileDescriptor
+
+const file_path_qos_generic_rest_proto_rawDesc = "" +
+	"\n" +
+	"\x1bpath/qos/generic_rest.proto\x12\bpath.qos\x1a\x1dpath/qos/request_origin.proto\x1a\x1cpath/qos/request_error.proto\"\xb2\x03\n" +
+	"\x1eGenericRestRequestObservations\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x124\n" +
+	"\x16request_payload_length\x18\x02 \x01(\rR\x14requestPayloadLength\x12>\n" +
+	"\x0erequest_origin\x18\x03 \x01(\x0e2\x17.path.qos.RequestOriginR\rrequestOrigin\x12@\n" +
+	"\rrequest_error\x18\x04 \x01(\v2\x16.path.qos.RequestErrorH\x00R\frequestError\x88\x01\x01\x12%\n" +
+	"\x0erequest_method\x18\x05 \x01(\tR\rrequestMethod\x12!\n" +
+	"\frequest_path\x18\x06 \x01(\tR\vrequestPath\x12]\n" +
+	"\x15endpoint_observations\x18\a \x03(\v2(.path.qos.GenericRestEndpointObservationR\x14endpointObservationsB\x10\n" +
+	"\x0e_request_error\"\xca\x01\n" +
+	"\x1eGenericRestEndpointObservation\x12#\n" +
+	"\rendpoint_addr\x18\x01 \x01(\tR\fendpointAddr\x12(\n" +
+	"\x10http_status_code\x18\x02 \x01(\x05R\x0ehttpStatusCode\x12H\n" +
+	"\fprobe_result\x18\x03 \x01(\v2 .path.qos.GenericRestProbeResultH\x00R\vprobeResult\x88\x01\x01B\x0f\n" +
+	"\r_probe_result\"\x9e\x01\n" +
+	"\x16GenericRestProbeResult\x12\x1d\n" +
+	"\n" +
+	"probe_name\x18\x01 \x01(\tR\tprobeName\x12\x16\n" +
+	"\x06passed\x18\x02 \x01(\bR\x06passed\x12%\n" +
+	"\x0efailure_reason\x18\x03 \x01(\tR\rfailureReason\x12\x1b\n" +
+	"\x06height\x18\x04 \x01(\x04H\x00R\x06height\x88\x01\x01B\t\n" +
+	"\a_heightB0Z.github.com/buildwithgrove/path/observation/qosb\x06proto3"
+
+var (
+	file_path_qos_generic_rest_proto_rawDescOnce sync.Once
+	file_path_qos_generic_rest_proto_rawDescData []byte
+)
+
+func file_path_qos_generic_rest_proto_rawDescGZIP() []byte {
+	file_path_qos_generic_rest_proto_rawDescOnce.Do(func() {
+		file_path_qos_generic_rest_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_path_qos_generic_rest_proto_rawDesc), len(file_path_qos_generic_rest_proto_rawDesc)))
+	})
+	return file_path_qos_generic_rest_proto_rawDescData
+}
+
+var file_path_qos_generic_rest_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_path_qos_generic_rest_proto_goTypes = []any{
+	(*GenericRestRequestObservations)(nil), // 0: path.qos.GenericRestRequestObservations
+	(*GenericRestEndpointObservation)(nil), // 1: path.qos.GenericRestEndpointObservation
+	(*GenericRestProbeResult)(nil),         // 2: path.qos.GenericRestProbeResult
+	(RequestOrigin)(0),                     // 3: path.qos.RequestOrigin
+	(*RequestError)(nil),                   // 4: path.qos.RequestError
+}
+var file_path_qos_generic_rest_proto_depIdxs = []int32{
+	3, // 0: path.qos.GenericRestRequestObservations.request_origin:type_name -> path.qos.RequestOrigin
+	4, // 1: path.qos.GenericRestRequestObservations.request_error:type_name -> path.qos.RequestError
+	1, // 2: path.qos.GenericRestRequestObservations.endpoint_observations:type_name -> path.qos.GenericRestEndpointObservation
+	2, // 3: path.qos.GenericRestEndpointObservation.probe_result:type_name -> path.qos.GenericRestProbeResult
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_path_qos_generic_rest_proto_init() }
+func file_path_qos_generic_rest_proto_init() {
+	if File_path_qos_generic_rest_proto != nil {
+		return
+	}
+	file_path_qos_request_origin_proto_init()
+	file_path_qos_request_error_proto_init()
+	file_path_qos_generic_rest_proto_msgTypes[0].OneofWrappers = []any{}
+	file_path_qos_generic_rest_proto_msgTypes[1].OneofWrappers = []any{}
+	file_path_qos_generic_rest_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_path_qos_generic_rest_proto_rawDesc), len(file_path_qos_generic_rest_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_path_qos_generic_rest_proto_goTypes,
+		DependencyIndexes: file_path_qos_generic_rest_proto_depIdxs,
+		MessageInfos:      file_path_qos_generic_rest_proto_msgTypes,
+	}.Build()
+	File_path_qos_generic_rest_proto = out.File
+	file_path_qos_generic_rest_proto_goTypes = nil
+	file_path_qos_generic_rest_proto_depIdxs = nil
+}
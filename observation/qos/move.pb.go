@@ -0,0 +1,691 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: path/qos/move.proto
+
+package qos
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MoveRequestObservations captures QoS data for a single request to a Move-based blockchain service,
+// e.g. Sui (JSON-RPC) or Aptos (REST), including all observations made during potential retries.
+type MoveRequestObservations struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// chain_id is the chain ID expected from endpoints:
+	//   - JSON-RPC (Sui): the chain identifier, e.g. "35834a8a".
+	//   - REST (Aptos): the numeric chain ID, e.g. "1".
+	// This is preset by the processor and not determined by the request.
+	// Used by metrics and data pipeline.
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// service_id is the identifier for the QoS implementation.
+	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// The length of the client's request payload, in bytes.
+	RequestPayloadLength uint32 `protobuf:"varint,3,opt,name=request_payload_length,json=requestPayloadLength,proto3" json:"request_payload_length,omitempty"`
+	// The origin of the request: user vs. QoS service (requests built by QoS for collecting data on endpoints)
+	RequestOrigin RequestOrigin `protobuf:"varint,4,opt,name=request_origin,json=requestOrigin,proto3,enum=path.qos.RequestOrigin" json:"request_origin,omitempty"`
+	// Tracks request errors, if any.
+	RequestError *RequestError `protobuf:"bytes,5,opt,name=request_error,json=requestError,proto3,oneof" json:"request_error,omitempty"`
+	// The API of the request: JSON-RPC or REST.
+	BackendServiceType BackendServiceType `protobuf:"varint,6,opt,name=backend_service_type,json=backendServiceType,proto3,enum=path.qos.BackendServiceType" json:"backend_service_type,omitempty"`
+	// The parsed request.
+	// Not set for JSON-RPC batch requests: the batch is relayed as a single payload.
+	//
+	// Types that are valid to be assigned to ParsedRequest:
+	//
+	//	*MoveRequestObservations_JsonrpcRequest
+	//	*MoveRequestObservations_RestRequest
+	ParsedRequest isMoveRequestObservations_ParsedRequest `protobuf_oneof:"parsed_request"`
+	// Multiple observations possible if:
+	// - Original endpoint returns invalid response
+	// - Retry mechanism activates
+	EndpointObservations []*MoveEndpointObservation `protobuf:"bytes,9,rep,name=endpoint_observations,json=endpointObservations,proto3" json:"endpoint_observations,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *MoveRequestObservations) Reset() {
+	*x = MoveRequestObservations{}
+	mi := &file_path_qos_move_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveRequestObservations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveRequestObservations) ProtoMessage() {}
+
+func (x *MoveRequestObservations) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_move_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveRequestObservations.ProtoReflect.Descriptor instead.
+func (*MoveRequestObservations) Descriptor() ([]byte, []int) {
+	return file_path_qos_move_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MoveRequestObservations) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *MoveRequestObservations) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *MoveRequestObservations) GetRequestPayloadLength() uint32 {
+	if x != nil {
+		return x.RequestPayloadLength
+	}
+	return 0
+}
+
+func (x *MoveRequestObservations) GetRequestOrigin() RequestOrigin {
+	if x != nil {
+		return x.RequestOrigin
+	}
+	return RequestOrigin_REQUEST_ORIGIN_UNSPECIFIED
+}
+
+func (x *MoveRequestObservations) GetRequestError() *RequestError {
+	if x != nil {
+		return x.RequestError
+	}
+	return nil
+}
+
+func (x *MoveRequestObservations) GetBackendServiceType() BackendServiceType {
+	if x != nil {
+		return x.BackendServiceType
+	}
+	return BackendServiceType_BACKEND_SERVICE_TYPE_UNSPECIFIED
+}
+
+func (x *MoveRequestObservations) GetParsedRequest() isMoveRequestObservations_ParsedRequest {
+	if x != nil {
+		return x.ParsedRequest
+	}
+	return nil
+}
+
+func (x *MoveRequestObservations) GetJsonrpcRequest() *JsonRpcRequest {
+	if x != nil {
+		if x, ok := x.ParsedRequest.(*MoveRequestObservations_JsonrpcRequest); ok {
+			return x.JsonrpcRequest
+		}
+	}
+	return nil
+}
+
+func (x *MoveRequestObservations) GetRestRequest() *RESTRequest {
+	if x != nil {
+		if x, ok := x.ParsedRequest.(*MoveRequestObservations_RestRequest); ok {
+			return x.RestRequest
+		}
+	}
+	return nil
+}
+
+func (x *MoveRequestObservations) GetEndpointObservations() []*MoveEndpointObservation {
+	if x != nil {
+		return x.EndpointObservations
+	}
+	return nil
+}
+
+type isMoveRequestObservations_ParsedRequest interface {
+	isMoveRequestObservations_ParsedRequest()
+}
+
+type MoveRequestObservations_JsonrpcRequest struct {
+	JsonrpcRequest *JsonRpcRequest `protobuf:"bytes,7,opt,name=jsonrpc_request,json=jsonrpcRequest,proto3,oneof"`
+}
+
+type MoveRequestObservations_RestRequest struct {
+	RestRequest *RESTRequest `protobuf:"bytes,8,opt,name=rest_request,json=restRequest,proto3,oneof"`
+}
+
+func (*MoveRequestObservations_JsonrpcRequest) isMoveRequestObservations_ParsedRequest() {}
+
+func (*MoveRequestObservations_RestRequest) isMoveRequestObservations_ParsedRequest() {}
+
+// MoveEndpointObservation captures a single endpoint's response to a request
+type MoveEndpointObservation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Address of the endpoint handling the request
+	EndpointAddr string `protobuf:"bytes,1,opt,name=endpoint_addr,json=endpointAddr,proto3" json:"endpoint_addr,omitempty"`
+	// HTTP status code returned to the user, or returned by the endpoint if the relay failed.
+	HttpStatusCode int32 `protobuf:"varint,2,opt,name=http_status_code,json=httpStatusCode,proto3" json:"http_status_code,omitempty"`
+	// Types that are valid to be assigned to ResponseObservation:
+	//
+	//	*MoveEndpointObservation_SuiChainIdentifierResponse
+	//	*MoveEndpointObservation_SuiLatestCheckpointResponse
+	//	*MoveEndpointObservation_AptosLedgerInfoResponse
+	//	*MoveEndpointObservation_UnrecognizedResponse
+	ResponseObservation isMoveEndpointObservation_ResponseObservation `protobuf_oneof:"response_observation"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *MoveEndpointObservation) Reset() {
+	*x = MoveEndpointObservation{}
+	mi := &file_path_qos_move_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveEndpointObservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveEndpointObservation) ProtoMessage() {}
+
+func (x *MoveEndpointObservation) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_move_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveEndpointObservation.ProtoReflect.Descriptor instead.
+func (*MoveEndpointObservation) Descriptor() ([]byte, []int) {
+	return file_path_qos_move_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MoveEndpointObservation) GetEndpointAddr() string {
+	if x != nil {
+		return x.EndpointAddr
+	}
+	return ""
+}
+
+func (x *MoveEndpointObservation) GetHttpStatusCode() int32 {
+	if x != nil {
+		return x.HttpStatusCode
+	}
+	return 0
+}
+
+func (x *MoveEndpointObservation) GetResponseObservation() isMoveEndpointObservation_ResponseObservation {
+	if x != nil {
+		return x.ResponseObservation
+	}
+	return nil
+}
+
+func (x *MoveEndpointObservation) GetSuiChainIdentifierResponse() *MoveSuiChainIdentifierResponse {
+	if x != nil {
+		if x, ok := x.ResponseObservation.(*MoveEndpointObservation_SuiChainIdentifierResponse); ok {
+			return x.SuiChainIdentifierResponse
+		}
+	}
+	return nil
+}
+
+func (x *MoveEndpointObservation) GetSuiLatestCheckpointResponse() *MoveSuiLatestCheckpointResponse {
+	if x != nil {
+		if x, ok := x.ResponseObservation.(*MoveEndpointObservation_SuiLatestCheckpointResponse); ok {
+			return x.SuiLatestCheckpointResponse
+		}
+	}
+	return nil
+}
+
+func (x *MoveEndpointObservation) GetAptosLedgerInfoResponse() *MoveAptosLedgerInfoResponse {
+	if x != nil {
+		if x, ok := x.ResponseObservation.(*MoveEndpointObservation_AptosLedgerInfoResponse); ok {
+			return x.AptosLedgerInfoResponse
+		}
+	}
+	return nil
+}
+
+func (x *MoveEndpointObservation) GetUnrecognizedResponse() *MoveUnrecognizedResponse {
+	if x != nil {
+		if x, ok := x.ResponseObservation.(*MoveEndpointObservation_UnrecognizedResponse); ok {
+			return x.UnrecognizedResponse
+		}
+	}
+	return nil
+}
+
+type isMoveEndpointObservation_ResponseObservation interface {
+	isMoveEndpointObservation_ResponseObservation()
+}
+
+type MoveEndpointObservation_SuiChainIdentifierResponse struct {
+	// Response to a Sui `sui_getChainIdentifier` request.
+	// Docs: https://docs.sui.io/sui-api-ref#sui_getchainidentifier
+	SuiChainIdentifierResponse *MoveSuiChainIdentifierResponse `protobuf:"bytes,3,opt,name=sui_chain_identifier_response,json=suiChainIdentifierResponse,proto3,oneof"`
+}
+
+type MoveEndpointObservation_SuiLatestCheckpointResponse struct {
+	// Response to a Sui `sui_getLatestCheckpointSequenceNumber` request.
+	// Docs: https://docs.sui.io/sui-api-ref#sui_getlatestcheckpointsequencenumber
+	SuiLatestCheckpointResponse *MoveSuiLatestCheckpointResponse `protobuf:"bytes,4,opt,name=sui_latest_checkpoint_response,json=suiLatestCheckpointResponse,proto3,oneof"`
+}
+
+type MoveEndpointObservation_AptosLedgerInfoResponse struct {
+	// Response to an Aptos ledger info request, i.e. `GET /v1`.
+	// Docs: https://aptos.dev/en/build/apis/fullnode-rest-api-reference#tag/general/GET/
+	AptosLedgerInfoResponse *MoveAptosLedgerInfoResponse `protobuf:"bytes,5,opt,name=aptos_ledger_info_response,json=aptosLedgerInfoResponse,proto3,oneof"`
+}
+
+type MoveEndpointObservation_UnrecognizedResponse struct {
+	// Responses not used in endpoint validation (e.g. sui_getObject, or Aptos account resources)
+	UnrecognizedResponse *MoveUnrecognizedResponse `protobuf:"bytes,6,opt,name=unrecognized_response,json=unrecognizedResponse,proto3,oneof"`
+}
+
+func (*MoveEndpointObservation_SuiChainIdentifierResponse) isMoveEndpointObservation_ResponseObservation() {
+}
+
+func (*MoveEndpointObservation_SuiLatestCheckpointResponse) isMoveEndpointObservation_ResponseObservation() {
+}
+
+func (*MoveEndpointObservation_AptosLedgerInfoResponse) isMoveEndpointObservation_ResponseObservation() {
+}
+
+func (*MoveEndpointObservation_UnrecognizedResponse) isMoveEndpointObservation_ResponseObservation() {
+}
+
+// MoveSuiChainIdentifierResponse stores the `sui_getChainIdentifier` response data used in endpoint validation.
+type MoveSuiChainIdentifierResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The chain identifier of the endpoint, e.g. "35834a8a" for Sui mainnet.
+	ChainIdentifier string `protobuf:"bytes,1,opt,name=chain_identifier,json=chainIdentifier,proto3" json:"chain_identifier,omitempty"`
+	// Set if the endpoint returned a JSON-RPC error or an unparsable result.
+	Invalid       bool `protobuf:"varint,2,opt,name=invalid,proto3" json:"invalid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveSuiChainIdentifierResponse) Reset() {
+	*x = MoveSuiChainIdentifierResponse{}
+	mi := &file_path_qos_move_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveSuiChainIdentifierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveSuiChainIdentifierResponse) ProtoMessage() {}
+
+func (x *MoveSuiChainIdentifierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_move_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveSuiChainIdentifierResponse.ProtoReflect.Descriptor instead.
+func (*MoveSuiChainIdentifierResponse) Descriptor() ([]byte, []int) {
+	return file_path_qos_move_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MoveSuiChainIdentifierResponse) GetChainIdentifier() string {
+	if x != nil {
+		return x.ChainIdentifier
+	}
+	return ""
+}
+
+func (x *MoveSuiChainIdentifierResponse) GetInvalid() bool {
+	if x != nil {
+		return x.Invalid
+	}
+	return false
+}
+
+// MoveSuiLatestCheckpointResponse stores the `sui_getLatestCheckpointSequenceNumber` response data used in endpoint validation.
+type MoveSuiLatestCheckpointResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The sequence number of the endpoint's latest checkpoint.
+	CheckpointSequenceNumber uint64 `protobuf:"varint,1,opt,name=checkpoint_sequence_number,json=checkpointSequenceNumber,proto3" json:"checkpoint_sequence_number,omitempty"`
+	// Set if the endpoint returned a JSON-RPC error or an unparsable result.
+	Invalid       bool `protobuf:"varint,2,opt,name=invalid,proto3" json:"invalid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveSuiLatestCheckpointResponse) Reset() {
+	*x = MoveSuiLatestCheckpointResponse{}
+	mi := &file_path_qos_move_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveSuiLatestCheckpointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveSuiLatestCheckpointResponse) ProtoMessage() {}
+
+func (x *MoveSuiLatestCheckpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_move_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveSuiLatestCheckpointResponse.ProtoReflect.Descriptor instead.
+func (*MoveSuiLatestCheckpointResponse) Descriptor() ([]byte, []int) {
+	return file_path_qos_move_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MoveSuiLatestCheckpointResponse) GetCheckpointSequenceNumber() uint64 {
+	if x != nil {
+		return x.CheckpointSequenceNumber
+	}
+	return 0
+}
+
+func (x *MoveSuiLatestCheckpointResponse) GetInvalid() bool {
+	if x != nil {
+		return x.Invalid
+	}
+	return false
+}
+
+// MoveAptosLedgerInfoResponse stores the Aptos ledger info data used in endpoint validation.
+type MoveAptosLedgerInfoResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The chain ID of the endpoint, e.g. "1" for Aptos mainnet.
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// The endpoint's latest ledger version, i.e. the number of committed transactions.
+	LedgerVersion uint64 `protobuf:"varint,2,opt,name=ledger_version,json=ledgerVersion,proto3" json:"ledger_version,omitempty"`
+	// The endpoint's latest block height.
+	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Set if the endpoint returned a non-2xx response or an unparsable payload.
+	Invalid       bool `protobuf:"varint,4,opt,name=invalid,proto3" json:"invalid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveAptosLedgerInfoResponse) Reset() {
+	*x = MoveAptosLedgerInfoResponse{}
+	mi := &file_path_qos_move_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveAptosLedgerInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveAptosLedgerInfoResponse) ProtoMessage() {}
+
+func (x *MoveAptosLedgerInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_move_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveAptosLedgerInfoResponse.ProtoReflect.Descriptor instead.
+func (*MoveAptosLedgerInfoResponse) Descriptor() ([]byte, []int) {
+	return file_path_qos_move_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MoveAptosLedgerInfoResponse) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *MoveAptosLedgerInfoResponse) GetLedgerVersion() uint64 {
+	if x != nil {
+		return x.LedgerVersion
+	}
+	return 0
+}
+
+func (x *MoveAptosLedgerInfoResponse) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *MoveAptosLedgerInfoResponse) GetInvalid() bool {
+	if x != nil {
+		return x.Invalid
+	}
+	return false
+}
+
+// MoveUnrecognizedResponse stores responses not used in endpoint validation.
+type MoveUnrecognizedResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only set for JSON-RPC responses.
+	JsonrpcResponse *JsonRpcResponse `protobuf:"bytes,1,opt,name=jsonrpc_response,json=jsonrpcResponse,proto3,oneof" json:"jsonrpc_response,omitempty"`
+	// Optional validation error information, e.g. a JSON-RPC request receiving a non-JSON-RPC response.
+	ValidationError *JsonRpcResponseValidationError `protobuf:"bytes,2,opt,name=validation_error,json=validationError,proto3,oneof" json:"validation_error,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MoveUnrecognizedResponse) Reset() {
+	*x = MoveUnrecognizedResponse{}
+	mi := &file_path_qos_move_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveUnrecognizedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveUnrecognizedResponse) ProtoMessage() {}
+
+func (x *MoveUnrecognizedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_move_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveUnrecognizedResponse.ProtoReflect.Descriptor instead.
+func (*MoveUnrecognizedResponse) Descriptor() ([]byte, []int) {
+	return file_path_qos_move_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MoveUnrecognizedResponse) GetJsonrpcResponse() *JsonRpcResponse {
+	if x != nil {
+		return x.JsonrpcResponse
+	}
+	return nil
+}
+
+func (x *MoveUnrecognizedResponse) GetValidationError() *JsonRpcResponseValidationError {
+	if x != nil {
+		return x.ValidationError
+	}
+	return nil
+}
+
+var File_path_qos_move_proto protoreflect.FileDescriptor
+
+const file_path_qos_move_proto_rawDesc = "" +
+	"\n" +
+	"\x13path/qos/move.proto\x12\bpath.qos\x1a\x1dpath/qos/cosmos_request.proto\x1a\x16path/qos/jsonrpc.proto\x1a\x1dpath/qos/request_origin.proto\x1a\x1cpath/qos/request_error.proto\x1a'path/qos/jsonrpc_validation_error.proto\"\xd8\x04\n" +
+	"\x17MoveRequestObservations\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x02 \x01(\tR\tserviceId\x124\n" +
+	"\x16request_payload_length\x18\x03 \x01(\rR\x14requestPayloadLength\x12>\n" +
+	"\x0erequest_origin\x18\x04 \x01(\x0e2\x17.path.qos.RequestOriginR\rrequestOrigin\x12@\n" +
+	"\rrequest_error\x18\x05 \x01(\v2\x16.path.qos.RequestErrorH\x01R\frequestError\x88\x01\x01\x12N\n" +
+	"\x14backend_service_type\x18\x06 \x01(\x0e2\x1c.path.qos.BackendServiceTypeR\x12backendServiceType\x12C\n" +
+	"\x0fjsonrpc_request\x18\a \x01(\v2\x18.path.qos.JsonRpcRequestH\x00R\x0ejsonrpcRequest\x12:\n" +
+	"\frest_request\x18\b \x01(\v2\x15.path.qos.RESTRequestH\x00R\vrestRequest\x12V\n" +
+	"\x15endpoint_observations\x18\t \x03(\v2!.path.qos.MoveEndpointObservationR\x14endpointObservationsB\x10\n" +
+	"\x0eparsed_requestB\x10\n" +
+	"\x0e_request_error\"\xa2\x04\n" +
+	"\x17MoveEndpointObservation\x12#\n" +
+	"\rendpoint_addr\x18\x01 \x01(\tR\fendpointAddr\x12(\n" +
+	"\x10http_status_code\x18\x02 \x01(\x05R\x0ehttpStatusCode\x12m\n" +
+	"\x1dsui_chain_identifier_response\x18\x03 \x01(\v2(.path.qos.MoveSuiChainIdentifierResponseH\x00R\x1asuiChainIdentifierResponse\x12p\n" +
+	"\x1esui_latest_checkpoint_response\x18\x04 \x01(\v2).path.qos.MoveSuiLatestCheckpointResponseH\x00R\x1bsuiLatestCheckpointResponse\x12d\n" +
+	"\x1aaptos_ledger_info_response\x18\x05 \x01(\v2%.path.qos.MoveAptosLedgerInfoResponseH\x00R\x17aptosLedgerInfoResponse\x12Y\n" +
+	"\x15unrecognized_response\x18\x06 \x01(\v2\".path.qos.MoveUnrecognizedResponseH\x00R\x14unrecognizedResponseB\x16\n" +
+	"\x14response_observation\"e\n" +
+	"\x1eMoveSuiChainIdentifierResponse\x12)\n" +
+	"\x10chain_identifier\x18\x01 \x01(\tR\x0fchainIdentifier\x12\x18\n" +
+	"\ainvalid\x18\x02 \x01(\bR\ainvalid\"y\n" +
+	"\x1fMoveSuiLatestCheckpointResponse\x12<\n" +
+	"\x1acheckpoint_sequence_number\x18\x01 \x01(\x04R\x18checkpointSequenceNumber\x12\x18\n" +
+	"\ainvalid\x18\x02 \x01(\bR\ainvalid\"\x9c\x01\n" +
+	"\x1bMoveAptosLedgerInfoResponse\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12%\n" +
+	"\x0eledger_version\x18\x02 \x01(\x04R\rledgerVersion\x12!\n" +
+	"\fblock_height\x18\x03 \x01(\x04R\vblockHeight\x12\x18\n" +
+	"\ainvalid\x18\x04 \x01(\bR\ainvalid\"\xe9\x01\n" +
+	"\x18MoveUnrecognizedResponse\x12I\n" +
+	"\x10jsonrpc_response\x18\x01 \x01(\v2\x19.path.qos.JsonRpcResponseH\x00R\x0fjsonrpcResponse\x88\x01\x01\x12X\n" +
+	"\x10validation_error\x18\x02 \x01(\v2(.path.qos.JsonRpcResponseValidationErrorH\x01R\x0fvalidationError\x88\x01\x01B\x13\n" +
+	"\x11_jsonrpc_responseB\x13\n" +
+	"\x11_validation_errorB0Z.github.com/buildwithgrove/path/observation/qosb\x06proto3"
+
+var (
+	file_path_qos_move_proto_rawDescOnce sync.Once
+	file_path_qos_move_proto_rawDescData []byte
+)
+
+func file_path_qos_move_proto_rawDescGZIP() []byte {
+	file_path_qos_move_proto_rawDescOnce.Do(func() {
+		file_path_qos_move_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_path_qos_move_proto_rawDesc), len(file_path_qos_move_proto_rawDesc)))
+	})
+	return file_path_qos_move_proto_rawDescData
+}
+
+var file_path_qos_move_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_path_qos_move_proto_goTypes = []any{
+	(*MoveRequestObservations)(nil),         // 0: path.qos.MoveRequestObservations
+	(*MoveEndpointObservation)(nil),         // 1: path.qos.MoveEndpointObservation
+	(*MoveSuiChainIdentifierResponse)(nil),  // 2: path.qos.MoveSuiChainIdentifierResponse
+	(*MoveSuiLatestCheckpointResponse)(nil), // 3: path.qos.MoveSuiLatestCheckpointResponse
+	(*MoveAptosLedgerInfoResponse)(nil),     // 4: path.qos.MoveAptosLedgerInfoResponse
+	(*MoveUnrecognizedResponse)(nil),        // 5: path.qos.MoveUnrecognizedResponse
+	(RequestOrigin)(0),                      // 6: path.qos.RequestOrigin
+	(*RequestError)(nil),                    // 7: path.qos.RequestError
+	(BackendServiceType)(0),                 // 8: path.qos.BackendServiceType
+	(*JsonRpcRequest)(nil),                  // 9: path.qos.JsonRpcRequest
+	(*RESTRequest)(nil),                     // 10: path.qos.RESTRequest
+	(*JsonRpcResponse)(nil),                 // 11: path.qos.JsonRpcResponse
+	(*JsonRpcResponseValidationError)(nil),  // 12: path.qos.JsonRpcResponseValidationError
+}
+var file_path_qos_move_proto_depIdxs = []int32{
+	6,  // 0: path.qos.MoveRequestObservations.request_origin:type_name -> path.qos.RequestOrigin
+	7,  // 1: path.qos.MoveRequestObservations.request_error:type_name -> path.qos.RequestError
+	8,  // 2: path.qos.MoveRequestObservations.backend_service_type:type_name -> path.qos.BackendServiceType
+	9,  // 3: path.qos.MoveRequestObservations.jsonrpc_request:type_name -> path.qos.JsonRpcRequest
+	10, // 4: path.qos.MoveRequestObservations.rest_request:type_name -> path.qos.RESTRequest
+	1,  // 5: path.qos.MoveRequestObservations.endpoint_observations:type_name -> path.qos.MoveEndpointObservation
+	2,  // 6: path.qos.MoveEndpointObservation.sui_chain_identifier_response:type_name -> path.qos.MoveSuiChainIdentifierResponse
+	3,  // 7: path.qos.MoveEndpointObservation.sui_latest_checkpoint_response:type_name -> path.qos.MoveSuiLatestCheckpointResponse
+	4,  // 8: path.qos.MoveEndpointObservation.aptos_ledger_info_response:type_name -> path.qos.MoveAptosLedgerInfoResponse
+	5,  // 9: path.qos.MoveEndpointObservation.unrecognized_response:type_name -> path.qos.MoveUnrecognizedResponse
+	11, // 10: path.qos.MoveUnrecognizedResponse.jsonrpc_response:type_name -> path.qos.JsonRpcResponse
+	12, // 11: path.qos.MoveUnrecognizedResponse.validation_error:type_name -> path.qos.JsonRpcResponseValidationError
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_path_qos_move_proto_init() }
+func file_path_qos_move_proto_init() {
+	if File_path_qos_move_proto != nil {
+		return
+	}
+	file_path_qos_cosmos_request_proto_init()
+	file_path_qos_jsonrpc_proto_init()
+	file_path_qos_request_origin_proto_init()
+	file_path_qos_request_error_proto_init()
+	file_path_qos_jsonrpc_validation_error_proto_init()
+	file_path_qos_move_proto_msgTypes[0].OneofWrappers = []any{
+		(*MoveRequestObservations_JsonrpcRequest)(nil),
+		(*MoveRequestObservations_RestRequest)(nil),
+	}
+	file_path_qos_move_proto_msgTypes[1].OneofWrappers = []any{
+		(*MoveEndpointObservation_SuiChainIdentifierResponse)(nil),
+		(*MoveEndpointObservation_SuiLatestCheckpointResponse)(nil),
+		(*MoveEndpointObservation_AptosLedgerInfoResponse)(nil),
+		(*MoveEndpointObservation_UnrecognizedResponse)(nil),
+	}
+	file_path_qos_move_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_path_qos_move_proto_rawDesc), len(file_path_qos_move_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_path_qos_move_proto_goTypes,
+		DependencyIndexes: file_path_qos_move_proto_depIdxs,
+		MessageInfos:      file_path_qos_move_proto_msgTypes,
+	}.Build()
+	File_path_qos_move_proto = out.File
+	file_path_qos_move_proto_goTypes = nil
+	file_path_qos_move_proto_depIdxs = nil
+}
@@ -0,0 +1,628 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: path/qos/near.proto
+
+package qos
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// NearErrorKind classifies a NEAR error by the party responsible for it.
+type NearErrorKind int32
+
+const (
+	NearErrorKind_NEAR_ERROR_KIND_UNSPECIFIED NearErrorKind = 0
+	// The error was caused by the user's request, e.g. an unknown account: the endpoint is not penalized.
+	NearErrorKind_NEAR_ERROR_KIND_USER NearErrorKind = 1
+	// The error was caused by the endpoint, e.g. it is not synced yet or failed internally.
+	NearErrorKind_NEAR_ERROR_KIND_ENDPOINT NearErrorKind = 2
+)
+
+// Enum value maps for NearErrorKind.
+var (
+	NearErrorKind_name = map[int32]string{
+		0: "NEAR_ERROR_KIND_UNSPECIFIED",
+		1: "NEAR_ERROR_KIND_USER",
+		2: "NEAR_ERROR_KIND_ENDPOINT",
+	}
+	NearErrorKind_value = map[string]int32{
+		"NEAR_ERROR_KIND_UNSPECIFIED": 0,
+		"NEAR_ERROR_KIND_USER":        1,
+		"NEAR_ERROR_KIND_ENDPOINT":    2,
+	}
+)
+
+func (x NearErrorKind) Enum() *NearErrorKind {
+	p := new(NearErrorKind)
+	*p = x
+	return p
+}
+
+func (x NearErrorKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NearErrorKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_path_qos_near_proto_enumTypes[0].Descriptor()
+}
+
+func (NearErrorKind) Type() protoreflect.EnumType {
+	return &file_path_qos_near_proto_enumTypes[0]
+}
+
+func (x NearErrorKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NearErrorKind.Descriptor instead.
+func (NearErrorKind) EnumDescriptor() ([]byte, []int) {
+	return file_path_qos_near_proto_rawDescGZIP(), []int{0}
+}
+
+// NearRequestObservations captures QoS data for a single NEAR blockchain service request,
+// including all observations made during potential retries.
+type NearRequestObservations struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// chain_id is the NEAR chain ID expected from endpoints, e.g. "mainnet".
+	// This is preset by the processor and not determined by the request.
+	// Used by metrics and data pipeline.
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// service_id is the identifier for the QoS implementation.
+	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// The length of the client's request payload, in bytes.
+	RequestPayloadLength uint32 `protobuf:"varint,3,opt,name=request_payload_length,json=requestPayloadLength,proto3" json:"request_payload_length,omitempty"`
+	// The origin of the request: user vs. QoS service (requests built by QoS for collecting data on endpoints)
+	RequestOrigin RequestOrigin `protobuf:"varint,4,opt,name=request_origin,json=requestOrigin,proto3,enum=path.qos.RequestOrigin" json:"request_origin,omitempty"`
+	// Tracks request errors, if any.
+	RequestError *RequestError `protobuf:"bytes,5,opt,name=request_error,json=requestError,proto3,oneof" json:"request_error,omitempty"`
+	// JSON-RPC request to the NEAR blockchain service.
+	// Only set if the HTTP request payload was successfully parsed into JSONRPC.
+	JsonrpcRequest *JsonRpcRequest `protobuf:"bytes,6,opt,name=jsonrpc_request,json=jsonrpcRequest,proto3,oneof" json:"jsonrpc_request,omitempty"`
+	// Multiple observations possible if:
+	// - Original endpoint returns invalid response
+	// - Retry mechanism activates
+	EndpointObservations []*NearEndpointObservation `protobuf:"bytes,7,rep,name=endpoint_observations,json=endpointObservations,proto3" json:"endpoint_observations,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *NearRequestObservations) Reset() {
+	*x = NearRequestObservations{}
+	mi := &file_path_qos_near_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearRequestObservations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearRequestObservations) ProtoMessage() {}
+
+func (x *NearRequestObservations) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_near_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearRequestObservations.ProtoReflect.Descriptor instead.
+func (*NearRequestObservations) Descriptor() ([]byte, []int) {
+	return file_path_qos_near_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NearRequestObservations) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *NearRequestObservations) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *NearRequestObservations) GetRequestPayloadLength() uint32 {
+	if x != nil {
+		return x.RequestPayloadLength
+	}
+	return 0
+}
+
+func (x *NearRequestObservations) GetRequestOrigin() RequestOrigin {
+	if x != nil {
+		return x.RequestOrigin
+	}
+	return RequestOrigin_REQUEST_ORIGIN_UNSPECIFIED
+}
+
+func (x *NearRequestObservations) GetRequestError() *RequestError {
+	if x != nil {
+		return x.RequestError
+	}
+	return nil
+}
+
+func (x *NearRequestObservations) GetJsonrpcRequest() *JsonRpcRequest {
+	if x != nil {
+		return x.JsonrpcRequest
+	}
+	return nil
+}
+
+func (x *NearRequestObservations) GetEndpointObservations() []*NearEndpointObservation {
+	if x != nil {
+		return x.EndpointObservations
+	}
+	return nil
+}
+
+// NearEndpointObservation captures a single endpoint's response to a request
+type NearEndpointObservation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Address of the endpoint handling the request
+	EndpointAddr string `protobuf:"bytes,1,opt,name=endpoint_addr,json=endpointAddr,proto3" json:"endpoint_addr,omitempty"`
+	// HTTP status code returned to the user.
+	// It is derived from either:
+	//   - The endpoint payload parsed as a JSONRPC response, and its NEAR error, if any.
+	//   - A generic JSONRPC error response if the endpoint payload fails to parse.
+	HttpStatusCode int32 `protobuf:"varint,2,opt,name=http_status_code,json=httpStatusCode,proto3" json:"http_status_code,omitempty"`
+	// Types that are valid to be assigned to ResponseObservation:
+	//
+	//	*NearEndpointObservation_StatusResponse
+	//	*NearEndpointObservation_UnrecognizedResponse
+	ResponseObservation isNearEndpointObservation_ResponseObservation `protobuf_oneof:"response_observation"`
+	// The NEAR error returned by the endpoint, if any.
+	NearError     *NearError `protobuf:"bytes,5,opt,name=near_error,json=nearError,proto3,oneof" json:"near_error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NearEndpointObservation) Reset() {
+	*x = NearEndpointObservation{}
+	mi := &file_path_qos_near_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearEndpointObservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearEndpointObservation) ProtoMessage() {}
+
+func (x *NearEndpointObservation) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_near_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearEndpointObservation.ProtoReflect.Descriptor instead.
+func (*NearEndpointObservation) Descriptor() ([]byte, []int) {
+	return file_path_qos_near_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NearEndpointObservation) GetEndpointAddr() string {
+	if x != nil {
+		return x.EndpointAddr
+	}
+	return ""
+}
+
+func (x *NearEndpointObservation) GetHttpStatusCode() int32 {
+	if x != nil {
+		return x.HttpStatusCode
+	}
+	return 0
+}
+
+func (x *NearEndpointObservation) GetResponseObservation() isNearEndpointObservation_ResponseObservation {
+	if x != nil {
+		return x.ResponseObservation
+	}
+	return nil
+}
+
+func (x *NearEndpointObservation) GetStatusResponse() *NearStatusResponse {
+	if x != nil {
+		if x, ok := x.ResponseObservation.(*NearEndpointObservation_StatusResponse); ok {
+			return x.StatusResponse
+		}
+	}
+	return nil
+}
+
+func (x *NearEndpointObservation) GetUnrecognizedResponse() *NearUnrecognizedResponse {
+	if x != nil {
+		if x, ok := x.ResponseObservation.(*NearEndpointObservation_UnrecognizedResponse); ok {
+			return x.UnrecognizedResponse
+		}
+	}
+	return nil
+}
+
+func (x *NearEndpointObservation) GetNearError() *NearError {
+	if x != nil {
+		return x.NearError
+	}
+	return nil
+}
+
+type isNearEndpointObservation_ResponseObservation interface {
+	isNearEndpointObservation_ResponseObservation()
+}
+
+type NearEndpointObservation_StatusResponse struct {
+	// Response from status
+	// Docs: https://docs.near.org/api/rpc/network#node-status
+	StatusResponse *NearStatusResponse `protobuf:"bytes,3,opt,name=status_response,json=statusResponse,proto3,oneof"`
+}
+
+type NearEndpointObservation_UnrecognizedResponse struct {
+	// Responses not used in endpoint validation (e.g., block, query)
+	UnrecognizedResponse *NearUnrecognizedResponse `protobuf:"bytes,4,opt,name=unrecognized_response,json=unrecognizedResponse,proto3,oneof"`
+}
+
+func (*NearEndpointObservation_StatusResponse) isNearEndpointObservation_ResponseObservation() {}
+
+func (*NearEndpointObservation_UnrecognizedResponse) isNearEndpointObservation_ResponseObservation() {
+}
+
+// NearStatusResponse stores the status response data used in endpoint validation.
+// Docs: https://docs.near.org/api/rpc/network#node-status
+type NearStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The chain ID of the endpoint, e.g. "mainnet".
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// The value of the `sync_info.latest_block_height` field.
+	LatestBlockHeight uint64 `protobuf:"varint,2,opt,name=latest_block_height,json=latestBlockHeight,proto3" json:"latest_block_height,omitempty"`
+	// The value of the `sync_info.syncing` field.
+	Syncing bool `protobuf:"varint,3,opt,name=syncing,proto3" json:"syncing,omitempty"`
+	// Set if the endpoint returned a JSON-RPC error or an unparsable result.
+	Invalid       bool `protobuf:"varint,4,opt,name=invalid,proto3" json:"invalid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NearStatusResponse) Reset() {
+	*x = NearStatusResponse{}
+	mi := &file_path_qos_near_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearStatusResponse) ProtoMessage() {}
+
+func (x *NearStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_near_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearStatusResponse.ProtoReflect.Descriptor instead.
+func (*NearStatusResponse) Descriptor() ([]byte, []int) {
+	return file_path_qos_near_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NearStatusResponse) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *NearStatusResponse) GetLatestBlockHeight() uint64 {
+	if x != nil {
+		return x.LatestBlockHeight
+	}
+	return 0
+}
+
+func (x *NearStatusResponse) GetSyncing() bool {
+	if x != nil {
+		return x.Syncing
+	}
+	return false
+}
+
+func (x *NearStatusResponse) GetInvalid() bool {
+	if x != nil {
+		return x.Invalid
+	}
+	return false
+}
+
+// NearUnrecognizedResponse stores responses from methods not used in validation
+// Examples: block, query, tx
+type NearUnrecognizedResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	JsonrpcResponse *JsonRpcResponse       `protobuf:"bytes,1,opt,name=jsonrpc_response,json=jsonrpcResponse,proto3" json:"jsonrpc_response,omitempty"`
+	// Optional validation error information
+	ValidationError *JsonRpcResponseValidationError `protobuf:"bytes,2,opt,name=validation_error,json=validationError,proto3,oneof" json:"validation_error,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NearUnrecognizedResponse) Reset() {
+	*x = NearUnrecognizedResponse{}
+	mi := &file_path_qos_near_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearUnrecognizedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearUnrecognizedResponse) ProtoMessage() {}
+
+func (x *NearUnrecognizedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_near_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearUnrecognizedResponse.ProtoReflect.Descriptor instead.
+func (*NearUnrecognizedResponse) Descriptor() ([]byte, []int) {
+	return file_path_qos_near_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *NearUnrecognizedResponse) GetJsonrpcResponse() *JsonRpcResponse {
+	if x != nil {
+		return x.JsonrpcResponse
+	}
+	return nil
+}
+
+func (x *NearUnrecognizedResponse) GetValidationError() *JsonRpcResponseValidationError {
+	if x != nil {
+		return x.ValidationError
+	}
+	return nil
+}
+
+// NearError captures NEAR's structured error, i.e. the `name` and `cause.name` fields of the JSON-RPC error object.
+// Docs: https://docs.near.org/api/rpc/setup#rpc-errors
+type NearError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The error type, e.g. "HANDLER_ERROR".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The name of the error cause, e.g. "UNKNOWN_BLOCK".
+	CauseName string `protobuf:"bytes,2,opt,name=cause_name,json=causeName,proto3" json:"cause_name,omitempty"`
+	// The classification of the error: caused by the user's request, or by the endpoint.
+	Kind NearErrorKind `protobuf:"varint,3,opt,name=kind,proto3,enum=path.qos.NearErrorKind" json:"kind,omitempty"`
+	// Timestamp when the error was received.
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NearError) Reset() {
+	*x = NearError{}
+	mi := &file_path_qos_near_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearError) ProtoMessage() {}
+
+func (x *NearError) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_near_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearError.ProtoReflect.Descriptor instead.
+func (*NearError) Descriptor() ([]byte, []int) {
+	return file_path_qos_near_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NearError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NearError) GetCauseName() string {
+	if x != nil {
+		return x.CauseName
+	}
+	return ""
+}
+
+func (x *NearError) GetKind() NearErrorKind {
+	if x != nil {
+		return x.Kind
+	}
+	return NearErrorKind_NEAR_ERROR_KIND_UNSPECIFIED
+}
+
+func (x *NearError) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+var File_path_qos_near_proto protoreflect.FileDescriptor
+
+const file_path_qos_near_proto_rawDesc = "" +
+	"\n" +
+	"\x13path/qos/near.proto\x12\bpath.qos\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16path/qos/jsonrpc.proto\x1a\x1dpath/qos/request_origin.proto\x1a\x1cpath/qos/request_error.proto\x1a'path/qos/jsonrpc_validation_error.proto\"\xd1\x03\n" +
+	"\x17NearRequestObservations\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x02 \x01(\tR\tserviceId\x124\n" +
+	"\x16request_payload_length\x18\x03 \x01(\rR\x14requestPayloadLength\x12>\n" +
+	"\x0erequest_origin\x18\x04 \x01(\x0e2\x17.path.qos.RequestOriginR\rrequestOrigin\x12@\n" +
+	"\rrequest_error\x18\x05 \x01(\v2\x16.path.qos.RequestErrorH\x00R\frequestError\x88\x01\x01\x12F\n" +
+	"\x0fjsonrpc_request\x18\x06 \x01(\v2\x18.path.qos.JsonRpcRequestH\x01R\x0ejsonrpcRequest\x88\x01\x01\x12V\n" +
+	"\x15endpoint_observations\x18\a \x03(\v2!.path.qos.NearEndpointObservationR\x14endpointObservationsB\x10\n" +
+	"\x0e_request_errorB\x12\n" +
+	"\x10_jsonrpc_request\"\xec\x02\n" +
+	"\x17NearEndpointObservation\x12#\n" +
+	"\rendpoint_addr\x18\x01 \x01(\tR\fendpointAddr\x12(\n" +
+	"\x10http_status_code\x18\x02 \x01(\x05R\x0ehttpStatusCode\x12G\n" +
+	"\x0fstatus_response\x18\x03 \x01(\v2\x1c.path.qos.NearStatusResponseH\x00R\x0estatusResponse\x12Y\n" +
+	"\x15unrecognized_response\x18\x04 \x01(\v2\".path.qos.NearUnrecognizedResponseH\x00R\x14unrecognizedResponse\x127\n" +
+	"\n" +
+	"near_error\x18\x05 \x01(\v2\x13.path.qos.NearErrorH\x01R\tnearError\x88\x01\x01B\x16\n" +
+	"\x14response_observationB\r\n" +
+	"\v_near_error\"\x93\x01\n" +
+	"\x12NearStatusResponse\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12.\n" +
+	"\x13latest_block_height\x18\x02 \x01(\x04R\x11latestBlockHeight\x12\x18\n" +
+	"\asyncing\x18\x03 \x01(\bR\asyncing\x12\x18\n" +
+	"\ainvalid\x18\x04 \x01(\bR\ainvalid\"\xcf\x01\n" +
+	"\x18NearUnrecognizedResponse\x12D\n" +
+	"\x10jsonrpc_response\x18\x01 \x01(\v2\x19.path.qos.JsonRpcResponseR\x0fjsonrpcResponse\x12X\n" +
+	"\x10validation_error\x18\x02 \x01(\v2(.path.qos.JsonRpcResponseValidationErrorH\x00R\x0fvalidationError\x88\x01\x01B\x13\n" +
+	"\x11_validation_error\"\xa5\x01\n" +
+	"\tNearError\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"cause_name\x18\x02 \x01(\tR\tcauseName\x12+\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x17.path.qos.NearErrorKindR\x04kind\x128\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp*h\n" +
+	"\rNearErrorKind\x12\x1f\n" +
+	"\x1bNEAR_ERROR_KIND_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14NEAR_ERROR_KIND_USER\x10\x01\x12\x1c\n" +
+	"\x18NEAR_ERROR_KIND_ENDPOINT\x10\x02B0Z.github.com/buildwithgrove/path/observation/qosb\x06proto3"
+
+var (
+	file_path_qos_near_proto_rawDescOnce sync.Once
+	file_path_qos_near_proto_rawDescData []byte
+)
+
+func file_path_qos_near_proto_rawDescGZIP() []byte {
+	file_path_qos_near_proto_rawDescOnce.Do(func() {
+		file_path_qos_near_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_path_qos_near_proto_rawDesc), len(file_path_qos_near_proto_rawDesc)))
+	})
+	return file_path_qos_near_proto_rawDescData
+}
+
+var file_path_qos_near_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_path_qos_near_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_path_qos_near_proto_goTypes = []any{
+	(NearErrorKind)(0),                     // 0: path.qos.NearErrorKind
+	(*NearRequestObservations)(nil),        // 1: path.qos.NearRequestObservations
+	(*NearEndpointObservation)(nil),        // 2: path.qos.NearEndpointObservation
+	(*NearStatusResponse)(nil),             // 3: path.qos.NearStatusResponse
+	(*NearUnrecognizedResponse)(nil),       // 4: path.qos.NearUnrecognizedResponse
+	(*NearError)(nil),                      // 5: path.qos.NearError
+	(RequestOrigin)(0),                     // 6: path.qos.RequestOrigin
+	(*RequestError)(nil),                   // 7: path.qos.RequestError
+	(*JsonRpcRequest)(nil),                 // 8: path.qos.JsonRpcRequest
+	(*JsonRpcResponse)(nil),                // 9: path.qos.JsonRpcResponse
+	(*JsonRpcResponseValidationError)(nil), // 10: path.qos.JsonRpcResponseValidationError
+	(*timestamppb.Timestamp)(nil),          // 11: google.protobuf.Timestamp
+}
+var file_path_qos_near_proto_depIdxs = []int32{
+	6,  // 0: path.qos.NearRequestObservations.request_origin:type_name -> path.qos.RequestOrigin
+	7,  // 1: path.qos.NearRequestObservations.request_error:type_name -> path.qos.RequestError
+	8,  // 2: path.qos.NearRequestObservations.jsonrpc_request:type_name -> path.qos.JsonRpcRequest
+	2,  // 3: path.qos.NearRequestObservations.endpoint_observations:type_name -> path.qos.NearEndpointObservation
+	3,  // 4: path.qos.NearEndpointObservation.status_response:type_name -> path.qos.NearStatusResponse
+	4,  // 5: path.qos.NearEndpointObservation.unrecognized_response:type_name -> path.qos.NearUnrecognizedResponse
+	5,  // 6: path.qos.NearEndpointObservation.near_error:type_name -> path.qos.NearError
+	9,  // 7: path.qos.NearUnrecognizedResponse.jsonrpc_response:type_name -> path.qos.JsonRpcResponse
+	10, // 8: path.qos.NearUnrecognizedResponse.validation_error:type_name -> path.qos.JsonRpcResponseValidationError
+	0,  // 9: path.qos.NearError.kind:type_name -> path.qos.NearErrorKind
+	11, // 10: path.qos.NearError.timestamp:type_name -> google.protobuf.Timestamp
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_path_qos_near_proto_init() }
+func file_path_qos_near_proto_init() {
+	if File_path_qos_near_proto != nil {
+		return
+	}
+	file_path_qos_jsonrpc_proto_init()
+	file_path_qos_request_origin_proto_init()
+	file_path_qos_request_error_proto_init()
+	file_path_qos_jsonrpc_validation_error_proto_init()
+	file_path_qos_near_proto_msgTypes[0].OneofWrappers = []any{}
+	file_path_qos_near_proto_msgTypes[1].OneofWrappers = []any{
+		(*NearEndpointObservation_StatusResponse)(nil),
+		(*NearEndpointObservation_UnrecognizedResponse)(nil),
+	}
+	file_path_qos_near_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_path_qos_near_proto_rawDesc), len(file_path_qos_near_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_path_qos_near_proto_goTypes,
+		DependencyIndexes: file_path_qos_near_proto_depIdxs,
+		EnumInfos:         file_path_qos_near_proto_enumTypes,
+		MessageInfos:      file_path_qos_near_proto_msgTypes,
+	}.Build()
+	File_path_qos_near_proto = out.File
+	file_path_qos_near_proto_goTypes = nil
+	file_path_qos_near_proto_depIdxs = nil
+}
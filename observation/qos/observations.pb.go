@@ -25,6 +25,12 @@ const (
 // Currently supports:
 // - Solana blockchain service
 // - EVM blockchains service
+// - CosmosSDK blockchains service
+// - JSON-RPC services using the generic JSON-RPC QoS
+// - REST services using the generic REST QoS
+// - Bitcoin-family (UTXO) blockchains service
+// - NEAR blockchain service
+// - Move-based blockchains service, e.g. Sui or Aptos
 type Observations struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// service_observations contains QoS measurements specific to the service type
@@ -34,6 +40,11 @@ type Observations struct {
 	//	*Observations_Solana
 	//	*Observations_Evm
 	//	*Observations_Cosmos
+	//	*Observations_GenericJsonrpc
+	//	*Observations_GenericRest
+	//	*Observations_Utxo
+	//	*Observations_Near
+	//	*Observations_Move
 	ServiceObservations isObservations_ServiceObservations `protobuf_oneof:"service_observations"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
@@ -103,6 +114,51 @@ func (x *Observations) GetCosmos() *CosmosRequestObservations {
 	return nil
 }
 
+func (x *Observations) GetGenericJsonrpc() *GenericJsonRpcRequestObservations {
+	if x != nil {
+		if x, ok := x.ServiceObservations.(*Observations_GenericJsonrpc); ok {
+			return x.GenericJsonrpc
+		}
+	}
+	return nil
+}
+
+func (x *Observations) GetGenericRest() *GenericRestRequestObservations {
+	if x != nil {
+		if x, ok := x.ServiceObservations.(*Observations_GenericRest); ok {
+			return x.GenericRest
+		}
+	}
+	return nil
+}
+
+func (x *Observations) GetUtxo() *UTXORequestObservations {
+	if x != nil {
+		if x, ok := x.ServiceObservations.(*Observations_Utxo); ok {
+			return x.Utxo
+		}
+	}
+	return nil
+}
+
+func (x *Observations) GetNear() *NearRequestObservations {
+	if x != nil {
+		if x, ok := x.ServiceObservations.(*Observations_Near); ok {
+			return x.Near
+		}
+	}
+	return nil
+}
+
+func (x *Observations) GetMove() *MoveRequestObservations {
+	if x != nil {
+		if x, ok := x.ServiceObservations.(*Observations_Move); ok {
+			return x.Move
+		}
+	}
+	return nil
+}
+
 type isObservations_ServiceObservations interface {
 	isObservations_ServiceObservations()
 }
@@ -122,21 +178,61 @@ type Observations_Cosmos struct {
 	Cosmos *CosmosRequestObservations `protobuf:"bytes,3,opt,name=cosmos,proto3,oneof"`
 }
 
+type Observations_GenericJsonrpc struct {
+	// generic_jsonrpc contains QoS measurements for a single request to a service using the generic JSON-RPC QoS
+	GenericJsonrpc *GenericJsonRpcRequestObservations `protobuf:"bytes,4,opt,name=generic_jsonrpc,json=genericJsonrpc,proto3,oneof"`
+}
+
+type Observations_GenericRest struct {
+	// generic_rest contains QoS measurements for a single request to a service using the generic REST QoS
+	GenericRest *GenericRestRequestObservations `protobuf:"bytes,5,opt,name=generic_rest,json=genericRest,proto3,oneof"`
+}
+
+type Observations_Utxo struct {
+	// utxo contains QoS measurements for a single Bitcoin-family (UTXO) blockchain request
+	Utxo *UTXORequestObservations `protobuf:"bytes,6,opt,name=utxo,proto3,oneof"`
+}
+
+type Observations_Near struct {
+	// near contains QoS measurements for a single NEAR blockchain request
+	Near *NearRequestObservations `protobuf:"bytes,7,opt,name=near,proto3,oneof"`
+}
+
+type Observations_Move struct {
+	// move contains QoS measurements for a single Move-based blockchain request, e.g. Sui or Aptos
+	Move *MoveRequestObservations `protobuf:"bytes,8,opt,name=move,proto3,oneof"`
+}
+
 func (*Observations_Solana) isObservations_ServiceObservations() {}
 
 func (*Observations_Evm) isObservations_ServiceObservations() {}
 
 func (*Observations_Cosmos) isObservations_ServiceObservations() {}
 
+func (*Observations_GenericJsonrpc) isObservations_ServiceObservations() {}
+
+func (*Observations_GenericRest) isObservations_ServiceObservations() {}
+
+func (*Observations_Utxo) isObservations_ServiceObservations() {}
+
+func (*Observations_Near) isObservations_ServiceObservations() {}
+
+func (*Observations_Move) isObservations_ServiceObservations() {}
+
 var File_path_qos_observations_proto protoreflect.FileDescriptor
 
 const file_path_qos_observations_proto_rawDesc = "" +
 	"\n" +
-	"\x1bpath/qos/observations.proto\x12\bpath.qos\x1a\x12path/qos/evm.proto\x1a\x15path/qos/solana.proto\x1a\x15path/qos/cosmos.proto\"\xda\x01\n" +
+	"\x1bpath/qos/observations.proto\x12\bpath.qos\x1a\x12path/qos/evm.proto\x1a\x15path/qos/solana.proto\x1a\x15path/qos/cosmos.proto\x1a\x1epath/qos/generic_jsonrpc.proto\x1a\x1bpath/qos/generic_rest.proto\x1a\x13path/qos/utxo.proto\x1a\x13path/qos/near.proto\x1a\x13path/qos/move.proto\"\xac\x04\n" +
 	"\fObservations\x12=\n" +
 	"\x06solana\x18\x01 \x01(\v2#.path.qos.SolanaRequestObservationsH\x00R\x06solana\x124\n" +
 	"\x03evm\x18\x02 \x01(\v2 .path.qos.EVMRequestObservationsH\x00R\x03evm\x12=\n" +
-	"\x06cosmos\x18\x03 \x01(\v2#.path.qos.CosmosRequestObservationsH\x00R\x06cosmosB\x16\n" +
+	"\x06cosmos\x18\x03 \x01(\v2#.path.qos.CosmosRequestObservationsH\x00R\x06cosmos\x12V\n" +
+	"\x0fgeneric_jsonrpc\x18\x04 \x01(\v2+.path.qos.GenericJsonRpcRequestObservationsH\x00R\x0egenericJsonrpc\x12M\n" +
+	"\fgeneric_rest\x18\x05 \x01(\v2(.path.qos.GenericRestRequestObservationsH\x00R\vgenericRest\x127\n" +
+	"\x04utxo\x18\x06 \x01(\v2!.path.qos.UTXORequestObservationsH\x00R\x04utxo\x127\n" +
+	"\x04near\x18\a \x01(\v2!.path.qos.NearRequestObservationsH\x00R\x04near\x127\n" +
+	"\x04move\x18\b \x01(\v2!.path.qos.MoveRequestObservationsH\x00R\x04moveB\x16\n" +
 	"\x14service_observationsB0Z.github.com/buildwithgrove/path/observation/qosb\x06proto3"
 
 var (
@@ -153,20 +249,30 @@ func file_path_qos_observations_proto_rawDescGZIP() []byte {
 
 var file_path_qos_observations_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_path_qos_observations_proto_goTypes = []any{
-	(*Observations)(nil),              // 0: path.qos.Observations
-	(*SolanaRequestObservations)(nil), // 1: path.qos.SolanaRequestObservations
-	(*EVMRequestObservations)(nil),    // 2: path.qos.EVMRequestObservations
-	(*CosmosRequestObservations)(nil), // 3: path.qos.CosmosRequestObservations
+	(*Observations)(nil),                      // 0: path.qos.Observations
+	(*SolanaRequestObservations)(nil),         // 1: path.qos.SolanaRequestObservations
+	(*EVMRequestObservations)(nil),            // 2: path.qos.EVMRequestObservations
+	(*CosmosRequestObservations)(nil),         // 3: path.qos.CosmosRequestObservations
+	(*GenericJsonRpcRequestObservations)(nil), // 4: path.qos.GenericJsonRpcRequestObservations
+	(*GenericRestRequestObservations)(nil),    // 5: path.qos.GenericRestRequestObservations
+	(*UTXORequestObservations)(nil),           // 6: path.qos.UTXORequestObservations
+	(*NearRequestObservations)(nil),           // 7: path.qos.NearRequestObservations
+	(*MoveRequestObservations)(nil),           // 8: path.qos.MoveRequestObservations
 }
 var file_path_qos_observations_proto_depIdxs = []int32{
 	1, // 0: path.qos.Observations.solana:type_name -> path.qos.SolanaRequestObservations
 	2, // 1: path.qos.Observations.evm:type_name -> path.qos.EVMRequestObservations
 	3, // 2: path.qos.Observations.cosmos:type_name -> path.qos.CosmosRequestObservations
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 3: path.qos.Observations.generic_jsonrpc:type_name -> path.qos.GenericJsonRpcRequestObservations
+	5, // 4: path.qos.Observations.generic_rest:type_name -> path.qos.GenericRestRequestObservations
+	6, // 5: path.qos.Observations.utxo:type_name -> path.qos.UTXORequestObservations
+	7, // 6: path.qos.Observations.near:type_name -> path.qos.NearRequestObservations
+	8, // 7: path.qos.Observations.move:type_name -> path.qos.MoveRequestObservations
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_path_qos_observations_proto_init() }
@@ -177,10 +283,20 @@ func file_path_qos_observations_proto_init() {
 	file_path_qos_evm_proto_init()
 	file_path_qos_solana_proto_init()
 	file_path_qos_cosmos_proto_init()
+	file_path_qos_generic_jsonrpc_proto_init()
+	file_path_qos_generic_rest_proto_init()
+	file_path_qos_utxo_proto_init()
+	file_path_qos_near_proto_init()
+	file_path_qos_move_proto_init()
 	file_path_qos_observations_proto_msgTypes[0].OneofWrappers = []any{
 		(*Observations_Solana)(nil),
 		(*Observations_Evm)(nil),
 		(*Observations_Cosmos)(nil),
+		(*Observations_GenericJsonrpc)(nil),
+		(*Observations_GenericRest)(nil),
+		(*Observations_Utxo)(nil),
+		(*Observations_Near)(nil),
+		(*Observations_Move)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -0,0 +1,148 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: path/qos/service_stall.proto
+
+package qos
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ServiceStallObservation captures whether a service's perceived block height has stopped advancing.
+// e.g. a chain halt, or all endpoints stuck at the same height.
+type ServiceStallObservation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the perceived block height has not advanced for longer than the stall threshold.
+	Stalled bool `protobuf:"varint,1,opt,name=stalled,proto3" json:"stalled,omitempty"`
+	// The perceived block height of the service.
+	PerceivedHeight uint64 `protobuf:"varint,2,opt,name=perceived_height,json=perceivedHeight,proto3" json:"perceived_height,omitempty"`
+	// The time at which the perceived block height last advanced.
+	LastAdvancedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_advanced_at,json=lastAdvancedAt,proto3" json:"last_advanced_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ServiceStallObservation) Reset() {
+	*x = ServiceStallObservation{}
+	mi := &file_path_qos_service_stall_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceStallObservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceStallObservation) ProtoMessage() {}
+
+func (x *ServiceStallObservation) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_service_stall_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceStallObservation.ProtoReflect.Descriptor instead.
+func (*ServiceStallObservation) Descriptor() ([]byte, []int) {
+	return file_path_qos_service_stall_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ServiceStallObservation) GetStalled() bool {
+	if x != nil {
+		return x.Stalled
+	}
+	return false
+}
+
+func (x *ServiceStallObservation) GetPerceivedHeight() uint64 {
+	if x != nil {
+		return x.PerceivedHeight
+	}
+	return 0
+}
+
+func (x *ServiceStallObservation) GetLastAdvancedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAdvancedAt
+	}
+	return nil
+}
+
+var File_path_qos_service_stall_proto protoreflect.FileDescriptor
+
+const file_path_qos_service_stall_proto_rawDesc = "" +
+	"\n" +
+	"\x1cpath/qos/service_stall.proto\x12\bpath.qos\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa4\x01\n" +
+	"\x17ServiceStallObservation\x12\x18\n" +
+	"\astalled\x18\x01 \x01(\bR\astalled\x12)\n" +
+	"\x10perceived_height\x18\x02 \x01(\x04R\x0fperceivedHeight\x12D\n" +
+	"\x10last_advanced_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastAdvancedAtB0Z.github.com/buildwithgrove/path/observation/qosb\x06proto3"
+
+var (
+	file_path_qos_service_stall_proto_rawDescOnce sync.Once
+	file_path_qos_service_stall_proto_rawDescData []byte
+)
+
+func file_path_qos_service_stall_proto_rawDescGZIP() []byte {
+	file_path_qos_service_stall_proto_rawDescOnce.Do(func() {
+		file_path_qos_service_stall_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_path_qos_service_stall_proto_rawDesc), len(file_path_qos_service_stall_proto_rawDesc)))
+	})
+	return file_path_qos_service_stall_proto_rawDescData
+}
+
+var file_path_qos_service_stall_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_path_qos_service_stall_proto_goTypes = []any{
+	(*ServiceStallObservation)(nil), // 0: path.qos.ServiceStallObservation
+	(*timestamppb.Timestamp)(nil),   // 1: google.protobuf.Timestamp
+}
+var file_path_qos_service_stall_proto_depIdxs = []int32{
+	1, // 0: path.qos.ServiceStallObservation.last_advanced_at:type_name -> google.protobuf.Timestamp
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_path_qos_service_stall_proto_init() }
+func file_path_qos_service_stall_proto_init() {
+	if File_path_qos_service_stall_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_path_qos_service_stall_proto_rawDesc), len(file_path_qos_service_stall_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_path_qos_service_stall_proto_goTypes,
+		DependencyIndexes: file_path_qos_service_stall_proto_depIdxs,
+		MessageInfos:      file_path_qos_service_stall_proto_msgTypes,
+	}.Build()
+	File_path_qos_service_stall_proto = out.File
+	file_path_qos_service_stall_proto_goTypes = nil
+	file_path_qos_service_stall_proto_depIdxs = nil
+}
@@ -47,8 +47,11 @@ type SolanaRequestObservations struct {
 	// - Original endpoint returns invalid response
 	// - Retry mechanism activates
 	EndpointObservations []*SolanaEndpointObservation `protobuf:"bytes,7,rep,name=endpoint_observations,json=endpointObservations,proto3" json:"endpoint_observations,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// Stall state of the service, i.e. whether the perceived block height has stopped advancing.
+	// Only set if stall detection is enabled for the service.
+	ServiceStall  *ServiceStallObservation `protobuf:"bytes,8,opt,name=service_stall,json=serviceStall,proto3,oneof" json:"service_stall,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SolanaRequestObservations) Reset() {
@@ -130,6 +133,13 @@ func (x *SolanaRequestObservations) GetEndpointObservations() []*SolanaEndpointO
 	return nil
 }
 
+func (x *SolanaRequestObservations) GetServiceStall() *ServiceStallObservation {
+	if x != nil {
+		return x.ServiceStall
+	}
+	return nil
+}
+
 // TODO_MVP(@adshmh): add unmarshaling error tracker to endpoint observations.
 //
 // SolanaEndpointObservation captures a single endpoint's response to a request
@@ -147,6 +157,8 @@ type SolanaEndpointObservation struct {
 	//	*SolanaEndpointObservation_GetEpochInfoResponse
 	//	*SolanaEndpointObservation_GetHealthResponse
 	//	*SolanaEndpointObservation_UnrecognizedResponse
+	//	*SolanaEndpointObservation_GetSlotResponse
+	//	*SolanaEndpointObservation_GetBlockResponse
 	ResponseObservation isSolanaEndpointObservation_ResponseObservation `protobuf_oneof:"response_observation"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
@@ -230,6 +242,24 @@ func (x *SolanaEndpointObservation) GetUnrecognizedResponse() *SolanaUnrecognize
 	return nil
 }
 
+func (x *SolanaEndpointObservation) GetGetSlotResponse() *SolanaGetSlotResponse {
+	if x != nil {
+		if x, ok := x.ResponseObservation.(*SolanaEndpointObservation_GetSlotResponse); ok {
+			return x.GetSlotResponse
+		}
+	}
+	return nil
+}
+
+func (x *SolanaEndpointObservation) GetGetBlockResponse() *SolanaGetBlockResponse {
+	if x != nil {
+		if x, ok := x.ResponseObservation.(*SolanaEndpointObservation_GetBlockResponse); ok {
+			return x.GetBlockResponse
+		}
+	}
+	return nil
+}
+
 type isSolanaEndpointObservation_ResponseObservation interface {
 	isSolanaEndpointObservation_ResponseObservation()
 }
@@ -251,6 +281,18 @@ type SolanaEndpointObservation_UnrecognizedResponse struct {
 	UnrecognizedResponse *SolanaUnrecognizedResponse `protobuf:"bytes,5,opt,name=unrecognized_response,json=unrecognizedResponse,proto3,oneof"`
 }
 
+type SolanaEndpointObservation_GetSlotResponse struct {
+	// Response from getSlot, at a specific commitment level
+	// Docs: https://solana.com/docs/rpc/http/getslot
+	GetSlotResponse *SolanaGetSlotResponse `protobuf:"bytes,6,opt,name=get_slot_response,json=getSlotResponse,proto3,oneof"`
+}
+
+type SolanaEndpointObservation_GetBlockResponse struct {
+	// Response from getBlock, used to verify the endpoint serves historical (archival) blocks
+	// Docs: https://solana.com/docs/rpc/http/getblock
+	GetBlockResponse *SolanaGetBlockResponse `protobuf:"bytes,7,opt,name=get_block_response,json=getBlockResponse,proto3,oneof"`
+}
+
 func (*SolanaEndpointObservation_GetEpochInfoResponse) isSolanaEndpointObservation_ResponseObservation() {
 }
 
@@ -260,6 +302,11 @@ func (*SolanaEndpointObservation_GetHealthResponse) isSolanaEndpointObservation_
 func (*SolanaEndpointObservation_UnrecognizedResponse) isSolanaEndpointObservation_ResponseObservation() {
 }
 
+func (*SolanaEndpointObservation_GetSlotResponse) isSolanaEndpointObservation_ResponseObservation() {}
+
+func (*SolanaEndpointObservation_GetBlockResponse) isSolanaEndpointObservation_ResponseObservation() {
+}
+
 // SolanaEpochInfoResponse stores getEpochInfo response data
 // Docs: https://solana.com/docs/rpc/http/getepochinfo
 type SolanaGetEpochInfoResponse struct {
@@ -318,10 +365,13 @@ func (x *SolanaGetEpochInfoResponse) GetEpoch() uint64 {
 // SolanaGetHealthResponse stores getHealth response data
 // Docs: https://solana.com/docs/rpc/http/gethealth
 type SolanaGetHealthResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Result string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// The number of slots the endpoint reported being behind by, parsed from the `data.numSlotsBehind` field of an unhealthy response.
+	// Only set if the endpoint responded with a "Node is behind by N slots" error.
+	NumSlotsBehind *uint64 `protobuf:"varint,2,opt,name=num_slots_behind,json=numSlotsBehind,proto3,oneof" json:"num_slots_behind,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *SolanaGetHealthResponse) Reset() {
@@ -361,6 +411,127 @@ func (x *SolanaGetHealthResponse) GetResult() string {
 	return ""
 }
 
+func (x *SolanaGetHealthResponse) GetNumSlotsBehind() uint64 {
+	if x != nil && x.NumSlotsBehind != nil {
+		return *x.NumSlotsBehind
+	}
+	return 0
+}
+
+// SolanaGetSlotResponse stores getSlot response data
+// Docs: https://solana.com/docs/rpc/http/getslot
+type SolanaGetSlotResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The commitment level of the request: "processed", "confirmed" or "finalized".
+	// Defaults to "finalized" if not set in the request.
+	Commitment string `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// The slot returned by the endpoint. 0 if the endpoint returned an error or an unparsable result.
+	Slot          uint64 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SolanaGetSlotResponse) Reset() {
+	*x = SolanaGetSlotResponse{}
+	mi := &file_path_qos_solana_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SolanaGetSlotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolanaGetSlotResponse) ProtoMessage() {}
+
+func (x *SolanaGetSlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_solana_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolanaGetSlotResponse.ProtoReflect.Descriptor instead.
+func (*SolanaGetSlotResponse) Descriptor() ([]byte, []int) {
+	return file_path_qos_solana_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SolanaGetSlotResponse) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+func (x *SolanaGetSlotResponse) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+// SolanaGetBlockResponse stores the availability of a block, as reported by an endpoint's getBlock response.
+// Docs: https://solana.com/docs/rpc/http/getblock
+type SolanaGetBlockResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The slot of the requested block.
+	Slot uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	// Set if the endpoint returned the block, or reported the slot as skipped in long-term storage.
+	// Not set if the endpoint has pruned the block, e.g. a non-archival node.
+	Available     bool `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SolanaGetBlockResponse) Reset() {
+	*x = SolanaGetBlockResponse{}
+	mi := &file_path_qos_solana_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SolanaGetBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolanaGetBlockResponse) ProtoMessage() {}
+
+func (x *SolanaGetBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_solana_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolanaGetBlockResponse.ProtoReflect.Descriptor instead.
+func (*SolanaGetBlockResponse) Descriptor() ([]byte, []int) {
+	return file_path_qos_solana_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SolanaGetBlockResponse) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *SolanaGetBlockResponse) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
 // SolanaUnrecognizedResponse stores responses from methods not used in validation
 // Examples: getTokenSupply, getTransaction
 type SolanaUnrecognizedResponse struct {
@@ -374,7 +545,7 @@ type SolanaUnrecognizedResponse struct {
 
 func (x *SolanaUnrecognizedResponse) Reset() {
 	*x = SolanaUnrecognizedResponse{}
-	mi := &file_path_qos_solana_proto_msgTypes[4]
+	mi := &file_path_qos_solana_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -386,7 +557,7 @@ func (x *SolanaUnrecognizedResponse) String() string {
 func (*SolanaUnrecognizedResponse) ProtoMessage() {}
 
 func (x *SolanaUnrecognizedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_path_qos_solana_proto_msgTypes[4]
+	mi := &file_path_qos_solana_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -399,7 +570,7 @@ func (x *SolanaUnrecognizedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SolanaUnrecognizedResponse.ProtoReflect.Descriptor instead.
 func (*SolanaUnrecognizedResponse) Descriptor() ([]byte, []int) {
-	return file_path_qos_solana_proto_rawDescGZIP(), []int{4}
+	return file_path_qos_solana_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SolanaUnrecognizedResponse) GetJsonrpcResponse() *JsonRpcResponse {
@@ -420,7 +591,7 @@ var File_path_qos_solana_proto protoreflect.FileDescriptor
 
 const file_path_qos_solana_proto_rawDesc = "" +
 	"\n" +
-	"\x15path/qos/solana.proto\x12\bpath.qos\x1a\x16path/qos/jsonrpc.proto\x1a\x1dpath/qos/request_origin.proto\x1a\x1cpath/qos/request_error.proto\x1a'path/qos/jsonrpc_validation_error.proto\"\xd5\x03\n" +
+	"\x15path/qos/solana.proto\x12\bpath.qos\x1a\x16path/qos/jsonrpc.proto\x1a\x1dpath/qos/request_origin.proto\x1a\x1cpath/qos/request_error.proto\x1a\x1cpath/qos/service_stall.proto\x1a'path/qos/jsonrpc_validation_error.proto\"\xb4\x04\n" +
 	"\x19SolanaRequestObservations\x12\x19\n" +
 	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x1d\n" +
 	"\n" +
@@ -429,21 +600,35 @@ const file_path_qos_solana_proto_rawDesc = "" +
 	"\x0erequest_origin\x18\x04 \x01(\x0e2\x17.path.qos.RequestOriginR\rrequestOrigin\x12@\n" +
 	"\rrequest_error\x18\x05 \x01(\v2\x16.path.qos.RequestErrorH\x00R\frequestError\x88\x01\x01\x12F\n" +
 	"\x0fjsonrpc_request\x18\x06 \x01(\v2\x18.path.qos.JsonRpcRequestH\x01R\x0ejsonrpcRequest\x88\x01\x01\x12X\n" +
-	"\x15endpoint_observations\x18\a \x03(\v2#.path.qos.SolanaEndpointObservationR\x14endpointObservationsB\x10\n" +
+	"\x15endpoint_observations\x18\a \x03(\v2#.path.qos.SolanaEndpointObservationR\x14endpointObservations\x12K\n" +
+	"\rservice_stall\x18\b \x01(\v2!.path.qos.ServiceStallObservationH\x02R\fserviceStall\x88\x01\x01B\x10\n" +
 	"\x0e_request_errorB\x12\n" +
-	"\x10_jsonrpc_request\"\x93\x03\n" +
+	"\x10_jsonrpc_requestB\x10\n" +
+	"\x0e_service_stall\"\xb4\x04\n" +
 	"\x19SolanaEndpointObservation\x12#\n" +
 	"\rendpoint_addr\x18\x01 \x01(\tR\fendpointAddr\x12(\n" +
 	"\x10http_status_code\x18\x02 \x01(\x05R\x0ehttpStatusCode\x12]\n" +
 	"\x17get_epoch_info_response\x18\x03 \x01(\v2$.path.qos.SolanaGetEpochInfoResponseH\x00R\x14getEpochInfoResponse\x12S\n" +
 	"\x13get_health_response\x18\x04 \x01(\v2!.path.qos.SolanaGetHealthResponseH\x00R\x11getHealthResponse\x12[\n" +
-	"\x15unrecognized_response\x18\x05 \x01(\v2$.path.qos.SolanaUnrecognizedResponseH\x00R\x14unrecognizedResponseB\x16\n" +
+	"\x15unrecognized_response\x18\x05 \x01(\v2$.path.qos.SolanaUnrecognizedResponseH\x00R\x14unrecognizedResponse\x12M\n" +
+	"\x11get_slot_response\x18\x06 \x01(\v2\x1f.path.qos.SolanaGetSlotResponseH\x00R\x0fgetSlotResponse\x12P\n" +
+	"\x12get_block_response\x18\a \x01(\v2 .path.qos.SolanaGetBlockResponseH\x00R\x10getBlockResponseB\x16\n" +
 	"\x14response_observation\"U\n" +
 	"\x1aSolanaGetEpochInfoResponse\x12!\n" +
 	"\fblock_height\x18\x01 \x01(\x04R\vblockHeight\x12\x14\n" +
-	"\x05epoch\x18\x02 \x01(\x04R\x05epoch\"1\n" +
+	"\x05epoch\x18\x02 \x01(\x04R\x05epoch\"u\n" +
 	"\x17SolanaGetHealthResponse\x12\x16\n" +
-	"\x06result\x18\x01 \x01(\tR\x06result\"\xd1\x01\n" +
+	"\x06result\x18\x01 \x01(\tR\x06result\x12-\n" +
+	"\x10num_slots_behind\x18\x02 \x01(\x04H\x00R\x0enumSlotsBehind\x88\x01\x01B\x13\n" +
+	"\x11_num_slots_behind\"K\n" +
+	"\x15SolanaGetSlotResponse\x12\x1e\n" +
+	"\n" +
+	"commitment\x18\x01 \x01(\tR\n" +
+	"commitment\x12\x12\n" +
+	"\x04slot\x18\x02 \x01(\x04R\x04slot\"J\n" +
+	"\x16SolanaGetBlockResponse\x12\x12\n" +
+	"\x04slot\x18\x01 \x01(\x04R\x04slot\x12\x1c\n" +
+	"\tavailable\x18\x02 \x01(\bR\tavailable\"\xd1\x01\n" +
 	"\x1aSolanaUnrecognizedResponse\x12D\n" +
 	"\x10jsonrpc_response\x18\x01 \x01(\v2\x19.path.qos.JsonRpcResponseR\x0fjsonrpcResponse\x12X\n" +
 	"\x10validation_error\x18\x02 \x01(\v2(.path.qos.JsonRpcResponseValidationErrorH\x00R\x0fvalidationError\x88\x01\x01B\x13\n" +
@@ -461,34 +646,40 @@ func file_path_qos_solana_proto_rawDescGZIP() []byte {
 	return file_path_qos_solana_proto_rawDescData
 }
 
-var file_path_qos_solana_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_path_qos_solana_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_path_qos_solana_proto_goTypes = []any{
 	(*SolanaRequestObservations)(nil),      // 0: path.qos.SolanaRequestObservations
 	(*SolanaEndpointObservation)(nil),      // 1: path.qos.SolanaEndpointObservation
 	(*SolanaGetEpochInfoResponse)(nil),     // 2: path.qos.SolanaGetEpochInfoResponse
 	(*SolanaGetHealthResponse)(nil),        // 3: path.qos.SolanaGetHealthResponse
-	(*SolanaUnrecognizedResponse)(nil),     // 4: path.qos.SolanaUnrecognizedResponse
-	(RequestOrigin)(0),                     // 5: path.qos.RequestOrigin
-	(*RequestError)(nil),                   // 6: path.qos.RequestError
-	(*JsonRpcRequest)(nil),                 // 7: path.qos.JsonRpcRequest
-	(*JsonRpcResponse)(nil),                // 8: path.qos.JsonRpcResponse
-	(*JsonRpcResponseValidationError)(nil), // 9: path.qos.JsonRpcResponseValidationError
+	(*SolanaGetSlotResponse)(nil),          // 4: path.qos.SolanaGetSlotResponse
+	(*SolanaGetBlockResponse)(nil),         // 5: path.qos.SolanaGetBlockResponse
+	(*SolanaUnrecognizedResponse)(nil),     // 6: path.qos.SolanaUnrecognizedResponse
+	(RequestOrigin)(0),                     // 7: path.qos.RequestOrigin
+	(*RequestError)(nil),                   // 8: path.qos.RequestError
+	(*JsonRpcRequest)(nil),                 // 9: path.qos.JsonRpcRequest
+	(*ServiceStallObservation)(nil),        // 10: path.qos.ServiceStallObservation
+	(*JsonRpcResponse)(nil),                // 11: path.qos.JsonRpcResponse
+	(*JsonRpcResponseValidationError)(nil), // 12: path.qos.JsonRpcResponseValidationError
 }
 var file_path_qos_solana_proto_depIdxs = []int32{
-	5, // 0: path.qos.SolanaRequestObservations.request_origin:type_name -> path.qos.RequestOrigin
-	6, // 1: path.qos.SolanaRequestObservations.request_error:type_name -> path.qos.RequestError
-	7, // 2: path.qos.SolanaRequestObservations.jsonrpc_request:type_name -> path.qos.JsonRpcRequest
-	1, // 3: path.qos.SolanaRequestObservations.endpoint_observations:type_name -> path.qos.SolanaEndpointObservation
-	2, // 4: path.qos.SolanaEndpointObservation.get_epoch_info_response:type_name -> path.qos.SolanaGetEpochInfoResponse
-	3, // 5: path.qos.SolanaEndpointObservation.get_health_response:type_name -> path.qos.SolanaGetHealthResponse
-	4, // 6: path.qos.SolanaEndpointObservation.unrecognized_response:type_name -> path.qos.SolanaUnrecognizedResponse
-	8, // 7: path.qos.SolanaUnrecognizedResponse.jsonrpc_response:type_name -> path.qos.JsonRpcResponse
-	9, // 8: path.qos.SolanaUnrecognizedResponse.validation_error:type_name -> path.qos.JsonRpcResponseValidationError
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	7,  // 0: path.qos.SolanaRequestObservations.request_origin:type_name -> path.qos.RequestOrigin
+	8,  // 1: path.qos.SolanaRequestObservations.request_error:type_name -> path.qos.RequestError
+	9,  // 2: path.qos.SolanaRequestObservations.jsonrpc_request:type_name -> path.qos.JsonRpcRequest
+	1,  // 3: path.qos.SolanaRequestObservations.endpoint_observations:type_name -> path.qos.SolanaEndpointObservation
+	10, // 4: path.qos.SolanaRequestObservations.service_stall:type_name -> path.qos.ServiceStallObservation
+	2,  // 5: path.qos.SolanaEndpointObservation.get_epoch_info_response:type_name -> path.qos.SolanaGetEpochInfoResponse
+	3,  // 6: path.qos.SolanaEndpointObservation.get_health_response:type_name -> path.qos.SolanaGetHealthResponse
+	6,  // 7: path.qos.SolanaEndpointObservation.unrecognized_response:type_name -> path.qos.SolanaUnrecognizedResponse
+	4,  // 8: path.qos.SolanaEndpointObservation.get_slot_response:type_name -> path.qos.SolanaGetSlotResponse
+	5,  // 9: path.qos.SolanaEndpointObservation.get_block_response:type_name -> path.qos.SolanaGetBlockResponse
+	11, // 10: path.qos.SolanaUnrecognizedResponse.jsonrpc_response:type_name -> path.qos.JsonRpcResponse
+	12, // 11: path.qos.SolanaUnrecognizedResponse.validation_error:type_name -> path.qos.JsonRpcResponseValidationError
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_path_qos_solana_proto_init() }
@@ -499,21 +690,25 @@ func file_path_qos_solana_proto_init() {
 	file_path_qos_jsonrpc_proto_init()
 	file_path_qos_request_origin_proto_init()
 	file_path_qos_request_error_proto_init()
+	file_path_qos_service_stall_proto_init()
 	file_path_qos_jsonrpc_validation_error_proto_init()
 	file_path_qos_solana_proto_msgTypes[0].OneofWrappers = []any{}
 	file_path_qos_solana_proto_msgTypes[1].OneofWrappers = []any{
 		(*SolanaEndpointObservation_GetEpochInfoResponse)(nil),
 		(*SolanaEndpointObservation_GetHealthResponse)(nil),
 		(*SolanaEndpointObservation_UnrecognizedResponse)(nil),
+		(*SolanaEndpointObservation_GetSlotResponse)(nil),
+		(*SolanaEndpointObservation_GetBlockResponse)(nil),
 	}
-	file_path_qos_solana_proto_msgTypes[4].OneofWrappers = []any{}
+	file_path_qos_solana_proto_msgTypes[3].OneofWrappers = []any{}
+	file_path_qos_solana_proto_msgTypes[6].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_path_qos_solana_proto_rawDesc), len(file_path_qos_solana_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,565 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: path/qos/utxo.proto
+
+package qos
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// UTXORequestObservations captures QoS data for a single request to a Bitcoin-family (UTXO) blockchain service,
+// e.g. Bitcoin, Litecoin or Dogecoin, including all observations made during potential retries.
+type UTXORequestObservations struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// chain_id is the name of the chain expected from endpoints, as reported by `getblockchaininfo`, e.g. "main".
+	// This is preset by the processor and not determined by the request.
+	// Used by metrics and data pipeline.
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// service_id is the identifier for the QoS implementation.
+	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// The length of the client's request payload, in bytes.
+	RequestPayloadLength uint32 `protobuf:"varint,3,opt,name=request_payload_length,json=requestPayloadLength,proto3" json:"request_payload_length,omitempty"`
+	// The origin of the request: user vs. QoS service (requests built by QoS for collecting data on endpoints)
+	RequestOrigin RequestOrigin `protobuf:"varint,4,opt,name=request_origin,json=requestOrigin,proto3,enum=path.qos.RequestOrigin" json:"request_origin,omitempty"`
+	// Tracks request errors, if any.
+	RequestError *RequestError `protobuf:"bytes,5,opt,name=request_error,json=requestError,proto3,oneof" json:"request_error,omitempty"`
+	// JSON-RPC request to the UTXO blockchain service.
+	// Only set if the HTTP request payload was successfully parsed into a single JSONRPC request.
+	JsonrpcRequest *JsonRpcRequest `protobuf:"bytes,6,opt,name=jsonrpc_request,json=jsonrpcRequest,proto3,oneof" json:"jsonrpc_request,omitempty"`
+	// Multiple observations possible if:
+	// - Original endpoint returns invalid response
+	// - Retry mechanism activates
+	EndpointObservations []*UTXOEndpointObservation `protobuf:"bytes,7,rep,name=endpoint_observations,json=endpointObservations,proto3" json:"endpoint_observations,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UTXORequestObservations) Reset() {
+	*x = UTXORequestObservations{}
+	mi := &file_path_qos_utxo_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UTXORequestObservations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UTXORequestObservations) ProtoMessage() {}
+
+func (x *UTXORequestObservations) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_utxo_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UTXORequestObservations.ProtoReflect.Descriptor instead.
+func (*UTXORequestObservations) Descriptor() ([]byte, []int) {
+	return file_path_qos_utxo_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UTXORequestObservations) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *UTXORequestObservations) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *UTXORequestObservations) GetRequestPayloadLength() uint32 {
+	if x != nil {
+		return x.RequestPayloadLength
+	}
+	return 0
+}
+
+func (x *UTXORequestObservations) GetRequestOrigin() RequestOrigin {
+	if x != nil {
+		return x.RequestOrigin
+	}
+	return RequestOrigin_REQUEST_ORIGIN_UNSPECIFIED
+}
+
+func (x *UTXORequestObservations) GetRequestError() *RequestError {
+	if x != nil {
+		return x.RequestError
+	}
+	return nil
+}
+
+func (x *UTXORequestObservations) GetJsonrpcRequest() *JsonRpcRequest {
+	if x != nil {
+		return x.JsonrpcRequest
+	}
+	return nil
+}
+
+func (x *UTXORequestObservations) GetEndpointObservations() []*UTXOEndpointObservation {
+	if x != nil {
+		return x.EndpointObservations
+	}
+	return nil
+}
+
+// UTXOEndpointObservation captures a single endpoint's response to a request
+type UTXOEndpointObservation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Address of the endpoint handling the request
+	EndpointAddr string `protobuf:"bytes,1,opt,name=endpoint_addr,json=endpointAddr,proto3" json:"endpoint_addr,omitempty"`
+	// HTTP status code returned to the user.
+	// It is derived from either:
+	//   - The endpoint payload parsed as a JSONRPC response.
+	//   - A generic JSONRPC error response if the endpoint payload fails to parse.
+	HttpStatusCode int32 `protobuf:"varint,2,opt,name=http_status_code,json=httpStatusCode,proto3" json:"http_status_code,omitempty"`
+	// Types that are valid to be assigned to ResponseObservation:
+	//
+	//	*UTXOEndpointObservation_GetBlockchainInfoResponse
+	//	*UTXOEndpointObservation_GetBlockCountResponse
+	//	*UTXOEndpointObservation_UnrecognizedResponse
+	ResponseObservation isUTXOEndpointObservation_ResponseObservation `protobuf_oneof:"response_observation"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UTXOEndpointObservation) Reset() {
+	*x = UTXOEndpointObservation{}
+	mi := &file_path_qos_utxo_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UTXOEndpointObservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UTXOEndpointObservation) ProtoMessage() {}
+
+func (x *UTXOEndpointObservation) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_utxo_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UTXOEndpointObservation.ProtoReflect.Descriptor instead.
+func (*UTXOEndpointObservation) Descriptor() ([]byte, []int) {
+	return file_path_qos_utxo_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UTXOEndpointObservation) GetEndpointAddr() string {
+	if x != nil {
+		return x.EndpointAddr
+	}
+	return ""
+}
+
+func (x *UTXOEndpointObservation) GetHttpStatusCode() int32 {
+	if x != nil {
+		return x.HttpStatusCode
+	}
+	return 0
+}
+
+func (x *UTXOEndpointObservation) GetResponseObservation() isUTXOEndpointObservation_ResponseObservation {
+	if x != nil {
+		return x.ResponseObservation
+	}
+	return nil
+}
+
+func (x *UTXOEndpointObservation) GetGetBlockchainInfoResponse() *UTXOGetBlockchainInfoResponse {
+	if x != nil {
+		if x, ok := x.ResponseObservation.(*UTXOEndpointObservation_GetBlockchainInfoResponse); ok {
+			return x.GetBlockchainInfoResponse
+		}
+	}
+	return nil
+}
+
+func (x *UTXOEndpointObservation) GetGetBlockCountResponse() *UTXOGetBlockCountResponse {
+	if x != nil {
+		if x, ok := x.ResponseObservation.(*UTXOEndpointObservation_GetBlockCountResponse); ok {
+			return x.GetBlockCountResponse
+		}
+	}
+	return nil
+}
+
+func (x *UTXOEndpointObservation) GetUnrecognizedResponse() *UTXOUnrecognizedResponse {
+	if x != nil {
+		if x, ok := x.ResponseObservation.(*UTXOEndpointObservation_UnrecognizedResponse); ok {
+			return x.UnrecognizedResponse
+		}
+	}
+	return nil
+}
+
+type isUTXOEndpointObservation_ResponseObservation interface {
+	isUTXOEndpointObservation_ResponseObservation()
+}
+
+type UTXOEndpointObservation_GetBlockchainInfoResponse struct {
+	// Response from getblockchaininfo
+	// Docs: https://developer.bitcoin.org/reference/rpc/getblockchaininfo.html
+	GetBlockchainInfoResponse *UTXOGetBlockchainInfoResponse `protobuf:"bytes,3,opt,name=get_blockchain_info_response,json=getBlockchainInfoResponse,proto3,oneof"`
+}
+
+type UTXOEndpointObservation_GetBlockCountResponse struct {
+	// Response from getblockcount
+	// Docs: https://developer.bitcoin.org/reference/rpc/getblockcount.html
+	GetBlockCountResponse *UTXOGetBlockCountResponse `protobuf:"bytes,4,opt,name=get_block_count_response,json=getBlockCountResponse,proto3,oneof"`
+}
+
+type UTXOEndpointObservation_UnrecognizedResponse struct {
+	// Responses not used in endpoint validation (e.g., getblock)
+	UnrecognizedResponse *UTXOUnrecognizedResponse `protobuf:"bytes,5,opt,name=unrecognized_response,json=unrecognizedResponse,proto3,oneof"`
+}
+
+func (*UTXOEndpointObservation_GetBlockchainInfoResponse) isUTXOEndpointObservation_ResponseObservation() {
+}
+
+func (*UTXOEndpointObservation_GetBlockCountResponse) isUTXOEndpointObservation_ResponseObservation() {
+}
+
+func (*UTXOEndpointObservation_UnrecognizedResponse) isUTXOEndpointObservation_ResponseObservation() {
+}
+
+// UTXOGetBlockchainInfoResponse stores the getblockchaininfo response data used in endpoint validation.
+// Docs: https://developer.bitcoin.org/reference/rpc/getblockchaininfo.html
+type UTXOGetBlockchainInfoResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the chain the endpoint is on, e.g. "main", "test", "signet" or "regtest".
+	Chain string `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	// Height of the most-work fully-validated chain.
+	Blocks uint64 `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// Set if the endpoint is in Initial Block Download mode, i.e. still syncing.
+	InitialBlockDownload bool `protobuf:"varint,3,opt,name=initial_block_download,json=initialBlockDownload,proto3" json:"initial_block_download,omitempty"`
+	// Estimate of the endpoint's chain verification progress, in [0, 1].
+	VerificationProgress float64 `protobuf:"fixed64,4,opt,name=verification_progress,json=verificationProgress,proto3" json:"verification_progress,omitempty"`
+	// Set if the endpoint returned a JSON-RPC error or an unparsable result.
+	Invalid       bool `protobuf:"varint,5,opt,name=invalid,proto3" json:"invalid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UTXOGetBlockchainInfoResponse) Reset() {
+	*x = UTXOGetBlockchainInfoResponse{}
+	mi := &file_path_qos_utxo_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UTXOGetBlockchainInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UTXOGetBlockchainInfoResponse) ProtoMessage() {}
+
+func (x *UTXOGetBlockchainInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_utxo_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UTXOGetBlockchainInfoResponse.ProtoReflect.Descriptor instead.
+func (*UTXOGetBlockchainInfoResponse) Descriptor() ([]byte, []int) {
+	return file_path_qos_utxo_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UTXOGetBlockchainInfoResponse) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *UTXOGetBlockchainInfoResponse) GetBlocks() uint64 {
+	if x != nil {
+		return x.Blocks
+	}
+	return 0
+}
+
+func (x *UTXOGetBlockchainInfoResponse) GetInitialBlockDownload() bool {
+	if x != nil {
+		return x.InitialBlockDownload
+	}
+	return false
+}
+
+func (x *UTXOGetBlockchainInfoResponse) GetVerificationProgress() float64 {
+	if x != nil {
+		return x.VerificationProgress
+	}
+	return 0
+}
+
+func (x *UTXOGetBlockchainInfoResponse) GetInvalid() bool {
+	if x != nil {
+		return x.Invalid
+	}
+	return false
+}
+
+// UTXOGetBlockCountResponse stores the getblockcount response data.
+// Docs: https://developer.bitcoin.org/reference/rpc/getblockcount.html
+type UTXOGetBlockCountResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Height of the most-work fully-validated chain.
+	BlockCount uint64 `protobuf:"varint,1,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty"`
+	// Set if the endpoint returned a JSON-RPC error or an unparsable result.
+	Invalid       bool `protobuf:"varint,2,opt,name=invalid,proto3" json:"invalid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UTXOGetBlockCountResponse) Reset() {
+	*x = UTXOGetBlockCountResponse{}
+	mi := &file_path_qos_utxo_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UTXOGetBlockCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UTXOGetBlockCountResponse) ProtoMessage() {}
+
+func (x *UTXOGetBlockCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_utxo_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UTXOGetBlockCountResponse.ProtoReflect.Descriptor instead.
+func (*UTXOGetBlockCountResponse) Descriptor() ([]byte, []int) {
+	return file_path_qos_utxo_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UTXOGetBlockCountResponse) GetBlockCount() uint64 {
+	if x != nil {
+		return x.BlockCount
+	}
+	return 0
+}
+
+func (x *UTXOGetBlockCountResponse) GetInvalid() bool {
+	if x != nil {
+		return x.Invalid
+	}
+	return false
+}
+
+// UTXOUnrecognizedResponse stores responses from methods not used in validation
+// Examples: getblock, getrawtransaction
+type UTXOUnrecognizedResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	JsonrpcResponse *JsonRpcResponse       `protobuf:"bytes,1,opt,name=jsonrpc_response,json=jsonrpcResponse,proto3" json:"jsonrpc_response,omitempty"`
+	// Optional validation error information
+	ValidationError *JsonRpcResponseValidationError `protobuf:"bytes,2,opt,name=validation_error,json=validationError,proto3,oneof" json:"validation_error,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UTXOUnrecognizedResponse) Reset() {
+	*x = UTXOUnrecognizedResponse{}
+	mi := &file_path_qos_utxo_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UTXOUnrecognizedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UTXOUnrecognizedResponse) ProtoMessage() {}
+
+func (x *UTXOUnrecognizedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_path_qos_utxo_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UTXOUnrecognizedResponse.ProtoReflect.Descriptor instead.
+func (*UTXOUnrecognizedResponse) Descriptor() ([]byte, []int) {
+	return file_path_qos_utxo_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UTXOUnrecognizedResponse) GetJsonrpcResponse() *JsonRpcResponse {
+	if x != nil {
+		return x.JsonrpcResponse
+	}
+	return nil
+}
+
+func (x *UTXOUnrecognizedResponse) GetValidationError() *JsonRpcResponseValidationError {
+	if x != nil {
+		return x.ValidationError
+	}
+	return nil
+}
+
+var File_path_qos_utxo_proto protoreflect.FileDescriptor
+
+const file_path_qos_utxo_proto_rawDesc = "" +
+	"\n" +
+	"\x13path/qos/utxo.proto\x12\bpath.qos\x1a\x16path/qos/jsonrpc.proto\x1a\x1dpath/qos/request_origin.proto\x1a\x1cpath/qos/request_error.proto\x1a'path/qos/jsonrpc_validation_error.proto\"\xd1\x03\n" +
+	"\x17UTXORequestObservations\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x02 \x01(\tR\tserviceId\x124\n" +
+	"\x16request_payload_length\x18\x03 \x01(\rR\x14requestPayloadLength\x12>\n" +
+	"\x0erequest_origin\x18\x04 \x01(\x0e2\x17.path.qos.RequestOriginR\rrequestOrigin\x12@\n" +
+	"\rrequest_error\x18\x05 \x01(\v2\x16.path.qos.RequestErrorH\x00R\frequestError\x88\x01\x01\x12F\n" +
+	"\x0fjsonrpc_request\x18\x06 \x01(\v2\x18.path.qos.JsonRpcRequestH\x01R\x0ejsonrpcRequest\x88\x01\x01\x12V\n" +
+	"\x15endpoint_observations\x18\a \x03(\v2!.path.qos.UTXOEndpointObservationR\x14endpointObservationsB\x10\n" +
+	"\x0e_request_errorB\x12\n" +
+	"\x10_jsonrpc_request\"\xa7\x03\n" +
+	"\x17UTXOEndpointObservation\x12#\n" +
+	"\rendpoint_addr\x18\x01 \x01(\tR\fendpointAddr\x12(\n" +
+	"\x10http_status_code\x18\x02 \x01(\x05R\x0ehttpStatusCode\x12j\n" +
+	"\x1cget_blockchain_info_response\x18\x03 \x01(\v2'.path.qos.UTXOGetBlockchainInfoResponseH\x00R\x19getBlockchainInfoResponse\x12^\n" +
+	"\x18get_block_count_response\x18\x04 \x01(\v2#.path.qos.UTXOGetBlockCountResponseH\x00R\x15getBlockCountResponse\x12Y\n" +
+	"\x15unrecognized_response\x18\x05 \x01(\v2\".path.qos.UTXOUnrecognizedResponseH\x00R\x14unrecognizedResponseB\x16\n" +
+	"\x14response_observation\"\xd2\x01\n" +
+	"\x1dUTXOGetBlockchainInfoResponse\x12\x14\n" +
+	"\x05chain\x18\x01 \x01(\tR\x05chain\x12\x16\n" +
+	"\x06blocks\x18\x02 \x01(\x04R\x06blocks\x124\n" +
+	"\x16initial_block_download\x18\x03 \x01(\bR\x14initialBlockDownload\x123\n" +
+	"\x15verification_progress\x18\x04 \x01(\x01R\x14verificationProgress\x12\x18\n" +
+	"\ainvalid\x18\x05 \x01(\bR\ainvalid\"V\n" +
+	"\x19UTXOGetBlockCountResponse\x12\x1f\n" +
+	"\vblock_count\x18\x01 \x01(\x04R\n" +
+	"blockCount\x12\x18\n" +
+	"\ainvalid\x18\x02 \x01(\bR\ainvalid\"\xcf\x01\n" +
+	"\x18UTXOUnrecognizedResponse\x12D\n" +
+	"\x10jsonrpc_response\x18\x01 \x01(\v2\x19.path.qos.JsonRpcResponseR\x0fjsonrpcResponse\x12X\n" +
+	"\x10validation_error\x18\x02 \x01(\v2(.path.qos.JsonRpcResponseValidationErrorH\x00R\x0fvalidationError\x88\x01\x01B\x13\n" +
+	"\x11_validation_errorB0Z.github.com/buildwithgrove/path/observation/qosb\x06proto3"
+
+var (
+	file_path_qos_utxo_proto_rawDescOnce sync.Once
+	file_path_qos_utxo_proto_rawDescData []byte
+)
+
+func file_path_qos_utxo_proto_rawDescGZIP() []byte {
+	file_path_qos_utxo_proto_rawDescOnce.Do(func() {
+		file_path_qos_utxo_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_path_qos_utxo_proto_rawDesc), len(file_path_qos_utxo_proto_rawDesc)))
+	})
+	return file_path_qos_utxo_proto_rawDescData
+}
+
+var file_path_qos_utxo_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_path_qos_utxo_proto_goTypes = []any{
+	(*UTXORequestObservations)(nil),        // 0: path.qos.UTXORequestObservations
+	(*UTXOEndpointObservation)(nil),        // 1: path.qos.UTXOEndpointObservation
+	(*UTXOGetBlockchainInfoResponse)(nil),  // 2: path.qos.UTXOGetBlockchainInfoResponse
+	(*UTXOGetBlockCountResponse)(nil),      // 3: path.qos.UTXOGetBlockCountResponse
+	(*UTXOUnrecognizedResponse)(nil),       // 4: path.qos.UTXOUnrecognizedResponse
+	(RequestOrigin)(0),                     // 5: path.qos.RequestOrigin
+	(*RequestError)(nil),                   // 6: path.qos.RequestError
+	(*JsonRpcRequest)(nil),                 // 7: path.qos.JsonRpcRequest
+	(*JsonRpcResponse)(nil),                // 8: path.qos.JsonRpcResponse
+	(*JsonRpcResponseValidationError)(nil), // 9: path.qos.JsonRpcResponseValidationError
+}
+var file_path_qos_utxo_proto_depIdxs = []int32{
+	5, // 0: path.qos.UTXORequestObservations.request_origin:type_name -> path.qos.RequestOrigin
+	6, // 1: path.qos.UTXORequestObservations.request_error:type_name -> path.qos.RequestError
+	7, // 2: path.qos.UTXORequestObservations.jsonrpc_request:type_name -> path.qos.JsonRpcRequest
+	1, // 3: path.qos.UTXORequestObservations.endpoint_observations:type_name -> path.qos.UTXOEndpointObservation
+	2, // 4: path.qos.UTXOEndpointObservation.get_blockchain_info_response:type_name -> path.qos.UTXOGetBlockchainInfoResponse
+	3, // 5: path.qos.UTXOEndpointObservation.get_block_count_response:type_name -> path.qos.UTXOGetBlockCountResponse
+	4, // 6: path.qos.UTXOEndpointObservation.unrecognized_response:type_name -> path.qos.UTXOUnrecognizedResponse
+	8, // 7: path.qos.UTXOUnrecognizedResponse.jsonrpc_response:type_name -> path.qos.JsonRpcResponse
+	9, // 8: path.qos.UTXOUnrecognizedResponse.validation_error:type_name -> path.qos.JsonRpcResponseValidationError
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_path_qos_utxo_proto_init() }
+func file_path_qos_utxo_proto_init() {
+	if File_path_qos_utxo_proto != nil {
+		return
+	}
+	file_path_qos_jsonrpc_proto_init()
+	file_path_qos_request_origin_proto_init()
+	file_path_qos_request_error_proto_init()
+	file_path_qos_jsonrpc_validation_error_proto_init()
+	file_path_qos_utxo_proto_msgTypes[0].OneofWrappers = []any{}
+	file_path_qos_utxo_proto_msgTypes[1].OneofWrappers = []any{
+		(*UTXOEndpointObservation_GetBlockchainInfoResponse)(nil),
+		(*UTXOEndpointObservation_GetBlockCountResponse)(nil),
+		(*UTXOEndpointObservation_UnrecognizedResponse)(nil),
+	}
+	file_path_qos_utxo_proto_msgTypes[4].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_path_qos_utxo_proto_rawDesc), len(file_path_qos_utxo_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_path_qos_utxo_proto_goTypes,
+		DependencyIndexes: file_path_qos_utxo_proto_depIdxs,
+		MessageInfos:      file_path_qos_utxo_proto_msgTypes,
+	}.Build()
+	File_path_qos_utxo_proto = out.File
+	file_path_qos_utxo_proto_goTypes = nil
+	file_path_qos_utxo_proto_depIdxs = nil
+}
@@ -50,6 +50,15 @@ func (e EndpointAddr) String() string {
 	return string(e)
 }
 
+// NewEndpointAddr builds the address of an endpoint from its supplier's address and its URL.
+// It is the inverse of the GetAddress and GetURL methods.
+// For example:
+// - Given the supplier address "pokt1eetcwfv2agdl2nvpf4cprhe89rdq3cxdf037wq" and the URL "https://relayminer.shannon-mainnet.eu.nodefleet.net"
+// - Would return "pokt1eetcwfv2agdl2nvpf4cprhe89rdq3cxdf037wq-https://relayminer.shannon-mainnet.eu.nodefleet.net"
+func NewEndpointAddr(supplier, url string) EndpointAddr {
+	return EndpointAddr(fmt.Sprintf("%s-%s", supplier, url))
+}
+
 // GetURL returns the effective TLD+1 domain of the endpoint address.
 // For example:
 // - Given the endpoint address "pokt1eetcwfv2agdl2nvpf4cprhe89rdq3cxdf037wq-https://relayminer.shannon-mainnet.eu.nodefleet.net"
@@ -538,3 +538,44 @@ func TestEndpointAddrList_String(t *testing.T) {
 		})
 	}
 }
+
+func TestNewEndpointAddr(t *testing.T) {
+	tests := []struct {
+		name     string
+		supplier string
+		url      string
+		expected EndpointAddr
+	}{
+		{
+			name:     "Shannon endpoint",
+			supplier: "pokt1eetcwfv2agdl2nvpf4cprhe89rdq3cxdf037wq",
+			url:      "https://skyrim.belongs-to-the.eu.nordfleet.net",
+			expected: EndpointAddr("pokt1eetcwfv2agdl2nvpf4cprhe89rdq3cxdf037wq-https://skyrim.belongs-to-the.eu.nordfleet.net"),
+		},
+		{
+			name:     "Fallback endpoint",
+			supplier: "fallback",
+			url:      "http://localhost:8545",
+			expected: EndpointAddr("fallback-http://localhost:8545"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := NewEndpointAddr(tt.supplier, tt.url)
+			if result != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, result)
+			}
+
+			// The supplier and URL must be recoverable from the endpoint address.
+			supplier, err := result.GetAddress()
+			if err != nil || supplier != tt.supplier {
+				t.Errorf("Expected supplier %q, got %q (err: %v)", tt.supplier, supplier, err)
+			}
+			url, err := result.GetURL()
+			if err != nil || url != tt.url {
+				t.Errorf("Expected URL %q, got %q (err: %v)", tt.url, url, err)
+			}
+		})
+	}
+}
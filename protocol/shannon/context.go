@@ -39,6 +39,10 @@ const maxWaitBeforeFallbackMillisecond = 1_000
 // Maximum endpoint payload length for error logging (100 chars)
 const maxEndpointPayloadLenForLogging = 100
 
+// Minimum timeout of a relay: a relay sent just before the deadline of the request's relays
+// is given at least this much time, rather than timing out before reaching the endpoint.
+const minRelayTimeout = 50 * time.Millisecond
+
 // requestContext provides all the functionality required by the gateway package
 // for handling a single service request.
 var _ gateway.ProtocolRequestContext = &requestContext{}
@@ -151,17 +155,20 @@ func (rc *requestContext) sendSingleRelay(payload protocol.Payload) (protocol.Re
 	endpointQueryTime := time.Now()
 
 	// Execute relay request using the appropriate strategy based on endpoint type and network conditions
-	relayResponse, err := rc.executeRelayRequestStrategy(payload)
+	relayResponse, httpTimings, err := rc.executeRelayRequestStrategy(payload)
 
 	// Failure: Pass the response (which may contain RelayMinerError data) to error handler.
 	if err != nil {
-		return rc.handleEndpointError(endpointQueryTime, err)
+		response, err := rc.handleEndpointError(endpointQueryTime, httpTimings, err)
+		// Preserve the HTTP status code returned by the endpoint, if any: e.g. a non-2xx response.
+		response.HTTPStatusCode = relayResponse.HTTPStatusCode
+		return response, err
 	}
 
 	// Success:
 	// - Record observation
 	// - Return response received from endpoint.
-	err = rc.handleEndpointSuccess(endpointQueryTime, &relayResponse)
+	err = rc.handleEndpointSuccess(endpointQueryTime, httpTimings, &relayResponse)
 	return relayResponse, err
 }
 
@@ -341,7 +348,9 @@ func (rc *requestContext) setSelectedEndpoint(endpoint endpoint) {
 // In particular, it includes logic that accounts for:
 //  1. Endpoint type (fallback vs protocol endpoint)
 //  2. Network conditions (session rollover periods)
-func (rc *requestContext) executeRelayRequestStrategy(payload protocol.Payload) (protocol.Response, error) {
+//
+// It also returns the timing breakdown of the HTTP request sent to the endpoint which served the relay, if any.
+func (rc *requestContext) executeRelayRequestStrategy(payload protocol.Payload) (protocol.Response, *protocolobservations.ShannonEndpointHTTPTimings, error) {
 	selectedEndpoint := rc.getSelectedEndpoint()
 	rc.hydrateLogger("executeRelayRequestStrategy")
 
@@ -404,7 +413,7 @@ func buildHeaders(payload protocol.Payload) map[string]string {
 // - Shields user from endpoint errors
 // - Updates the request context's selectedEndpoint for use by logging, metrics, and data logic.
 // TODO_TECHDEBT(@adshmh): This is an interim solution to be replaced with intelligent fallback.
-func (rc *requestContext) sendRelayWithFallback(payload protocol.Payload) (protocol.Response, error) {
+func (rc *requestContext) sendRelayWithFallback(payload protocol.Payload) (protocol.Response, *protocolobservations.ShannonEndpointHTTPTimings, error) {
 	rc.hydrateLogger("sendRelayWithFallback")
 
 	// Convert timeout to time.Duration
@@ -415,15 +424,16 @@ func (rc *requestContext) sendRelayWithFallback(payload protocol.Payload) (proto
 	// - Initialize response variables
 	endpointResponseReceivedChan := make(chan error, 1)
 	var (
-		endpointResponse protocol.Response
-		endpointErr      error
+		endpointResponse    protocol.Response
+		endpointHTTPTimings *protocolobservations.ShannonEndpointHTTPTimings
+		endpointErr         error
 	)
 
 	// Send Shannon relay in parallel:
 	// - Execute request asynchronously
 	// - Signal completion via channel
 	go func() {
-		endpointResponse, endpointErr = rc.sendProtocolRelay(payload)
+		endpointResponse, endpointHTTPTimings, endpointErr = rc.sendProtocolRelay(payload)
 		// Signal the completion of Shannon Network relay.
 		endpointResponseReceivedChan <- endpointErr
 	}()
@@ -438,7 +448,7 @@ func (rc *requestContext) sendRelayWithFallback(payload protocol.Payload) (proto
 		// Successfully received and validated a response from the shannon endpoint.
 		// No need to use the fallback endpoint's response.
 		if err == nil {
-			return endpointResponse, nil
+			return endpointResponse, endpointHTTPTimings, nil
 		}
 
 		// TODO_TECHDEBT(@adshmh): Verify correct observations/sanctions when using fallback due to endpoint error.
@@ -462,10 +472,10 @@ func (rc *requestContext) sendRelayWithFallback(payload protocol.Payload) (proto
 // - Routes payload via selected endpoint
 // - Returns error if no endpoints available
 // - Updates the request context's selectedEndpoint for use by logging, metrics, and data logic.
-func (rc *requestContext) sendRelayToARandomFallbackEndpoint(payload protocol.Payload) (protocol.Response, error) {
+func (rc *requestContext) sendRelayToARandomFallbackEndpoint(payload protocol.Payload) (protocol.Response, *protocolobservations.ShannonEndpointHTTPTimings, error) {
 	if len(rc.fallbackEndpoints) == 0 {
 		rc.logger.Warn().Msg("SHOULD HAPPEN RARELY: no fallback endpoints available for the service")
-		return protocol.Response{}, fmt.Errorf("no fallback endpoints available")
+		return protocol.Response{}, nil, fmt.Errorf("no fallback endpoints available")
 	}
 
 	rc.hydrateLogger("sendRelayToARandomFallbackEndpoint")
@@ -487,12 +497,12 @@ func (rc *requestContext) sendRelayToARandomFallbackEndpoint(payload protocol.Pa
 	rc.setSelectedEndpoint(fallbackEndpoint)
 
 	// Use the randomly selected fallback endpoint to send a relay.
-	relayResponse, err := rc.sendFallbackRelay(fallbackEndpoint, payload)
+	relayResponse, httpTimings, err := rc.sendFallbackRelay(fallbackEndpoint, payload)
 	if err != nil {
 		rc.logger.Warn().Err(err).Msg("SHOULD NEVER HAPPEN: fallback endpoint returned an error.")
 	}
 
-	return relayResponse, err
+	return relayResponse, httpTimings, err
 }
 
 // TODO_TECHDEBT(@adshmh): Refactor to split the selection of and interactions with the fallback endpoint.
@@ -506,7 +516,7 @@ func (rc *requestContext) sendRelayToARandomFallbackEndpoint(payload protocol.Pa
 //   - Enhanced error handling for more fine-grained endpoint error type classification.
 //   - Captures RelayMinerError data for reporting (but doesn't use it for classification).
 //   - Required to fulfill the FullNode interface.
-func (rc *requestContext) sendProtocolRelay(payload protocol.Payload) (protocol.Response, error) {
+func (rc *requestContext) sendProtocolRelay(payload protocol.Payload) (protocol.Response, *protocolobservations.ShannonEndpointHTTPTimings, error) {
 	rc.hydrateLogger("sendProtocolRelay")
 	rc.logger = hydrateLoggerWithPayload(rc.logger, &payload)
 
@@ -526,19 +536,19 @@ func (rc *requestContext) sendProtocolRelay(payload protocol.Payload) (protocol.
 	// Validate endpoint and session
 	app, err := rc.validateEndpointAndSession()
 	if err != nil {
-		return defaultResponse, err
+		return defaultResponse, nil, err
 	}
 
 	// Build and sign the relay request
 	signedRelayReq, err := rc.buildAndSignRelayRequest(payload, app)
 	if err != nil {
-		return defaultResponse, err
+		return defaultResponse, nil, err
 	}
 
 	// Marshal relay request to bytes
 	relayRequestBz, err := signedRelayReq.Marshal()
 	if err != nil {
-		return defaultResponse, fmt.Errorf("SHOULD NEVER HAPPEN: failed to marshal relay request: %w", err)
+		return defaultResponse, nil, fmt.Errorf("SHOULD NEVER HAPPEN: failed to marshal relay request: %w", err)
 	}
 
 	// TODO_UPNEXT(@adshmh): parse the LoadTesting server's URL in-advance.
@@ -563,13 +573,13 @@ func (rc *requestContext) sendProtocolRelay(payload protocol.Payload) (protocol.
 	// Use the new struct to pass data around for logging/metrics/etc.
 	//
 	// Send the HTTP request to the protocol endpoint.
-	httpRelayResponseBz, httpStatusCode, err := rc.sendHTTPRequest(payload, targetServerURL, relayRequestBz)
+	httpRelayResponseBz, httpStatusCode, httpTimings, err := rc.sendHTTPRequest(payload, targetServerURL, relayRequestBz)
 	if err != nil {
 		rc.logger.With(
 			"http_relay_response_preview", polylog.Preview(string(httpRelayResponseBz)),
 			"http_status_code", httpStatusCode,
 		).Error().Err(err).Msg("HTTP relay failed.")
-		return defaultResponse, err
+		return defaultResponse, httpTimings, err
 	}
 
 	// TODO_TECHDEBT(@adshmh): Refactor to clarify the request flow via matching of processing logic:
@@ -580,7 +590,7 @@ func (rc *requestContext) sendProtocolRelay(payload protocol.Payload) (protocol.
 	//
 	// Non-2xx HTTP status code received from the endpoint: build and return an error
 	if httpStatusCode != http.StatusOK {
-		return defaultResponse, fmt.Errorf("%w %w: %d", errSendHTTPRelay, errEndpointNon2XXHTTPStatusCode, httpStatusCode)
+		return defaultResponse, httpTimings, fmt.Errorf("%w %w: %d", errSendHTTPRelay, errEndpointNon2XXHTTPStatusCode, httpStatusCode)
 	}
 
 	// LoadTesting mode using a backend server.
@@ -592,19 +602,19 @@ func (rc *requestContext) sendProtocolRelay(payload protocol.Payload) (protocol.
 			// Intentionally leaving the endpoint address empty.
 			// Ensuring no sanctions/invalidation rules apply to LoadTesting backend server
 			EndpointAddr: "",
-		}, nil
+		}, httpTimings, nil
 	}
 
 	// Validate and process the response
 	response, err := rc.validateAndProcessResponse(httpRelayResponseBz)
 	if err != nil {
-		return defaultResponse, err
+		return defaultResponse, httpTimings, err
 	}
 
 	// Deserialize the response
 	deserializedResponse, err := rc.deserializeRelayResponse(response)
 	if err != nil {
-		return defaultResponse, err
+		return defaultResponse, httpTimings, err
 	}
 	// Hydrate the response with the endpoint address
 	deserializedResponse.EndpointAddr = selectedEndpoint.Addr()
@@ -614,10 +624,12 @@ func (rc *requestContext) sendProtocolRelay(payload protocol.Payload) (protocol.
 	responseHTTPStatusCode := deserializedResponse.HTTPStatusCode
 	if err := pathhttp.EnsureHTTPSuccess(responseHTTPStatusCode); err != nil {
 		errMsg := fmt.Sprintf("Backend service returned status non-2xx: %d", responseHTTPStatusCode)
-		return defaultResponse, fmt.Errorf("%w: %s", err, errMsg)
+		// Only the status code is returned: QoS services may track non-2xx responses, e.g. the generic REST QoS.
+		defaultResponse.HTTPStatusCode = responseHTTPStatusCode
+		return defaultResponse, httpTimings, fmt.Errorf("%w: %s", err, errMsg)
 	}
 
-	return deserializedResponse, nil
+	return deserializedResponse, httpTimings, nil
 }
 
 // validateEndpointAndSession validates that the endpoint and session are properly configured
@@ -774,7 +786,7 @@ func buildUnsignedRelayRequest(
 func (rc *requestContext) sendFallbackRelay(
 	fallbackEndpoint endpoint,
 	payload protocol.Payload,
-) (protocol.Response, error) {
+) (protocol.Response, *protocolobservations.ShannonEndpointHTTPTimings, error) {
 	// Get the fallback URL for the fallback endpoint.
 	// If the RPC type is unknown or not configured, it will default URL.
 	endpointFallbackURL := fallbackEndpoint.GetURL(payload.RPCType)
@@ -783,7 +795,7 @@ func (rc *requestContext) sendFallbackRelay(
 	fallbackURL := prepareURLFromPayload(endpointFallbackURL, payload)
 
 	// Send the HTTP request to the fallback endpoint.
-	httpResponseBz, httpStatusCode, err := rc.sendHTTPRequest(
+	httpResponseBz, httpStatusCode, httpTimings, err := rc.sendHTTPRequest(
 		payload,
 		fallbackURL,
 		[]byte(payload.Data),
@@ -792,7 +804,7 @@ func (rc *requestContext) sendFallbackRelay(
 	if err != nil {
 		return protocol.Response{
 			EndpointAddr: fallbackEndpoint.Addr(),
-		}, err
+		}, httpTimings, err
 	}
 
 	// TODO_CONSIDERATION(@adshmh): Are there any scenarios where a fallback endpoint should return a non-2xx HTTP status code?
@@ -801,8 +813,9 @@ func (rc *requestContext) sendFallbackRelay(
 	// Non-2xx HTTP status code: build and return an error.
 	if httpStatusCode != http.StatusOK {
 		return protocol.Response{
-			EndpointAddr: fallbackEndpoint.Addr(),
-		}, fmt.Errorf("%w %w: %d", errSendHTTPRelay, errEndpointNon2XXHTTPStatusCode, httpStatusCode)
+			HTTPStatusCode: httpStatusCode,
+			EndpointAddr:   fallbackEndpoint.Addr(),
+		}, httpTimings, fmt.Errorf("%w %w: %d", errSendHTTPRelay, errEndpointNon2XXHTTPStatusCode, httpStatusCode)
 	}
 
 	// Build and return the fallback response
@@ -810,7 +823,7 @@ func (rc *requestContext) sendFallbackRelay(
 		Bytes:          httpResponseBz,
 		HTTPStatusCode: httpStatusCode,
 		EndpointAddr:   fallbackEndpoint.Addr(),
-	}, nil
+	}, httpTimings, nil
 }
 
 // trackRelayMinerError:
@@ -869,6 +882,7 @@ func (rc *requestContext) handleInternalError(internalErr error) (protocol.Respo
 //   - Includes any RelayMinerError data that was captured via trackRelayMinerError.
 func (rc *requestContext) handleEndpointError(
 	endpointQueryTime time.Time,
+	httpTimings *protocolobservations.ShannonEndpointHTTPTimings,
 	endpointErr error,
 ) (protocol.Response, error) {
 	rc.hydrateLogger("handleEndpointError")
@@ -897,6 +911,7 @@ func (rc *requestContext) handleEndpointError(
 		fmt.Sprintf("relay error: %v", endpointErr),
 		recommendedSanctionType,
 		rc.currentRelayMinerError, // Use RelayMinerError data from request context
+		httpTimings,               // Use HTTP timings of the relay's HTTP request
 		rc.currentRPCType,         // Use RPC type from request context
 	)
 
@@ -917,6 +932,7 @@ func (rc *requestContext) handleEndpointError(
 //   - Builds and returns protocol response from endpoint's returned data.
 func (rc *requestContext) handleEndpointSuccess(
 	endpointQueryTime time.Time,
+	httpTimings *protocolobservations.ShannonEndpointHTTPTimings,
 	endpointResponse *protocol.Response,
 ) error {
 	rc.hydrateLogger("handleEndpointSuccess")
@@ -932,6 +948,7 @@ func (rc *requestContext) handleEndpointSuccess(
 		time.Now(), // Timestamp: endpoint query completed.
 		endpointResponse,
 		rc.currentRelayMinerError, // Use RelayMinerError data from request context
+		httpTimings,               // Use HTTP timings of the relay's HTTP request
 		rc.currentRPCType,         // Use RPC type from request context
 	)
 
@@ -942,16 +959,23 @@ func (rc *requestContext) handleEndpointSuccess(
 	return nil
 }
 
-// sendHTTPRequest is a shared method for sending HTTP requests with common logic
+// sendHTTPRequest is a shared method for sending HTTP requests with common logic.
+// It also returns the timing breakdown of the HTTP request, e.g. DNS lookup, TLS handshake, time to first byte, for observations.
 func (rc *requestContext) sendHTTPRequest(
 	payload protocol.Payload,
 	url string,
 	requestData []byte,
-) ([]byte, int, error) {
-	// Prepare a timeout context for the request
-	timeout := time.Duration(gateway.RelayRequestTimeout) * time.Millisecond
+) ([]byte, int, *protocolobservations.ShannonEndpointHTTPTimings, error) {
+	// Prepare a timeout context for the request, using the deadline of the request's relays, if set by the gateway.
+	// e.g. a per-method timeout of the service, or a shorter deadline requested by the client.
+	timeout, err := rc.getRelayTimeout()
+	if err != nil {
+		// The deadline passed before the relay was sent: the endpoint was not contacted.
+		return nil, 0, nil, fmt.Errorf("error sending request to endpoint %s: %w", rc.getSelectedEndpoint().Addr(), err)
+	}
 
-	// TODO_INVESTIGATE: Evaluate `rc.context` vs `context.TODO` and pick the right one for timeouts.
+	// The request context's context is not used as the parent: relays may intentionally outlive the user request,
+	// e.g. the remaining relays of a broadcast are completed after the user response is returned.
 	ctxWithTimeout, cancelFn := context.WithTimeout(context.TODO(), timeout)
 	defer cancelFn()
 
@@ -959,7 +983,7 @@ func (rc *requestContext) sendHTTPRequest(
 	headers := buildHeaders(payload)
 
 	// Send the HTTP request
-	httpResponseBz, httpStatusCode, err := rc.httpClient.SendHTTPRelay(
+	httpResponseBz, httpStatusCode, httpTimings, err := rc.httpClient.SendHTTPRelay(
 		ctxWithTimeout,
 		rc.logger,
 		url,
@@ -967,22 +991,54 @@ func (rc *requestContext) sendHTTPRequest(
 		requestData,
 		headers,
 	)
+	// Track the timing breakdown of the HTTP request for observations.
+	httpTimingsObs := buildEndpointHTTPTimingsObservation(httpTimings)
 
 	if err != nil {
 		// Endpoint failed to respond before the timeout expires
 		// Wrap the net/http error with our classification error
 		// Include the net/http error for HTTP relay error classification.
 		wrappedErr := fmt.Errorf("%w: %w", errSendHTTPRelay, err)
+		// The endpoint did not respond before the deadline requested by the client: classify separately to avoid sanctioning the endpoint.
+		if ctxWithTimeout.Err() == context.DeadlineExceeded && rc.context != nil && gateway.IsClientRequestedDeadline(rc.context) {
+			wrappedErr = fmt.Errorf("%w: %w", errRelayClientDeadlineExceeded, wrappedErr)
+		}
 
 		selectedEndpoint := rc.getSelectedEndpoint()
 		rc.logger.With(
 			"http_response_preview", polylog.Preview(string(httpResponseBz)),
 			"http_status_code", httpStatusCode,
 		).Debug().Err(wrappedErr).Msgf("Failed to receive a response from the selected endpoint: '%s'. Relay request will FAIL", selectedEndpoint.Addr())
-		return httpResponseBz, httpStatusCode, fmt.Errorf("error sending request to endpoint %s: %w", selectedEndpoint.Addr(), wrappedErr)
+		return httpResponseBz, httpStatusCode, httpTimingsObs, fmt.Errorf("error sending request to endpoint %s: %w", selectedEndpoint.Addr(), wrappedErr)
+	}
+
+	return httpResponseBz, httpStatusCode, httpTimingsObs, nil
+}
+
+// getRelayTimeout returns the time remaining before the deadline of the request's relays, at least minRelayTimeout.
+// Defaults to gateway.RelayRequestTimeout if the request context's context has no deadline, e.g. for hydrator checks.
+//
+// Returns an error if the deadline has already passed, e.g. after a slow primary endpoint:
+// the relay is not sent, and the endpoint is not sanctioned.
+func (rc *requestContext) getRelayTimeout() (time.Duration, error) {
+	if rc.context == nil {
+		return gateway.RelayRequestTimeout, nil
+	}
+
+	deadline, ok := rc.context.Deadline()
+	if !ok {
+		return gateway.RelayRequestTimeout, nil
+	}
+
+	timeout := time.Until(deadline)
+	if timeout <= 0 {
+		if gateway.IsClientRequestedDeadline(rc.context) {
+			return 0, fmt.Errorf("%w: %w", errRelayClientDeadlineExceeded, errRelayDeadlinePassed)
+		}
+		return 0, errRelayDeadlinePassed
 	}
 
-	return httpResponseBz, httpStatusCode, nil
+	return max(timeout, minRelayTimeout), nil
 }
 
 // prepareURLFromPayload constructs the URL for requests, including optional path.
@@ -0,0 +1,80 @@
+package shannon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildwithgrove/path/gateway"
+	protocolobservations "github.com/buildwithgrove/path/observation/protocol"
+	"github.com/buildwithgrove/path/protocol"
+)
+
+func Test_getRelayTimeout(t *testing.T) {
+	tests := []struct {
+		name            string
+		deadline        time.Duration
+		expectedTimeout time.Duration
+		expectedErr     error
+	}{
+		{
+			name:            "relay timeout is the time remaining before the deadline",
+			deadline:        10 * time.Second,
+			expectedTimeout: 10 * time.Second,
+		},
+		{
+			name:            "relay timeout is at least the minimum relay timeout",
+			deadline:        time.Millisecond,
+			expectedTimeout: minRelayTimeout,
+		},
+		{
+			name:        "error if the deadline has already passed",
+			deadline:    -time.Second,
+			expectedErr: errRelayDeadlinePassed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), test.deadline)
+			defer cancel()
+
+			rc := &requestContext{context: ctx}
+			timeout, err := rc.getRelayTimeout()
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.InDelta(t, test.expectedTimeout, timeout, float64(100*time.Millisecond))
+		})
+	}
+
+	// Requests without a deadline, e.g. hydrator checks, use the default relay timeout.
+	timeout, err := (&requestContext{context: context.Background()}).getRelayTimeout()
+	require.NoError(t, err)
+	require.Equal(t, gateway.RelayRequestTimeout, timeout)
+}
+
+func Test_sendHTTPRequest_DeadlinePassed(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), -time.Second)
+	defer cancel()
+
+	// No HTTP client is set: the relay must not be sent once the deadline has passed.
+	rc := &requestContext{
+		logger:           polyzero.NewLogger(),
+		context:          ctx,
+		selectedEndpoint: &protocolEndpoint{supplier: "supplier", url: "https://endpoint.example"},
+	}
+
+	_, _, _, err := rc.sendHTTPRequest(protocol.Payload{}, "https://endpoint.example", nil)
+	require.ErrorIs(t, err, errRelayDeadlinePassed)
+
+	// The endpoint was not contacted: it is not sanctioned.
+	_, sanctionType := classifyRelayError(rc.logger, err)
+	require.Equal(t, protocolobservations.ShannonSanctionType_SHANNON_SANCTION_DO_NOT_SANCTION, sanctionType)
+}
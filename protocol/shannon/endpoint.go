@@ -60,7 +60,7 @@ func (e fallbackEndpoint) IsFallback() bool {
 // Fallback endpoints do not exist on the Shannon protocol and so do not have a supplier address.
 // Instead, they are identified by the `fallbackSupplierString` const value and the default URL.
 func (e fallbackEndpoint) Addr() protocol.EndpointAddr {
-	return protocol.EndpointAddr(fmt.Sprintf("%s-%s", fallbackSupplierString, e.defaultURL))
+	return protocol.NewEndpointAddr(fallbackSupplierString, e.defaultURL)
 }
 
 // PublicURL is a no-op for fallback endpoints.
@@ -142,7 +142,7 @@ func (e protocolEndpoint) IsFallback() bool {
 // For protocol-level concerns: the (app/session, URL) should be taken into account; e.g. a healthy endpoint may have been maxed out for a particular app.
 // For QoS-level concerns: only the URL of the endpoint matters; e.g. an unhealthy endpoint should be skipped regardless of the app/session to which it is attached.
 func (e protocolEndpoint) Addr() protocol.EndpointAddr {
-	return protocol.EndpointAddr(fmt.Sprintf("%s-%s", e.supplier, e.url))
+	return protocol.NewEndpointAddr(e.supplier, e.url)
 }
 
 // PublicURL returns the URL of the endpoint.
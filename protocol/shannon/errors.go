@@ -21,6 +21,10 @@ var (
 
 	// endpoint timeout
 	errRelayEndpointTimeout = errors.New("timeout waiting for endpoint response")
+	// endpoint did not respond before the deadline requested by the client, which is shorter than the service's relay timeout.
+	errRelayClientDeadlineExceeded = errors.New("timeout waiting for endpoint response before the client-requested deadline")
+	// the deadline of the request's relays passed before the relay was sent, e.g. after a slow primary endpoint.
+	errRelayDeadlinePassed = errors.New("deadline of the request's relays passed before sending the relay")
 	// PATH manually canceled the context for the request.
 	// E.g. Parallel requests were made and one succeeded so the other was canceled.
 	errContextCanceled = errors.New("context canceled manually")
@@ -9,6 +9,7 @@ import (
 	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	pathhttp "github.com/buildwithgrove/path/network/http"
 	protocolobservations "github.com/buildwithgrove/path/observation/protocol"
 	"github.com/buildwithgrove/path/protocol"
 )
@@ -105,6 +106,7 @@ func translateContextSetupErrorToRequestErrorType(err error) protocolobservation
 // - endpoint details: address, url, app
 // - endpoint query and response timestamps.
 // - relay miner error if present: for tracking/cross referencing against endpoint errors.
+// - HTTP timings if present: the timing breakdown of the HTTP request sent to the endpoint.
 func buildEndpointSuccessObservation(
 	logger polylog.Logger,
 	endpoint endpoint,
@@ -112,6 +114,7 @@ func buildEndpointSuccessObservation(
 	endpointResponseTimestamp time.Time,
 	endpointResponse *protocol.Response,
 	relayMinerError *protocolobservations.ShannonRelayMinerError,
+	httpTimings *protocolobservations.ShannonEndpointHTTPTimings,
 	rpcType sharedtypes.RPCType,
 ) *protocolobservations.ShannonEndpointObservation {
 	// initialize an observation with endpoint details: URL, app, etc.
@@ -122,6 +125,8 @@ func buildEndpointSuccessObservation(
 	endpointObs.EndpointResponseTimestamp = timestamppb.New(endpointResponseTimestamp)
 	// Track RelayMiner error.
 	endpointObs.RelayMinerError = relayMinerError
+	// Track HTTP timings.
+	endpointObs.HttpTimings = httpTimings
 
 	return endpointObs
 }
@@ -131,6 +136,7 @@ func buildEndpointSuccessObservation(
 // - the encountered error
 // - any sanctions resulting from the error.
 // - relay miner error if present: for tracking/cross referencing against endpoint errors.
+// - HTTP timings if present: e.g. to tell a slow TLS handshake apart from a slow backend on timeouts.
 func buildEndpointErrorObservation(
 	logger polylog.Logger,
 	endpoint endpoint,
@@ -140,6 +146,7 @@ func buildEndpointErrorObservation(
 	errorDetails string,
 	sanctionType protocolobservations.ShannonSanctionType,
 	relayMinerError *protocolobservations.ShannonRelayMinerError,
+	httpTimings *protocolobservations.ShannonEndpointHTTPTimings,
 	rpcType sharedtypes.RPCType,
 ) *protocolobservations.ShannonEndpointObservation {
 	// initialize an observation with endpoint details: URL, app, etc.
@@ -155,10 +162,31 @@ func buildEndpointErrorObservation(
 	endpointObs.RecommendedSanction = &sanctionType
 	// Track RelayMiner error
 	endpointObs.RelayMinerError = relayMinerError
+	// Track HTTP timings
+	endpointObs.HttpTimings = httpTimings
 
 	return endpointObs
 }
 
+// builds the observation of an HTTP request's timing breakdown.
+// Returns nil if the HTTP request was not sent, e.g. no concurrency slot was available.
+func buildEndpointHTTPTimingsObservation(timings pathhttp.HTTPRequestTimings) *protocolobservations.ShannonEndpointHTTPTimings {
+	if timings.Total == 0 {
+		return nil
+	}
+
+	return &protocolobservations.ShannonEndpointHTTPTimings{
+		DnsLookupSeconds:       timings.DNSLookup.Seconds(),
+		ConnectSeconds:         timings.Connect.Seconds(),
+		TlsHandshakeSeconds:    timings.TLSHandshake.Seconds(),
+		GetConnectionSeconds:   timings.GetConnection.Seconds(),
+		RequestWriteSeconds:    timings.RequestWrite.Seconds(),
+		TimeToFirstByteSeconds: timings.TimeToFirstByte.Seconds(),
+		TotalSeconds:           timings.Total.Seconds(),
+		ConnectionReused:       timings.ConnectionReused,
+	}
+}
+
 // BuildEndpointObservation builds a Shannon endpoint observation to include:
 // endpoint: supplier, URL
 // session: app, service ID, session ID, session start and end heights (using `buildEndpointObservationFromSession`).
@@ -30,6 +30,17 @@ func classifyRelayError(logger polylog.Logger, err error) (protocolobservations.
 	// Errors make come from the SDK, HTTP, internal, etc....
 	switch {
 
+	// The endpoint did not respond before the client-requested deadline.
+	// Checked before HTTP relay errors: the deadline is set by the client, so the endpoint is not sanctioned.
+	case errors.Is(err, errRelayClientDeadlineExceeded):
+		return protocolobservations.ShannonEndpointErrorType_SHANNON_ENDPOINT_ERROR_CLIENT_DEADLINE_EXCEEDED,
+			protocolobservations.ShannonSanctionType_SHANNON_SANCTION_DO_NOT_SANCTION
+
+	// The deadline of the request's relays passed before the relay was sent: the endpoint was not contacted.
+	case errors.Is(err, errRelayDeadlinePassed):
+		return protocolobservations.ShannonEndpointErrorType_SHANNON_ENDPOINT_ERROR_INTERNAL,
+			protocolobservations.ShannonSanctionType_SHANNON_SANCTION_DO_NOT_SANCTION
+
 	// HTTP relay errors - check first to handle HTTP-specific classifications
 	case errors.Is(err, errSendHTTPRelay):
 		return classifyHttpError(logger, err)
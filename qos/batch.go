@@ -0,0 +1,24 @@
+package qos
+
+// DefaultBatchNumEndpoints is the maximum number of endpoints the members of a single JSON-RPC batch request are distributed across, unless configured for the service.
+// Members which fail are retried on a different endpoint.
+const DefaultBatchNumEndpoints = 3
+
+// GetBatchNumEndpoints returns the maximum number of endpoints the members of a JSON-RPC batch request are distributed across:
+//   - DefaultBatchNumEndpoints if numEndpoints is 0, i.e. not configured for the service.
+//   - A numEndpoints of 1 disables distribution: batches are sent as-is to a single endpoint.
+func GetBatchNumEndpoints(numEndpoints uint) uint {
+	if numEndpoints == 0 {
+		return DefaultBatchNumEndpoints
+	}
+	return numEndpoints
+}
+
+// GetBatchRequestNumEndpoints returns the number of endpoints a JSON-RPC batch with the supplied number of requests is distributed across, capped at numEndpoints.
+// Returns 0 for a batch with fewer than 2 requests, i.e. one which is sent to a single endpoint.
+func GetBatchRequestNumEndpoints(numRequests int, numEndpoints uint) uint {
+	if numRequests < 2 {
+		return 0
+	}
+	return min(uint(numRequests), numEndpoints)
+}
@@ -0,0 +1,63 @@
+package qos
+
+import (
+	"strings"
+
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// DefaultTxBroadcastNumEndpoints is the number of endpoints a transaction submission request is broadcast to, unless configured for the service.
+// - Sending a transaction to a single endpoint is fragile: the transaction is lost if the endpoint's mempool does not propagate it.
+// - Endpoints are selected with TLD diversity preference, to maximize the chances of propagation.
+const DefaultTxBroadcastNumEndpoints = 3
+
+// GetTxBroadcastNumEndpoints returns the number of endpoints a transaction submission request is broadcast to:
+//   - DefaultTxBroadcastNumEndpoints if numEndpoints is 0, i.e. not configured for the service.
+//   - A numEndpoints of 1 disables broadcasting: the transaction is sent to a single endpoint.
+func GetTxBroadcastNumEndpoints(numEndpoints uint) uint {
+	if numEndpoints == 0 {
+		return DefaultTxBroadcastNumEndpoints
+	}
+	return numEndpoints
+}
+
+// txAlreadySubmittedErrMessages are substrings of endpoint error messages indicating the
+// submitted transaction is already known to the endpoint, e.g. received from another endpoint's mempool.
+// A broadcast which receives any of these errors is considered successful.
+//
+// DEV_NOTE: EVM "nonce too low" errors are intentionally not included: they are also returned
+// for a different transaction reusing an already mined nonce, i.e. a rejected transaction.
+var txAlreadySubmittedErrMessages = []string{
+	// EVM: e.g. geth, erigon, nethermind.
+	"already known",
+	"known transaction",
+	"already imported",
+	// Solana
+	"already been processed",
+	"alreadyprocessed",
+	// CometBFT/Cosmos SDK mempool.
+	"tx already in mempool",
+	"tx already exists in cache",
+}
+
+// IsTxAlreadySubmittedErrMessage returns true if the supplied error message indicates the transaction was already submitted.
+func IsTxAlreadySubmittedErrMessage(errMsg string) bool {
+	errMsg = strings.ToLower(errMsg)
+	for _, alreadySubmittedMsg := range txAlreadySubmittedErrMessages {
+		if strings.Contains(errMsg, alreadySubmittedMsg) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSuccessfulTxBroadcastJSONRPCResponse returns true if the supplied JSON-RPC response to a
+// transaction submission request indicates the transaction was accepted by the endpoint, i.e. either:
+// - A result was returned.
+// - An error indicating the transaction was already submitted was returned.
+func IsSuccessfulTxBroadcastJSONRPCResponse(jsonrpcResponse jsonrpc.Response) bool {
+	if jsonrpcResponse.Error != nil {
+		return IsTxAlreadySubmittedErrMessage(jsonrpcResponse.Error.Message)
+	}
+	return jsonrpcResponse.Result != nil
+}
@@ -0,0 +1,51 @@
+package qos
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// GetJSONRPCCoalescingKey returns the key identifying identical JSON-RPC requests, for request coalescing:
+//   - The key is built from the method and the normalized (i.e. compacted) params.
+//   - The request's ID is not part of the key: identical requests from different users have different IDs.
+//
+// Returns false if the request's method is not in the supplied allowlist, e.g. state-changing methods.
+func GetJSONRPCCoalescingKey(jsonrpcReq jsonrpc.Request, coalescedMethods map[string]struct{}) (string, bool) {
+	if _, found := coalescedMethods[string(jsonrpcReq.Method)]; !found {
+		return "", false
+	}
+
+	// Requests without params, e.g. `eth_blockNumber`, are keyed by their method alone.
+	var normalizedParams bytes.Buffer
+	if !jsonrpcReq.Params.IsEmpty() {
+		paramsBz, err := json.Marshal(jsonrpcReq.Params)
+		if err != nil {
+			return "", false
+		}
+		if err := json.Compact(&normalizedParams, paramsBz); err != nil {
+			return "", false
+		}
+	}
+
+	return fmt.Sprintf("%s:%s", jsonrpcReq.Method, normalizedParams.String()), true
+}
+
+// RewriteJSONRPCResponseID returns a copy of the serialized JSON-RPC response, with its ID replaced by the supplied ID.
+// Used to reuse the response to a coalesced request for all identical requests, each with its own ID.
+func RewriteJSONRPCResponseID(responseBz []byte, id jsonrpc.ID) ([]byte, error) {
+	var responseFields map[string]json.RawMessage
+	if err := json.Unmarshal(responseBz, &responseFields); err != nil {
+		return nil, fmt.Errorf("error unmarshaling the JSON-RPC response: %w", err)
+	}
+
+	idBz, err := json.Marshal(id)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling the JSON-RPC ID: %w", err)
+	}
+	responseFields["id"] = idBz
+
+	return json.Marshal(responseFields)
+}
@@ -0,0 +1,53 @@
+package qos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+func TestGetJSONRPCCoalescingKey(t *testing.T) {
+	coalescedMethods := map[string]struct{}{"eth_getBalance": {}, "eth_blockNumber": {}}
+
+	buildRequest := func(id jsonrpc.ID, method, params string) jsonrpc.Request {
+		jsonrpcReq := jsonrpc.Request{ID: id, JSONRPC: jsonrpc.Version2, Method: jsonrpc.Method(method)}
+		if params != "" {
+			jsonrpcReq.SetParams([]byte(params))
+		}
+		return jsonrpcReq
+	}
+
+	c := require.New(t)
+
+	key1, ok := GetJSONRPCCoalescingKey(buildRequest(jsonrpc.IDFromInt(1), "eth_getBalance", `["0xdead", "latest"]`), coalescedMethods)
+	c.True(ok)
+	key2, ok := GetJSONRPCCoalescingKey(buildRequest(jsonrpc.IDFromStr("abc"), "eth_getBalance", `["0xdead","latest"]`), coalescedMethods)
+	c.True(ok)
+	c.Equal(key1, key2, "requests differing only by ID and whitespace should share a key")
+
+	key3, ok := GetJSONRPCCoalescingKey(buildRequest(jsonrpc.IDFromInt(1), "eth_getBalance", `["0xbeef","latest"]`), coalescedMethods)
+	c.True(ok)
+	c.NotEqual(key1, key3, "requests with different params should not share a key")
+
+	key4, ok := GetJSONRPCCoalescingKey(buildRequest(jsonrpc.IDFromInt(1), "eth_blockNumber", ""), coalescedMethods)
+	c.True(ok, "requests without params should be coalesced")
+	key5, ok := GetJSONRPCCoalescingKey(buildRequest(jsonrpc.IDFromInt(2), "eth_blockNumber", ""), coalescedMethods)
+	c.True(ok)
+	c.Equal(key4, key5)
+
+	_, ok = GetJSONRPCCoalescingKey(buildRequest(jsonrpc.IDFromInt(1), "eth_sendRawTransaction", `["0x00"]`), coalescedMethods)
+	c.False(ok, "methods outside the allowlist should not be coalesced")
+}
+
+func TestRewriteJSONRPCResponseID(t *testing.T) {
+	c := require.New(t)
+
+	rewrittenBz, err := RewriteJSONRPCResponseID([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x10"}`), jsonrpc.IDFromStr("abc"))
+	c.NoError(err)
+	c.JSONEq(`{"jsonrpc":"2.0","id":"abc","result":"0x10"}`, string(rewrittenBz))
+
+	_, err = RewriteJSONRPCResponseID([]byte(`not json`), jsonrpc.IDFromInt(1))
+	c.Error(err)
+}
@@ -0,0 +1,218 @@
+package qos
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// HTTPHeaderConsensusRead is the key of the HTTP header used by users to opt into consensus-read mode.
+// The header's value is the number of endpoints the request should be sent to, e.g. "3".
+// The majority of the endpoints is required to agree on the result, i.e. a quorum of numEndpoints/2 + 1.
+//
+// The header is ignored unless allowed by the service's QoS config: each opted-in request costs multiple relays.
+const HTTPHeaderConsensusRead = "Consensus-Read"
+
+const (
+	// MinConsensusReadNumEndpoints is the minimum number of endpoints for a consensus-read.
+	MinConsensusReadNumEndpoints = 2
+
+	// MaxConsensusReadNumEndpoints caps the number of endpoints a single consensus-read can be sent to.
+	// Prevents a single user request from fanning out an unbounded number of relays.
+	MaxConsensusReadNumEndpoints = 7
+)
+
+// ConsensusReadConfig specifies how a request is sent in consensus-read mode:
+//   - The same payload is sent to NumEndpoints endpoints, in parallel.
+//   - A result is returned to the user only if at least Quorum endpoints returned it.
+type ConsensusReadConfig struct {
+	NumEndpoints uint
+	Quorum       uint
+}
+
+// NewConsensusReadConfig returns a consensus-read config for the supplied number of endpoints and quorum.
+//   - The number of endpoints is capped at MaxConsensusReadNumEndpoints.
+//   - A quorum of 0 defaults to the majority of the endpoints.
+//   - The quorum is capped at the number of endpoints.
+func NewConsensusReadConfig(numEndpoints, quorum uint) *ConsensusReadConfig {
+	numEndpoints = min(numEndpoints, MaxConsensusReadNumEndpoints)
+
+	if quorum == 0 {
+		quorum = numEndpoints/2 + 1
+	}
+
+	return &ConsensusReadConfig{
+		NumEndpoints: numEndpoints,
+		Quorum:       min(quorum, numEndpoints),
+	}
+}
+
+// GetConsensusReadConfigFromHTTPRequest returns the consensus-read config requested through the HTTP request's headers.
+// Returns nil if the request does not opt into consensus-read mode, or the header's value is invalid.
+func GetConsensusReadConfigFromHTTPRequest(httpReq *http.Request) *ConsensusReadConfig {
+	headerValue := httpReq.Header.Get(HTTPHeaderConsensusRead)
+	if headerValue == "" {
+		return nil
+	}
+
+	numEndpoints, err := strconv.ParseUint(headerValue, 10, 32)
+	if err != nil || numEndpoints < MinConsensusReadNumEndpoints {
+		return nil
+	}
+
+	return NewConsensusReadConfig(uint(numEndpoints), 0)
+}
+
+// ConsensusEndpointResponse is a JSON-RPC response received from an endpoint in consensus-read mode.
+type ConsensusEndpointResponse struct {
+	EndpointAddr protocol.EndpointAddr
+	Response     jsonrpc.Response
+}
+
+// ConsensusResult is the outcome of comparing the responses received in consensus-read mode.
+type ConsensusResult struct {
+	// MajorityResponse is the response returned by the largest number of endpoints.
+	// Only set if at least one response was received.
+	MajorityResponse *jsonrpc.Response
+
+	// NumAgreeingResponses is the number of responses matching the majority response.
+	NumAgreeingResponses uint
+
+	// QuorumReached is true if the majority result was returned by at least a quorum of the endpoints.
+	// Only results count towards the quorum: a quorum of identical errors is not a consensus.
+	QuorumReached bool
+
+	// MajorityIsError is true if more endpoints returned the same JSON-RPC error than any single result.
+	// e.g. a quorum of lagging endpoints returning an error for a block they do not have yet.
+	// The error is returned to the user as-is, and no endpoint is flagged as disagreeing.
+	MajorityIsError bool
+
+	// DisagreeingEndpoints holds the endpoints whose results did not match the majority result.
+	//   - Only set if the quorum was reached: otherwise, no endpoint response can be considered correct.
+	//   - Endpoints which returned an error are excluded: errors are not compared against results.
+	DisagreeingEndpoints map[protocol.EndpointAddr]struct{}
+}
+
+// FindJSONRPCConsensus compares the supplied endpoint responses and returns the majority response.
+//   - The JSON-RPC ID is ignored, i.e. normalized, when comparing responses.
+//   - Results are compared after compacting their JSON, so whitespace differences are ignored.
+//   - Errors are compared by code, and never count towards the quorum.
+//   - On a tie, the response which first reached the tied count wins.
+func (c ConsensusReadConfig) FindJSONRPCConsensus(endpointResponses []ConsensusEndpointResponse) ConsensusResult {
+	var (
+		responseKeys = make([]string, len(endpointResponses))
+		keyCounts    = make(map[string]uint)
+		keyFirstIdx  = make(map[string]int)
+
+		majorityResultKey   string
+		majorityResultCount uint
+		majorityErrorKey    string
+		majorityErrorCount  uint
+	)
+
+	for idx, endpointResponse := range endpointResponses {
+		key := getJSONRPCResponseConsensusKey(endpointResponse.Response)
+		responseKeys[idx] = key
+
+		if _, found := keyFirstIdx[key]; !found {
+			keyFirstIdx[key] = idx
+		}
+		keyCounts[key]++
+
+		if endpointResponse.Response.Error != nil {
+			if keyCounts[key] > majorityErrorCount {
+				majorityErrorKey = key
+				majorityErrorCount = keyCounts[key]
+			}
+			continue
+		}
+
+		if keyCounts[key] > majorityResultCount {
+			majorityResultKey = key
+			majorityResultCount = keyCounts[key]
+		}
+	}
+
+	// The majority of the endpoints returned the same error: return it without flagging any endpoint.
+	if majorityErrorCount > majorityResultCount {
+		return ConsensusResult{
+			MajorityResponse:     &endpointResponses[keyFirstIdx[majorityErrorKey]].Response,
+			NumAgreeingResponses: majorityErrorCount,
+			MajorityIsError:      true,
+		}
+	}
+
+	majorityIdx, found := keyFirstIdx[majorityResultKey]
+	if !found {
+		return ConsensusResult{}
+	}
+
+	result := ConsensusResult{
+		MajorityResponse:     &endpointResponses[majorityIdx].Response,
+		NumAgreeingResponses: majorityResultCount,
+		QuorumReached:        majorityResultCount >= c.Quorum,
+	}
+
+	if !result.QuorumReached {
+		return result
+	}
+
+	result.DisagreeingEndpoints = make(map[protocol.EndpointAddr]struct{})
+	for idx, endpointResponse := range endpointResponses {
+		if endpointResponse.Response.Error != nil {
+			continue
+		}
+		if responseKeys[idx] != majorityResultKey {
+			result.DisagreeingEndpoints[endpointResponse.EndpointAddr] = struct{}{}
+		}
+	}
+
+	return result
+}
+
+// GetObservation returns the observation of the consensus-read outcome.
+func (c ConsensusReadConfig) GetObservation(numResponses int, result ConsensusResult) *qosobservations.ConsensusReadObservation {
+	disagreeingEndpointAddrs := make([]string, 0, len(result.DisagreeingEndpoints))
+	for endpointAddr := range result.DisagreeingEndpoints {
+		disagreeingEndpointAddrs = append(disagreeingEndpointAddrs, string(endpointAddr))
+	}
+
+	return &qosobservations.ConsensusReadObservation{
+		NumEndpoints:             uint32(c.NumEndpoints),
+		Quorum:                   uint32(c.Quorum),
+		NumResponses:             uint32(numResponses),
+		NumAgreeingResponses:     uint32(result.NumAgreeingResponses),
+		QuorumReached:            result.QuorumReached,
+		DisagreeingEndpointAddrs: disagreeingEndpointAddrs,
+	}
+}
+
+// getJSONRPCResponseConsensusKey returns the key used to compare JSON-RPC responses, ignoring the ID.
+func getJSONRPCResponseConsensusKey(response jsonrpc.Response) string {
+	if response.Error != nil {
+		// Error messages vary across node clients: only compare the error code.
+		return "error:" + strconv.Itoa(response.Error.Code)
+	}
+
+	if response.Result == nil {
+		return "result:null"
+	}
+
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, *response.Result); err != nil {
+		return "result:" + string(*response.Result)
+	}
+	return "result:" + compacted.String()
+}
+
+// GetConsensusNotReachedError returns the error describing a consensus-read which did not reach the quorum.
+func (c ConsensusReadConfig) GetConsensusNotReachedError(numResponses int, result ConsensusResult) error {
+	return fmt.Errorf("consensus not reached: %d of %d endpoint responses agreed, quorum of %d required",
+		result.NumAgreeingResponses, numResponses, c.Quorum)
+}
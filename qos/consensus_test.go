@@ -0,0 +1,152 @@
+package qos
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+func TestFindJSONRPCConsensus(t *testing.T) {
+	buildResponse := func(id int, result string) jsonrpc.Response {
+		raw := json.RawMessage(result)
+		return jsonrpc.Response{
+			ID:      jsonrpc.IDFromInt(id),
+			Version: jsonrpc.Version2,
+			Result:  &raw,
+		}
+	}
+	buildErrResponse := func(id int, code int) jsonrpc.Response {
+		return jsonrpc.Response{
+			ID:      jsonrpc.IDFromInt(id),
+			Version: jsonrpc.Version2,
+			Error:   &jsonrpc.ResponseError{Code: code, Message: "header not found"},
+		}
+	}
+
+	tests := []struct {
+		name                         string
+		config                       *ConsensusReadConfig
+		responses                    []ConsensusEndpointResponse
+		expectedQuorumReached        bool
+		expectedMajorityIsError      bool
+		expectedNumAgreeingResponses uint
+		expectedResult               string
+		expectedErrorCode            int
+		expectedDisagreeingEndpoints []protocol.EndpointAddr
+	}{
+		{
+			name:   "majority result is returned and the disagreeing endpoint is reported",
+			config: NewConsensusReadConfig(3, 0),
+			responses: []ConsensusEndpointResponse{
+				{EndpointAddr: "endpoint_1", Response: buildResponse(1, `"0x10"`)},
+				{EndpointAddr: "endpoint_2", Response: buildResponse(1, `"0x11"`)},
+				{EndpointAddr: "endpoint_3", Response: buildResponse(1, `"0x10"`)},
+			},
+			expectedQuorumReached:        true,
+			expectedNumAgreeingResponses: 2,
+			expectedResult:               `"0x10"`,
+			expectedDisagreeingEndpoints: []protocol.EndpointAddr{"endpoint_2"},
+		},
+		{
+			name:   "JSON-RPC ID and whitespace are ignored",
+			config: NewConsensusReadConfig(2, 2),
+			responses: []ConsensusEndpointResponse{
+				{EndpointAddr: "endpoint_1", Response: buildResponse(1, `{"a": 1}`)},
+				{EndpointAddr: "endpoint_2", Response: buildResponse(7, `{"a":1}`)},
+			},
+			expectedQuorumReached:        true,
+			expectedNumAgreeingResponses: 2,
+			expectedResult:               `{"a": 1}`,
+		},
+		{
+			name:   "quorum not reached",
+			config: NewConsensusReadConfig(3, 3),
+			responses: []ConsensusEndpointResponse{
+				{EndpointAddr: "endpoint_1", Response: buildResponse(1, `"0x10"`)},
+				{EndpointAddr: "endpoint_2", Response: buildResponse(1, `"0x11"`)},
+				{EndpointAddr: "endpoint_3", Response: buildResponse(1, `"0x10"`)},
+			},
+			expectedQuorumReached:        false,
+			expectedNumAgreeingResponses: 2,
+			expectedResult:               `"0x10"`,
+		},
+		{
+			name:   "errors do not count towards the quorum and are not reported as disagreeing",
+			config: NewConsensusReadConfig(3, 0),
+			responses: []ConsensusEndpointResponse{
+				{EndpointAddr: "endpoint_1", Response: buildResponse(1, `"0x10"`)},
+				{EndpointAddr: "endpoint_2", Response: buildErrResponse(1, -32000)},
+				{EndpointAddr: "endpoint_3", Response: buildResponse(1, `"0x10"`)},
+			},
+			expectedQuorumReached:        true,
+			expectedNumAgreeingResponses: 2,
+			expectedResult:               `"0x10"`,
+		},
+		{
+			name:   "majority error is returned without reporting the endpoints which returned a result",
+			config: NewConsensusReadConfig(3, 0),
+			responses: []ConsensusEndpointResponse{
+				{EndpointAddr: "endpoint_1", Response: buildErrResponse(1, -32000)},
+				{EndpointAddr: "endpoint_2", Response: buildResponse(1, `"0x10"`)},
+				{EndpointAddr: "endpoint_3", Response: buildErrResponse(1, -32000)},
+			},
+			expectedQuorumReached:        false,
+			expectedMajorityIsError:      true,
+			expectedNumAgreeingResponses: 2,
+			expectedErrorCode:            -32000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.config.FindJSONRPCConsensus(tt.responses)
+
+			require.Equal(t, tt.expectedQuorumReached, result.QuorumReached)
+			require.Equal(t, tt.expectedMajorityIsError, result.MajorityIsError)
+			require.Equal(t, tt.expectedNumAgreeingResponses, result.NumAgreeingResponses)
+			require.NotNil(t, result.MajorityResponse)
+			if tt.expectedMajorityIsError {
+				require.NotNil(t, result.MajorityResponse.Error)
+				require.Equal(t, tt.expectedErrorCode, result.MajorityResponse.Error.Code)
+			} else {
+				require.Equal(t, tt.expectedResult, string(*result.MajorityResponse.Result))
+			}
+
+			require.Len(t, result.DisagreeingEndpoints, len(tt.expectedDisagreeingEndpoints))
+			for _, endpointAddr := range tt.expectedDisagreeingEndpoints {
+				require.Contains(t, result.DisagreeingEndpoints, endpointAddr)
+			}
+		})
+	}
+}
+
+func TestGetConsensusReadConfigFromHTTPRequest(t *testing.T) {
+	tests := []struct {
+		name           string
+		headerValue    string
+		expectedConfig *ConsensusReadConfig
+	}{
+		{name: "header not set", headerValue: "", expectedConfig: nil},
+		{name: "invalid value", headerValue: "all", expectedConfig: nil},
+		{name: "single endpoint", headerValue: "1", expectedConfig: nil},
+		{name: "majority quorum", headerValue: "3", expectedConfig: &ConsensusReadConfig{NumEndpoints: 3, Quorum: 2}},
+		{name: "capped number of endpoints", headerValue: "100", expectedConfig: &ConsensusReadConfig{NumEndpoints: MaxConsensusReadNumEndpoints, Quorum: MaxConsensusReadNumEndpoints/2 + 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpReq, err := http.NewRequest(http.MethodPost, "/", nil)
+			require.NoError(t, err)
+			if tt.headerValue != "" {
+				httpReq.Header.Set(HTTPHeaderConsensusRead, tt.headerValue)
+			}
+
+			require.Equal(t, tt.expectedConfig, GetConsensusReadConfigFromHTTPRequest(httpReq))
+		})
+	}
+}
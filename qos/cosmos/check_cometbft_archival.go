@@ -0,0 +1,77 @@
+package cosmos
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+/* -------------------- CometBFT Archival Check -------------------- */
+
+// CometBFT ID checks begin with 2 for JSON-RPC requests.
+//
+// This is an arbitrary ID selected by the engineering team at Grove.
+// It is used for compatibility with the JSON-RPC spec.
+// It is a loose convention in the QoS package.
+
+// ID for the CometBFT archival check.
+const idCometBFTArchivalCheck = 2003
+
+// methodCometBFTBlock is the CometBFT JSON-RPC method for getting the block at a given height.
+// Reference: https://docs.cometbft.com/v1.0/spec/rpc/#block
+const methodCometBFTBlock = jsonrpc.Method("block")
+
+// checkArchivalInterval is the interval at which the archival check is re-run against an endpoint.
+// Pruning only moves an endpoint's retained range forward, so a long interval is sufficient.
+const checkArchivalInterval = 20 * time.Minute
+
+var (
+	errNoArchivalObs  = fmt.Errorf("endpoint has not had an observation of its response to the archival CometBFT '%q' request", methodCometBFTBlock)
+	errNotArchivalObs = fmt.Errorf("endpoint did not return the block in its response to the archival CometBFT '%q' request", methodCometBFTBlock)
+)
+
+// endpointCheckCometBFTArchival is a check that ensures the endpoint serves the block at a known historical height.
+// It is used to route requests targeting old heights away from pruned endpoints.
+//
+// Note that this check has an expiry as endpoints may start pruning at any time.
+type endpointCheckCometBFTArchival struct {
+	// available stores whether the endpoint returned the block at the archival check's height.
+	// It is nil if there has NOT been an observation of the endpoint's response to the archival check.
+	available *bool
+
+	// expiresAt stores the time at which the last check expires.
+	expiresAt time.Time
+}
+
+// getRequest returns a JSONRPC request for the block at the supplied historical height.
+// eg. '{"jsonrpc":"2.0","id":2003,"method":"block","params":{"height":"1000"}}'
+//
+// It is called in `request_validator_checks.go` to generate the endpoint checks.
+func (e *endpointCheckCometBFTArchival) getRequest(blockHeight uint64) jsonrpc.Request {
+	request := jsonrpc.Request{
+		JSONRPC: jsonrpc.Version2,
+		ID:      jsonrpc.IDFromInt(idCometBFTArchivalCheck),
+		Method:  methodCometBFTBlock,
+	}
+	// CometBFT expects 64-bit integers as strings.
+	request.SetParams(fmt.Appendf(nil, `{"height":%q}`, strconv.FormatUint(blockHeight, 10)))
+	return request
+}
+
+// IsArchival returns an error if the endpoint has not been observed returning the block at the archival check's height.
+func (e *endpointCheckCometBFTArchival) IsArchival() error {
+	if e.available == nil {
+		return errNoArchivalObs
+	}
+	if !*e.available {
+		return errNotArchivalObs
+	}
+	return nil
+}
+
+// IsExpired returns true if the check has expired and needs to be refreshed.
+func (e *endpointCheckCometBFTArchival) IsExpired() bool {
+	return time.Now().After(e.expiresAt)
+}
@@ -30,6 +30,7 @@ var (
 	errInvalidCometBFTStatusObs  = fmt.Errorf("endpoint returned an invalid response to a CometBFT '%q' request", methodCometBFTStatus)
 	errInvalidCometBFTChainIDObs = fmt.Errorf("endpoint returned an invalid chain ID in its response to a CometBFT '%q' request", methodCometBFTStatus)
 	errCatchingUpCometBFTObs     = fmt.Errorf("endpoint is catching up to the network in its response to a CometBFT '%q' request", methodCometBFTStatus)
+	errStaleBlockTimeCometBFTObs = fmt.Errorf("endpoint returned a stale latest block time in its response to a CometBFT '%q' request", methodCometBFTStatus)
 )
 
 // endpointCheckCometBFTStatus is a check that ensures the endpoint's status information is valid.
@@ -52,6 +53,14 @@ type endpointCheckCometBFTStatus struct {
 	// It is nil if there has NOT been an observation of the endpoint's response to a `status` request.
 	latestBlockHeight *uint64
 
+	// earliestBlockHeight stores the lowest block height retained by the endpoint, from its response to a `status` request.
+	// It is nil if there has NOT been an observation of the endpoint's response to a `status` request.
+	earliestBlockHeight *uint64
+
+	// latestBlockTime stores the time of the endpoint's latest block, from its response to a `status` request.
+	// It is the zero time if there has NOT been an observation, or the endpoint did not return a valid block time.
+	latestBlockTime time.Time
+
 	// expiresAt stores the time at which the last check expires.
 	expiresAt time.Time
 }
@@ -98,6 +107,20 @@ func (e *endpointCheckCometBFTStatus) GetLatestBlockHeight() (uint64, error) {
 	return *e.latestBlockHeight, nil
 }
 
+// GetEarliestBlockHeight returns the lowest block height retained by the endpoint, i.e. the start of its non-pruned range.
+func (e *endpointCheckCometBFTStatus) GetEarliestBlockHeight() (uint64, error) {
+	if e.earliestBlockHeight == nil {
+		return 0, errNoCometBFTStatusObs
+	}
+	return *e.earliestBlockHeight, nil
+}
+
+// GetLatestBlockTime returns the time of the endpoint's latest block.
+// Returns the zero time if the block time is not known.
+func (e *endpointCheckCometBFTStatus) GetLatestBlockTime() time.Time {
+	return e.latestBlockTime
+}
+
 // IsExpired returns true if the check has expired and needs to be refreshed.
 func (e *endpointCheckCometBFTStatus) IsExpired() bool {
 	return time.Now().After(e.expiresAt)
@@ -1,17 +1,21 @@
 package cosmos
 
 import (
-	"encoding/json"
-	"net/http"
-
 	"github.com/pokt-network/poktroll/pkg/polylog"
 
+	"github.com/buildwithgrove/path/gateway"
 	pathhttp "github.com/buildwithgrove/path/network/http"
 	qosobservations "github.com/buildwithgrove/path/observation/qos"
 	"github.com/buildwithgrove/path/protocol"
 	"github.com/buildwithgrove/path/qos/jsonrpc"
 )
 
+// requestContext supports distributing the members of a JSON-RPC batch request across multiple endpoints.
+var _ gateway.BatchQoSContext = &requestContext{}
+
+// requestContext supports broadcasting transaction submissions to multiple endpoints.
+var _ gateway.BroadcastQoSContext = &requestContext{}
+
 // requestContext provides specialized context for both JSONRPC and REST requests
 // Implements gateway.RequestQoSContext interface
 type requestContext struct {
@@ -35,6 +39,26 @@ type requestContext struct {
 	// In the case of a batch request of length 1, the response must be returned as an array.
 	isBatch bool
 
+	// jsonrpcBatchRequest holds the JSON-RPC requests in their original order.
+	// Used to return the responses to a batch request in request order.
+	// Not set for REST requests.
+	jsonrpcBatchRequest jsonrpc.BatchRequest
+
+	// blockHeights are the block heights targeted by the request, e.g. via the `x-cosmos-block-height` header.
+	// Used to select endpoints retaining the requested heights.
+	// Empty if the request does not target a specific height.
+	blockHeights []uint64
+
+	// Whether the request is a transaction submission, e.g. CometBFT `broadcast_tx_sync`.
+	// Transaction submissions are broadcast to multiple endpoints.
+	isTxBroadcast bool
+
+	// txBroadcastNumEndpoints is the number of endpoints a transaction submission is broadcast to.
+	txBroadcastNumEndpoints uint
+
+	// batchNumEndpoints is the maximum number of endpoints the members of a JSON-RPC batch request are distributed across.
+	batchNumEndpoints uint
+
 	// QoS observations for this request
 	observations *qosobservations.CosmosRequestObservations
 
@@ -52,7 +76,7 @@ type requestContext struct {
 	endpointResponseValidator func(polylog.Logger, []byte) response
 
 	// Service state for endpoint selection
-	serviceState protocol.EndpointSelector
+	serviceState *serviceState
 
 	// Endpoint response tracking
 	endpointResponses []endpointResponse
@@ -113,56 +137,19 @@ func (rc requestContext) GetHTTPResponse() pathhttp.HTTPResponse {
 		return rc.getBatchHTTPResponse()
 	}
 
-	// Handle single requests
-	return rc.endpointResponses[0].response.GetHTTPResponse()
-}
-
-// getBatchHTTPResponse handles batch requests by combining individual JSON-RPC responses
-// into an array according to the JSON-RPC 2.0 specification.
-// https://www.jsonrpc.org/specification#batch
-func (rc requestContext) getBatchHTTPResponse() pathhttp.HTTPResponse {
-	// Collect individual response payloads
-	var individualResponses []json.RawMessage
-	for _, endpointResp := range rc.endpointResponses {
-		// Extract the JSON payload from each response
-		payload := endpointResp.response.GetHTTPResponse().GetPayload()
-		if len(payload) > 0 {
-			individualResponses = append(individualResponses, json.RawMessage(payload))
-		}
-	}
-
-	// According to JSON-RPC spec: "If there are no Response objects contained within the Response array
-	// as it is to be sent to the client, the server MUST NOT return an empty Array and should return nothing at all."
-	// This can happen when all requests in the batch are notifications (which don't get responses)
-	// or when all individual responses are empty/invalid.
-	if len(individualResponses) == 0 {
-		// Create a responseGeneric for empty batch response and return its HTTP response
-		errorResponse := getGenericResponseBatchEmpty(rc.logger)
-		return errorResponse.GetHTTPResponse()
+	// Transaction broadcast to multiple endpoints: return the response of an endpoint which accepted the transaction.
+	if rc.isTxBroadcast {
+		return rc.getBroadcastHTTPResponse()
 	}
 
-	// Validate and construct batch response using jsonrpc package
-	batchResponse, err := jsonrpc.ValidateAndBuildBatchResponse(
-		rc.logger,
-		individualResponses,
-		rc.servicePayloads,
-	)
-	if err != nil {
-		// Create a responseGeneric for batch validation failure and return its HTTP response
-		errorResponse := getGenericJSONRPCErrResponseBatchMarshalFailure(rc.logger, err)
-		return errorResponse.GetHTTPResponse()
-	}
-
-	return jsonrpc.HTTPResponse{
-		ResponsePayload: batchResponse,
-		// According to the JSON-RPC 2.0 specification, even if individual responses
-		// in a batch contain errors, the entire batch should still return HTTP 200 OK.
-		HTTPStatusCode: http.StatusOK,
-	}
+	// Handle single requests
+	return rc.endpointResponses[0].response.GetHTTPResponse()
 }
 
 // GetObservations returns QoS observations for requests
 func (rc *requestContext) GetObservations() qosobservations.Observations {
+	rc.observations.ServiceStall = rc.serviceState.stallTracker.GetObservation()
+
 	// Handle case where no endpoint responses were received
 	if len(rc.endpointResponses) == 0 {
 		rc.observations.RequestLevelError = rc.protocolErrorObservationBuilder()
@@ -192,20 +179,23 @@ func (rc *requestContext) GetObservations() qosobservations.Observations {
 }
 
 // GetEndpointSelector returns the endpoint selector for the request context.
+// The request context is used, rather than the service state, to route requests targeting a specific block height.
 // Implements the gateway.RequestQoSContext interface.
 func (rc *requestContext) GetEndpointSelector() protocol.EndpointSelector {
-	return rc.serviceState
+	return rc
 }
 
 // Select returns the address of an endpoint using the request context's service state.
 // Implements the protocol.EndpointSelector interface.
+// Endpoints are pre-filtered to those retaining the block heights targeted by the request, if any.
 func (rc *requestContext) Select(allEndpoints protocol.EndpointAddrList) (protocol.EndpointAddr, error) {
-	return rc.serviceState.Select(allEndpoints)
+	return rc.serviceState.Select(rc.serviceState.filterEndpointsAtBlockHeights(rc.logger, allEndpoints, rc.blockHeights))
 }
 
 // SelectMultiple returns multiple endpoint addresses using the request context's service state.
 // Implements the protocol.EndpointSelector interface.
 func (rc *requestContext) SelectMultiple(allEndpoints protocol.EndpointAddrList, numEndpoints uint) (protocol.EndpointAddrList, error) {
 	// Select multiple endpoints from the available endpoints using the service state.
-	return rc.serviceState.SelectMultiple(allEndpoints, numEndpoints)
+	// Endpoints are pre-filtered to those retaining the block heights targeted by the request, if any.
+	return rc.serviceState.SelectMultiple(rc.serviceState.filterEndpointsAtBlockHeights(rc.logger, allEndpoints, rc.blockHeights), numEndpoints)
 }
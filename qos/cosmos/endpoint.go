@@ -18,6 +18,8 @@ type endpoint struct {
 	checkCometBFTStatus endpointCheckCometBFTStatus
 	// Checks node health via JSON-RPC `health`
 	checkCometBFTHealth endpointCheckCometBFTHealth
+	// Checks the block at the archival check's historical height is served via JSON-RPC `block`
+	checkCometBFTArchival endpointCheckCometBFTArchival
 
 	// *** CosmosSDK-specific checks ***
 	// Checks Cosmos SDK status via REST `/cosmos/base/node/v1beta1/status`
@@ -27,3 +29,23 @@ type endpoint struct {
 	// Checks EVM chain ID via eth_chainId
 	checkEVMChainID endpointCheckEVMChainID
 }
+
+// coversBlockHeight returns true if the endpoint is known to retain the supplied block height, i.e. the height is:
+//   - At or above the endpoint's earliest_block_height reported by CometBFT `status`.
+//   - Served by the endpoint according to the archival check, if at or below the archival check's height.
+//     A non-zero archivalBlockHeight indicates the archival check is enabled.
+//
+// Only the lower bound is enforced: the latest_block_height is only as recent as the endpoint's last `status` check,
+// and the chain keeps advancing between checks. Endpoints lagging behind are disqualified by the sync allowance instead.
+func (e endpoint) coversBlockHeight(blockHeight, archivalBlockHeight uint64) bool {
+	earliestBlockHeight, err := e.checkCometBFTStatus.GetEarliestBlockHeight()
+	if err != nil || blockHeight < earliestBlockHeight {
+		return false
+	}
+
+	if archivalBlockHeight != 0 && blockHeight <= archivalBlockHeight {
+		return e.checkCometBFTArchival.IsArchival() == nil
+	}
+
+	return true
+}
@@ -34,8 +34,10 @@ func (es *endpointStore) getEndpoint(endpointAddr protocol.EndpointAddr) endpoin
 
 // updateEndpointsFromObservations creates/updates endpoint entries in the store based
 // on the supplied observations. It returns the set of created/updated endpoints.
+// Observations of the archival check are only applied if they are for the supplied archival block height.
 func (es *endpointStore) updateEndpointsFromObservations(
 	cosmosObservations *qosobservations.CosmosRequestObservations,
+	archivalBlockHeight uint64,
 ) map[protocol.EndpointAddr]endpoint {
 	es.endpointsMu.Lock()
 	defer es.endpointsMu.Unlock()
@@ -68,6 +70,7 @@ func (es *endpointStore) updateEndpointsFromObservations(
 		endpointWasMutated := applyObservation(
 			&storedEndpoint,
 			observation,
+			archivalBlockHeight,
 		)
 
 		// If the observation did not mutate the endpoint, there is no need to update the stored endpoint entry.
@@ -92,6 +95,7 @@ func (es *endpointStore) updateEndpointsFromObservations(
 func applyObservation(
 	endpoint *endpoint,
 	observation *qosobservations.CosmosEndpointObservation,
+	archivalBlockHeight uint64,
 ) (endpointWasMutated bool) {
 	validationResult := observation.EndpointResponseValidationResult
 	if validationResult == nil {
@@ -131,6 +135,16 @@ func applyObservation(
 		applyEVMChainIDObservation(endpoint, response.ResponseEvmJsonrpcChainId)
 		endpointWasMutated = true
 
+	// CometBFT `block` method observation of the archival check.
+	// Skipped if the archival check's height has changed, e.g. due to a config update.
+	// Reference: https://docs.cometbft.com/v1.0/spec/rpc/#block
+	case *qosobservations.CosmosEndpointResponseValidationResult_ResponseCometBftBlock:
+		if response.ResponseCometBftBlock.BlockHeight != archivalBlockHeight {
+			return false
+		}
+		applyCometBFTArchivalObservation(endpoint, response.ResponseCometBftBlock)
+		endpointWasMutated = true
+
 		// TODO_TECHDEBT(@adshmh): Introduce and use a new response type to:
 		// - Capture invalid endpoint responses to RESTful API requests
 		// - Support sanctions on endpoints based on above.
@@ -170,12 +184,26 @@ func applyCometBFTStatusObservation(endpoint *endpoint, statusResponse *qosobser
 	chainID := statusResponse.ChainId
 	catchingUp := statusResponse.CatchingUp
 	blockHeight := parseBlockHeightResponse(statusResponse.LatestBlockHeight)
+	earliestBlockHeight := parseBlockHeightResponse(statusResponse.EarliestBlockHeight)
+	// An invalid or missing block time is stored as the zero time, i.e. unknown.
+	latestBlockTime, _ := time.Parse(time.RFC3339Nano, statusResponse.LatestBlockTime)
 
 	endpoint.checkCometBFTStatus = endpointCheckCometBFTStatus{
-		chainID:           &chainID,
-		catchingUp:        &catchingUp,
-		latestBlockHeight: &blockHeight,
-		expiresAt:         time.Now().Add(checkStatusInterval),
+		chainID:             &chainID,
+		catchingUp:          &catchingUp,
+		latestBlockHeight:   &blockHeight,
+		earliestBlockHeight: &earliestBlockHeight,
+		latestBlockTime:     latestBlockTime,
+		expiresAt:           time.Now().Add(checkStatusInterval),
+	}
+}
+
+// applyCometBFTArchivalObservation updates the archival check if a valid observation is provided.
+func applyCometBFTArchivalObservation(endpoint *endpoint, blockResponse *qosobservations.CosmosResponseCometBFTBlock) {
+	available := blockResponse.Available
+	endpoint.checkCometBFTArchival = endpointCheckCometBFTArchival{
+		available: &available,
+		expiresAt: time.Now().Add(checkArchivalInterval),
 	}
 }
 
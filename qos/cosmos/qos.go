@@ -9,6 +9,7 @@ import (
 	"github.com/buildwithgrove/path/gateway"
 	"github.com/buildwithgrove/path/metrics/devtools"
 	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos"
 )
 
 // QoS implements gateway.QoSService by providing:
@@ -56,6 +57,8 @@ func NewQoSInstance(logger polylog.Logger, config CosmosSDKServiceQoSConfig) *Qo
 		logger:           logger,
 		serviceQoSConfig: config,
 		endpointStore:    store,
+		// Stall detection is disabled unless an expected block time is configured for the service.
+		stallTracker: qos.NewStallTracker(config.getExpectedBlockTime()),
 	}
 
 	requestValidator := &requestValidator{
@@ -65,6 +68,11 @@ func NewQoSInstance(logger polylog.Logger, config CosmosSDKServiceQoSConfig) *Qo
 		evmChainID:    evmChainID,
 		serviceState:  serviceState,
 		supportedAPIs: config.getSupportedAPIs(),
+		requestLimits: config.getRequestLimits(),
+		// Transaction submissions are broadcast to the service's number of endpoints, or the default.
+		txBroadcastNumEndpoints: config.getTxBroadcastNumEndpoints(),
+		// Members of batch requests are distributed across the service's number of endpoints, or the default.
+		batchNumEndpoints: config.getBatchNumEndpoints(),
 	}
 
 	return &QoS{
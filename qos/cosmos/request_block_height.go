@@ -0,0 +1,125 @@
+package cosmos
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// headerCosmosBlockHeight is the HTTP header used by Cosmos SDK REST (gRPC-gateway) queries to target the state at a specific height.
+// Reference: https://docs.cosmos.network/main/user/run-node/interact-node#query-for-historical-state-using-rest
+const headerCosmosBlockHeight = "x-cosmos-block-height"
+
+// paramHeight is the name of the CometBFT RPC parameter holding the target block height.
+// Reference: https://docs.cometbft.com/v1.0/spec/rpc/
+const paramHeight = "height"
+
+// cometBFTHeightParamIndex maps CometBFT methods accepting a `height` parameter to the index of the parameter,
+// used if the params are sent as an array rather than an object.
+// Reference: https://docs.cometbft.com/v1.0/spec/rpc/
+var cometBFTHeightParamIndex = map[string]int{
+	"block":            0,
+	"block_results":    0,
+	"commit":           0,
+	"header":           0,
+	"validators":       0,
+	"consensus_params": 0,
+	"abci_query":       2, // abci_query params: path, data, height, prove
+}
+
+// cosmosSDKHeightPathPrefixes are the Cosmos SDK REST paths which include the target block height as their last segment.
+// e.g. `/cosmos/base/tendermint/v1beta1/blocks/1000`
+// Reference: https://docs.cosmos.network/api#tag/Service
+var cosmosSDKHeightPathPrefixes = []string{
+	"/cosmos/base/tendermint/v1beta1/blocks/",
+	"/cosmos/base/tendermint/v1beta1/validatorsets/",
+}
+
+// getJSONRPCRequestBlockHeights returns the block heights targeted by the supplied JSON-RPC requests.
+// Requests not targeting a specific height, e.g. the latest block, are skipped.
+func getJSONRPCRequestBlockHeights(jsonrpcReqs map[jsonrpc.ID]jsonrpc.Request) []uint64 {
+	var blockHeights []uint64
+	for _, req := range jsonrpcReqs {
+		if blockHeight, ok := getJSONRPCRequestBlockHeight(req); ok {
+			blockHeights = append(blockHeights, blockHeight)
+		}
+	}
+	return blockHeights
+}
+
+// getJSONRPCRequestBlockHeight returns the block height targeted by a CometBFT JSON-RPC request.
+// The height may be sent as a string or a number, in either an object or an array of params.
+func getJSONRPCRequestBlockHeight(req jsonrpc.Request) (uint64, bool) {
+	paramIndex, ok := cometBFTHeightParamIndex[string(req.Method)]
+	if !ok || req.Params.IsEmpty() {
+		return 0, false
+	}
+
+	paramsBz, err := json.Marshal(req.Params)
+	if err != nil {
+		return 0, false
+	}
+
+	var objectParams map[string]json.RawMessage
+	if err := json.Unmarshal(paramsBz, &objectParams); err == nil {
+		return parseBlockHeightParam(objectParams[paramHeight])
+	}
+
+	var arrayParams []json.RawMessage
+	if err := json.Unmarshal(paramsBz, &arrayParams); err != nil || len(arrayParams) <= paramIndex {
+		return 0, false
+	}
+	return parseBlockHeightParam(arrayParams[paramIndex])
+}
+
+// parseBlockHeightParam parses a block height param sent as either a string, e.g. "1000", or a number.
+// A missing, null or zero height targets the latest block, and is not reported.
+func parseBlockHeightParam(param json.RawMessage) (uint64, bool) {
+	if len(param) == 0 {
+		return 0, false
+	}
+
+	var heightStr string
+	if err := json.Unmarshal(param, &heightStr); err != nil {
+		heightStr = string(param)
+	}
+
+	return parseBlockHeight(heightStr)
+}
+
+// getRESTRequestBlockHeight returns the block height targeted by a REST request, using either:
+//   - The `x-cosmos-block-height` header of Cosmos SDK queries.
+//   - The `height` query parameter of CometBFT URI requests, e.g. `/block?height=1000`.
+//   - The last path segment of Cosmos SDK block queries, e.g. `/cosmos/base/tendermint/v1beta1/blocks/1000`.
+func getRESTRequestBlockHeight(httpRequestURL *url.URL, httpRequestHeaders http.Header) (uint64, bool) {
+	if blockHeight, ok := parseBlockHeight(httpRequestHeaders.Get(headerCosmosBlockHeight)); ok {
+		return blockHeight, true
+	}
+
+	if _, ok := cometBFTHeightParamIndex[strings.TrimPrefix(httpRequestURL.Path, "/")]; ok {
+		// CometBFT URI requests may quote string params, e.g. `/block?height="1000"`.
+		return parseBlockHeight(strings.Trim(httpRequestURL.Query().Get(paramHeight), `"`))
+	}
+
+	for _, prefix := range cosmosSDKHeightPathPrefixes {
+		if heightStr, found := strings.CutPrefix(httpRequestURL.Path, prefix); found {
+			return parseBlockHeight(heightStr)
+		}
+	}
+
+	return 0, false
+}
+
+// parseBlockHeight parses a decimal block height.
+// Returns false for an invalid or zero height: CometBFT and Cosmos SDK treat a zero height as the latest block.
+func parseBlockHeight(heightStr string) (uint64, bool) {
+	blockHeight, err := strconv.ParseUint(heightStr, 10, 64)
+	if err != nil || blockHeight == 0 {
+		return 0, false
+	}
+	return blockHeight, true
+}
@@ -0,0 +1,126 @@
+package cosmos
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+func TestGetJSONRPCRequestBlockHeight(t *testing.T) {
+	tests := []struct {
+		name           string
+		request        string
+		expectedHeight uint64
+		expectedOK     bool
+	}{
+		{
+			name:           "block with height as a string in object params",
+			request:        `{"jsonrpc":"2.0","id":1,"method":"block","params":{"height":"1000"}}`,
+			expectedHeight: 1000,
+			expectedOK:     true,
+		},
+		{
+			name:           "block_results with height as a number in array params",
+			request:        `{"jsonrpc":"2.0","id":1,"method":"block_results","params":[1000]}`,
+			expectedHeight: 1000,
+			expectedOK:     true,
+		},
+		{
+			name:           "abci_query with height as the third array param",
+			request:        `{"jsonrpc":"2.0","id":1,"method":"abci_query","params":["/store/bank/key","0x00","1000",false]}`,
+			expectedHeight: 1000,
+			expectedOK:     true,
+		},
+		{
+			name:       "block without params targets the latest block",
+			request:    `{"jsonrpc":"2.0","id":1,"method":"block"}`,
+			expectedOK: false,
+		},
+		{
+			name:       "block with a zero height targets the latest block",
+			request:    `{"jsonrpc":"2.0","id":1,"method":"block","params":{"height":"0"}}`,
+			expectedOK: false,
+		},
+		{
+			name:       "method without a height param",
+			request:    `{"jsonrpc":"2.0","id":1,"method":"tx","params":{"hash":"0xabc"}}`,
+			expectedOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req jsonrpc.Request
+			require.NoError(t, json.Unmarshal([]byte(tt.request), &req))
+
+			height, ok := getJSONRPCRequestBlockHeight(req)
+			require.Equal(t, tt.expectedOK, ok)
+			require.Equal(t, tt.expectedHeight, height)
+		})
+	}
+}
+
+func TestGetRESTRequestBlockHeight(t *testing.T) {
+	tests := []struct {
+		name           string
+		url            string
+		headers        http.Header
+		expectedHeight uint64
+		expectedOK     bool
+	}{
+		{
+			name:           "Cosmos SDK query with block height header",
+			url:            "/cosmos/bank/v1beta1/balances/osmo1abc",
+			headers:        http.Header{"X-Cosmos-Block-Height": []string{"1000"}},
+			expectedHeight: 1000,
+			expectedOK:     true,
+		},
+		{
+			name:           "CometBFT URI request with quoted height",
+			url:            `/block?height="1000"`,
+			expectedHeight: 1000,
+			expectedOK:     true,
+		},
+		{
+			name:           "Cosmos SDK block query by height",
+			url:            "/cosmos/base/tendermint/v1beta1/blocks/1000",
+			expectedHeight: 1000,
+			expectedOK:     true,
+		},
+		{
+			name:       "Cosmos SDK latest block query",
+			url:        "/cosmos/base/tendermint/v1beta1/blocks/latest",
+			expectedOK: false,
+		},
+		{
+			name:       "Cosmos SDK query without block height header",
+			url:        "/cosmos/bank/v1beta1/balances/osmo1abc",
+			expectedOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestURL, err := url.Parse(tt.url)
+			require.NoError(t, err)
+
+			height, ok := getRESTRequestBlockHeight(requestURL, tt.headers)
+			require.Equal(t, tt.expectedOK, ok)
+			require.Equal(t, tt.expectedHeight, height)
+		})
+	}
+}
+
+func TestBuildRESTServicePayload_ForwardsBlockHeightHeader(t *testing.T) {
+	requestURL, err := url.Parse("/cosmos/bank/v1beta1/balances/osmo1abc")
+	require.NoError(t, err)
+
+	payload := buildRESTServicePayload(sharedtypes.RPCType_REST, requestURL, http.MethodGet, http.Header{"X-Cosmos-Block-Height": []string{"1000"}}, nil)
+	require.Equal(t, map[string]string{headerCosmosBlockHeight: "1000"}, payload.Headers)
+}
@@ -0,0 +1,147 @@
+package cosmos
+
+import (
+	"encoding/json"
+	"net/http"
+
+	pathhttp "github.com/buildwithgrove/path/network/http"
+	"github.com/buildwithgrove/path/qos"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// cosmosSDKTxBroadcastPath is the Cosmos SDK REST path for submitting a signed transaction.
+// Reference: https://docs.cosmos.network/api#tag/Service/operation/BroadcastTx
+const cosmosSDKTxBroadcastPath = "/cosmos/tx/v1beta1/txs"
+
+// cosmosSDKErrCodeTxInMempoolCache is the Cosmos SDK error code returned for a transaction already in the mempool.
+// Reference: https://github.com/cosmos/cosmos-sdk/blob/main/types/errors/errors.go
+const cosmosSDKErrCodeTxInMempoolCache = 19
+
+// cometBFTTxBroadcastMethods are the CometBFT methods for submitting a signed transaction.
+// Used as JSON-RPC methods, and as URI paths (with a leading "/") for REST-style requests.
+// Reference: https://docs.cometbft.com/v0.38/rpc/#/Tx
+var cometBFTTxBroadcastMethods = map[string]struct{}{
+	"broadcast_tx_sync":   {},
+	"broadcast_tx_async":  {},
+	"broadcast_tx_commit": {},
+}
+
+// isJSONRPCTxBroadcastRequest returns true if the JSON-RPC request is a single (i.e. non-batch) transaction submission.
+func isJSONRPCTxBroadcastRequest(jsonrpcReqs map[jsonrpc.ID]jsonrpc.Request, isBatch bool) bool {
+	if isBatch || len(jsonrpcReqs) != 1 {
+		return false
+	}
+
+	for _, jsonrpcReq := range jsonrpcReqs {
+		_, found := cometBFTTxBroadcastMethods[string(jsonrpcReq.Method)]
+		return found
+	}
+	return false
+}
+
+// isRESTTxBroadcastRequest returns true if the REST request is a transaction submission, either:
+//   - A Cosmos SDK broadcast, i.e. POST /cosmos/tx/v1beta1/txs
+//   - A CometBFT URI broadcast, e.g. /broadcast_tx_sync?tx=0x...
+func isRESTTxBroadcastRequest(httpRequestPath, httpRequestMethod string) bool {
+	if httpRequestPath == cosmosSDKTxBroadcastPath {
+		return httpRequestMethod == http.MethodPost
+	}
+
+	if len(httpRequestPath) < 2 || httpRequestPath[0] != '/' {
+		return false
+	}
+	_, found := cometBFTTxBroadcastMethods[httpRequestPath[1:]]
+	return found
+}
+
+// txBroadcastResult captures the fields of a transaction submission response used to determine its outcome.
+//   - CometBFT: `result.code`, or `result.check_tx.code` for broadcast_tx_commit.
+//   - Cosmos SDK: `tx_response.code`
+type txBroadcastResult struct {
+	Code    *uint32 `json:"code"`
+	Log     string  `json:"log"`
+	RawLog  string  `json:"raw_log"`
+	CheckTx *struct {
+		Code uint32 `json:"code"`
+		Log  string `json:"log"`
+	} `json:"check_tx"`
+}
+
+// accepted returns true if the result indicates the transaction was accepted, or was already in the mempool.
+func (r txBroadcastResult) accepted() bool {
+	code, log := r.Code, r.Log+r.RawLog
+	if r.CheckTx != nil {
+		code, log = &r.CheckTx.Code, r.CheckTx.Log
+	}
+
+	if code == nil {
+		return false
+	}
+
+	return *code == 0 || *code == cosmosSDKErrCodeTxInMempoolCache || qos.IsTxAlreadySubmittedErrMessage(log)
+}
+
+// isSuccessfulTxBroadcastResponse returns true if the endpoint accepted the submitted transaction.
+// Supports both CometBFT (JSON-RPC and URI) and Cosmos SDK REST response formats.
+func isSuccessfulTxBroadcastResponse(httpResponse pathhttp.HTTPResponse) bool {
+	var response struct {
+		// CometBFT
+		Result *txBroadcastResult `json:"result"`
+		Error  *struct {
+			Message string `json:"message"`
+			Data    string `json:"data"`
+		} `json:"error"`
+		// Cosmos SDK
+		TxResponse *txBroadcastResult `json:"tx_response"`
+	}
+
+	if err := json.Unmarshal(httpResponse.GetPayload(), &response); err != nil {
+		return false
+	}
+
+	switch {
+	// e.g. CometBFT returns "tx already exists in cache" as the error data.
+	case response.Error != nil:
+		return qos.IsTxAlreadySubmittedErrMessage(response.Error.Message + " " + response.Error.Data)
+	case response.Result != nil:
+		return response.Result.accepted()
+	case response.TxResponse != nil:
+		return response.TxResponse.accepted()
+	default:
+		return false
+	}
+}
+
+// GetBroadcastNumEndpoints returns the number of endpoints the request should be broadcast to.
+// Returns 0 for requests which are not transaction submissions.
+// Implements the gateway.BroadcastQoSContext interface.
+func (rc *requestContext) GetBroadcastNumEndpoints() uint {
+	if !rc.isTxBroadcast {
+		return 0
+	}
+	return rc.txBroadcastNumEndpoints
+}
+
+// HasSuccessfulBroadcastResponse returns true if any endpoint accepted the transaction.
+// Implements the gateway.BroadcastQoSContext interface.
+func (rc *requestContext) HasSuccessfulBroadcastResponse() bool {
+	for _, endpointResp := range rc.endpointResponses {
+		if isSuccessfulTxBroadcastResponse(endpointResp.response.GetHTTPResponse()) {
+			return true
+		}
+	}
+	return false
+}
+
+// getBroadcastHTTPResponse returns the user response for a transaction broadcast to multiple endpoints.
+// The first response indicating the transaction was accepted is used, otherwise the first received response.
+func (rc requestContext) getBroadcastHTTPResponse() pathhttp.HTTPResponse {
+	for _, endpointResp := range rc.endpointResponses {
+		httpResponse := endpointResp.response.GetHTTPResponse()
+		if isSuccessfulTxBroadcastResponse(httpResponse) {
+			return httpResponse
+		}
+	}
+
+	return rc.endpointResponses[0].response.GetHTTPResponse()
+}
@@ -0,0 +1,82 @@
+package cosmos
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+func TestIsSuccessfulTxBroadcastResponse(t *testing.T) {
+	tests := []struct {
+		name            string
+		payload         string
+		expectedSuccess bool
+	}{
+		{
+			name:            "CometBFT broadcast_tx_sync accepted",
+			payload:         `{"jsonrpc":"2.0","id":1,"result":{"code":0,"data":"","log":"","hash":"ABCD"}}`,
+			expectedSuccess: true,
+		},
+		{
+			name:            "CometBFT broadcast_tx_sync rejected by CheckTx",
+			payload:         `{"jsonrpc":"2.0","id":1,"result":{"code":5,"log":"insufficient funds","hash":"ABCD"}}`,
+			expectedSuccess: false,
+		},
+		{
+			name:            "CometBFT broadcast_tx_commit accepted",
+			payload:         `{"jsonrpc":"2.0","id":1,"result":{"check_tx":{"code":0},"tx_result":{"code":0},"hash":"ABCD"}}`,
+			expectedSuccess: true,
+		},
+		{
+			name:            "CometBFT transaction already in the mempool cache",
+			payload:         `{"jsonrpc":"2.0","id":1,"error":{"code":-32603,"message":"Internal error","data":"tx already exists in cache"}}`,
+			expectedSuccess: true,
+		},
+		{
+			name:            "CometBFT internal error",
+			payload:         `{"jsonrpc":"2.0","id":1,"error":{"code":-32603,"message":"Internal error","data":"mempool is full"}}`,
+			expectedSuccess: false,
+		},
+		{
+			name:            "Cosmos SDK broadcast accepted",
+			payload:         `{"tx_response":{"height":"0","txhash":"ABCD","code":0,"raw_log":""}}`,
+			expectedSuccess: true,
+		},
+		{
+			name:            "Cosmos SDK transaction already in the mempool",
+			payload:         `{"tx_response":{"height":"0","txhash":"ABCD","code":19,"raw_log":"tx already in mempool"}}`,
+			expectedSuccess: true,
+		},
+		{
+			name:            "Cosmos SDK response missing the code",
+			payload:         `{"tx_response":{"height":"0","txhash":"ABCD"}}`,
+			expectedSuccess: false,
+		},
+		{
+			name:            "malformed response",
+			payload:         `not json`,
+			expectedSuccess: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpResponse := jsonrpc.HTTPResponse{
+				ResponsePayload: []byte(tt.payload),
+				HTTPStatusCode:  http.StatusOK,
+			}
+			require.Equal(t, tt.expectedSuccess, isSuccessfulTxBroadcastResponse(httpResponse))
+		})
+	}
+}
+
+func TestIsRESTTxBroadcastRequest(t *testing.T) {
+	require.True(t, isRESTTxBroadcastRequest("/cosmos/tx/v1beta1/txs", http.MethodPost))
+	require.False(t, isRESTTxBroadcastRequest("/cosmos/tx/v1beta1/txs", http.MethodGet))
+	require.True(t, isRESTTxBroadcastRequest("/broadcast_tx_sync", http.MethodGet))
+	require.False(t, isRESTTxBroadcastRequest("/status", http.MethodGet))
+	require.False(t, isRESTTxBroadcastRequest("/", http.MethodGet))
+}
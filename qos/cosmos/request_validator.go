@@ -1,7 +1,7 @@
 package cosmos
 
 import (
-	"io"
+	"errors"
 	"net/http"
 	"strings"
 
@@ -24,6 +24,15 @@ type requestValidator struct {
 	serviceID     protocol.ServiceID
 	supportedAPIs map[sharedtypes.RPCType]struct{}
 	serviceState  *serviceState
+
+	// requestLimits bounds the size of the requests accepted by the service.
+	requestLimits jsonrpc.RequestLimits
+
+	// txBroadcastNumEndpoints is the number of endpoints a transaction submission is broadcast to.
+	txBroadcastNumEndpoints uint
+
+	// batchNumEndpoints is the maximum number of endpoints the members of a batch request are distributed across.
+	batchNumEndpoints uint
 }
 
 // validateHTTPRequest validates an HTTP request and routes to appropriate sub-validator
@@ -38,7 +47,11 @@ func (rv *requestValidator) validateHTTPRequest(req *http.Request) (gateway.Requ
 
 	// Read the request body.
 	// This is necessary to distinguish REST vs. JSONRPC on request with POST HTTP method.
-	body, err := io.ReadAll(req.Body)
+	body, err := rv.requestLimits.ReadRequestBody(req.Body)
+	if errors.Is(err, jsonrpc.ErrRequestBodyTooLarge) {
+		logger.Info().Err(err).Msg("Rejecting request: request body too large")
+		return rv.createJSONRPCParseFailureContext(err), false
+	}
 	if err != nil {
 		logger.Error().Err(err).Msg("Failed to parse JSONRPC request")
 		// Return a context with a JSONRPC-formatted response, as we cannot detect the request type.
@@ -58,7 +71,7 @@ func (rv *requestValidator) validateHTTPRequest(req *http.Request) (gateway.Requ
 
 		// Build and returns a request context to handle the REST request.
 		// Uses a specialized context for handling invalid requests.
-		return rv.validateRESTRequest(req.URL, req.Method, body)
+		return rv.validateRESTRequest(req.URL, req.Method, req.Header, body)
 	}
 }
 
@@ -75,6 +75,15 @@ func (rv *requestValidator) getCometBFTEndpointChecks(endpoint endpoint) []gatew
 		))
 	}
 
+	// CometBFT 'block' method archival check, if enabled for the service.
+	archivalBlockHeight := rv.serviceState.serviceQoSConfig.getArchivalCheckConfig().getBlockHeight()
+	if archivalBlockHeight != 0 && rv.shouldCometBFTArchivalCheckRun(endpoint.checkCometBFTArchival) {
+		checks = append(checks, rv.getCometBFTArchivalRequestContext(
+			endpoint.checkCometBFTArchival.getRequest(archivalBlockHeight),
+			archivalBlockHeight,
+		))
+	}
+
 	return checks
 }
 
@@ -118,6 +127,11 @@ func (rv *requestValidator) shouldCometBFTStatusCheckRun(check endpointCheckCome
 	return check.expiresAt.IsZero() || check.IsExpired()
 }
 
+// shouldCometBFTArchivalCheckRun returns true if the archival check is not yet initialized or has expired.
+func (rv *requestValidator) shouldCometBFTArchivalCheckRun(check endpointCheckCometBFTArchival) bool {
+	return check.expiresAt.IsZero() || check.IsExpired()
+}
+
 // shouldEVMChainIDCheckRun returns true if the chain ID check is not yet initialized or has expired.
 func (rv *requestValidator) shouldEVMChainIDCheckRun(check endpointCheckEVMChainID) bool {
 	return check.expiresAt.IsZero() || check.IsExpired()
@@ -135,6 +149,7 @@ func (rv *requestValidator) getJSONRPCRequestContextFromRequest(
 
 	context, ok := rv.buildJSONRPCRequestContext(
 		jsonrpcReqs,
+		jsonrpc.BatchRequest{Requests: []jsonrpc.Request{jsonrpcReq}},
 		false, // isBatch = false for single request
 		qosobservations.RequestOrigin_REQUEST_ORIGIN_SYNTHETIC,
 	)
@@ -144,6 +159,22 @@ func (rv *requestValidator) getJSONRPCRequestContextFromRequest(
 	return context
 }
 
+// getCometBFTArchivalRequestContext prepares a gateway request context for the archival check.
+// The context uses the archival check's response validator, which records whether the block at the archival height was returned.
+func (rv *requestValidator) getCometBFTArchivalRequestContext(
+	jsonrpcReq jsonrpc.Request,
+	archivalBlockHeight uint64,
+) gateway.RequestQoSContext {
+	context := rv.getJSONRPCRequestContextFromRequest(sharedtypes.RPCType_COMET_BFT, jsonrpcReq)
+	if reqCtx, ok := context.(*requestContext); ok {
+		reqCtx.endpointResponseValidator = getCometBFTArchivalResponseValidator(
+			map[jsonrpc.ID]jsonrpc.Request{jsonrpcReq.ID: jsonrpcReq},
+			archivalBlockHeight,
+		)
+	}
+	return context
+}
+
 // getRESTRequestContextFromRequest prepares a gateway request context for a REST QoS endpoint check.
 func (rv *requestValidator) getRESTRequestContextFromRequest(
 	rpcType sharedtypes.RPCType,
@@ -163,6 +194,7 @@ func (rv *requestValidator) getRESTRequestContextFromRequest(
 		rpcType,
 		restReq.URL,
 		restReq.Method,
+		restReq.Header,
 		httpRequestBody,
 		qosobservations.RequestOrigin_REQUEST_ORIGIN_SYNTHETIC,
 	)
@@ -33,15 +33,23 @@ func (rv *requestValidator) validateJSONRPCRequest(
 	logger := rv.logger.With("validator", "JSONRPC")
 
 	// Parse and validate the JSONRPC request(s) - handles both single and batch requests
-	jsonrpcReqs, isBatch, err := jsonrpc.ParseJSONRPCFromRequestBody(logger, body)
+	jsonrpcReqs, jsonrpcBatchRequest, isBatch, err := jsonrpc.ParseOrderedJSONRPCFromRequestBody(logger, body)
 	if err != nil {
 		// If no requests parsed or empty ID, requestID will be zero value (empty)
 		return rv.createJSONRPCParseFailureContext(err), false
 	}
 
+	// Reject batch requests exceeding the service's maximum batch size.
+	if isBatch {
+		if err := rv.requestLimits.ValidateBatchSize(len(jsonrpcBatchRequest.Requests)); err != nil {
+			return rv.createJSONRPCParseFailureContext(err), false
+		}
+	}
+
 	// Build and return the request context
 	return rv.buildJSONRPCRequestContext(
 		jsonrpcReqs,
+		jsonrpcBatchRequest,
 		isBatch,
 		qosobservations.RequestOrigin_REQUEST_ORIGIN_ORGANIC,
 	)
@@ -80,6 +88,7 @@ func (rv *requestValidator) buildJSONRPCServicePayloadsFromRequests(
 
 func (rv *requestValidator) buildJSONRPCRequestContext(
 	jsonrpcReqs map[jsonrpc.ID]jsonrpc.Request,
+	jsonrpcBatchRequest jsonrpc.BatchRequest,
 	isBatch bool,
 	requestOrigin qosobservations.RequestOrigin,
 ) (gateway.RequestQoSContext, bool) {
@@ -109,9 +118,14 @@ func (rv *requestValidator) buildJSONRPCRequestContext(
 		serviceState:                 rv.serviceState,
 		servicePayloads:              servicePayloads,
 		isBatch:                      isBatch,
+		jsonrpcBatchRequest:          jsonrpcBatchRequest,
+		blockHeights:                 getJSONRPCRequestBlockHeights(jsonrpcReqs),
+		isTxBroadcast:                isJSONRPCTxBroadcastRequest(jsonrpcReqs, isBatch),
+		txBroadcastNumEndpoints:      rv.txBroadcastNumEndpoints,
+		batchNumEndpoints:            rv.batchNumEndpoints,
 		observations:                 requestObservation,
 		endpointResponseValidator:    getJSONRPCRequestEndpointResponseValidator(jsonrpcReqs),
-		protocolErrorResponseBuilder: buildJSONRPCProtocolErrorResponse(getJsonRpcIDForErrorResponse(jsonrpcReqs)),
+		protocolErrorResponseBuilder: buildJSONRPCProtocolErrorResponse(getJsonRpcIDForErrorResponse(jsonrpcReqs), rv.serviceState.stallTracker),
 		// Protocol-level request error observation is the same for JSONRPC and REST.
 		protocolErrorObservationBuilder: buildProtocolErrorObservation,
 	}, true
@@ -148,12 +162,15 @@ func getJSONRPCRequestEndpointResponseValidator(
 
 func buildJSONRPCProtocolErrorResponse(
 	jsonrpcRequestID jsonrpc.ID,
+	stallTracker *qos.StallTracker,
 ) func(logger polylog.Logger) pathhttp.HTTPResponse {
 	return func(logger polylog.Logger) pathhttp.HTTPResponse {
 		errorResp := jsonrpc.NewErrResponseInternalErr(
 			jsonrpcRequestID,
 			errors.New("protocol-level error: no endpoint responses received"),
 		)
+		// Let the user know if the service is stalled, e.g. a chain halt.
+		errorResp = stallTracker.AddToErrorResponse(errorResp)
 		return qos.BuildHTTPResponseFromJSONRPCResponse(logger, errorResp)
 	}
 }
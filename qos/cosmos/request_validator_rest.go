@@ -2,6 +2,7 @@ package cosmos
 
 import (
 	"errors"
+	"net/http"
 	"net/url"
 
 	"github.com/pokt-network/poktroll/pkg/polylog"
@@ -28,6 +29,7 @@ const restRequestID = "rest_request"
 func (rv *requestValidator) validateRESTRequest(
 	httpRequestURL *url.URL,
 	httpRequestMethod string,
+	httpRequestHeaders http.Header,
 	httpRequestBody []byte,
 ) (gateway.RequestQoSContext, bool) {
 	httpRequestPath := httpRequestURL.Path
@@ -58,6 +60,7 @@ func (rv *requestValidator) validateRESTRequest(
 		rpcType,
 		httpRequestURL,
 		httpRequestMethod,
+		httpRequestHeaders,
 		httpRequestBody,
 		qosobservations.RequestOrigin_REQUEST_ORIGIN_ORGANIC,
 	)
@@ -68,6 +71,7 @@ func (rv *requestValidator) buildRESTRequestContext(
 	rpcType sharedtypes.RPCType,
 	httpRequestURL *url.URL,
 	httpRequestMethod string,
+	httpRequestHeaders http.Header,
 	httpRequestBody []byte,
 	requestOrigin qosobservations.RequestOrigin,
 ) (gateway.RequestQoSContext, bool) {
@@ -80,9 +84,16 @@ func (rv *requestValidator) buildRESTRequestContext(
 		rpcType,
 		httpRequestURL,
 		httpRequestMethod,
+		httpRequestHeaders,
 		httpRequestBody,
 	)
 
+	// Requests targeting a specific block height are only sent to endpoints retaining that height.
+	var blockHeights []uint64
+	if blockHeight, ok := getRESTRequestBlockHeight(httpRequestURL, httpRequestHeaders); ok {
+		blockHeights = []uint64{blockHeight}
+	}
+
 	// Generate the QoS observation for the request.
 	// requestContext will amend this with endpoint observation(s).
 	requestObservation := rv.buildRESTRequestObservations(
@@ -111,9 +122,12 @@ func (rv *requestValidator) buildRESTRequestContext(
 		servicePayloads: map[jsonrpc.ID]protocol.Payload{
 			jsonrpc.IDFromStr(restRequestID): servicePayload,
 		},
+		blockHeights:                 blockHeights,
+		isTxBroadcast:                isRESTTxBroadcastRequest(httpRequestURL.Path, httpRequestMethod),
+		txBroadcastNumEndpoints:      rv.txBroadcastNumEndpoints,
 		observations:                 requestObservation,
 		endpointResponseValidator:    getRESTRequestEndpointResponseValidator(httpRequestURL.Path),
-		protocolErrorResponseBuilder: buildRESTProtocolErrorResponse(),
+		protocolErrorResponseBuilder: buildRESTProtocolErrorResponse(rv.serviceState.stallTracker),
 		// Protocol-level request error observation is the same for JSONRPC and REST.
 		protocolErrorObservationBuilder: buildProtocolErrorObservation,
 	}, true
@@ -123,6 +137,7 @@ func buildRESTServicePayload(
 	rpcType sharedtypes.RPCType,
 	httpRequestURL *url.URL,
 	httpRequestMethod string,
+	httpRequestHeaders http.Header,
 	httpRequestBody []byte,
 ) protocol.Payload {
 	path := httpRequestURL.Path
@@ -130,11 +145,17 @@ func buildRESTServicePayload(
 		path += "?" + httpRequestURL.RawQuery
 	}
 
+	// Forward the height of historical Cosmos SDK queries to the endpoint.
+	headers := map[string]string{}
+	if blockHeight := httpRequestHeaders.Get(headerCosmosBlockHeight); blockHeight != "" {
+		headers[headerCosmosBlockHeight] = blockHeight
+	}
+
 	return protocol.Payload{
 		Data:    string(httpRequestBody),
 		Method:  httpRequestMethod,
 		Path:    path,
-		Headers: map[string]string{},
+		Headers: headers,
 		RPCType: rpcType, // Add the RPCType hint, so protocol sets correct HTTP headers for the endpoint.
 	}
 }
@@ -184,7 +205,7 @@ func (rv *requestValidator) buildRESTRequestObservations(
 }
 
 // TODO_TECHDEBT(@adshmh): Review the expected user experience on protocol errors in REST requests.
-func buildRESTProtocolErrorResponse() func(logger polylog.Logger) pathhttp.HTTPResponse {
+func buildRESTProtocolErrorResponse(stallTracker *qos.StallTracker) func(logger polylog.Logger) pathhttp.HTTPResponse {
 	return func(logger polylog.Logger) pathhttp.HTTPResponse {
 		// For REST requests, we return a JSON-RPC error response with null ID
 		// TODO_TECHDEBT(@adshmh): Consider returning proper REST error response format
@@ -192,6 +213,8 @@ func buildRESTProtocolErrorResponse() func(logger polylog.Logger) pathhttp.HTTPR
 			jsonrpc.ID{}, // use null as ID.
 			errors.New("protocol-level error: no endpoint responses received"),
 		)
+		// Let the user know if the service is stalled, e.g. a chain halt.
+		errorResp = stallTracker.AddToErrorResponse(errorResp)
 		return qos.BuildHTTPResponseFromJSONRPCResponse(logger, errorResp)
 	}
 }
@@ -0,0 +1,121 @@
+package cosmos
+
+import (
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
+	pathhttp "github.com/buildwithgrove/path/network/http"
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/qos"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// resultBlock captures the fields of a CometBFT `block` method result used by the archival check.
+// Reference: https://docs.cometbft.com/v1.0/spec/rpc/#block
+type resultBlock struct {
+	Block struct {
+		Header struct {
+			// CometBFT JSON-RPC returns the height as a string.
+			Height string `json:"height"`
+		} `json:"header"`
+	} `json:"block"`
+}
+
+// getCometBFTArchivalResponseValidator returns the validator of endpoint responses to the archival check.
+//
+// DEV_NOTE: The archival check uses a dedicated validator, rather than an entry in jsonrpcRequestEndpointResponseValidators,
+// so that responses to organic `block` requests, which may target any height, are not used to assess endpoints' archival status.
+func getCometBFTArchivalResponseValidator(
+	jsonrpcReqs map[jsonrpc.ID]jsonrpc.Request,
+	blockHeight uint64,
+) func(polylog.Logger, []byte) response {
+	return func(logger polylog.Logger, endpointResponseBz []byte) response {
+		jsonrpcResponse, _, responseValidationErr := unmarshalAsJSONRPCResponse(logger, jsonrpcReqs, endpointResponseBz)
+		if responseValidationErr != nil {
+			return &jsonrpcUnrecognizedResponse{
+				logger:          logger,
+				jsonrpcResponse: jsonrpcResponse,
+				validationErr:   *responseValidationErr,
+			}
+		}
+
+		return responseValidatorCometBFTBlock(logger, jsonrpcResponse, blockHeight)
+	}
+}
+
+// responseValidatorCometBFTBlock validates a response to the archival check's `block` request.
+// The block is considered available only if the endpoint returned the block at the requested height.
+func responseValidatorCometBFTBlock(logger polylog.Logger, jsonrpcResponse jsonrpc.Response, blockHeight uint64) response {
+	logger = logger.With("response_validator", "block", "block_height", blockHeight)
+
+	// The endpoint returned an error, e.g. the height has been pruned.
+	if jsonrpcResponse.IsError() {
+		logger.Debug().
+			Str("jsonrpc_error", jsonrpcResponse.Error.Message).
+			Int("jsonrpc_error_code", jsonrpcResponse.Error.Code).
+			Msg("Endpoint returned JSON-RPC error for the archival block request")
+
+		return &responseCometBFTBlock{
+			logger:          logger,
+			jsonrpcResponse: jsonrpcResponse,
+			blockHeight:     blockHeight,
+		}
+	}
+
+	var result resultBlock
+	if err := jsonrpcResponse.UnmarshalResult(&result); err != nil {
+		logger.Debug().Err(err).Msg("Failed to unmarshal JSON-RPC result of the archival block request")
+
+		return &responseCometBFTBlock{
+			logger:          logger,
+			jsonrpcResponse: jsonrpcResponse,
+			blockHeight:     blockHeight,
+		}
+	}
+
+	return &responseCometBFTBlock{
+		logger:          logger,
+		jsonrpcResponse: jsonrpcResponse,
+		blockHeight:     blockHeight,
+		available:       parseBlockHeightResponse(result.Block.Header.Height) == blockHeight,
+	}
+}
+
+// responseCometBFTBlock captures an endpoint's response to the archival check's `block` request.
+// Reference: https://docs.cometbft.com/v1.0/spec/rpc/#block
+type responseCometBFTBlock struct {
+	logger polylog.Logger
+
+	// jsonrpcResponse stores the JSON-RPC response parsed from an endpoint's response bytes
+	jsonrpcResponse jsonrpc.Response
+
+	// blockHeight is the historical block height requested by the archival check.
+	blockHeight uint64
+
+	// available indicates whether the endpoint returned the block at the requested height.
+	available bool
+}
+
+// GetObservation returns an observation of the endpoint's response to the archival check.
+// Implements the response interface
+func (r *responseCometBFTBlock) GetObservation() qosobservations.CosmosEndpointObservation {
+	return qosobservations.CosmosEndpointObservation{
+		EndpointResponseValidationResult: &qosobservations.CosmosEndpointResponseValidationResult{
+			ResponseValidationType: qosobservations.CosmosResponseValidationType_COSMOS_RESPONSE_VALIDATION_TYPE_JSONRPC,
+			HttpStatusCode:         int32(r.jsonrpcResponse.GetRecommendedHTTPStatusCode()),
+			ValidationError:        nil, // No validation error for successfully processed responses
+			UserJsonrpcResponse:    r.jsonrpcResponse.GetObservation(),
+			ParsedResponse: &qosobservations.CosmosEndpointResponseValidationResult_ResponseCometBftBlock{
+				ResponseCometBftBlock: &qosobservations.CosmosResponseCometBFTBlock{
+					BlockHeight: r.blockHeight,
+					Available:   r.available,
+				},
+			},
+		},
+	}
+}
+
+// GetHTTPResponse builds and returns the HTTP response
+// Implements the response interface
+func (r *responseCometBFTBlock) GetHTTPResponse() pathhttp.HTTPResponse {
+	return qos.BuildHTTPResponseFromJSONRPCResponse(r.logger, r.jsonrpcResponse)
+}
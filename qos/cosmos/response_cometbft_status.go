@@ -17,7 +17,7 @@ import (
 // but CometBFT JSON-RPC returns string values, causing unmarshalling errors.
 //
 // Workaround: Using custom structs with string fields for compatibility.
-// Only includes fields needed for QoS validation (chain_id, catching_up, latest_block_height).
+// Only includes fields needed for QoS validation (chain_id, catching_up, latest_block_height, earliest_block_height, latest_block_time).
 //
 // Reference: https://github.com/cometbft/cometbft/blob/4226b0ea6ab4725ef807a16b86d6d24835bb45d4/rpc/core/types/responses.go#L100
 type (
@@ -29,8 +29,10 @@ type (
 
 	// Info about the node's syncing state
 	SyncInfo struct {
-		LatestBlockHeight string `json:"latest_block_height"`
-		CatchingUp        bool   `json:"catching_up"`
+		LatestBlockHeight   string `json:"latest_block_height"`
+		EarliestBlockHeight string `json:"earliest_block_height"`
+		LatestBlockTime     string `json:"latest_block_time"`
+		CatchingUp          bool   `json:"catching_up"`
 	}
 
 	// DefaultNodeInfo is the basic node information exchanged
@@ -91,14 +93,18 @@ func responseValidatorCometBFTStatus(logger polylog.Logger, jsonrpcResponse json
 		Str("chain_id", result.NodeInfo.Network).
 		Bool("catching_up", result.SyncInfo.CatchingUp).
 		Str("latest_block_height", result.SyncInfo.LatestBlockHeight).
+		Str("earliest_block_height", result.SyncInfo.EarliestBlockHeight).
+		Str("latest_block_time", result.SyncInfo.LatestBlockTime).
 		Msg("Successfully parsed /status response")
 
 	return &responseCometBFTStatus{
-		logger:            logger,
-		jsonrpcResponse:   jsonrpcResponse,
-		cosmosSDKChainID:  result.NodeInfo.Network,
-		catchingUp:        result.SyncInfo.CatchingUp,
-		latestBlockHeight: result.SyncInfo.LatestBlockHeight,
+		logger:              logger,
+		jsonrpcResponse:     jsonrpcResponse,
+		cosmosSDKChainID:    result.NodeInfo.Network,
+		catchingUp:          result.SyncInfo.CatchingUp,
+		latestBlockHeight:   result.SyncInfo.LatestBlockHeight,
+		earliestBlockHeight: result.SyncInfo.EarliestBlockHeight,
+		latestBlockTime:     result.SyncInfo.LatestBlockTime,
 	}
 }
 
@@ -125,6 +131,16 @@ type responseCometBFTStatus struct {
 	// Comes from the `SyncInfo.LatestBlockHeight` field in the `/status` response
 	// Reference: https://docs.cometbft.com/v1.0/spec/rpc/#status
 	latestBlockHeight string
+
+	// earliestBlockHeight stores the lowest block height retained by the endpoint as a string.
+	// Comes from the `SyncInfo.EarliestBlockHeight` field in the `/status` response
+	// Reference: https://docs.cometbft.com/v1.0/spec/rpc/#status
+	earliestBlockHeight string
+
+	// latestBlockTime stores the time of the endpoint's latest block, in RFC3339 format.
+	// Comes from the `SyncInfo.LatestBlockTime` field in the `/status` response
+	// Reference: https://docs.cometbft.com/v1.0/spec/rpc/#status
+	latestBlockTime string
 }
 
 // GetObservation returns an observation using a /status request's response
@@ -138,9 +154,11 @@ func (r *responseCometBFTStatus) GetObservation() qosobservations.CosmosEndpoint
 			UserJsonrpcResponse:    r.jsonrpcResponse.GetObservation(),
 			ParsedResponse: &qosobservations.CosmosEndpointResponseValidationResult_ResponseCometBftStatus{
 				ResponseCometBftStatus: &qosobservations.CosmosResponseCometBFTStatus{
-					ChainId:           r.cosmosSDKChainID,
-					CatchingUp:        r.catchingUp,
-					LatestBlockHeight: r.latestBlockHeight,
+					ChainId:             r.cosmosSDKChainID,
+					CatchingUp:          r.catchingUp,
+					LatestBlockHeight:   r.latestBlockHeight,
+					EarliestBlockHeight: r.earliestBlockHeight,
+					LatestBlockTime:     r.latestBlockTime,
 				},
 			},
 		},
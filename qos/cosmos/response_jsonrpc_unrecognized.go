@@ -52,20 +52,3 @@ func getGenericResponseBatchEmpty(logger polylog.Logger) jsonrpcUnrecognizedResp
 		validationErr:   qosobservations.CosmosResponseValidationError_COSMOS_RESPONSE_VALIDATION_ERROR_UNSPECIFIED, // No validation error - this is valid JSON-RPC behavior
 	}
 }
-
-// getGenericJSONRPCErrResponseBatchMarshalFailure creates a generic response for batch marshaling failures.
-// This occurs when individual responses are valid but combining them into a JSON array fails.
-// Uses null ID per JSON-RPC spec for batch-level errors that cannot be correlated to specific requests.
-func getGenericJSONRPCErrResponseBatchMarshalFailure(logger polylog.Logger, err error) jsonrpcUnrecognizedResponse {
-	logger.Error().Err(err).Msg("Failed to marshal batch response")
-
-	// Create the batch marshal failure response using the error function
-	jsonrpcResponse := jsonrpc.NewErrResponseBatchMarshalFailure(err)
-
-	// No validation error since this is an internal processing issue, not an endpoint issue
-	return jsonrpcUnrecognizedResponse{
-		logger:          logger,
-		jsonrpcResponse: jsonrpcResponse,
-		validationErr:   qosobservations.CosmosResponseValidationError_COSMOS_RESPONSE_VALIDATION_ERROR_UNSPECIFIED, // No validation error - this is an internal marshaling issue
-	}
-}
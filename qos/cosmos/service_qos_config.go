@@ -1,9 +1,13 @@
 package cosmos
 
 import (
+	"time"
+
 	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 
 	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
 )
 
 // QoSType is the QoS type for the CosmosSDK blockchain.
@@ -28,6 +32,97 @@ type CosmosSDKServiceQoSConfig interface {
 	getEVMChainID() string
 	getSyncAllowance() uint64
 	getSupportedAPIs() map[sharedtypes.RPCType]struct{}
+	getRequestLimits() jsonrpc.RequestLimits
+	getArchivalCheckConfig() *cosmosArchivalCheckConfig
+	getExpectedBlockTime() time.Duration
+	getTxBroadcastNumEndpoints() uint
+	getBatchNumEndpoints() uint
+}
+
+// CosmosSDKServiceQoSConfigOption customizes an optional setting of a CosmosSDK service QoS configuration.
+type CosmosSDKServiceQoSConfigOption func(*cosmosSDKServiceQoSConfig)
+
+// WithCosmosSDKChainID sets the Cosmos chain ID of the service, e.g. "cosmoshub-4".
+// Used to override the chain ID of an existing config: see OverrideCosmosSDKServiceQoSConfig.
+func WithCosmosSDKChainID(cosmosSDKChainID string) CosmosSDKServiceQoSConfigOption {
+	return func(c *cosmosSDKServiceQoSConfig) {
+		c.cosmosSDKChainID = cosmosSDKChainID
+	}
+}
+
+// WithEVMChainID sets the EVM chain ID of a service with native EVM support, e.g. XRPLEVM.
+// Used to override the EVM chain ID of an existing config: see OverrideCosmosSDKServiceQoSConfig.
+func WithEVMChainID(evmChainID string) CosmosSDKServiceQoSConfigOption {
+	return func(c *cosmosSDKServiceQoSConfig) {
+		c.evmChainID = evmChainID
+	}
+}
+
+// WithSupportedAPIs sets the RPC types supported by the service.
+// Used to override the supported APIs of an existing config: see OverrideCosmosSDKServiceQoSConfig.
+func WithSupportedAPIs(supportedAPIs map[sharedtypes.RPCType]struct{}) CosmosSDKServiceQoSConfigOption {
+	return func(c *cosmosSDKServiceQoSConfig) {
+		c.supportedAPIs = supportedAPIs
+	}
+}
+
+// WithSyncAllowance sets the number of blocks an endpoint may be behind the perceived block number and still be considered valid.
+// Defaults to defaultCosmosSDKBlockNumberSyncAllowance if not set.
+func WithSyncAllowance(syncAllowance uint64) CosmosSDKServiceQoSConfigOption {
+	return func(c *cosmosSDKServiceQoSConfig) {
+		c.syncAllowance = syncAllowance
+	}
+}
+
+// WithArchivalCheck enables the archival check using the supplied configuration.
+// Requests targeting a block height at or below the archival check's height are only sent to endpoints which passed the check.
+func WithArchivalCheck(archivalCheckConfig *cosmosArchivalCheckConfig) CosmosSDKServiceQoSConfigOption {
+	return func(c *cosmosSDKServiceQoSConfig) {
+		c.archivalCheckConfig = archivalCheckConfig
+	}
+}
+
+// WithMaxBatchSize sets the maximum number of requests in a JSONRPC batch request.
+// Defaults to jsonrpc.DefaultMaxBatchSize if not set.
+func WithMaxBatchSize(maxBatchSize int) CosmosSDKServiceQoSConfigOption {
+	return func(c *cosmosSDKServiceQoSConfig) {
+		c.maxBatchSize = maxBatchSize
+	}
+}
+
+// WithMaxRequestBodyBytes sets the maximum size of a request body, in bytes.
+// Defaults to jsonrpc.DefaultMaxRequestBodyBytes if not set.
+func WithMaxRequestBodyBytes(maxRequestBodyBytes int64) CosmosSDKServiceQoSConfigOption {
+	return func(c *cosmosSDKServiceQoSConfig) {
+		c.maxRequestBodyBytes = maxRequestBodyBytes
+	}
+}
+
+// WithExpectedBlockTime enables stall detection for the service:
+//   - The service is considered stalled if the perceived block height does not advance for several expected block times.
+//   - Endpoints reporting a latest block time too far in the past are disqualified, unless the whole service is stalled.
+//
+// An expectedBlockTime of 0 disables stall detection, which is the default.
+func WithExpectedBlockTime(expectedBlockTime time.Duration) CosmosSDKServiceQoSConfigOption {
+	return func(c *cosmosSDKServiceQoSConfig) {
+		c.expectedBlockTime = expectedBlockTime
+	}
+}
+
+// WithTxBroadcastNumEndpoints sets the number of endpoints a transaction submission, e.g. CometBFT `broadcast_tx_sync`, is broadcast to.
+// Defaults to qos.DefaultTxBroadcastNumEndpoints if not set. A numEndpoints of 1 disables broadcasting.
+func WithTxBroadcastNumEndpoints(numEndpoints uint) CosmosSDKServiceQoSConfigOption {
+	return func(c *cosmosSDKServiceQoSConfig) {
+		c.txBroadcastNumEndpoints = numEndpoints
+	}
+}
+
+// WithBatchNumEndpoints sets the maximum number of endpoints the members of a JSON-RPC batch request are distributed across.
+// Defaults to qos.DefaultBatchNumEndpoints if not set. A numEndpoints of 1 disables distribution: batches are sent to a single endpoint.
+func WithBatchNumEndpoints(numEndpoints uint) CosmosSDKServiceQoSConfigOption {
+	return func(c *cosmosSDKServiceQoSConfig) {
+		c.batchNumEndpoints = numEndpoints
+	}
 }
 
 // NewCosmosSDKServiceQoSConfig creates a new CosmosSDK service configuration.
@@ -36,13 +131,58 @@ func NewCosmosSDKServiceQoSConfig(
 	cosmosSDKChainID string,
 	evmChainID string,
 	supportedAPIs map[sharedtypes.RPCType]struct{},
+	opts ...CosmosSDKServiceQoSConfigOption,
 ) CosmosSDKServiceQoSConfig {
-	return cosmosSDKServiceQoSConfig{
+	config := cosmosSDKServiceQoSConfig{
 		serviceID:        serviceID,
 		cosmosSDKChainID: cosmosSDKChainID,
 		evmChainID:       evmChainID,
 		supportedAPIs:    supportedAPIs,
 	}
+
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return config
+}
+
+// OverrideCosmosSDKServiceQoSConfig returns a copy of the supplied config, with the options applied on top of its settings.
+// e.g. used to change a single setting of a compiled-in service config, while keeping all its other settings.
+func OverrideCosmosSDKServiceQoSConfig(config CosmosSDKServiceQoSConfig, opts ...CosmosSDKServiceQoSConfigOption) CosmosSDKServiceQoSConfig {
+	overridden, ok := config.(cosmosSDKServiceQoSConfig)
+	if !ok {
+		return config
+	}
+
+	for _, opt := range opts {
+		opt(&overridden)
+	}
+
+	return overridden
+}
+
+// NewCosmosArchivalCheckConfig creates the archival check configuration of a CosmosSDK service.
+// The blockHeight is a known historical height: only endpoints returning the CometBFT block at that height are considered archival.
+func NewCosmosArchivalCheckConfig(blockHeight uint64) *cosmosArchivalCheckConfig {
+	return &cosmosArchivalCheckConfig{
+		blockHeight: blockHeight,
+	}
+}
+
+// cosmosArchivalCheckConfig is the configuration of the archival check of a CosmosSDK service.
+type cosmosArchivalCheckConfig struct {
+	// blockHeight is the historical block height queried by the archival check.
+	blockHeight uint64
+}
+
+// getBlockHeight returns the historical block height queried by the archival check.
+// Returns 0 if the archival check is disabled.
+func (c *cosmosArchivalCheckConfig) getBlockHeight() uint64 {
+	if c == nil {
+		return 0
+	}
+	return c.blockHeight
 }
 
 // Ensure implementation satisfies interface
@@ -54,6 +194,28 @@ type cosmosSDKServiceQoSConfig struct {
 	evmChainID       string
 	syncAllowance    uint64
 	supportedAPIs    map[sharedtypes.RPCType]struct{}
+
+	// archivalCheckConfig is the configuration of the archival check.
+	// The archival check is disabled if nil.
+	archivalCheckConfig *cosmosArchivalCheckConfig
+
+	// maxBatchSize is the maximum number of requests in a JSONRPC batch request.
+	maxBatchSize int
+
+	// maxRequestBodyBytes is the maximum size of a request body, in bytes.
+	maxRequestBodyBytes int64
+
+	// expectedBlockTime is the expected time between blocks, used for stall detection.
+	// Stall detection is disabled if set to 0.
+	expectedBlockTime time.Duration
+
+	// txBroadcastNumEndpoints is the number of endpoints a transaction submission is broadcast to.
+	// The default is used if set to 0.
+	txBroadcastNumEndpoints uint
+
+	// batchNumEndpoints is the maximum number of endpoints the members of a JSON-RPC batch request are distributed across.
+	// The default is used if set to 0.
+	batchNumEndpoints uint
 }
 
 // GetServiceID returns the ID of the service.
@@ -103,3 +265,33 @@ func (c cosmosSDKServiceQoSConfig) getSyncAllowance() uint64 {
 func (c cosmosSDKServiceQoSConfig) getSupportedAPIs() map[sharedtypes.RPCType]struct{} {
 	return c.supportedAPIs
 }
+
+// getRequestLimits returns the limits on the size of requests, with defaults applied.
+// Implements the CosmosSDKServiceQoSConfig interface.
+func (c cosmosSDKServiceQoSConfig) getRequestLimits() jsonrpc.RequestLimits {
+	return jsonrpc.NewRequestLimits(c.maxBatchSize, c.maxRequestBodyBytes)
+}
+
+// getArchivalCheckConfig returns the archival check configuration, or nil if the archival check is disabled.
+// Implements the CosmosSDKServiceQoSConfig interface.
+func (c cosmosSDKServiceQoSConfig) getArchivalCheckConfig() *cosmosArchivalCheckConfig {
+	return c.archivalCheckConfig
+}
+
+// getExpectedBlockTime returns the expected time between blocks: 0 if stall detection is disabled.
+// Implements the CosmosSDKServiceQoSConfig interface.
+func (c cosmosSDKServiceQoSConfig) getExpectedBlockTime() time.Duration {
+	return c.expectedBlockTime
+}
+
+// getTxBroadcastNumEndpoints returns the number of endpoints a transaction submission is broadcast to, with the default applied.
+// Implements the CosmosSDKServiceQoSConfig interface.
+func (c cosmosSDKServiceQoSConfig) getTxBroadcastNumEndpoints() uint {
+	return qos.GetTxBroadcastNumEndpoints(c.txBroadcastNumEndpoints)
+}
+
+// getBatchNumEndpoints returns the maximum number of endpoints the members of a JSON-RPC batch request are distributed across, with the default applied.
+// Implements the CosmosSDKServiceQoSConfig interface.
+func (c cosmosSDKServiceQoSConfig) getBatchNumEndpoints() uint {
+	return qos.GetBatchNumEndpoints(c.batchNumEndpoints)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/buildwithgrove/path/metrics/devtools"
 	qosobservations "github.com/buildwithgrove/path/observation/qos"
 	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos"
 )
 
 var _ protocol.EndpointSelector = &serviceState{}
@@ -43,6 +44,16 @@ type serviceState struct {
 	// It is calculated as the maximum of block height reported by
 	// any of the endpoints for the service.
 	perceivedBlockNumber uint64
+
+	// stallTracker tracks whether the perceived block number is advancing.
+	// It is nil if stall detection is not enabled for the service.
+	stallTracker *qos.StallTracker
+}
+
+// IsStalled returns true if the perceived block number has stopped advancing, e.g. due to a chain halt.
+// Implements the health.StallReporter interface.
+func (ss *serviceState) IsStalled() bool {
+	return ss.stallTracker.IsStalled()
 }
 
 /* -------------------- QoS Endpoint State Updater -------------------- */
@@ -60,6 +71,7 @@ func (ss *serviceState) ApplyObservations(observations *qosobservations.Observat
 
 	updatedEndpoints := ss.endpointStore.updateEndpointsFromObservations(
 		cosmosSDKObservations,
+		ss.serviceQoSConfig.getArchivalCheckConfig().getBlockHeight(),
 	)
 
 	return ss.updateFromEndpoints(updatedEndpoints)
@@ -102,6 +114,9 @@ func (ss *serviceState) updateFromEndpoints(updatedEndpoints map[protocol.Endpoi
 		}
 	}
 
+	// Track the time at which the perceived block number last advanced, to detect a stalled service.
+	ss.stallTracker.UpdateHeight(ss.perceivedBlockNumber)
+
 	return nil
 }
 
@@ -5,6 +5,8 @@ import (
 	"math/rand"
 	"time"
 
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
 	"github.com/buildwithgrove/path/protocol"
 	"github.com/buildwithgrove/path/qos/selector"
 )
@@ -115,3 +117,50 @@ func (ss *serviceState) filterValidEndpoints(availableEndpoints protocol.Endpoin
 
 	return filteredEndpointsAddr, nil
 }
+
+// filterEndpointsAtBlockHeights returns the subset of available endpoints retaining all the supplied block heights.
+// Used to route requests targeting a specific height, e.g. via the `x-cosmos-block-height` header, away from pruned endpoints.
+//
+// All available endpoints are returned if:
+//   - No block heights are supplied, i.e. the request targets the latest state.
+//   - No endpoint is known to retain the block heights: the endpoint selection falls back to the regular validation.
+func (ss *serviceState) filterEndpointsAtBlockHeights(
+	logger polylog.Logger,
+	availableEndpoints protocol.EndpointAddrList,
+	blockHeights []uint64,
+) protocol.EndpointAddrList {
+	if len(blockHeights) == 0 {
+		return availableEndpoints
+	}
+
+	archivalBlockHeight := ss.serviceQoSConfig.getArchivalCheckConfig().getBlockHeight()
+
+	ss.endpointStore.endpointsMu.RLock()
+	defer ss.endpointStore.endpointsMu.RUnlock()
+
+	var filteredEndpoints protocol.EndpointAddrList
+	for _, endpointAddr := range availableEndpoints {
+		endpoint, found := ss.endpointStore.endpoints[endpointAddr]
+		if !found || !endpointCoversBlockHeights(endpoint, blockHeights, archivalBlockHeight) {
+			continue
+		}
+		filteredEndpoints = append(filteredEndpoints, endpointAddr)
+	}
+
+	if len(filteredEndpoints) == 0 {
+		logger.Warn().Msgf("No endpoint is known to retain the requested block heights %v: falling back to all %d available endpoints.", blockHeights, len(availableEndpoints))
+		return availableEndpoints
+	}
+
+	return filteredEndpoints
+}
+
+// endpointCoversBlockHeights returns true if the endpoint retains all the supplied block heights.
+func endpointCoversBlockHeights(endpoint endpoint, blockHeights []uint64, archivalBlockHeight uint64) bool {
+	for _, blockHeight := range blockHeights {
+		if !endpoint.coversBlockHeight(blockHeight, archivalBlockHeight) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,161 @@
+package cosmos
+
+import (
+	"testing"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// newEndpointRetaining returns an endpoint whose CometBFT status reports the supplied retained block range.
+func newEndpointRetaining(earliestBlockHeight, latestBlockHeight uint64) endpoint {
+	return endpoint{
+		checkCometBFTStatus: endpointCheckCometBFTStatus{
+			earliestBlockHeight: &earliestBlockHeight,
+			latestBlockHeight:   &latestBlockHeight,
+		},
+	}
+}
+
+func TestServiceState_FilterEndpointsAtBlockHeights(t *testing.T) {
+	available, notAvailable := true, false
+
+	archivalEndpoint := newEndpointRetaining(1, 2000)
+	archivalEndpoint.checkCometBFTArchival.available = &available
+
+	// Reports a full range, but failed the archival check, e.g. its state is pruned.
+	failedArchivalEndpoint := newEndpointRetaining(1, 2000)
+	failedArchivalEndpoint.checkCometBFTArchival.available = &notAvailable
+
+	endpoints := map[protocol.EndpointAddr]endpoint{
+		"archival":        archivalEndpoint,
+		"failed_archival": failedArchivalEndpoint,
+		"pruned":          newEndpointRetaining(1500, 2000),
+		"no_status":       {},
+	}
+	allEndpoints := protocol.EndpointAddrList{"archival", "failed_archival", "pruned", "no_status"}
+
+	tests := []struct {
+		name                string
+		availableEndpoints  protocol.EndpointAddrList
+		archivalBlockHeight uint64
+		blockHeights        []uint64
+		expected            protocol.EndpointAddrList
+	}{
+		{
+			name:     "request not targeting a block height is sent to any endpoint",
+			expected: allEndpoints,
+		},
+		{
+			name:         "request for a recent block height is sent to endpoints retaining it",
+			blockHeights: []uint64{1800},
+			expected:     protocol.EndpointAddrList{"archival", "failed_archival", "pruned"},
+		},
+		{
+			name:         "request for a block height just above the last checked latest block height is sent to endpoints retaining it",
+			blockHeights: []uint64{2001},
+			expected:     protocol.EndpointAddrList{"archival", "failed_archival", "pruned"},
+		},
+		{
+			name:         "request for a pruned block height skips pruned endpoints",
+			blockHeights: []uint64{1000},
+			expected:     protocol.EndpointAddrList{"archival", "failed_archival"},
+		},
+		{
+			name:                "request at or below the archival check height is only sent to endpoints passing the check",
+			archivalBlockHeight: 1000,
+			blockHeights:        []uint64{500},
+			expected:            protocol.EndpointAddrList{"archival"},
+		},
+		{
+			name:                "batch request is only sent to endpoints retaining every block height",
+			archivalBlockHeight: 1000,
+			blockHeights:        []uint64{1800, 500},
+			expected:            protocol.EndpointAddrList{"archival"},
+		},
+		{
+			name:               "falls back to all endpoints if none retains the block height",
+			availableEndpoints: protocol.EndpointAddrList{"pruned", "no_status"},
+			blockHeights:       []uint64{1000},
+			expected:           protocol.EndpointAddrList{"pruned", "no_status"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var opts []CosmosSDKServiceQoSConfigOption
+			if tt.archivalBlockHeight != 0 {
+				opts = append(opts, WithArchivalCheck(NewCosmosArchivalCheckConfig(tt.archivalBlockHeight)))
+			}
+
+			ss := &serviceState{
+				logger:           polyzero.NewLogger(),
+				serviceQoSConfig: NewCosmosSDKServiceQoSConfig("osmosis", "osmosis-1", "", nil, opts...),
+				endpointStore:    &endpointStore{endpoints: endpoints},
+			}
+
+			availableEndpoints := tt.availableEndpoints
+			if availableEndpoints == nil {
+				availableEndpoints = allEndpoints
+			}
+
+			filtered := ss.filterEndpointsAtBlockHeights(ss.logger, availableEndpoints, tt.blockHeights)
+			require.Equal(t, tt.expected, filtered)
+		})
+	}
+}
+
+func TestApplyObservation_ArchivalCheck(t *testing.T) {
+	const archivalBlockHeight = 1000
+
+	tests := []struct {
+		name                string
+		endpointResponse    string
+		archivalBlockHeight uint64
+		expectedMutated     bool
+		expectedErr         error
+	}{
+		{
+			name:                "endpoint returning the block at the archival height passes the check",
+			endpointResponse:    `{"jsonrpc":"2.0","id":2003,"result":{"block":{"header":{"height":"1000"}}}}`,
+			archivalBlockHeight: archivalBlockHeight,
+			expectedMutated:     true,
+		},
+		{
+			name:                "endpoint returning an error for a pruned height fails the check",
+			endpointResponse:    `{"jsonrpc":"2.0","id":2003,"error":{"code":-32603,"message":"height 1000 is not available, lowest height is 1500"}}`,
+			archivalBlockHeight: archivalBlockHeight,
+			expectedMutated:     true,
+			expectedErr:         errNotArchivalObs,
+		},
+		{
+			name:                "observation for a different archival height is skipped",
+			endpointResponse:    `{"jsonrpc":"2.0","id":2003,"result":{"block":{"header":{"height":"1000"}}}}`,
+			archivalBlockHeight: 2000,
+			expectedErr:         errNoArchivalObs,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := endpointCheckCometBFTArchival{}
+			jsonrpcReq := check.getRequest(archivalBlockHeight)
+			validator := getCometBFTArchivalResponseValidator(map[jsonrpc.ID]jsonrpc.Request{jsonrpcReq.ID: jsonrpcReq}, archivalBlockHeight)
+
+			observation := validator(polyzero.NewLogger(), []byte(tt.endpointResponse)).GetObservation()
+
+			var endpoint endpoint
+			require.Equal(t, tt.expectedMutated, applyObservation(&endpoint, &observation, tt.archivalBlockHeight))
+
+			err := endpoint.checkCometBFTArchival.IsArchival()
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
@@ -25,6 +25,7 @@ var (
 //   - 'status' - invalid chain ID
 //   - 'status' - catching up
 //   - 'status' - block height outside sync allowance
+//   - 'status' - latest block time too far in the past, if stall detection is enabled
 //   - 'health' - unhealthy
 //
 // CosmosSDK-specific checks if the endpoint has recently returned:
@@ -87,6 +88,7 @@ func (ss *serviceState) basicEndpointValidation(endpoint endpoint) error {
 // - Health status via `health` method
 // - Chain ID and sync status via `status` method
 // - Block height within acceptable sync tolerance
+// - Latest block time not too far in the past
 func (ss *serviceState) validateEndpointCometBFTChecks(endpoint endpoint) error {
 	// Check if the endpoint's health status is valid.
 	if err := ss.isCometBFTHealthValid(endpoint.checkCometBFTHealth); err != nil {
@@ -103,6 +105,11 @@ func (ss *serviceState) validateEndpointCometBFTChecks(endpoint endpoint) error
 		return fmt.Errorf("cometBFT block height validation failed: %w", err)
 	}
 
+	// Check if the endpoint's latest block time is recent enough.
+	if err := ss.isCometBFTBlockTimeValid(endpoint.checkCometBFTStatus); err != nil {
+		return fmt.Errorf("cometBFT block time validation failed: %w", err)
+	}
+
 	return nil
 }
 
@@ -174,6 +181,24 @@ func (ss *serviceState) isCometBFTBlockHeightValid(check endpointCheckCometBFTSt
 	return nil
 }
 
+// isCometBFTBlockTimeValid returns an error if the endpoint's latest block time is further in the past than the stall threshold.
+//
+// Stale block times are not held against endpoints if the service itself is stalled, e.g. a chain halt:
+// all endpoints are expected to report the same stale block time, and none should be disqualified for it.
+// The check is skipped if stall detection is not enabled for the service, or the block time is not known.
+func (ss *serviceState) isCometBFTBlockTimeValid(check endpointCheckCometBFTStatus) error {
+	if ss.stallTracker.IsStalled() {
+		return nil
+	}
+
+	latestBlockTime := check.GetLatestBlockTime()
+	if ss.stallTracker.IsBlockTimeStale(latestBlockTime) {
+		return fmt.Errorf("%w: latest block time %s", errStaleBlockTimeCometBFTObs, latestBlockTime.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
 // validateEndpointCosmosSDKChecks validates the endpoint's CosmosSDK checks.
 // Checks:
 //   - Status information (block height)
@@ -0,0 +1,54 @@
+package cosmos
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildwithgrove/path/qos"
+)
+
+func TestServiceState_IsCometBFTBlockTimeValid(t *testing.T) {
+	tests := []struct {
+		name              string
+		expectedBlockTime time.Duration
+		latestBlockTime   time.Time
+		expectedErr       error
+	}{
+		{
+			name:              "endpoint with a recent block time is valid",
+			expectedBlockTime: 6 * time.Second,
+			latestBlockTime:   time.Now().Add(-10 * time.Second),
+		},
+		{
+			name:              "endpoint with a stale block time is invalid",
+			expectedBlockTime: 6 * time.Second,
+			latestBlockTime:   time.Now().Add(-10 * time.Minute),
+			expectedErr:       errStaleBlockTimeCometBFTObs,
+		},
+		{
+			name:              "endpoint without a block time is valid",
+			expectedBlockTime: 6 * time.Second,
+		},
+		{
+			name:            "block time is not checked if stall detection is disabled",
+			latestBlockTime: time.Now().Add(-10 * time.Minute),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ss := &serviceState{stallTracker: qos.NewStallTracker(tt.expectedBlockTime)}
+			// The service's perceived block height is advancing: it is not stalled.
+			ss.stallTracker.UpdateHeight(1000)
+
+			err := ss.isCometBFTBlockTimeValid(endpointCheckCometBFTStatus{latestBlockTime: tt.latestBlockTime})
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
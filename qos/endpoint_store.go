@@ -0,0 +1,200 @@
+package qos
+
+import (
+	"errors"
+	"math/rand"
+	"sync"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
+	"github.com/buildwithgrove/path/metrics/devtools"
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos/selector"
+)
+
+// EndpointStore provides the endpoint selection capability required
+// by the protocol package for handling a service request.
+var _ protocol.EndpointSelector = &EndpointStore[struct{}]{}
+
+// EndpointStore maintains QoS data on the set of available endpoints of a service, of endpoint type E.
+// It performs several tasks:
+//   - Endpoint selection based on the quality data available, using the service's endpoint validation.
+//   - Application of endpoints' observations to update the data on endpoints.
+//
+// It is shared by QoS packages whose endpoints are validated independently of the service request,
+// e.g. generic JSON-RPC, generic REST, UTXO, NEAR and Move.
+type EndpointStore[E any] struct {
+	logger polylog.Logger
+
+	// validateEndpoint returns an error if the endpoint is not valid, based on the perceived state of the service.
+	validateEndpoint func(E) error
+
+	endpointsMu sync.RWMutex
+	endpoints   map[protocol.EndpointAddr]E
+}
+
+// NewEndpointStore returns an empty endpoint store, which validates endpoints using the supplied function.
+func NewEndpointStore[E any](logger polylog.Logger, validateEndpoint func(E) error) *EndpointStore[E] {
+	return &EndpointStore[E]{
+		logger:           logger,
+		validateEndpoint: validateEndpoint,
+		endpoints:        make(map[protocol.EndpointAddr]E),
+	}
+}
+
+// Select returns a random endpoint address from the list of valid endpoints.
+// Valid endpoints are determined by filtering the available endpoints based on their
+// validity criteria.
+func (es *EndpointStore[E]) Select(allAvailableEndpoints protocol.EndpointAddrList) (protocol.EndpointAddr, error) {
+	logger := es.logger.With(
+		"method", "Select",
+		"num_endpoints", len(allAvailableEndpoints),
+	)
+
+	filteredEndpointsAddr, err := es.filterValidEndpoints(allAvailableEndpoints)
+	if err != nil {
+		logger.Error().Err(err).Msg("error filtering endpoints: service request will fail.")
+		return protocol.EndpointAddr(""), err
+	}
+
+	// No valid endpoints -> select a random endpoint
+	if len(filteredEndpointsAddr) == 0 {
+		logger.Warn().Msg("SELECTING A RANDOM ENDPOINT because all endpoints failed validation.")
+		return allAvailableEndpoints[rand.Intn(len(allAvailableEndpoints))], nil
+	}
+
+	return filteredEndpointsAddr[rand.Intn(len(filteredEndpointsAddr))], nil
+}
+
+// SelectMultiple returns multiple endpoint addresses from the list of valid endpoints.
+// Valid endpoints are determined by filtering the available endpoints based on their
+// validity criteria.
+func (es *EndpointStore[E]) SelectMultiple(
+	allAvailableEndpoints protocol.EndpointAddrList,
+	numEndpoints uint,
+) (protocol.EndpointAddrList, error) {
+	logger := es.logger.With(
+		"method", "SelectMultiple",
+		"num_endpoints_available", len(allAvailableEndpoints),
+		"num_endpoints", numEndpoints,
+	)
+
+	filteredEndpointsAddr, err := es.filterValidEndpoints(allAvailableEndpoints)
+	if err != nil {
+		logger.Error().Err(err).Msg("error filtering endpoints: service request will fail.")
+		return nil, err
+	}
+
+	// Select random endpoints as fallback
+	if len(filteredEndpointsAddr) == 0 {
+		logger.Warn().Msg("SELECTING RANDOM ENDPOINTS because all endpoints failed validation.")
+		return selector.RandomSelectMultiple(allAvailableEndpoints, numEndpoints), nil
+	}
+
+	return selector.SelectEndpointsWithDiversity(logger, filteredEndpointsAddr, numEndpoints), nil
+}
+
+// filterValidEndpoints returns the subset of available endpoints that are valid according to previously processed observations.
+func (es *EndpointStore[E]) filterValidEndpoints(allAvailableEndpoints protocol.EndpointAddrList) (protocol.EndpointAddrList, error) {
+	es.endpointsMu.RLock()
+	defer es.endpointsMu.RUnlock()
+
+	if len(allAvailableEndpoints) == 0 {
+		return nil, errors.New("received empty list of endpoints to select from")
+	}
+
+	logger := es.logger.With("method", "filterValidEndpoints")
+
+	var filteredEndpointsAddr protocol.EndpointAddrList
+	for _, availableEndpointAddr := range allAvailableEndpoints {
+		endpoint, found := es.endpoints[availableEndpointAddr]
+		if !found {
+			logger.Debug().Msgf("SKIPPING endpoint because it was not found in PATH's endpoint store: %s", availableEndpointAddr)
+			continue
+		}
+
+		if err := es.validateEndpoint(endpoint); err != nil {
+			logger.Debug().Err(err).Msgf("SKIPPING endpoint because it failed validation: %s", availableEndpointAddr)
+			continue
+		}
+
+		filteredEndpointsAddr = append(filteredEndpointsAddr, availableEndpointAddr)
+	}
+
+	return filteredEndpointsAddr, nil
+}
+
+// EndpointObservation is an observation of a single endpoint, e.g. a *qosobservations.UTXOEndpointObservation.
+type EndpointObservation interface {
+	GetEndpointAddr() string
+}
+
+// UpdateEndpointsFromObservations CRUDs endpoint entries in the store based on the supplied observations:
+//   - applyObservation updates the endpoint using the observation, and returns true if the endpoint was mutated.
+//   - Observations with no endpoint address, e.g. nil observations, are skipped.
+//
+// It returns the set of created/updated endpoints.
+func UpdateEndpointsFromObservations[E any, O EndpointObservation](
+	es *EndpointStore[E],
+	endpointObservations []O,
+	applyObservation func(*E, O) bool,
+) map[protocol.EndpointAddr]E {
+	es.endpointsMu.Lock()
+	defer es.endpointsMu.Unlock()
+
+	updatedEndpoints := make(map[protocol.EndpointAddr]E)
+	for _, observation := range endpointObservations {
+		endpointAddr := protocol.EndpointAddr(observation.GetEndpointAddr())
+		if endpointAddr == "" {
+			continue
+		}
+
+		// It is a valid scenario for an endpoint to not be present in the store.
+		// E.g. when the first observation(s) are received for an endpoint.
+		endpoint := es.endpoints[endpointAddr]
+
+		// If the observation did not mutate the endpoint, there is no need to update the stored endpoint entry.
+		if !applyObservation(&endpoint, observation) {
+			continue
+		}
+
+		es.endpoints[endpointAddr] = endpoint
+		updatedEndpoints[endpointAddr] = endpoint
+	}
+
+	return updatedEndpoints
+}
+
+// GetDisqualifiedEndpointsResponse returns the QoS-level disqualified endpoints of the service, for a devtools.DisqualifiedEndpointResponse.
+// countDisqualification increments the response's counter matching an endpoint's validation error: it returns false if the error is not recognized.
+// This data is useful for creating a snapshot of the current QoS state for a given service.
+func (es *EndpointStore[E]) GetDisqualifiedEndpointsResponse(
+	serviceID protocol.ServiceID,
+	countDisqualification func(*devtools.QoSLevelDataResponse, error) bool,
+) devtools.QoSLevelDataResponse {
+	es.endpointsMu.RLock()
+	defer es.endpointsMu.RUnlock()
+
+	qosLevelDataResponse := devtools.QoSLevelDataResponse{
+		DisqualifiedEndpoints: make(map[protocol.EndpointAddr]devtools.QoSDisqualifiedEndpoint),
+	}
+
+	for endpointAddr, endpoint := range es.endpoints {
+		err := es.validateEndpoint(endpoint)
+		if err == nil {
+			continue
+		}
+
+		qosLevelDataResponse.DisqualifiedEndpoints[endpointAddr] = devtools.QoSDisqualifiedEndpoint{
+			EndpointAddr: endpointAddr,
+			Reason:       err.Error(),
+			ServiceID:    serviceID,
+		}
+
+		if !countDisqualification(&qosLevelDataResponse, err) {
+			es.logger.Error().Err(err).Msgf("SHOULD NEVER HAPPEN: unknown error for endpoint: %s", endpointAddr)
+		}
+	}
+
+	return qosLevelDataResponse
+}
@@ -0,0 +1,212 @@
+package qos
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildwithgrove/path/metrics/devtools"
+	"github.com/buildwithgrove/path/protocol"
+)
+
+var errTestEndpointInvalid = errors.New("endpoint is invalid")
+
+// testEndpoint is a minimal endpoint, valid if its latest observation was valid.
+type testEndpoint struct {
+	observed bool
+	valid    bool
+}
+
+// testEndpointObservation is a minimal endpoint observation.
+type testEndpointObservation struct {
+	endpointAddr string
+	valid        bool
+	// ignored observations do not mutate the endpoint.
+	ignored bool
+}
+
+func (o testEndpointObservation) GetEndpointAddr() string {
+	return o.endpointAddr
+}
+
+func applyTestEndpointObservation(e *testEndpoint, obs testEndpointObservation) bool {
+	if obs.ignored {
+		return false
+	}
+	e.observed = true
+	e.valid = obs.valid
+	return true
+}
+
+func validateTestEndpoint(e testEndpoint) error {
+	if !e.valid {
+		return errTestEndpointInvalid
+	}
+	return nil
+}
+
+func newTestEndpointStore(observations ...testEndpointObservation) *EndpointStore[testEndpoint] {
+	es := NewEndpointStore(polyzero.NewLogger(), validateTestEndpoint)
+	UpdateEndpointsFromObservations(es, observations, applyTestEndpointObservation)
+	return es
+}
+
+func TestEndpointStore_SelectMultiple(t *testing.T) {
+	tests := []struct {
+		name               string
+		observations       []testEndpointObservation
+		availableEndpoints protocol.EndpointAddrList
+		numEndpoints       uint
+		expected           protocol.EndpointAddrList
+		expectedNum        int
+		expectError        bool
+	}{
+		{
+			name: "only valid endpoints are selected",
+			observations: []testEndpointObservation{
+				{endpointAddr: "supplier1-https://endpoint1.example.com", valid: true},
+				{endpointAddr: "supplier2-https://endpoint2.example.org", valid: false},
+				{endpointAddr: "supplier3-https://endpoint3.example.net", valid: true},
+			},
+			availableEndpoints: protocol.EndpointAddrList{
+				"supplier1-https://endpoint1.example.com",
+				"supplier2-https://endpoint2.example.org",
+				"supplier3-https://endpoint3.example.net",
+			},
+			numEndpoints: 3,
+			expected: protocol.EndpointAddrList{
+				"supplier1-https://endpoint1.example.com",
+				"supplier3-https://endpoint3.example.net",
+			},
+			expectedNum: 2,
+		},
+		{
+			name: "endpoints missing from the store are not selected",
+			observations: []testEndpointObservation{
+				{endpointAddr: "supplier1-https://endpoint1.example.com", valid: true},
+			},
+			availableEndpoints: protocol.EndpointAddrList{
+				"supplier1-https://endpoint1.example.com",
+				"supplier2-https://endpoint2.example.org",
+			},
+			numEndpoints: 2,
+			expected:     protocol.EndpointAddrList{"supplier1-https://endpoint1.example.com"},
+			expectedNum:  1,
+		},
+		{
+			name: "random endpoints are selected if no endpoint is valid",
+			observations: []testEndpointObservation{
+				{endpointAddr: "supplier1-https://endpoint1.example.com", valid: false},
+			},
+			availableEndpoints: protocol.EndpointAddrList{
+				"supplier1-https://endpoint1.example.com",
+				"supplier2-https://endpoint2.example.org",
+			},
+			numEndpoints: 2,
+			expected: protocol.EndpointAddrList{
+				"supplier1-https://endpoint1.example.com",
+				"supplier2-https://endpoint2.example.org",
+			},
+			expectedNum: 2,
+		},
+		{
+			name:               "error for an empty list of available endpoints",
+			availableEndpoints: protocol.EndpointAddrList{},
+			numEndpoints:       1,
+			expectError:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			es := newTestEndpointStore(tt.observations...)
+
+			selected, err := es.SelectMultiple(tt.availableEndpoints, tt.numEndpoints)
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, selected, tt.expectedNum)
+			require.Subset(t, tt.expected, selected)
+		})
+	}
+}
+
+func TestUpdateEndpointsFromObservations(t *testing.T) {
+	tests := []struct {
+		name            string
+		observations    []testEndpointObservation
+		expectedUpdated map[protocol.EndpointAddr]testEndpoint
+	}{
+		{
+			name: "observation of a new endpoint creates the endpoint",
+			observations: []testEndpointObservation{
+				{endpointAddr: "supplier1-https://endpoint1.example.com", valid: true},
+			},
+			expectedUpdated: map[protocol.EndpointAddr]testEndpoint{
+				"supplier1-https://endpoint1.example.com": {observed: true, valid: true},
+			},
+		},
+		{
+			name: "latest observation of an endpoint is applied",
+			observations: []testEndpointObservation{
+				{endpointAddr: "supplier1-https://endpoint1.example.com", valid: true},
+				{endpointAddr: "supplier1-https://endpoint1.example.com", valid: false},
+			},
+			expectedUpdated: map[protocol.EndpointAddr]testEndpoint{
+				"supplier1-https://endpoint1.example.com": {observed: true, valid: false},
+			},
+		},
+		{
+			name: "observations which do not mutate the endpoint are not reported as updates",
+			observations: []testEndpointObservation{
+				{endpointAddr: "supplier1-https://endpoint1.example.com", ignored: true},
+			},
+			expectedUpdated: map[protocol.EndpointAddr]testEndpoint{},
+		},
+		{
+			name: "observations with no endpoint address are skipped",
+			observations: []testEndpointObservation{
+				{endpointAddr: "", valid: true},
+			},
+			expectedUpdated: map[protocol.EndpointAddr]testEndpoint{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			es := NewEndpointStore(polyzero.NewLogger(), validateTestEndpoint)
+
+			updated := UpdateEndpointsFromObservations(es, tt.observations, applyTestEndpointObservation)
+			require.Equal(t, tt.expectedUpdated, updated)
+		})
+	}
+}
+
+func TestEndpointStore_GetDisqualifiedEndpointsResponse(t *testing.T) {
+	es := newTestEndpointStore(
+		testEndpointObservation{endpointAddr: "supplier1-https://endpoint1.example.com", valid: true},
+		testEndpointObservation{endpointAddr: "supplier2-https://endpoint2.example.org", valid: false},
+	)
+
+	response := es.GetDisqualifiedEndpointsResponse("svc", func(response *devtools.QoSLevelDataResponse, err error) bool {
+		if !errors.Is(err, errTestEndpointInvalid) {
+			return false
+		}
+		response.EmptyResponseCount++
+		return true
+	})
+
+	require.Equal(t, map[protocol.EndpointAddr]devtools.QoSDisqualifiedEndpoint{
+		"supplier2-https://endpoint2.example.org": {
+			EndpointAddr: "supplier2-https://endpoint2.example.org",
+			Reason:       errTestEndpointInvalid.Error(),
+			ServiceID:    "svc",
+		},
+	}, response.DisqualifiedEndpoints)
+	require.Equal(t, 1, response.EmptyResponseCount)
+}
@@ -0,0 +1,52 @@
+package qos
+
+import (
+	"errors"
+	"time"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+)
+
+// TODO_TECHDEBT: Make configurable via service config.
+//
+// JSONRPCValidationErrorWindow is the duration an endpoint is disqualified for after returning an invalid JSON-RPC response.
+// 30 minutes allows for temporary network issues while preventing persistently broken endpoints from being used.
+const JSONRPCValidationErrorWindow = 30 * time.Minute
+
+// ErrRecentJSONRPCValidationError is returned when validating an endpoint which returned an invalid JSON-RPC response within JSONRPCValidationErrorWindow.
+var ErrRecentJSONRPCValidationError = errors.New("endpoint has recent JSON-RPC validation errors")
+
+// JSONRPCValidationErrorTracker tracks the most recent JSON-RPC response validation error of an endpoint.
+// Embedded in the endpoints of QoS packages which disqualify endpoints returning invalid JSON-RPC responses.
+// The zero value tracks no validation error.
+type JSONRPCValidationErrorTracker struct {
+	latestValidationError *qosobservations.JsonRpcResponseValidationError
+}
+
+// ApplyValidationError records the supplied validation error, if it is more recent than the tracked one.
+// Returns true if the tracked validation error was updated.
+func (t *JSONRPCValidationErrorTracker) ApplyValidationError(validationError *qosobservations.JsonRpcResponseValidationError) bool {
+	if validationError == nil {
+		return false
+	}
+
+	if t.latestValidationError != nil &&
+		!validationError.GetTimestamp().AsTime().After(t.latestValidationError.GetTimestamp().AsTime()) {
+		return false
+	}
+
+	t.latestValidationError = validationError
+	return true
+}
+
+// ValidateNoRecentValidationError returns ErrRecentJSONRPCValidationError if the tracked validation error is within JSONRPCValidationErrorWindow.
+func (t JSONRPCValidationErrorTracker) ValidateNoRecentValidationError() error {
+	if t.latestValidationError == nil {
+		return nil
+	}
+
+	if t.latestValidationError.GetTimestamp().AsTime().After(time.Now().Add(-JSONRPCValidationErrorWindow)) {
+		return ErrRecentJSONRPCValidationError
+	}
+	return nil
+}
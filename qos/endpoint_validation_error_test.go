@@ -0,0 +1,75 @@
+package qos
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+)
+
+func TestJSONRPCValidationErrorTracker(t *testing.T) {
+	newValidationError := func(age time.Duration) *qosobservations.JsonRpcResponseValidationError {
+		return &qosobservations.JsonRpcResponseValidationError{Timestamp: timestamppb.New(time.Now().Add(-age))}
+	}
+
+	tests := []struct {
+		name             string
+		validationErrors []*qosobservations.JsonRpcResponseValidationError
+		expectedApplied  []bool
+		expectedErr      error
+	}{
+		{
+			name:        "no validation error",
+			expectedErr: nil,
+		},
+		{
+			name:             "nil validation error is ignored",
+			validationErrors: []*qosobservations.JsonRpcResponseValidationError{nil},
+			expectedApplied:  []bool{false},
+			expectedErr:      nil,
+		},
+		{
+			name:             "recent validation error disqualifies the endpoint",
+			validationErrors: []*qosobservations.JsonRpcResponseValidationError{newValidationError(time.Minute)},
+			expectedApplied:  []bool{true},
+			expectedErr:      ErrRecentJSONRPCValidationError,
+		},
+		{
+			name:             "validation error older than the window is ignored",
+			validationErrors: []*qosobservations.JsonRpcResponseValidationError{newValidationError(JSONRPCValidationErrorWindow + time.Minute)},
+			expectedApplied:  []bool{true},
+			expectedErr:      nil,
+		},
+		{
+			name: "older validation error does not replace a more recent one",
+			validationErrors: []*qosobservations.JsonRpcResponseValidationError{
+				newValidationError(time.Minute),
+				newValidationError(JSONRPCValidationErrorWindow + time.Minute),
+			},
+			expectedApplied: []bool{true, false},
+			expectedErr:     ErrRecentJSONRPCValidationError,
+		},
+		{
+			name: "more recent validation error replaces an older one",
+			validationErrors: []*qosobservations.JsonRpcResponseValidationError{
+				newValidationError(JSONRPCValidationErrorWindow + time.Minute),
+				newValidationError(time.Minute),
+			},
+			expectedApplied: []bool{true, true},
+			expectedErr:     ErrRecentJSONRPCValidationError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tracker JSONRPCValidationErrorTracker
+			for i, validationError := range tt.validationErrors {
+				require.Equal(t, tt.expectedApplied[i], tracker.ApplyValidationError(validationError))
+			}
+			require.ErrorIs(t, tracker.ValidateNoRecentValidationError(), tt.expectedErr)
+		})
+	}
+}
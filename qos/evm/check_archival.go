@@ -1,9 +1,12 @@
 package evm
 
 import (
-	"fmt"
+	"errors"
+	"maps"
 	"time"
 
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
 	"github.com/buildwithgrove/path/protocol"
 	"github.com/buildwithgrove/path/qos/jsonrpc"
 )
@@ -14,57 +17,91 @@ import (
 // It is used for compatibility with the JSON-RPC spec.
 // It is a loose convention in the QoS package.
 
-// ID for the eth_getBalance check which is used to verify the endpoint is archival.
+// ID for the archival probe requests which are used to verify the endpoint is archival.
+// All probes share the same ID: the probe is identified by the request context. See archivalProbeRequest.
 const idArchivalCheck = 1003
 
 // methodGetBalance is the JSON-RPC method for getting the balance of an account at a specific block number.
 // Reference: https://ethereum.org/en/developers/docs/apis/json-rpc/#eth_getbalance
 const methodGetBalance = jsonrpc.Method("eth_getBalance")
 
+// methodGetCode is the JSON-RPC method for getting the code of a contract at a specific block number.
+// Reference: https://ethereum.org/en/developers/docs/apis/json-rpc/#eth_getcode
+const methodGetCode = jsonrpc.Method("eth_getCode")
+
+// methodCall is the JSON-RPC method for executing a contract call at a specific block number.
+// Reference: https://ethereum.org/en/developers/docs/apis/json-rpc/#eth_call
+const methodCall = jsonrpc.Method("eth_call")
+
 // TODO_IMPROVE(@commoddity): determine an appropriate interval for checking archival status.
 const checkArchivalInterval = 20 * time.Minute
 
 var (
-	errNoArchivalBalanceObs      = fmt.Errorf("endpoint has not returned an archival balance response to a %q request", methodGetBalance)
-	errInvalidArchivalBalanceObs = fmt.Errorf("endpoint has incorrect archival balance")
+	errNoArchivalProbeObs       = errors.New("endpoint has not returned a value in response to an archival probe at the probe's block number")
+	errInvalidArchivalProbeObs  = errors.New("endpoint's archival probe value does not match the consensus value")
+	errNoArchivalProbeConsensus = errors.New("no consensus has been reached yet on the archival probe value")
 )
 
-// endpointCheckBlockNumber is a check that ensures the endpoint's block height is greater than the perceived block height.
-// It is used to ensure that the endpoint is not behind the chain.
+// archivalProbeRequest identifies the archival probe, and the block number, of a synthetic archival probe request.
+//
+// Probe responses are identified by the request context rather than by their method,
+// as probe methods, e.g. `eth_call`, are also used by organic requests.
+type archivalProbeRequest struct {
+	probeName      string
+	blockNumberHex string
+}
+
+// endpointCheckArchival is a check that ensures the endpoint can serve historical data.
+// It stores the endpoint's observations for each of the service's archival probes.
 type endpointCheckArchival struct {
-	// observedArchivalBalance stores the result of processing the endpoint's response
-	// to an `eth_getBalance` request for a specific contract address at a specific block number.
-	observedArchivalBalance string
-	expiresAt               time.Time
+	// probes maps an archival probe's name to the endpoint's observations for the probe.
+	//
+	// DEV_NOTE: the map is shared by all copies of the endpoint: it is cloned before being updated.
+	probes map[string]endpointCheckArchivalProbe
+}
+
+// endpointCheckArchivalProbe stores the endpoint's observations for a single archival probe.
+type endpointCheckArchivalProbe struct {
+	// latest is the endpoint's most recent observation for the probe.
+	latest archivalProbeObservation
+
+	// previous is the endpoint's most recent observation at a different block number than `latest`.
+	// It allows validating the endpoint against the probe's previous round, until a consensus is reached on the current one.
+	previous archivalProbeObservation
+
+	expiresAt time.Time
+}
+
+// archivalProbeObservation is the value returned by an endpoint in response to an archival probe.
+type archivalProbeObservation struct {
+	// blockNumberHex is the block number at which the probe was run, eg. 0x3f8627c.
+	blockNumberHex string
+
+	// value is the value returned by the endpoint, e.g. a balance or a contract's code.
+	// Empty if the endpoint returned an error, e.g. a pruned node which no longer has the historical state.
+	value string
 }
 
 func (e *endpointCheckArchival) getRequestID() jsonrpc.ID {
 	return jsonrpc.IDFromInt(idArchivalCheck)
 }
 
-// getRequest returns a JSONRPC request to check the balance of:
-//   - the contract specified in `a.archivalCheckConfig.ContractAddress`
-//   - at the block number specified in `a.blockNumberHex`
+// getServicePayload returns a JSONRPC request for the archival probe at the supplied block number.
 //
 // For example:
-// '{"jsonrpc":"2.0","id":1,"method":"eth_getBalance","params":["0x28C6c06298d514Db089934071355E5743bf21d60", "0xe71e1d"]}'
-func (e *endpointCheckArchival) getServicePayload(archivalState archivalState) protocol.Payload {
-	// Pass params in this order: [<contract_address>, <block_number>]
-	// eg. "params":["0x28C6c06298d514Db089934071355E5743bf21d60", "0xe71e1d"]
-	// Reference: https://ethereum.org/en/developers/docs/apis/json-rpc/#eth_getbalance
-	params, err := jsonrpc.BuildParamsFromStringArray([2]string{
-		archivalState.archivalCheckConfig.contractAddress,
-		archivalState.blockNumberHex,
-	})
+//   - eth_getBalance: '{"jsonrpc":"2.0","id":1003,"method":"eth_getBalance","params":["0x28C6c06298d514Db089934071355E5743bf21d60", "0xe71e1d"]}'
+//   - eth_call: '{"jsonrpc":"2.0","id":1003,"method":"eth_call","params":[{"to":"0xdAC17F958D2ee523a2206206994597C13D831ec7","data":"0x18160ddd"}, "0xe71e1d"]}'
+func (e *endpointCheckArchival) getServicePayload(logger polylog.Logger, probe ArchivalProbe, blockNumberHex string) protocol.Payload {
+	params, err := buildArchivalProbeParams(probe, blockNumberHex)
 	if err != nil {
-		archivalState.logger.Error().Msgf("failed to build archival check request params: %v", err)
+		logger.Error().Msgf("failed to build archival probe %q request params: %v", probe.Name, err)
 		return protocol.Payload{}
 	}
 
 	req := jsonrpc.Request{
 		JSONRPC: jsonrpc.Version2,
 		ID:      jsonrpc.IDFromInt(idArchivalCheck),
-		Method:  jsonrpc.Method(methodGetBalance),
+		Method:  probe.Method,
 		Params:  params,
 	}
 	// Hardcoded request will never fail to build the payload
@@ -72,11 +109,44 @@ func (e *endpointCheckArchival) getServicePayload(archivalState archivalState) p
 	return payload
 }
 
-// getArchivalBalance returns the observed archival balance for the endpoint at the archival block height.
-// Returns an error if the endpoint hasn't yet returned an archival balance observation.
-func (e *endpointCheckArchival) getArchivalBalance() (string, error) {
-	if e.observedArchivalBalance == "" {
-		return "", errNoArchivalBalanceObs
+// buildArchivalProbeParams returns the params of the archival probe's request at the supplied block number.
+func buildArchivalProbeParams(probe ArchivalProbe, blockNumberHex string) (jsonrpc.Params, error) {
+	switch probe.Method {
+	// Pass params in this order: [{"to": <contract_address>, "data": <call_data>}, <block_number>]
+	// Reference: https://ethereum.org/en/developers/docs/apis/json-rpc/#eth_call
+	case methodCall:
+		return jsonrpc.BuildParamsFromObjectAndString(
+			map[string]string{"to": probe.ContractAddress, "data": probe.CallData},
+			blockNumberHex,
+		)
+
+	// Pass params in this order: [<contract_address>, <block_number>]
+	// Reference: https://ethereum.org/en/developers/docs/apis/json-rpc/#eth_getbalance
+	default:
+		return jsonrpc.BuildParamsFromStringArray([2]string{probe.ContractAddress, blockNumberHex})
 	}
-	return e.observedArchivalBalance, nil
+}
+
+// getProbe returns the endpoint's observations for the named archival probe.
+func (e endpointCheckArchival) getProbe(probeName string) endpointCheckArchivalProbe {
+	return e.probes[probeName]
+}
+
+// withObservation returns a copy of the check, updated with the supplied observation for the named archival probe.
+// An observation at a new block number moves the probe's latest observation to `previous`.
+func (e endpointCheckArchival) withObservation(probeName string, observation archivalProbeObservation) endpointCheckArchival {
+	probeCheck := e.probes[probeName]
+	if probeCheck.latest.blockNumberHex != "" && probeCheck.latest.blockNumberHex != observation.blockNumberHex {
+		probeCheck.previous = probeCheck.latest
+	}
+	probeCheck.latest = observation
+	probeCheck.expiresAt = time.Now().Add(checkArchivalInterval)
+
+	probes := maps.Clone(e.probes)
+	if probes == nil {
+		probes = make(map[string]endpointCheckArchivalProbe)
+	}
+	probes[probeName] = probeCheck
+
+	return endpointCheckArchival{probes: probes}
 }
@@ -0,0 +1,69 @@
+package evm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// EVM checks begin with 1 for JSON-RPC requests.
+//
+// This is an arbitrary ID selected by the engineering team at Grove.
+// It is used for compatibility with the JSON-RPC spec.
+// It is a loose convention in the QoS package.
+
+// ID for the eth_getBlockByNumber check which is used to verify the endpoint is on the canonical chain.
+const idForkCheck = 1004
+
+// methodGetBlockByNumber is the JSON-RPC method for getting a block by its number.
+// Reference: https://ethereum.org/en/developers/docs/apis/json-rpc/#eth_getblockbynumber
+const methodGetBlockByNumber = jsonrpc.Method("eth_getBlockByNumber")
+
+// checkForkInterval is the interval after which an endpoint's block hash at the fork check height is re-checked.
+const checkForkInterval = 2 * time.Minute
+
+var (
+	errNoBlockHashObs       = fmt.Errorf("endpoint has not returned a block hash in response to a %q request", methodGetBlockByNumber)
+	errBlockHashMismatchObs = fmt.Errorf("endpoint's block hash does not match the consensus block hash in response to a %q request", methodGetBlockByNumber)
+)
+
+// endpointCheckFork is a check that ensures the endpoint is on the same chain as the majority of endpoints.
+// It is used to detect endpoints on a minority fork, or serving a different network which reuses the chain ID.
+type endpointCheckFork struct {
+	// blockNumberHex is the block number at which the endpoint's block hash was observed, eg. 0x3f8627c.
+	blockNumberHex string
+	// blockHash stores the block hash returned by the endpoint in response to an
+	// `eth_getBlockByNumber` request for the block number in `blockNumberHex`.
+	blockHash string
+	expiresAt time.Time
+}
+
+func (e *endpointCheckFork) getRequestID() jsonrpc.ID {
+	return jsonrpc.IDFromInt(idForkCheck)
+}
+
+// getServicePayload returns a JSONRPC request to get the block at the supplied block number, without full transactions.
+//
+// For example:
+// '{"jsonrpc":"2.0","id":1004,"method":"eth_getBlockByNumber","params":["0xe71e1d", false]}'
+func (e *endpointCheckFork) getServicePayload(forkState *forkState) protocol.Payload {
+	// Pass params in this order: [<block_number>, <include_full_transactions>]
+	// Reference: https://ethereum.org/en/developers/docs/apis/json-rpc/#eth_getblockbynumber
+	params, err := jsonrpc.BuildParamsFromStringAndBool(forkState.blockNumberHex, false)
+	if err != nil {
+		forkState.logger.Error().Msgf("failed to build fork check request params: %v", err)
+		return protocol.Payload{}
+	}
+
+	req := jsonrpc.Request{
+		JSONRPC: jsonrpc.Version2,
+		ID:      jsonrpc.IDFromInt(idForkCheck),
+		Method:  jsonrpc.Method(methodGetBlockByNumber),
+		Params:  params,
+	}
+	// Hardcoded request will never fail to build the payload
+	payload, _ := req.BuildPayload()
+	return payload
+}
@@ -0,0 +1,53 @@
+package evm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// EVM checks begin with 1 for JSON-RPC requests.
+//
+// This is an arbitrary ID selected by the engineering team at Grove.
+// It is used for compatibility with the JSON-RPC spec.
+// It is a loose convention in the QoS package.
+
+// ID for the net_peerCount check.
+const idPeerCountCheck = 1006
+
+// methodPeerCount is the JSON-RPC method for getting the number of peers connected to the endpoint.
+// Reference: https://ethereum.org/en/developers/docs/apis/json-rpc/#net_peercount
+const methodPeerCount = jsonrpc.Method("net_peerCount")
+
+// checkPeerCountInterval is the interval after which an endpoint's peer count is re-checked.
+const checkPeerCountInterval = 5 * time.Minute
+
+var errBelowMinPeerCountObs = fmt.Errorf("endpoint's peer count is below the minimum peer count in response to a %q request", methodPeerCount)
+
+// endpointCheckPeerCount is a check that ensures the endpoint is connected to a minimum number of peers.
+// An endpoint with too few peers is likely to stall, even if its block number is currently recent.
+type endpointCheckPeerCount struct {
+	// peerCount stores the result of processing the endpoint's response to a `net_peerCount` request.
+	// It is nil if there has NOT been an observation of the endpoint's response to a `net_peerCount` request.
+	peerCount *uint64
+	expiresAt time.Time
+}
+
+func (e *endpointCheckPeerCount) getRequestID() jsonrpc.ID {
+	return jsonrpc.IDFromInt(idPeerCountCheck)
+}
+
+// getServicePayload returns a JSONRPC request to check the peer count.
+// eg. '{"jsonrpc":"2.0","id":1006,"method":"net_peerCount"}'
+func (e *endpointCheckPeerCount) getServicePayload() protocol.Payload {
+	req := jsonrpc.Request{
+		JSONRPC: jsonrpc.Version2,
+		ID:      jsonrpc.IDFromInt(idPeerCountCheck),
+		Method:  jsonrpc.Method(methodPeerCount),
+	}
+	// Hardcoded request will never fail to build the payload
+	payload, _ := req.BuildPayload()
+	return payload
+}
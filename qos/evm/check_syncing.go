@@ -0,0 +1,54 @@
+package evm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// EVM checks begin with 1 for JSON-RPC requests.
+//
+// This is an arbitrary ID selected by the engineering team at Grove.
+// It is used for compatibility with the JSON-RPC spec.
+// It is a loose convention in the QoS package.
+
+// ID for the eth_syncing check.
+const idSyncingCheck = 1005
+
+// methodSyncing is the JSON-RPC method for getting the sync status of the endpoint.
+// Reference: https://ethereum.org/en/developers/docs/apis/json-rpc/#eth_syncing
+const methodSyncing = jsonrpc.Method("eth_syncing")
+
+// checkSyncingInterval is the interval after which an endpoint's sync status is re-checked.
+// An endpoint may fall behind and start syncing at any time, so the check runs frequently.
+const checkSyncingInterval = time.Minute
+
+var errSyncingObs = fmt.Errorf("endpoint reported it is syncing in response to a %q request", methodSyncing)
+
+// endpointCheckSyncing is a check that ensures the endpoint is not syncing.
+// An endpoint may report a recent block number while still syncing, e.g. during a state sync.
+type endpointCheckSyncing struct {
+	// isSyncing stores the result of processing the endpoint's response to an `eth_syncing` request.
+	// It is nil if there has NOT been an observation of the endpoint's response to an `eth_syncing` request.
+	isSyncing *bool
+	expiresAt time.Time
+}
+
+func (e *endpointCheckSyncing) getRequestID() jsonrpc.ID {
+	return jsonrpc.IDFromInt(idSyncingCheck)
+}
+
+// getServicePayload returns a JSONRPC request to check the sync status.
+// eg. '{"jsonrpc":"2.0","id":1005,"method":"eth_syncing"}'
+func (e *endpointCheckSyncing) getServicePayload() protocol.Payload {
+	req := jsonrpc.Request{
+		JSONRPC: jsonrpc.Version2,
+		ID:      jsonrpc.IDFromInt(idSyncingCheck),
+		Method:  jsonrpc.Method(methodSyncing),
+	}
+	// Hardcoded request will never fail to build the payload
+	payload, _ := req.BuildPayload()
+	return payload
+}
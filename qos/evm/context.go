@@ -2,7 +2,8 @@ package evm
 
 import (
 	"encoding/json"
-	"net/http"
+	"fmt"
+	"time"
 
 	"github.com/pokt-network/poktroll/pkg/polylog"
 
@@ -22,6 +23,21 @@ var _ gateway.RequestQoSContext = &requestContext{}
 // by the protocol package for handling a service request.
 var _ protocol.EndpointSelector = &requestContext{}
 
+// requestContext supports distributing the chunks of a split `eth_getLogs` request across multiple endpoints.
+var _ gateway.DistributedPayloadsQoSContext = &requestContext{}
+
+// requestContext supports distributing the members of a batch request across multiple endpoints.
+var _ gateway.BatchQoSContext = &requestContext{}
+
+// requestContext supports broadcasting transaction submissions to multiple endpoints.
+var _ gateway.BroadcastQoSContext = &requestContext{}
+
+// requestContext supports sending requests to multiple endpoints in consensus-read mode.
+var _ gateway.ConsensusQoSContext = &requestContext{}
+
+// requestContext supports sharing the relay of identical in-flight requests.
+var _ gateway.CoalescableQoSContext = &requestContext{}
+
 // TODO_REFACTOR: Improve naming clarity by distinguishing between interfaces and adapters
 // in the metrics/qos/evm and qos/evm packages, and elsewhere names like `response` are used.
 // Consider renaming:
@@ -85,6 +101,56 @@ type requestContext struct {
 	// In the case of a batch request of length 1, the response must be returned as an array.
 	isBatch bool
 
+	// jsonrpcBatchRequest holds the JSON-RPC requests in their original order.
+	// Used to return the responses to a batch request in request order.
+	jsonrpcBatchRequest jsonrpc.BatchRequest
+
+	// getLogsSplit is set if an `eth_getLogs` request was split into multiple block range chunks.
+	// In this case, servicePayloads contains the chunk requests rather than the user's original request.
+	getLogsSplit *getLogsSplit
+
+	// isTxBroadcast is set if the request is a transaction submission, e.g. `eth_sendRawTransaction`.
+	// In this case, the request is broadcast to multiple endpoints.
+	isTxBroadcast bool
+
+	// txBroadcastNumEndpoints is the number of endpoints a transaction submission is broadcast to.
+	// Set to 0 if the request is not a transaction submission.
+	txBroadcastNumEndpoints uint
+
+	// batchNumEndpoints is the maximum number of endpoints the members of a batch request are distributed across.
+	batchNumEndpoints uint
+
+	// consensusRead is set if the request is sent to multiple endpoints in consensus-read mode.
+	// In this case, the result agreed on by a quorum of the endpoints is returned.
+	consensusRead *qos.ConsensusReadConfig
+
+	// blockTagPin is set if the request's block tags were pinned to a concrete block number.
+	blockTagPin *blockTagPin
+
+	// minEndpointBlockNumber is the minimum block number of endpoints selected to serve the request.
+	// Set to the pinned block number, or the user's minimum block number, if any.
+	minEndpointBlockNumber uint64
+
+	// minBlockNumberErr is set if no endpoint had reached minEndpointBlockNumber during endpoint selection.
+	// In this case, an error response is returned to the user.
+	minBlockNumberErr error
+
+	// requiresArchival is set if the request targets a block older than the archival threshold.
+	// In this case, the request is served by an endpoint which passed the archival check, if any.
+	requiresArchival bool
+
+	// archivalProbe is set if the request is a synthetic archival probe.
+	// In this case, the endpoint's response is used to update its archival check.
+	archivalProbe *archivalProbeRequest
+
+	// coalescingKey identifies identical requests, which can share a single relay.
+	// Empty if the request cannot be coalesced.
+	coalescingKey string
+
+	// relayTimeout is the timeout of the request's relays, based on the service's per-method timeouts.
+	// The gateway's default relay timeout is used if set to 0.
+	relayTimeout time.Duration
+
 	// endpointResponses is the set of responses received from one or
 	// more endpoints as part of handling this service request.
 	// Supports both single and batch JSON-RPC requests.
@@ -120,7 +186,7 @@ func (rc *requestContext) UpdateWithResponse(endpointAddr protocol.EndpointAddr,
 	// indicating the validity of the request when calling on QoS instance's ParseHTTPRequest
 
 	response, err := unmarshalResponse(
-		rc.logger, rc.servicePayloads, responseBz, endpointAddr,
+		rc.logger, rc.servicePayloads, responseBz, endpointAddr, rc.archivalProbe,
 	)
 
 	rc.endpointResponses = append(rc.endpointResponses, endpointResponse{
@@ -141,14 +207,36 @@ func (rc *requestContext) UpdateWithResponse(endpointAddr protocol.EndpointAddr,
 func (rc requestContext) GetHTTPResponse() pathhttp.HTTPResponse {
 	// Use a noResponses struct if no responses were reported by the protocol from any endpoints.
 	if len(rc.endpointResponses) == 0 {
+		// No endpoint had reached the request's minimum block number: let the user know, rather than returning a generic error.
+		if rc.minBlockNumberErr != nil {
+			errResponse := newErrResponseInternalErr(getJsonRpcIDForErrorResponse(rc.servicePayloads), rc.minBlockNumberErr)
+			return buildJSONRPCHTTPResponse(errResponse, errResponse.GetRecommendedHTTPStatusCode())
+		}
+
 		rc.logger.Warn().Msg("No responses received from any endpoints. Returning generic non-response.")
 		responseNoneObj := responseNone{
 			logger:          rc.logger,
 			servicePayloads: rc.servicePayloads,
+			stallTracker:    rc.serviceState.stallTracker,
 		}
 		return responseNoneObj.GetHTTPResponse()
 	}
 
+	// Split `eth_getLogs` request: merge the chunks' responses into a single response.
+	if rc.getLogsSplit != nil {
+		return rc.getLogsSplit.buildHTTPResponse(rc.getParsedEndpointResponses())
+	}
+
+	// Transaction broadcast to multiple endpoints: return the response of an endpoint which accepted the transaction.
+	if rc.isTxBroadcast {
+		return rc.getBroadcastHTTPResponse()
+	}
+
+	// Consensus-read: return the result agreed on by a quorum of the endpoints.
+	if rc.consensusRead != nil {
+		return rc.getConsensusHTTPResponse()
+	}
+
 	numJSONRPCRequests := len(rc.servicePayloads)
 	numEndpointResponses := len(rc.endpointResponses)
 
@@ -166,53 +254,44 @@ func (rc requestContext) GetHTTPResponse() pathhttp.HTTPResponse {
 		rc.logger.Warn().Msgf("TODO_INVESTIGATE: Expected exactly one endpoint response for single JSON-RPC request, but received %d. Only using the first response for now.", numEndpointResponses)
 	}
 
+	// Pinned `eth_blockNumber` request: cap the returned block number at the pinned block number.
+	if rc.blockTagPin != nil && rc.blockTagPin.capBlockNumberResult {
+		return rc.getPinnedBlockNumberHTTPResponse()
+	}
+
 	// Non-batch requests.
 	// Return the only endpoint response reported to the context for single requests.
 	return rc.endpointResponses[0].GetHTTPResponse()
 }
 
-// getBatchHTTPResponse handles batch requests by combining individual JSON-RPC responses
-// into an array according to the JSON-RPC 2.0 specification.
-// https://www.jsonrpc.org/specification#batch
-func (rc requestContext) getBatchHTTPResponse() pathhttp.HTTPResponse {
-	// Collect individual response payloads
-	var individualResponses []json.RawMessage
+// getParsedEndpointResponses returns the successfully parsed endpoint responses, keyed by their JSON-RPC ID.
+func (rc requestContext) getParsedEndpointResponses() map[string]jsonrpc.Response {
+	parsedResponses := make(map[string]jsonrpc.Response, len(rc.endpointResponses))
 	for _, endpointResp := range rc.endpointResponses {
-		// Extract the JSON payload from each response
-		payload := endpointResp.GetHTTPResponse().GetPayload()
-		if len(payload) > 0 {
-			individualResponses = append(individualResponses, json.RawMessage(payload))
+		if endpointResp.unmarshalErr != nil {
+			continue
 		}
-	}
 
-	// According to JSON-RPC spec: "If there are no Response objects contained within the Response array
-	// as it is to be sent to the client, the server MUST NOT return an empty Array and should return nothing at all."
-	// This can happen when all requests in the batch are notifications (which don't get responses)
-	// or when all individual responses are empty/invalid.
-	if len(individualResponses) == 0 {
-		// Create a responseGeneric for empty batch response and return its HTTP response
-		errorResponse := getGenericResponseBatchEmpty(rc.logger)
-		return errorResponse.GetHTTPResponse()
+		var jsonrpcResponse jsonrpc.Response
+		if err := json.Unmarshal(endpointResp.GetHTTPResponse().GetPayload(), &jsonrpcResponse); err != nil {
+			continue
+		}
+		parsedResponses[jsonrpcResponse.ID.String()] = jsonrpcResponse
 	}
+	return parsedResponses
+}
 
-	// Validate and construct batch response using jsonrpc package
-	batchResponse, err := jsonrpc.ValidateAndBuildBatchResponse(
-		rc.logger,
-		individualResponses,
-		rc.servicePayloads,
-	)
-	if err != nil {
-		// Create a responseGeneric for batch validation failure and return its HTTP response
-		errorResponse := getGenericJSONRPCErrResponseBatchMarshalFailure(rc.logger, err)
-		return errorResponse.GetHTTPResponse()
-	}
+// DistributePayloadsAcrossEndpoints returns true if the request's service payloads should be sent to different endpoints.
+// As of now, only the chunks of a split `eth_getLogs` request are distributed across endpoints.
+// Implements the gateway.DistributedPayloadsQoSContext interface.
+func (rc *requestContext) DistributePayloadsAcrossEndpoints() bool {
+	return rc.getLogsSplit != nil
+}
 
-	return jsonrpc.HTTPResponse{
-		ResponsePayload: batchResponse,
-		// According to the JSON-RPC 2.0 specification, even if individual responses
-		// in a batch contain errors, the entire batch should still return HTTP 200 OK.
-		HTTPStatusCode: http.StatusOK,
-	}
+// GetRelayTimeout returns the timeout of the request's relays: 0 if the gateway's default should be used.
+// Implements the gateway.RelayTimeoutQoSContext interface.
+func (rc *requestContext) GetRelayTimeout() time.Duration {
+	return rc.relayTimeout
 }
 
 // GetObservations returns all endpoint observations from the request context.
@@ -248,6 +327,12 @@ func (rc requestContext) GetObservations() qosobservations.Observations {
 		requestError = qos.GetRequestErrorForJSONRPCBackendServiceUnmarshalError()
 	}
 
+	// Consensus-read: track the outcome, and set the request error if the quorum was not reached.
+	consensusReadObservation, consensusRequestError, _ := rc.getConsensusObservations()
+	if consensusRequestError != nil && requestError == nil {
+		requestError = consensusRequestError
+	}
+
 	return qosobservations.Observations{
 		ServiceObservations: &qosobservations.Observations_Evm{
 			Evm: &qosobservations.EVMRequestObservations{
@@ -257,9 +342,13 @@ func (rc requestContext) GetObservations() qosobservations.Observations {
 				RequestOrigin:        rc.requestOrigin,
 				RequestError:         requestError,
 				RequestObservations:  requestObservations,
+				ConsensusRead:        consensusReadObservation,
+				ServiceStall:         rc.serviceState.stallTracker.GetObservation(),
+				ForkCheck:            rc.serviceState.getForkCheckObservation(),
 				EndpointSelectionMetadata: &qosobservations.EndpointSelectionMetadata{
 					RandomEndpointFallback: rc.endpointSelectionMetadata.RandomEndpointFallback,
 					ValidationResults:      validationResults,
+					EndpointPool:           rc.endpointSelectionMetadata.EndpointPool,
 				},
 			},
 		},
@@ -308,6 +397,9 @@ func (rc requestContext) createNoResponseObservations() []*qosobservations.EVMRe
 func (rc requestContext) createResponseObservations() []*qosobservations.EVMRequestObservation {
 	var observations []*qosobservations.EVMRequestObservation
 
+	// Consensus-read: endpoints disagreeing with the majority are flagged.
+	_, _, disagreeingEndpointAddrs := rc.getConsensusObservations()
+
 	for _, endpointResp := range rc.endpointResponses {
 		var jsonrpcResponse jsonrpc.Response
 		err := json.Unmarshal(endpointResp.GetHTTPResponse().GetPayload(), &jsonrpcResponse)
@@ -337,6 +429,10 @@ func (rc requestContext) createResponseObservations() []*qosobservations.EVMRequ
 		// Ensure the endpoint address is always set in the observation
 		endpointObs.EndpointAddr = string(endpointResp.EndpointAddr)
 
+		if _, disagreed := disagreeingEndpointAddrs[endpointObs.EndpointAddr]; disagreed {
+			markConsensusDisagreement(&endpointObs, endpointResp.GetHTTPResponse().GetHTTPStatusCode())
+		}
+
 		observations = append(observations, &qosobservations.EVMRequestObservation{
 			JsonrpcRequest: jsonrpcReq.GetObservation(),
 			EndpointObservations: []*qosobservations.EVMEndpointObservation{
@@ -368,7 +464,12 @@ func (rc *requestContext) Select(allEndpoints protocol.EndpointAddrList) (protoc
 	// TODO_FUTURE(@adshmh): Enhance the endpoint selection meta data to track, e.g.:
 	// * Endpoint Selection Latency
 	// * Number of available endpoints
-	selectionResult, err := rc.serviceState.SelectWithMetadata(allEndpoints)
+	endpointsAtMinBlockNumber, err := rc.filterEndpointsAtMinBlockNumber(allEndpoints)
+	if err != nil {
+		return protocol.EndpointAddr(""), err
+	}
+
+	selectionResult, err := rc.serviceState.SelectWithMetadata(endpointsAtMinBlockNumber, rc.requiresArchival)
 	if err != nil {
 		return protocol.EndpointAddr(""), err
 	}
@@ -382,7 +483,38 @@ func (rc *requestContext) Select(allEndpoints protocol.EndpointAddrList) (protoc
 // SelectMultiple returns multiple endpoint addresses using the request context's endpoint store.
 // Implements the protocol.EndpointSelector interface.
 func (rc *requestContext) SelectMultiple(allEndpoints protocol.EndpointAddrList, numEndpoints uint) (protocol.EndpointAddrList, error) {
-	return rc.serviceState.SelectMultiple(allEndpoints, numEndpoints)
+	endpointsAtMinBlockNumber, err := rc.filterEndpointsAtMinBlockNumber(allEndpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	selectedEndpoints, selectionMetadata, err := rc.serviceState.SelectMultiple(endpointsAtMinBlockNumber, numEndpoints, rc.requiresArchival)
+	if err != nil {
+		return nil, err
+	}
+
+	// Store selection metadata for observation tracking
+	rc.endpointSelectionMetadata = selectionMetadata
+
+	return selectedEndpoints, nil
+}
+
+// filterEndpointsAtMinBlockNumber returns the endpoints at or above the request's minimum block number, if any.
+// Returns an error if none of them have reached the minimum block number:
+// serving the request from a lagging endpoint would break the user's monotonic reads.
+func (rc *requestContext) filterEndpointsAtMinBlockNumber(allEndpoints protocol.EndpointAddrList) (protocol.EndpointAddrList, error) {
+	if rc.minEndpointBlockNumber == 0 {
+		return allEndpoints, nil
+	}
+
+	filteredEndpoints := rc.serviceState.filterEndpointsAtMinBlockNumber(allEndpoints, rc.minEndpointBlockNumber)
+	if len(filteredEndpoints) == 0 {
+		rc.logger.Info().Msgf("No endpoints at or above the minimum block number %d: returning an error response.", rc.minEndpointBlockNumber)
+		rc.minBlockNumberErr = fmt.Errorf("%w %d", errNoEndpointAtMinBlockNumber, rc.minEndpointBlockNumber)
+		return nil, rc.minBlockNumberErr
+	}
+
+	return filteredEndpoints, nil
 }
 
 // findServicePayload finds a service payload by ID using value-based comparison.
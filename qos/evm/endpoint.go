@@ -23,4 +23,7 @@ type endpoint struct {
 	checkBlockNumber endpointCheckBlockNumber
 	checkChainID     endpointCheckChainID
 	checkArchival    endpointCheckArchival
+	checkFork        endpointCheckFork
+	checkSyncing     endpointCheckSyncing
+	checkPeerCount   endpointCheckPeerCount
 }
@@ -36,13 +36,21 @@ type EndpointSelectionMetadata struct {
 	RandomEndpointFallback bool
 	// ValidationResults contains detailed information about each validation attempt (both successful and failed)
 	ValidationResults []*qosobservations.EndpointValidationResult
+	// EndpointPool is the pool of valid endpoints the endpoint was selected from, e.g. archival endpoints only
+	EndpointPool qosobservations.EndpointPool
 }
 
 // SelectMultiple returns multiple endpoint addresses from the list of available endpoints.
 // Available endpoints are filtered based on their validity first.
 // Endpoints are selected with TLD diversity preference when possible.
 // If numEndpoints is 0, it defaults to 1. If numEndpoints is greater than available endpoints, it returns all valid endpoints.
-func (ss *serviceState) SelectMultiple(availableEndpoints protocol.EndpointAddrList, numEndpoints uint) (protocol.EndpointAddrList, error) {
+// If requiresArchival is set, endpoints are selected from the valid endpoints which passed the archival check, if any.
+// The returned metadata describes the selection process, as with SelectWithMetadata.
+func (ss *serviceState) SelectMultiple(
+	availableEndpoints protocol.EndpointAddrList,
+	numEndpoints uint,
+	requiresArchival bool,
+) (protocol.EndpointAddrList, EndpointSelectionMetadata, error) {
 	logger := ss.logger.With("method", "SelectMultiple").
 		With("chain_id", ss.serviceQoSConfig.getEVMChainID()).
 		With("service_id", ss.serviceQoSConfig.GetServiceID()).
@@ -50,27 +58,38 @@ func (ss *serviceState) SelectMultiple(availableEndpoints protocol.EndpointAddrL
 	logger.Info().Msgf("filtering %d available endpoints to select up to %d.", len(availableEndpoints), numEndpoints)
 
 	// Filter valid endpoints
-	filteredEndpointsAddr, _, err := ss.filterValidEndpointsWithDetails(availableEndpoints)
+	filteredEndpointsAddr, validationResults, err := ss.filterValidEndpointsWithDetails(availableEndpoints)
 	if err != nil {
 		logger.Error().Err(err).Msg("error filtering endpoints")
-		return nil, err
+		return nil, EndpointSelectionMetadata{}, err
 	}
 
 	// Select random endpoints as fallback
 	if len(filteredEndpointsAddr) == 0 {
 		logger.Warn().Msgf("SELECTING RANDOM ENDPOINTS because all endpoints failed validation from: %s", availableEndpoints.String())
-		return selector.RandomSelectMultiple(availableEndpoints, numEndpoints), nil
+		return selector.RandomSelectMultiple(availableEndpoints, numEndpoints), EndpointSelectionMetadata{
+			RandomEndpointFallback: true,
+			ValidationResults:      validationResults,
+		}, nil
 	}
 
+	// Restrict requests which need archival data to archival endpoints.
+	endpointPool, endpointPoolType := ss.selectEndpointPool(filteredEndpointsAddr, requiresArchival)
+
 	// Use the diversity-aware selection
 	logger.Info().Msgf("filtered %d endpoints from %d available endpoints", len(filteredEndpointsAddr), len(availableEndpoints))
-	return selector.SelectEndpointsWithDiversity(logger, filteredEndpointsAddr, numEndpoints), nil
+	return selector.SelectEndpointsWithDiversity(logger, endpointPool, numEndpoints), EndpointSelectionMetadata{
+		RandomEndpointFallback: false,
+		ValidationResults:      validationResults,
+		EndpointPool:           endpointPoolType,
+	}, nil
 }
 
 // SelectWithMetadata returns endpoint address and selection metadata.
 // Filters endpoints by validity and captures detailed validation failure information.
 // Selects random endpoint if all fail validation.
-func (ss *serviceState) SelectWithMetadata(availableEndpoints protocol.EndpointAddrList) (EndpointSelectionResult, error) {
+// If requiresArchival is set, the endpoint is selected from the valid endpoints which passed the archival check, if any.
+func (ss *serviceState) SelectWithMetadata(availableEndpoints protocol.EndpointAddrList, requiresArchival bool) (EndpointSelectionResult, error) {
 	logger := ss.logger.With("method", "SelectWithMetadata").
 		With("chain_id", ss.serviceQoSConfig.getEVMChainID()).
 		With("service_id", ss.serviceQoSConfig.GetServiceID())
@@ -100,13 +119,17 @@ func (ss *serviceState) SelectWithMetadata(availableEndpoints protocol.EndpointA
 
 	logger.Info().Msgf("filtered %d endpoints from %d available endpoints", validCount, availableCount)
 
+	// Restrict requests which need archival data to archival endpoints.
+	endpointPool, endpointPoolType := ss.selectEndpointPool(filteredEndpointsAddr, requiresArchival)
+
 	// Select random endpoint from valid candidates
-	selectedEndpointAddr := filteredEndpointsAddr[rand.Intn(validCount)]
+	selectedEndpointAddr := endpointPool[rand.Intn(len(endpointPool))]
 	return EndpointSelectionResult{
 		SelectedEndpoint: selectedEndpointAddr,
 		Metadata: EndpointSelectionMetadata{
 			RandomEndpointFallback: false,
 			ValidationResults:      validationResults,
+			EndpointPool:           endpointPoolType,
 		},
 	}, nil
 }
@@ -206,6 +229,15 @@ func (ss *serviceState) categorizeValidationFailure(err error) qosobservations.E
 	if errors.Is(err, errNoChainIDObs) {
 		return qosobservations.EndpointValidationFailureReason_ENDPOINT_VALIDATION_FAILURE_REASON_NO_CHAIN_ID_OBSERVATION
 	}
+	if errors.Is(err, errSyncingObs) {
+		return qosobservations.EndpointValidationFailureReason_ENDPOINT_VALIDATION_FAILURE_REASON_SYNCING
+	}
+	if errors.Is(err, errBelowMinPeerCountObs) {
+		return qosobservations.EndpointValidationFailureReason_ENDPOINT_VALIDATION_FAILURE_REASON_PEER_COUNT_BELOW_MINIMUM
+	}
+	if errors.Is(err, errBlockHashMismatchObs) || errors.Is(err, errNoBlockHashObs) {
+		return qosobservations.EndpointValidationFailureReason_ENDPOINT_VALIDATION_FAILURE_REASON_BLOCK_HASH_MISMATCH
+	}
 
 	// Check for archival validation failures
 	errorStr := err.Error()
@@ -220,12 +252,18 @@ func (ss *serviceState) categorizeValidationFailure(err error) qosobservations.E
 // basicEndpointValidation returns an error if the supplied endpoint is not
 // valid based on the perceived state of the EVM blockchain.
 //
+// The archival check is intentionally not part of the basic validation:
+// non-archival endpoints are valid for requests which do not need archival data.
+// See selectEndpointPool for the routing of requests which need archival data.
+//
 // It returns an error if:
 // - The endpoint has returned an empty response in the past.
 // - The endpoint has returned an invalid response within the last 30 minutes.
 // - The endpoint's response to an `eth_chainId` request is not the expected chain ID.
 // - The endpoint's response to an `eth_blockNumber` request is greater than the perceived block number.
-// - The endpoint's archival check is invalid, if enabled.
+// - The endpoint's response to an `eth_syncing` request indicates it is syncing.
+// - The endpoint's response to a `net_peerCount` request is below the minimum peer count, if configured.
+// - The endpoint's block hash at the fork check block number does not match the majority block hash.
 func (ss *serviceState) basicEndpointValidation(endpoint endpoint) error {
 	ss.serviceStateLock.RLock()
 	defer ss.serviceStateLock.RUnlock()
@@ -254,9 +292,19 @@ func (ss *serviceState) basicEndpointValidation(endpoint endpoint) error {
 		return fmt.Errorf("chain ID validation failed: %w", err)
 	}
 
-	// Check if the endpoint has returned an archival balance for the perceived block number.
-	if err := ss.archivalState.isArchivalBalanceValid(endpoint.checkArchival); err != nil {
-		return fmt.Errorf("archival balance validation failed: %w", err)
+	// Check if the endpoint reported it is syncing.
+	if err := ss.isSyncingValid(endpoint.checkSyncing); err != nil {
+		return fmt.Errorf("sync status validation failed: %w", err)
+	}
+
+	// Check if the endpoint is connected to the minimum number of peers.
+	if err := ss.isPeerCountValid(endpoint.checkPeerCount); err != nil {
+		return fmt.Errorf("peer count validation failed: %w", err)
+	}
+
+	// Check if the endpoint is on the same chain as the majority of endpoints.
+	if err := ss.forkState.isBlockHashValid(endpoint.checkFork); err != nil {
+		return fmt.Errorf("fork check validation failed: %w", err)
 	}
 
 	return nil
@@ -307,3 +355,31 @@ func (ss *serviceState) isChainIDValid(check endpointCheckChainID) error {
 	}
 	return nil
 }
+
+// isSyncingValid returns an error if the endpoint reported it is syncing in response to an `eth_syncing` request.
+//
+// Endpoints without an observation are not disqualified, as `eth_syncing` may be disabled by some providers.
+func (ss *serviceState) isSyncingValid(check endpointCheckSyncing) error {
+	if check.isSyncing != nil && *check.isSyncing {
+		return errSyncingObs
+	}
+	return nil
+}
+
+// isPeerCountValid returns an error if the endpoint's peer count is below the service's minimum peer count.
+//
+// Endpoints without an observation are not disqualified, as `net_peerCount` may be disabled by some providers.
+func (ss *serviceState) isPeerCountValid(check endpointCheckPeerCount) error {
+	minPeerCount := ss.serviceQoSConfig.getMinPeerCount()
+	if minPeerCount == 0 || check.peerCount == nil {
+		return nil
+	}
+
+	// Dereference pointer to show actual peer count instead of memory address in error logs
+	peerCount := *check.peerCount
+	if peerCount < minPeerCount {
+		return fmt.Errorf("%w: peer count %d is below the minimum peer count %d",
+			errBelowMinPeerCountObs, peerCount, minPeerCount)
+	}
+	return nil
+}
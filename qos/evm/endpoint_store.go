@@ -1,6 +1,7 @@
 package evm
 
 import (
+	"maps"
 	"strconv"
 	"sync"
 	"time"
@@ -28,7 +29,7 @@ type endpointStore struct {
 // on the supplied observations. It returns the set of created/updated endpoints.
 func (es *endpointStore) updateEndpointsFromObservations(
 	evmObservations *qosobservations.EVMRequestObservations,
-	archivalBlockHeight string,
+	forkCheckBlockHeight string,
 ) map[protocol.EndpointAddr]endpoint {
 	es.endpointsMu.Lock()
 	defer es.endpointsMu.Unlock()
@@ -65,7 +66,7 @@ func (es *endpointStore) updateEndpointsFromObservations(
 		isEndpointMutatedByObservation := applyObservation(
 			&storedEndpoint,
 			observation,
-			archivalBlockHeight,
+			forkCheckBlockHeight,
 		)
 
 		// If the observation did not mutate the endpoint, there is no need to update the stored endpoint entry.
@@ -81,12 +82,24 @@ func (es *endpointStore) updateEndpointsFromObservations(
 	return updatedEndpoints
 }
 
+// getEndpoints returns a snapshot of all the endpoints in the store.
+func (es *endpointStore) getEndpoints() map[protocol.EndpointAddr]endpoint {
+	es.endpointsMu.RLock()
+	defer es.endpointsMu.RUnlock()
+
+	return maps.Clone(es.endpoints)
+}
+
 // applyObservation updates the data stored regarding the endpoint using the supplied observation.
 // It returns true if the observation was recognized (i.e. mutated the endpoint).
 //
-// For archival balance observations:
-// - Only updates the archival balance if the balance was observed at the specified archival block height
-// - This ensures accurate historical balance validation at the specific block number
+// For archival probe observations:
+// - Observations at any block number are stored, along with the block number they were observed at
+// - The endpoint is validated against the probe's expected value at the same block number
+//
+// For fork check block observations:
+// - Only updates the block hash if the block was observed at the specified fork check block height
+// - This ensures block hashes are compared across endpoints at the same block number
 //
 // TODO_TECHDEBT(@adshmh): add a method to distinguish the following two scenarios:
 //   - an endpoint that returned in invalid response.
@@ -94,7 +107,7 @@ func (es *endpointStore) updateEndpointsFromObservations(
 func applyObservation(
 	endpoint *endpoint,
 	observation *qosobservations.EVMEndpointObservation,
-	archivalBlockHeight string,
+	forkCheckBlockHeight string,
 ) (endpointWasMutated bool) {
 	// If emptyResponse is not nil, the observation is for an empty response check.
 	if observation.GetEmptyResponse() != nil {
@@ -117,13 +130,32 @@ func applyObservation(
 		return
 	}
 
-	// If getBalanceResponse is not nil, the observation is for a getBalance check (which may be an archival check).
-	if getBalanceResponse := observation.GetGetBalanceResponse(); getBalanceResponse != nil {
-		balanceBlockHeight := getBalanceResponse.GetBlockNumber()
+	// If syncingResponse is not nil, the observation is for a sync status check.
+	if observation.GetSyncingResponse() != nil {
+		applySyncingObservation(endpoint, observation.GetSyncingResponse())
+		endpointWasMutated = true
+		return
+	}
+
+	// If peerCountResponse is not nil, the observation is for a peer count check.
+	if observation.GetPeerCountResponse() != nil {
+		applyPeerCountObservation(endpoint, observation.GetPeerCountResponse())
+		endpointWasMutated = true
+		return
+	}
 
-		// Only update the archival balance if the balance was observed at the archival block height.
-		if balanceBlockHeight == archivalBlockHeight {
-			applyArchivalObservation(endpoint, getBalanceResponse)
+	// If archivalProbeResponse is not nil, the observation is for an archival probe.
+	if archivalProbeResponse := observation.GetArchivalProbeResponse(); archivalProbeResponse != nil {
+		applyArchivalProbeObservation(endpoint, archivalProbeResponse)
+		endpointWasMutated = true
+		return
+	}
+
+	// If getBlockByNumberResponse is not nil, the observation is for a getBlockByNumber check (which may be a fork check).
+	if getBlockByNumberResponse := observation.GetGetBlockByNumberResponse(); getBlockByNumberResponse != nil {
+		// Only update the fork check if the block was observed at the fork check block height.
+		if forkCheckBlockHeight != "" && getBlockByNumberResponse.GetBlockNumber() == forkCheckBlockHeight {
+			applyForkObservation(endpoint, getBlockByNumberResponse)
 			endpointWasMutated = true
 			return
 		}
@@ -176,11 +208,47 @@ func applyChainIDObservation(endpoint *endpoint, chainIDResponse *qosobservation
 	}
 }
 
-// applyArchivalObservation updates the archival check if a valid observation is provided.
-func applyArchivalObservation(endpoint *endpoint, archivalResponse *qosobservations.EVMGetBalanceResponse) {
-	endpoint.checkArchival = endpointCheckArchival{
-		observedArchivalBalance: archivalResponse.GetBalance(),
-		expiresAt:               time.Now().Add(checkArchivalInterval),
+// applySyncingObservation updates the sync status check if a valid observation is provided.
+func applySyncingObservation(endpoint *endpoint, syncingResponse *qosobservations.EVMSyncingResponse) {
+	isSyncing := syncingResponse.GetIsSyncing()
+
+	endpoint.checkSyncing = endpointCheckSyncing{
+		isSyncing: &isSyncing,
+		expiresAt: time.Now().Add(checkSyncingInterval),
+	}
+}
+
+// applyPeerCountObservation updates the peer count check if a valid observation is provided.
+// The peer count is left unset if the response could not be parsed, e.g. if `net_peerCount` is not supported by the endpoint.
+func applyPeerCountObservation(endpoint *endpoint, peerCountResponse *qosobservations.EVMPeerCountResponse) {
+	var peerCount *uint64
+	if parsed, err := strconv.ParseUint(peerCountResponse.GetPeerCountResponse(), 0, 64); err == nil {
+		peerCount = &parsed
+	}
+
+	endpoint.checkPeerCount = endpointCheckPeerCount{
+		peerCount: peerCount,
+		expiresAt: time.Now().Add(checkPeerCountInterval),
+	}
+}
+
+// applyArchivalProbeObservation updates the archival check's observations for the probe if a valid observation is provided.
+func applyArchivalProbeObservation(endpoint *endpoint, archivalProbeResponse *qosobservations.EVMArchivalProbeResponse) {
+	endpoint.checkArchival = endpoint.checkArchival.withObservation(
+		archivalProbeResponse.GetProbeName(),
+		archivalProbeObservation{
+			blockNumberHex: archivalProbeResponse.GetBlockNumber(),
+			value:          archivalProbeResponse.GetValue(),
+		},
+	)
+}
+
+// applyForkObservation updates the fork check if a valid observation is provided.
+func applyForkObservation(endpoint *endpoint, getBlockByNumberResponse *qosobservations.EVMGetBlockByNumberResponse) {
+	endpoint.checkFork = endpointCheckFork{
+		blockNumberHex: getBlockByNumberResponse.GetBlockNumber(),
+		blockHash:      getBlockByNumberResponse.GetBlockHash(),
+		expiresAt:      time.Now().Add(checkForkInterval),
 	}
 }
 
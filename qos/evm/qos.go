@@ -9,6 +9,7 @@ import (
 	"github.com/buildwithgrove/path/gateway"
 	"github.com/buildwithgrove/path/metrics/devtools"
 	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos"
 )
 
 // QoS implements gateway.QoSService by providing:
@@ -52,26 +53,44 @@ func NewQoSInstance(logger polylog.Logger, config EVMServiceQoSConfig) *QoS {
 		logger:           logger,
 		serviceQoSConfig: config,
 		endpointStore:    store,
+		// Stall detection is disabled unless an expected block time is configured for the service.
+		stallTracker: qos.NewStallTracker(config.getExpectedBlockTime()),
+		forkState: forkState{
+			logger: logger.With("state", "fork"),
+		},
 	}
 
 	// TODO_CONSIDERATION(@olshansk): Archival checks are currently optional to enable iteration
 	// and optionality. In the future, evaluate whether it should be mandatory for all EVM services.
 	if config.archivalCheckEnabled() {
-		serviceState.archivalState = archivalState{
-			logger:              logger.With("state", "archival"),
-			archivalCheckConfig: config.getEVMArchivalCheckConfig(),
-			// Initialize the balance consensus map.
-			// It keeps track and maps a balance (at the configured address and contract)
-			// to the number of occurrences seen across all endpoints.
-			balanceConsensus: make(map[string]int),
-		}
+		serviceState.archivalState = newArchivalState(logger.With("state", "archival"), config.getEVMArchivalCheckConfig())
 	}
 
+	blockTagPinningLag, blockTagPinningEnabled := config.getBlockTagPinning()
+
 	evmRequestValidator := &evmRequestValidator{
 		logger:       logger,
 		serviceID:    serviceId,
 		chainID:      evmChainID,
 		serviceState: serviceState,
+		// Splitting of eth_getLogs requests is disabled unless configured for the service.
+		getLogsBlockRangeChunkSize: config.getGetLogsBlockRangeChunkSize(),
+		// Consensus-read mode is only enabled for configured methods, or if requested by the user and allowed for the service.
+		consensusReadMethods:       config.getConsensusReadMethods(),
+		consensusReadHeaderEnabled: config.consensusReadHeaderEnabled(),
+		// Block tags are only pinned to a concrete block number if configured for the service.
+		blockTagPinningEnabled: blockTagPinningEnabled,
+		blockTagPinningLag:     blockTagPinningLag,
+		// Only allowlisted methods are coalesced, if request coalescing is enabled for the gateway.
+		coalescedMethods: config.getCoalescedMethods(),
+		// Batch size and request body size are bounded by the service's limits, or the defaults.
+		requestLimits: config.getRequestLimits(),
+		// Only configured methods have a relay timeout other than the gateway's default.
+		methodTimeouts: config.getMethodTimeouts(),
+		// Transaction submissions are broadcast to the service's number of endpoints, or the default.
+		txBroadcastNumEndpoints: config.getTxBroadcastNumEndpoints(),
+		// Members of batch requests are distributed across the service's number of endpoints, or the default.
+		batchNumEndpoints: config.getBatchNumEndpoints(),
 	}
 
 	return &QoS{
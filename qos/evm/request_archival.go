@@ -0,0 +1,188 @@
+package evm
+
+import (
+	"encoding/json"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// blockTagEarliest is the block tag for the genesis block.
+// Reference: https://ethereum.org/en/developers/docs/apis/json-rpc/#default-block
+const blockTagEarliest = "earliest"
+
+// requiresArchivalData returns true if any of the JSON-RPC requests targets a block older than
+// the archival threshold, i.e. a block whose data is not expected to be available on a full node.
+//
+// Only the block parameters listed by getRequestBlockNumber are considered, e.g. of `eth_getBalance`, `eth_getLogs` or `trace_block`.
+// Always returns false if archival checks are not enabled for the service: there is no archival pool to route to.
+func (ss *serviceState) requiresArchivalData(jsonrpcReqs map[jsonrpc.ID]jsonrpc.Request) bool {
+	ss.serviceStateLock.RLock()
+	defer ss.serviceStateLock.RUnlock()
+
+	if !ss.archivalState.isEnabled() || ss.perceivedBlockNumber == 0 {
+		return false
+	}
+
+	archivalThreshold := ss.archivalState.archivalCheckConfig.threshold
+	if ss.perceivedBlockNumber <= archivalThreshold {
+		return false
+	}
+	minFullNodeBlockNumber := ss.perceivedBlockNumber - archivalThreshold
+
+	for _, jsonrpcReq := range jsonrpcReqs {
+		blockNumber, ok := getRequestBlockNumber(jsonrpcReq)
+		if ok && blockNumber < minFullNodeBlockNumber {
+			return true
+		}
+	}
+
+	return false
+}
+
+// traceBlockParamIndexByMethod maps the trace and debug JSON-RPC methods to the index of their block parameter.
+// They are only used to classify archival requests: their block tags are not pinned.
+//
+// References:
+//   - https://openethereum.github.io/JSONRPC-trace-module
+//   - https://geth.ethereum.org/docs/interacting-with-geth/rpc/ns-debug
+var traceBlockParamIndexByMethod = map[jsonrpc.Method]int{
+	"trace_block":                   0,
+	"trace_call":                    2,
+	"trace_callMany":                1,
+	"trace_replayBlockTransactions": 0,
+	"debug_traceBlockByNumber":      0,
+	"debug_traceCall":               1,
+}
+
+// methodTraceFilter is the JSON-RPC method for getting the traces matching a filter.
+// Like `eth_getLogs`, its block parameters are fields of the filter object.
+const methodTraceFilter = jsonrpc.Method("trace_filter")
+
+// getRequestBlockNumber returns the block number targeted by the block parameter of the JSON-RPC request:
+//   - The `fromBlock` field of the filter, for `eth_getLogs` and `trace_filter`.
+//   - The block parameter of the methods in blockParamIndexByMethod and traceBlockParamIndexByMethod.
+//
+// The block parameter may be one of:
+//   - A hex block number, e.g. "0x1b4".
+//   - The "earliest" block tag, i.e. the genesis block.
+//   - An EIP-1898 block object with a `blockNumber` field, e.g. {"blockNumber": "0x1b4"}.
+//
+// Returns false for any other block parameter, e.g. "latest" or a block hash, and for methods without a block parameter.
+func getRequestBlockNumber(jsonrpcReq jsonrpc.Request) (uint64, bool) {
+	paramsBz, err := json.Marshal(jsonrpcReq.Params)
+	if err != nil || len(paramsBz) == 0 {
+		return 0, false
+	}
+
+	var params []json.RawMessage
+	if err := json.Unmarshal(paramsBz, &params); err != nil {
+		return 0, false
+	}
+
+	switch jsonrpcReq.Method {
+	case methodGetLogs, methodTraceFilter:
+		return getFilterFromBlockNumber(params)
+	}
+
+	paramIdx, found := blockParamIndexByMethod[jsonrpcReq.Method]
+	if !found {
+		paramIdx, found = traceBlockParamIndexByMethod[jsonrpcReq.Method]
+	}
+	if !found || paramIdx >= len(params) {
+		return 0, false
+	}
+
+	return parseBlockParamNumber(params[paramIdx])
+}
+
+// getFilterFromBlockNumber returns the block number targeted by the `fromBlock` field of a filter object,
+// i.e. the oldest block the request targets.
+// Returns false if the filter has no `fromBlock`, which defaults to "latest".
+func getFilterFromBlockNumber(params []json.RawMessage) (uint64, bool) {
+	if len(params) != 1 {
+		return 0, false
+	}
+
+	var filter map[string]json.RawMessage
+	if err := json.Unmarshal(params[0], &filter); err != nil {
+		return 0, false
+	}
+
+	fromBlock, found := filter["fromBlock"]
+	if !found {
+		return 0, false
+	}
+
+	return parseBlockParamNumber(fromBlock)
+}
+
+// parseBlockParamNumber returns the block number of the supplied block parameter:
+// a hex block number, the "earliest" block tag, or an EIP-1898 block object with a `blockNumber` field.
+func parseBlockParamNumber(blockParamBz json.RawMessage) (uint64, bool) {
+	var blockParam string
+	if err := json.Unmarshal(blockParamBz, &blockParam); err != nil {
+		// Not a string: check for an EIP-1898 block object.
+		var blockObject struct {
+			BlockNumber string `json:"blockNumber"`
+		}
+		if err := json.Unmarshal(blockParamBz, &blockObject); err != nil {
+			return 0, false
+		}
+		blockParam = blockObject.BlockNumber
+	}
+
+	if blockParam == blockTagEarliest {
+		return 0, true
+	}
+
+	return parseHexUint64(blockParam)
+}
+
+// selectEndpointPool returns the pool of valid endpoints the request should be served from:
+//   - Requests which need archival data are restricted to the valid endpoints which passed the archival check.
+//   - All other requests use the full set of valid endpoints.
+//
+// Falls back to the full set of valid endpoints if none of them passed the archival check.
+func (ss *serviceState) selectEndpointPool(
+	validEndpoints protocol.EndpointAddrList,
+	requiresArchival bool,
+) (protocol.EndpointAddrList, qosobservations.EndpointPool) {
+	if !requiresArchival {
+		return validEndpoints, qosobservations.EndpointPool_ENDPOINT_POOL_ALL_VALID
+	}
+
+	archivalEndpoints := ss.filterArchivalEndpoints(validEndpoints)
+	if len(archivalEndpoints) == 0 {
+		ss.logger.Warn().Msgf("No archival endpoints among %d valid endpoints: selecting from all valid endpoints.", len(validEndpoints))
+		return validEndpoints, qosobservations.EndpointPool_ENDPOINT_POOL_ALL_VALID
+	}
+
+	return archivalEndpoints, qosobservations.EndpointPool_ENDPOINT_POOL_ARCHIVAL
+}
+
+// filterArchivalEndpoints returns the subset of the supplied endpoints which passed all the archival probes.
+func (ss *serviceState) filterArchivalEndpoints(endpointAddrs protocol.EndpointAddrList) protocol.EndpointAddrList {
+	ss.endpointStore.endpointsMu.RLock()
+	defer ss.endpointStore.endpointsMu.RUnlock()
+
+	ss.serviceStateLock.RLock()
+	defer ss.serviceStateLock.RUnlock()
+
+	var archivalEndpoints protocol.EndpointAddrList
+	for _, endpointAddr := range endpointAddrs {
+		endpoint, found := ss.endpointStore.endpoints[endpointAddr]
+		if !found {
+			continue
+		}
+
+		if err := ss.archivalState.isArchivalValid(endpoint.checkArchival); err != nil {
+			continue
+		}
+
+		archivalEndpoints = append(archivalEndpoints, endpointAddr)
+	}
+
+	return archivalEndpoints
+}
@@ -0,0 +1,226 @@
+package evm
+
+import (
+	"testing"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/stretchr/testify/require"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+func TestRequiresArchivalData(t *testing.T) {
+	tests := []struct {
+		name                     string
+		method                   jsonrpc.Method
+		params                   string
+		archivalDisabled         bool
+		expectedRequiresArchival bool
+	}{
+		{
+			name:                     "block older than the archival threshold requires archival data",
+			method:                   "eth_getBalance",
+			params:                   `["0xdead","0x64"]`,
+			expectedRequiresArchival: true,
+		},
+		{
+			name:   "block within the archival threshold does not require archival data",
+			method: "eth_getBalance",
+			params: `["0xdead","0x3e0"]`,
+		},
+		{
+			name:   "latest block tag does not require archival data",
+			method: "eth_call",
+			params: `[{"to":"0xdead"},"latest"]`,
+		},
+		{
+			name:                     "earliest block tag requires archival data",
+			method:                   "eth_getStorageAt",
+			params:                   `["0xdead","0x0","earliest"]`,
+			expectedRequiresArchival: true,
+		},
+		{
+			name:                     "EIP-1898 block object with an old block number requires archival data",
+			method:                   "eth_call",
+			params:                   `[{"to":"0xdead"},{"blockNumber":"0x64"}]`,
+			expectedRequiresArchival: true,
+		},
+		{
+			name:   "EIP-1898 block object with a block hash does not require archival data",
+			method: "eth_call",
+			params: `[{"to":"0xdead"},{"blockHash":"0xbeef"}]`,
+		},
+		{
+			name:   "method without a block parameter does not require archival data",
+			method: "eth_getTransactionByHash",
+			params: `["0x64"]`,
+		},
+		{
+			name:                     "eth_getLogs filter with an old fromBlock requires archival data",
+			method:                   "eth_getLogs",
+			params:                   `[{"fromBlock":"0x64","toBlock":"latest"}]`,
+			expectedRequiresArchival: true,
+		},
+		{
+			name:                     "eth_getLogs filter from the earliest block requires archival data",
+			method:                   "eth_getLogs",
+			params:                   `[{"fromBlock":"earliest","address":"0xdead"}]`,
+			expectedRequiresArchival: true,
+		},
+		{
+			name:   "eth_getLogs filter with a recent fromBlock does not require archival data",
+			method: "eth_getLogs",
+			params: `[{"fromBlock":"0x3e0","toBlock":"0x3e8"}]`,
+		},
+		{
+			name:   "eth_getLogs filter with no fromBlock does not require archival data",
+			method: "eth_getLogs",
+			params: `[{"toBlock":"0x64"}]`,
+		},
+		{
+			name:   "eth_getLogs filter with a block hash does not require archival data",
+			method: "eth_getLogs",
+			params: `[{"blockHash":"0xbeef"}]`,
+		},
+		{
+			name:                     "trace_filter with an old fromBlock requires archival data",
+			method:                   "trace_filter",
+			params:                   `[{"fromBlock":"0x64","toBlock":"0x65"}]`,
+			expectedRequiresArchival: true,
+		},
+		{
+			name:                     "trace_block of an old block requires archival data",
+			method:                   "trace_block",
+			params:                   `["0x64"]`,
+			expectedRequiresArchival: true,
+		},
+		{
+			name:   "trace_block of the latest block does not require archival data",
+			method: "trace_block",
+			params: `["latest"]`,
+		},
+		{
+			name:                     "trace_call at an old block requires archival data",
+			method:                   "trace_call",
+			params:                   `[{"to":"0xdead"},["trace"],"0x64"]`,
+			expectedRequiresArchival: true,
+		},
+		{
+			name:                     "trace_replayBlockTransactions of an old block requires archival data",
+			method:                   "trace_replayBlockTransactions",
+			params:                   `["0x64",["trace"]]`,
+			expectedRequiresArchival: true,
+		},
+		{
+			name:                     "debug_traceBlockByNumber of an old block requires archival data",
+			method:                   "debug_traceBlockByNumber",
+			params:                   `["0x64",{"tracer":"callTracer"}]`,
+			expectedRequiresArchival: true,
+		},
+		{
+			name:   "debug_traceBlockByNumber of a recent block does not require archival data",
+			method: "debug_traceBlockByNumber",
+			params: `["0x3e0",{"tracer":"callTracer"}]`,
+		},
+		{
+			name:                     "debug_traceCall at an old block requires archival data",
+			method:                   "debug_traceCall",
+			params:                   `[{"to":"0xdead"},"0x64",{"tracer":"callTracer"}]`,
+			expectedRequiresArchival: true,
+		},
+		{
+			name:   "debug_traceTransaction does not require archival data",
+			method: "debug_traceTransaction",
+			params: `["0xbeef"]`,
+		},
+		{
+			name:             "old block does not require archival data if archival checks are disabled",
+			method:           "eth_getBalance",
+			params:           `["0xdead","0x64"]`,
+			archivalDisabled: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ss := &serviceState{perceivedBlockNumber: 1000}
+			if !test.archivalDisabled {
+				ss.archivalState.archivalCheckConfig = *NewEVMArchivalCheckConfig("0xdead", 1)
+			}
+
+			jsonrpcReq := jsonrpc.Request{
+				ID:      jsonrpc.IDFromInt(1),
+				JSONRPC: jsonrpc.Version2,
+				Method:  test.method,
+			}
+			jsonrpcReq.SetParams([]byte(test.params))
+
+			requiresArchival := ss.requiresArchivalData(map[jsonrpc.ID]jsonrpc.Request{jsonrpcReq.ID: jsonrpcReq})
+			require.Equal(t, test.expectedRequiresArchival, requiresArchival)
+		})
+	}
+}
+
+func TestRequestContext_SelectMultiple_EndpointSelectionMetadata(t *testing.T) {
+	chainID := "0x1"
+	validEndpoint := func(blockNumber uint64) endpoint {
+		return endpoint{
+			checkBlockNumber: endpointCheckBlockNumber{parsedBlockNumberResponse: &blockNumber},
+			checkChainID:     endpointCheckChainID{chainID: &chainID},
+		}
+	}
+
+	tests := []struct {
+		name                           string
+		endpoints                      map[protocol.EndpointAddr]endpoint
+		requiresArchival               bool
+		expectedRandomEndpointFallback bool
+		expectedEndpointPool           qosobservations.EndpointPool
+	}{
+		{
+			name:                 "request not requiring archival data is served from all valid endpoints",
+			endpoints:            map[protocol.EndpointAddr]endpoint{"endpoint_1": validEndpoint(1000), "endpoint_2": validEndpoint(1000)},
+			expectedEndpointPool: qosobservations.EndpointPool_ENDPOINT_POOL_ALL_VALID,
+		},
+		{
+			name:                 "request requiring archival data is served from archival endpoints",
+			endpoints:            map[protocol.EndpointAddr]endpoint{"endpoint_1": validEndpoint(1000), "endpoint_2": validEndpoint(1000)},
+			requiresArchival:     true,
+			expectedEndpointPool: qosobservations.EndpointPool_ENDPOINT_POOL_ARCHIVAL,
+		},
+		{
+			name:                           "random endpoints are selected if all endpoints failed validation",
+			endpoints:                      map[protocol.EndpointAddr]endpoint{"endpoint_1": validEndpoint(1), "endpoint_2": {}},
+			expectedRandomEndpointFallback: true,
+			expectedEndpointPool:           qosobservations.EndpointPool_ENDPOINT_POOL_UNSPECIFIED,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := require.New(t)
+
+			rc := &requestContext{
+				logger: polyzero.NewLogger(),
+				serviceState: &serviceState{
+					logger:               polyzero.NewLogger(),
+					serviceQoSConfig:     NewEVMServiceQoSConfig("eth", chainID, nil, nil),
+					endpointStore:        &endpointStore{endpoints: test.endpoints},
+					perceivedBlockNumber: 1000,
+				},
+				requiresArchival: test.requiresArchival,
+			}
+
+			selectedEndpoints, err := rc.SelectMultiple(protocol.EndpointAddrList{"endpoint_1", "endpoint_2"}, 2)
+			c.NoError(err)
+			c.Len(selectedEndpoints, 2)
+
+			// The selection metadata is recorded for the request's observations, e.g. the endpoint selection debug header.
+			c.Equal(test.expectedRandomEndpointFallback, rc.endpointSelectionMetadata.RandomEndpointFallback)
+			c.Equal(test.expectedEndpointPool, rc.endpointSelectionMetadata.EndpointPool)
+			c.Len(rc.endpointSelectionMetadata.ValidationResults, 2)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package evm
+
+import (
+	"encoding/json"
+	"net/http"
+
+	pathhttp "github.com/buildwithgrove/path/network/http"
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// GetBatchNumEndpoints returns the number of endpoints the members of a batch request should be distributed across.
+// Returns 0 for requests which are not batches.
+// Implements the gateway.BatchQoSContext interface.
+func (rc *requestContext) GetBatchNumEndpoints() uint {
+	if !rc.isBatch {
+		return 0
+	}
+	return qos.GetBatchRequestNumEndpoints(len(rc.servicePayloads), rc.batchNumEndpoints)
+}
+
+// GetFailedServicePayloads returns the service payloads of the batch members with no successful response, e.g. due to an endpoint timing out.
+// Implements the gateway.BatchQoSContext interface.
+func (rc *requestContext) GetFailedServicePayloads() []protocol.Payload {
+	var failedPayloads []protocol.Payload
+	for _, failedReq := range rc.jsonrpcBatchRequest.GetFailedRequests(rc.getBatchJSONRPCResponses()) {
+		if payload, found := rc.findServicePayload(failedReq.ID); found {
+			failedPayloads = append(failedPayloads, payload)
+		}
+	}
+	return failedPayloads
+}
+
+// getBatchHTTPResponse handles batch requests by combining individual JSON-RPC responses
+// into an array according to the JSON-RPC 2.0 specification.
+// https://www.jsonrpc.org/specification#batch
+//
+// Responses are returned in request order. If a batch member was retried, its successful response is preferred.
+func (rc requestContext) getBatchHTTPResponse() pathhttp.HTTPResponse {
+	jsonrpcResponses := rc.getBatchJSONRPCResponses()
+
+	// According to JSON-RPC spec: "If there are no Response objects contained within the Response array
+	// as it is to be sent to the client, the server MUST NOT return an empty Array and should return nothing at all."
+	// This can happen when all requests in the batch are notifications (which don't get responses)
+	// or when all individual responses are empty/invalid.
+	if len(jsonrpcResponses) == 0 {
+		// Create a responseGeneric for empty batch response and return its HTTP response
+		errorResponse := getGenericResponseBatchEmpty(rc.logger)
+		return errorResponse.GetHTTPResponse()
+	}
+
+	return jsonrpc.HTTPResponse{
+		ResponsePayload: rc.jsonrpcBatchRequest.BuildResponseBytes(jsonrpcResponses),
+		// According to the JSON-RPC 2.0 specification, even if individual responses
+		// in a batch contain errors, the entire batch should still return HTTP 200 OK.
+		HTTPStatusCode: http.StatusOK,
+	}
+}
+
+// getBatchJSONRPCResponses returns the JSON-RPC responses to the members of a batch request.
+// Includes the generic error responses built for invalid endpoint payloads.
+func (rc requestContext) getBatchJSONRPCResponses() []jsonrpc.Response {
+	var jsonrpcResponses []jsonrpc.Response
+	for _, endpointResp := range rc.endpointResponses {
+		payload := endpointResp.GetHTTPResponse().GetPayload()
+		if len(payload) == 0 {
+			continue
+		}
+
+		var jsonrpcResponse jsonrpc.Response
+		if err := json.Unmarshal(payload, &jsonrpcResponse); err != nil {
+			continue
+		}
+		jsonrpcResponses = append(jsonrpcResponses, jsonrpcResponse)
+	}
+	return jsonrpcResponses
+}
@@ -0,0 +1,244 @@
+package evm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	pathhttp "github.com/buildwithgrove/path/network/http"
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// HTTPHeaderMinBlockNumber is the HTTP header a user can set to enforce monotonic reads:
+//   - The value is the minimum block number, as a hex (e.g. "0x1b4") or decimal (e.g. "436") string.
+//   - Only endpoints at or above the minimum block number are selected to serve the request.
+//   - If no endpoint has reached the minimum block number, an error response is returned rather than possibly stale data.
+//   - If block-tag pinning is enabled, block tags are never pinned below the minimum block number.
+//
+// Users would typically set it to the highest block number they have observed so far.
+const HTTPHeaderMinBlockNumber = "Min-Block-Number"
+
+// pinnableBlockTags are the block tags which are rewritten to a concrete block number if block-tag pinning is enabled.
+// Only `latest` can be pinned: a mined block number is not equivalent to the other block tags, e.g.:
+//   - `pending` includes the mempool, e.g. `eth_getTransactionCount(addr, "pending")` for the next nonce.
+//   - `safe` and `finalized` lag the head by a chain-specific, variable number of blocks.
+//
+// Reference: https://ethereum.org/en/developers/docs/apis/json-rpc/#default-block
+var pinnableBlockTags = map[string]struct{}{
+	"latest": {},
+}
+
+// errNoEndpointAtMinBlockNumber is returned by endpoint selection if none of the endpoints
+// has reached the minimum block number of the request, e.g. set through the HTTPHeaderMinBlockNumber header.
+var errNoEndpointAtMinBlockNumber = errors.New("no endpoint has reached the minimum block number")
+
+// blockParamIndexByMethod maps JSON-RPC methods to the index of their block parameter.
+// `eth_getLogs` is handled separately, as its block parameters are fields of the filter object.
+var blockParamIndexByMethod = map[jsonrpc.Method]int{
+	"eth_getBalance":                          1,
+	"eth_getCode":                             1,
+	"eth_getTransactionCount":                 1,
+	"eth_getStorageAt":                        2,
+	"eth_call":                                1,
+	"eth_estimateGas":                         1,
+	"eth_getProof":                            2,
+	"eth_getBlockByNumber":                    0,
+	"eth_getBlockReceipts":                    0,
+	"eth_getBlockTransactionCountByNumber":    0,
+	"eth_getTransactionByBlockNumberAndIndex": 0,
+	"eth_getUncleCountByBlockNumber":          0,
+}
+
+// blockTagPin tracks the block number the block tags of a request were pinned to.
+type blockTagPin struct {
+	// blockNumber is the concrete block number used in place of the pinnable block tags.
+	blockNumber uint64
+
+	// capBlockNumberResult is set for `eth_blockNumber` requests.
+	// The returned block number is capped at blockNumber, so the user never observes
+	// a block number higher than the one subsequent "latest" requests are pinned to.
+	capBlockNumberResult bool
+}
+
+// getMinBlockNumberFromHTTPRequest returns the minimum block number set by the user through the HTTPHeaderMinBlockNumber header.
+// Returns 0 if the header is not set or its value is invalid.
+func getMinBlockNumberFromHTTPRequest(httpReq *http.Request) uint64 {
+	headerValue := strings.TrimSpace(httpReq.Header.Get(HTTPHeaderMinBlockNumber))
+	if headerValue == "" {
+		return 0
+	}
+
+	if blockNumber, ok := parseHexUint64(headerValue); ok {
+		return blockNumber
+	}
+
+	blockNumber, err := strconv.ParseUint(headerValue, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return blockNumber
+}
+
+// getPinnedBlockNumber returns the block number the block tags of a request should be pinned to:
+//   - The perceived block number minus the lag, i.e. a block most endpoints are expected to have.
+//   - Raised to the user's minimum block number, without exceeding the perceived block number.
+//
+// Returns false if the perceived block number is not yet known.
+func (ss *serviceState) getPinnedBlockNumber(lag, minBlockNumber uint64) (uint64, bool) {
+	ss.serviceStateLock.RLock()
+	defer ss.serviceStateLock.RUnlock()
+
+	if ss.perceivedBlockNumber == 0 {
+		return 0, false
+	}
+
+	var pinnedBlockNumber uint64
+	if ss.perceivedBlockNumber > lag {
+		pinnedBlockNumber = ss.perceivedBlockNumber - lag
+	}
+
+	if minBlockNumber > pinnedBlockNumber {
+		pinnedBlockNumber = min(minBlockNumber, ss.perceivedBlockNumber)
+	}
+
+	return pinnedBlockNumber, true
+}
+
+// filterEndpointsAtMinBlockNumber returns the subset of available endpoints whose
+// last observed block number is at or above the supplied minimum block number.
+func (ss *serviceState) filterEndpointsAtMinBlockNumber(
+	availableEndpoints protocol.EndpointAddrList,
+	minBlockNumber uint64,
+) protocol.EndpointAddrList {
+	ss.endpointStore.endpointsMu.RLock()
+	defer ss.endpointStore.endpointsMu.RUnlock()
+
+	var filteredEndpoints protocol.EndpointAddrList
+	for _, endpointAddr := range availableEndpoints {
+		endpoint, found := ss.endpointStore.endpoints[endpointAddr]
+		if !found {
+			continue
+		}
+
+		blockNumber, err := endpoint.checkBlockNumber.getBlockNumber()
+		if err != nil || blockNumber < minBlockNumber {
+			continue
+		}
+
+		filteredEndpoints = append(filteredEndpoints, endpointAddr)
+	}
+
+	return filteredEndpoints
+}
+
+// pinRequestBlockTags rewrites the pinnable block tags (e.g. "latest") of the request to the supplied block number.
+// Returns false if the request has no pinnable block tags, in which case it should be sent as-is.
+func pinRequestBlockTags(jsonrpcReq jsonrpc.Request, blockNumber uint64) (jsonrpc.Request, bool) {
+	paramsBz, err := json.Marshal(jsonrpcReq.Params)
+	if err != nil || len(paramsBz) == 0 {
+		return jsonrpcReq, false
+	}
+
+	var params []json.RawMessage
+	if err := json.Unmarshal(paramsBz, &params); err != nil {
+		return jsonrpcReq, false
+	}
+
+	pinnedBlockTag := json.RawMessage(strconv.Quote(fmt.Sprintf("0x%x", blockNumber)))
+
+	var pinned bool
+	switch paramIdx, found := blockParamIndexByMethod[jsonrpcReq.Method]; {
+	case jsonrpcReq.Method == methodGetLogs:
+		pinned = pinGetLogsFilterBlockTags(params, pinnedBlockTag)
+	case found:
+		if paramIdx < len(params) && isPinnableBlockTag(params[paramIdx]) {
+			params[paramIdx] = pinnedBlockTag
+			pinned = true
+		}
+	}
+
+	if !pinned {
+		return jsonrpcReq, false
+	}
+
+	pinnedParamsBz, err := json.Marshal(params)
+	if err != nil {
+		return jsonrpcReq, false
+	}
+
+	jsonrpcReq.SetParams(pinnedParamsBz)
+	return jsonrpcReq, true
+}
+
+// pinGetLogsFilterBlockTags rewrites the pinnable block tags of the `fromBlock`/`toBlock` fields of an `eth_getLogs` filter.
+func pinGetLogsFilterBlockTags(params []json.RawMessage, pinnedBlockTag json.RawMessage) bool {
+	if len(params) != 1 {
+		return false
+	}
+
+	var filter map[string]json.RawMessage
+	if err := json.Unmarshal(params[0], &filter); err != nil {
+		return false
+	}
+
+	var pinned bool
+	for _, field := range []string{"fromBlock", "toBlock"} {
+		if isPinnableBlockTag(filter[field]) {
+			filter[field] = pinnedBlockTag
+			pinned = true
+		}
+	}
+
+	if !pinned {
+		return false
+	}
+
+	filterBz, err := json.Marshal(filter)
+	if err != nil {
+		return false
+	}
+
+	params[0] = filterBz
+	return true
+}
+
+// isPinnableBlockTag returns true if the supplied block parameter is one of the pinnable block tags.
+func isPinnableBlockTag(blockParam json.RawMessage) bool {
+	var blockTag string
+	if err := json.Unmarshal(blockParam, &blockTag); err != nil {
+		return false
+	}
+
+	_, found := pinnableBlockTags[blockTag]
+	return found
+}
+
+// getPinnedBlockNumberHTTPResponse returns the endpoint's response to an `eth_blockNumber` request,
+// with the returned block number capped at the pinned block number.
+func (rc requestContext) getPinnedBlockNumberHTTPResponse() pathhttp.HTTPResponse {
+	httpResponse := rc.endpointResponses[0].GetHTTPResponse()
+
+	var jsonrpcResponse jsonrpc.Response
+	if err := json.Unmarshal(httpResponse.GetPayload(), &jsonrpcResponse); err != nil || jsonrpcResponse.IsError() {
+		return httpResponse
+	}
+
+	var blockNumberStr string
+	if err := jsonrpcResponse.UnmarshalResult(&blockNumberStr); err != nil {
+		return httpResponse
+	}
+
+	blockNumber, ok := parseHexUint64(blockNumberStr)
+	if !ok || blockNumber <= rc.blockTagPin.blockNumber {
+		return httpResponse
+	}
+
+	pinnedResult := json.RawMessage(strconv.Quote(fmt.Sprintf("0x%x", rc.blockTagPin.blockNumber)))
+	jsonrpcResponse.Result = &pinnedResult
+
+	return buildJSONRPCHTTPResponse(jsonrpcResponse, httpResponse.GetHTTPStatusCode())
+}
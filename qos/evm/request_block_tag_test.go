@@ -0,0 +1,248 @@
+package evm
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+func TestPinRequestBlockTags(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         jsonrpc.Method
+		params         string
+		expectPinned   bool
+		expectedParams string
+	}{
+		{
+			name:           "latest block tag is pinned",
+			method:         "eth_getBalance",
+			params:         `["0xdead","latest"]`,
+			expectPinned:   true,
+			expectedParams: `["0xdead","0x64"]`,
+		},
+		{
+			name:           "eth_getLogs filter block tags are pinned",
+			method:         methodGetLogs,
+			params:         `[{"fromBlock":"0x1","toBlock":"latest"}]`,
+			expectPinned:   true,
+			expectedParams: `[{"fromBlock":"0x1","toBlock":"0x64"}]`,
+		},
+		{
+			name:         "pending block tag is not pinned",
+			method:       "eth_getTransactionCount",
+			params:       `["0xdead","pending"]`,
+			expectPinned: false,
+		},
+		{
+			name:         "safe block tag is not pinned",
+			method:       "eth_getBlockByNumber",
+			params:       `["safe",false]`,
+			expectPinned: false,
+		},
+		{
+			name:         "explicit block number is not pinned",
+			method:       "eth_getStorageAt",
+			params:       `["0xdead","0x0","0x10"]`,
+			expectPinned: false,
+		},
+		{
+			name:         "finalized block tag is not pinned",
+			method:       "eth_call",
+			params:       `[{"to":"0xdead"},"finalized"]`,
+			expectPinned: false,
+		},
+		{
+			name:         "omitted block parameter is not pinned",
+			method:       "eth_call",
+			params:       `[{"to":"0xdead"}]`,
+			expectPinned: false,
+		},
+		{
+			name:         "method without a block parameter is not pinned",
+			method:       "eth_getTransactionByHash",
+			params:       `["latest"]`,
+			expectPinned: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := require.New(t)
+
+			jsonrpcReq := jsonrpc.Request{
+				ID:      jsonrpc.IDFromInt(1),
+				JSONRPC: jsonrpc.Version2,
+				Method:  test.method,
+			}
+			jsonrpcReq.SetParams([]byte(test.params))
+
+			pinnedReq, pinned := pinRequestBlockTags(jsonrpcReq, 100)
+			c.Equal(test.expectPinned, pinned)
+			if !test.expectPinned {
+				return
+			}
+
+			paramsBz, err := json.Marshal(pinnedReq.Params)
+			c.NoError(err)
+			c.JSONEq(test.expectedParams, string(paramsBz))
+			c.Equal(jsonrpcReq.ID, pinnedReq.ID)
+		})
+	}
+}
+
+func TestGetPinnedBlockNumber(t *testing.T) {
+	tests := []struct {
+		name                 string
+		perceivedBlockNumber uint64
+		lag                  uint64
+		minBlockNumber       uint64
+		expectOK             bool
+		expectedBlockNumber  uint64
+	}{
+		{
+			name:                 "perceived block number not yet known",
+			perceivedBlockNumber: 0,
+			lag:                  2,
+			expectOK:             false,
+		},
+		{
+			name:                 "perceived block number minus lag",
+			perceivedBlockNumber: 100,
+			lag:                  2,
+			expectOK:             true,
+			expectedBlockNumber:  98,
+		},
+		{
+			name:                 "raised to the user's minimum block number",
+			perceivedBlockNumber: 100,
+			lag:                  5,
+			minBlockNumber:       97,
+			expectOK:             true,
+			expectedBlockNumber:  97,
+		},
+		{
+			name:                 "user's minimum block number capped at the perceived block number",
+			perceivedBlockNumber: 100,
+			lag:                  5,
+			minBlockNumber:       150,
+			expectOK:             true,
+			expectedBlockNumber:  100,
+		},
+		{
+			name:                 "lag larger than the perceived block number",
+			perceivedBlockNumber: 3,
+			lag:                  5,
+			expectOK:             true,
+			expectedBlockNumber:  0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := require.New(t)
+
+			ss := &serviceState{perceivedBlockNumber: test.perceivedBlockNumber}
+			blockNumber, ok := ss.getPinnedBlockNumber(test.lag, test.minBlockNumber)
+			c.Equal(test.expectOK, ok)
+			c.Equal(test.expectedBlockNumber, blockNumber)
+		})
+	}
+}
+
+func TestGetMinBlockNumberFromHTTPRequest(t *testing.T) {
+	tests := []struct {
+		name                string
+		headerValue         string
+		expectedBlockNumber uint64
+	}{
+		{name: "header not set", headerValue: "", expectedBlockNumber: 0},
+		{name: "hex block number", headerValue: "0x64", expectedBlockNumber: 100},
+		{name: "decimal block number", headerValue: "100", expectedBlockNumber: 100},
+		{name: "invalid block number", headerValue: "latest", expectedBlockNumber: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			httpReq, err := http.NewRequest(http.MethodPost, "/", nil)
+			require.NoError(t, err)
+			if test.headerValue != "" {
+				httpReq.Header.Set(HTTPHeaderMinBlockNumber, test.headerValue)
+			}
+
+			require.Equal(t, test.expectedBlockNumber, getMinBlockNumberFromHTTPRequest(httpReq))
+		})
+	}
+}
+
+func TestRequestContext_FilterEndpointsAtMinBlockNumber(t *testing.T) {
+	blockNumber := func(n uint64) endpoint {
+		return endpoint{checkBlockNumber: endpointCheckBlockNumber{parsedBlockNumberResponse: &n}}
+	}
+	allEndpoints := protocol.EndpointAddrList{"endpoint_at_100", "endpoint_at_90", "endpoint_without_block_number"}
+	ss := &serviceState{
+		endpointStore: &endpointStore{
+			endpoints: map[protocol.EndpointAddr]endpoint{
+				"endpoint_at_100":               blockNumber(100),
+				"endpoint_at_90":                blockNumber(90),
+				"endpoint_without_block_number": {},
+			},
+		},
+	}
+
+	tests := []struct {
+		name              string
+		minBlockNumber    uint64
+		expectedEndpoints protocol.EndpointAddrList
+		expectErr         bool
+	}{
+		{
+			name:              "all endpoints are eligible without a minimum block number",
+			minBlockNumber:    0,
+			expectedEndpoints: allEndpoints,
+		},
+		{
+			name:              "only endpoints at or above the minimum block number are eligible",
+			minBlockNumber:    95,
+			expectedEndpoints: protocol.EndpointAddrList{"endpoint_at_100"},
+		},
+		{
+			name:           "error if no endpoint has reached the minimum block number",
+			minBlockNumber: 101,
+			expectErr:      true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := require.New(t)
+
+			rc := &requestContext{
+				logger:                 polyzero.NewLogger(),
+				serviceState:           ss,
+				servicePayloads:        map[jsonrpc.ID]protocol.Payload{jsonrpc.IDFromInt(1): {}},
+				minEndpointBlockNumber: test.minBlockNumber,
+			}
+
+			endpoints, err := rc.filterEndpointsAtMinBlockNumber(allEndpoints)
+			if !test.expectErr {
+				c.NoError(err)
+				c.Equal(test.expectedEndpoints, endpoints)
+				return
+			}
+
+			c.ErrorIs(err, errNoEndpointAtMinBlockNumber)
+
+			// The user is returned an error response, rather than possibly stale data.
+			httpResponse := rc.GetHTTPResponse()
+			c.Equal(http.StatusInternalServerError, httpResponse.GetHTTPStatusCode())
+			c.Contains(string(httpResponse.GetPayload()), errNoEndpointAtMinBlockNumber.Error())
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package evm
+
+import (
+	"encoding/json"
+
+	pathhttp "github.com/buildwithgrove/path/network/http"
+	"github.com/buildwithgrove/path/qos"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// methodSendRawTransaction is the JSON-RPC method for submitting a signed transaction.
+// Reference: https://ethereum.org/en/developers/docs/apis/json-rpc/#eth_sendrawtransaction
+const methodSendRawTransaction = jsonrpc.Method("eth_sendRawTransaction")
+
+// isTxBroadcastRequest returns true if the request is a single (i.e. non-batch) transaction submission.
+// Transaction submissions are broadcast to multiple endpoints, to maximize the chances of propagation.
+func isTxBroadcastRequest(jsonrpcReqs map[jsonrpc.ID]jsonrpc.Request, isBatch bool) bool {
+	if isBatch || len(jsonrpcReqs) != 1 {
+		return false
+	}
+
+	for _, jsonrpcReq := range jsonrpcReqs {
+		return jsonrpcReq.Method == methodSendRawTransaction
+	}
+	return false
+}
+
+// GetBroadcastNumEndpoints returns the number of endpoints the request should be broadcast to.
+// Returns 0 for requests which are not transaction submissions.
+// Implements the gateway.BroadcastQoSContext interface.
+func (rc *requestContext) GetBroadcastNumEndpoints() uint {
+	return rc.txBroadcastNumEndpoints
+}
+
+// HasSuccessfulBroadcastResponse returns true if any endpoint accepted the transaction.
+// Implements the gateway.BroadcastQoSContext interface.
+func (rc *requestContext) HasSuccessfulBroadcastResponse() bool {
+	for _, jsonrpcResponse := range rc.getEndpointJSONRPCResponses() {
+		if qos.IsSuccessfulTxBroadcastJSONRPCResponse(jsonrpcResponse) {
+			return true
+		}
+	}
+	return false
+}
+
+// getBroadcastHTTPResponse returns the user response for a transaction broadcast to multiple endpoints.
+// Responses are used in the following order of preference:
+//  1. A response containing the transaction hash.
+//  2. An error indicating the transaction was already submitted, e.g. "already known".
+//  3. The first received response.
+func (rc requestContext) getBroadcastHTTPResponse() pathhttp.HTTPResponse {
+	jsonrpcResponses := rc.getEndpointJSONRPCResponses()
+
+	for idx, jsonrpcResponse := range jsonrpcResponses {
+		if jsonrpcResponse.Error == nil && jsonrpcResponse.Result != nil {
+			return rc.endpointResponses[idx].GetHTTPResponse()
+		}
+	}
+
+	for idx, jsonrpcResponse := range jsonrpcResponses {
+		if qos.IsSuccessfulTxBroadcastJSONRPCResponse(jsonrpcResponse) {
+			return rc.endpointResponses[idx].GetHTTPResponse()
+		}
+	}
+
+	return rc.endpointResponses[0].GetHTTPResponse()
+}
+
+// getEndpointJSONRPCResponses returns the JSON-RPC responses received from the endpoints, e.g. for a broadcast transaction.
+// The returned slice is aligned with endpointResponses: unparsable responses are left as the zero value.
+func (rc requestContext) getEndpointJSONRPCResponses() []jsonrpc.Response {
+	jsonrpcResponses := make([]jsonrpc.Response, len(rc.endpointResponses))
+	for idx, endpointResp := range rc.endpointResponses {
+		if endpointResp.unmarshalErr != nil {
+			continue
+		}
+
+		// An unmarshaling error leaves the zero value, which is not a successful broadcast.
+		_ = json.Unmarshal(endpointResp.GetHTTPResponse().GetPayload(), &jsonrpcResponses[idx])
+	}
+	return jsonrpcResponses
+}
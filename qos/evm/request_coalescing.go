@@ -0,0 +1,66 @@
+package evm
+
+import (
+	"fmt"
+
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// defaultCoalescedMethods are the JSON-RPC methods eligible for request coalescing, unless configured otherwise.
+// Only read-only methods, whose results are identical for concurrent requests, are included.
+var defaultCoalescedMethods = map[string]struct{}{
+	string(methodBlockNumber):  {},
+	string(methodChainID):      {},
+	"eth_gasPrice":             {},
+	"eth_maxPriorityFeePerGas": {},
+	"eth_blobBaseFee":          {},
+	"net_version":              {},
+}
+
+// getCoalescingKey returns the coalescing key of the request, or an empty string if the request cannot be coalesced.
+//   - Only applies to single (i.e. non-batch) requests, with a method in the allowlist.
+//   - The user's minimum block number, if any, is part of the key: responses are only shared between requests with the same minimum.
+func (erv *evmRequestValidator) getCoalescingKey(
+	jsonrpcReqs map[jsonrpc.ID]jsonrpc.Request,
+	isBatch bool,
+	minEndpointBlockNumber uint64,
+) string {
+	if isBatch || len(jsonrpcReqs) != 1 {
+		return ""
+	}
+
+	for _, jsonrpcReq := range jsonrpcReqs {
+		coalescingKey, ok := qos.GetJSONRPCCoalescingKey(jsonrpcReq, erv.coalescedMethods)
+		if !ok {
+			return ""
+		}
+
+		if minEndpointBlockNumber > 0 {
+			coalescingKey = fmt.Sprintf("%s@%d", coalescingKey, minEndpointBlockNumber)
+		}
+		return coalescingKey
+	}
+	return ""
+}
+
+// GetCoalescingKey returns the key identifying identical requests.
+// Implements the gateway.CoalescableQoSContext interface.
+func (rc *requestContext) GetCoalescingKey() (string, bool) {
+	return rc.coalescingKey, rc.coalescingKey != ""
+}
+
+// UpdateWithCoalescedResponse reports the response to an identical request, with its JSON-RPC ID rewritten to match this request.
+// Implements the gateway.CoalescableQoSContext interface.
+func (rc *requestContext) UpdateWithCoalescedResponse(endpointAddr protocol.EndpointAddr, responseBz []byte) {
+	rewrittenResponseBz, err := qos.RewriteJSONRPCResponseID(responseBz, rc.getSingleRequestID())
+	if err != nil {
+		// Report the response as-is: the QoS context handles invalid endpoint responses.
+		rc.logger.Warn().Err(err).Msg("Failed to rewrite the JSON-RPC ID of a coalesced response.")
+		rc.UpdateWithResponse(endpointAddr, responseBz)
+		return
+	}
+
+	rc.UpdateWithResponse(endpointAddr, rewrittenResponseBz)
+}
@@ -0,0 +1,142 @@
+package evm
+
+import (
+	"net/http"
+
+	pathhttp "github.com/buildwithgrove/path/network/http"
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/qos"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// getConsensusReadConfig returns the consensus-read config for the request, or nil if the request is sent to a single endpoint.
+//   - Only applies to single (i.e. non-batch) requests.
+//   - The user's consensus-read header, if allowed for the service, takes precedence over the service's per-method config.
+func (erv *evmRequestValidator) getConsensusReadConfig(
+	httpReq *http.Request,
+	jsonrpcReqs map[jsonrpc.ID]jsonrpc.Request,
+	isBatch bool,
+) *qos.ConsensusReadConfig {
+	if isBatch || len(jsonrpcReqs) != 1 {
+		return nil
+	}
+
+	if erv.consensusReadHeaderEnabled {
+		if consensusRead := qos.GetConsensusReadConfigFromHTTPRequest(httpReq); consensusRead != nil {
+			return consensusRead
+		}
+	}
+
+	for _, jsonrpcReq := range jsonrpcReqs {
+		return erv.consensusReadMethods[string(jsonrpcReq.Method)]
+	}
+	return nil
+}
+
+// GetConsensusNumEndpoints returns the number of endpoints the request should be sent to.
+// Returns 0 for requests which are not in consensus-read mode.
+// Implements the gateway.ConsensusQoSContext interface.
+func (rc *requestContext) GetConsensusNumEndpoints() uint {
+	if rc.consensusRead == nil {
+		return 0
+	}
+	return rc.consensusRead.NumEndpoints
+}
+
+// HasConsensusResponse returns true if a quorum of the endpoints returned the same result.
+// Implements the gateway.ConsensusQoSContext interface.
+func (rc *requestContext) HasConsensusResponse() bool {
+	if rc.consensusRead == nil {
+		return false
+	}
+	return rc.consensusRead.FindJSONRPCConsensus(rc.getConsensusEndpointResponses()).QuorumReached
+}
+
+// getConsensusHTTPResponse returns the user response for a consensus-read:
+//   - The majority result, if it was returned by at least a quorum of the endpoints.
+//   - The majority error, if more endpoints returned the same error than any single result.
+//   - An error response otherwise.
+func (rc requestContext) getConsensusHTTPResponse() pathhttp.HTTPResponse {
+	consensusResponses := rc.getConsensusEndpointResponses()
+	result := rc.consensusRead.FindJSONRPCConsensus(consensusResponses)
+
+	if result.MajorityIsError {
+		return buildJSONRPCHTTPResponse(*result.MajorityResponse, result.MajorityResponse.GetRecommendedHTTPStatusCode())
+	}
+
+	if !result.QuorumReached {
+		consensusErr := rc.consensusRead.GetConsensusNotReachedError(len(consensusResponses), result)
+		rc.logger.Warn().Err(consensusErr).Msg("Consensus-read failed: returning an error response.")
+
+		errResponse := jsonrpc.NewErrResponseInternalErr(rc.getSingleRequestID(), consensusErr)
+		return buildJSONRPCHTTPResponse(errResponse, errResponse.GetRecommendedHTTPStatusCode())
+	}
+
+	return buildJSONRPCHTTPResponse(*result.MajorityResponse, result.MajorityResponse.GetRecommendedHTTPStatusCode())
+}
+
+// getConsensusObservations returns the observation of the consensus-read outcome, and the request error if the quorum was not reached.
+//   - Returns nil values if the request is not a consensus-read, or no endpoint responses were received.
+//   - A majority error is not a request error: the endpoints' error is returned to the user, and no endpoint is flagged.
+func (rc requestContext) getConsensusObservations() (*qosobservations.ConsensusReadObservation, *qosobservations.RequestError, map[string]struct{}) {
+	if rc.consensusRead == nil || len(rc.endpointResponses) == 0 {
+		return nil, nil, nil
+	}
+
+	consensusResponses := rc.getConsensusEndpointResponses()
+	result := rc.consensusRead.FindJSONRPCConsensus(consensusResponses)
+	observation := rc.consensusRead.GetObservation(len(consensusResponses), result)
+
+	disagreeingEndpointAddrs := make(map[string]struct{}, len(observation.DisagreeingEndpointAddrs))
+	for _, endpointAddr := range observation.DisagreeingEndpointAddrs {
+		disagreeingEndpointAddrs[endpointAddr] = struct{}{}
+	}
+
+	if !result.QuorumReached && !result.MajorityIsError {
+		consensusErr := rc.consensusRead.GetConsensusNotReachedError(len(consensusResponses), result)
+		return observation, qos.GetRequestErrorForConsensusNotReached(consensusErr), disagreeingEndpointAddrs
+	}
+
+	return observation, nil, disagreeingEndpointAddrs
+}
+
+// getConsensusEndpointResponses returns the parsed JSON-RPC responses received from the endpoints.
+// Responses which failed to parse are excluded: they cannot count towards the quorum.
+func (rc requestContext) getConsensusEndpointResponses() []qos.ConsensusEndpointResponse {
+	jsonrpcResponses := rc.getEndpointJSONRPCResponses()
+
+	consensusResponses := make([]qos.ConsensusEndpointResponse, 0, len(jsonrpcResponses))
+	for idx, endpointResp := range rc.endpointResponses {
+		if endpointResp.unmarshalErr != nil {
+			continue
+		}
+
+		consensusResponses = append(consensusResponses, qos.ConsensusEndpointResponse{
+			EndpointAddr: endpointResp.EndpointAddr,
+			Response:     jsonrpcResponses[idx],
+		})
+	}
+	return consensusResponses
+}
+
+// getSingleRequestID returns the JSON-RPC ID of a single (i.e. non-batch) request.
+func (rc requestContext) getSingleRequestID() jsonrpc.ID {
+	for id := range rc.servicePayloads {
+		return id
+	}
+	return jsonrpc.ID{}
+}
+
+// markConsensusDisagreement updates the observation of an endpoint whose response disagreed with the consensus-read majority.
+// The validation error results in the endpoint being filtered out, similar to an invalid response.
+func markConsensusDisagreement(endpointObs *qosobservations.EVMEndpointObservation, httpStatusCode int) {
+	validationError := qosobservations.EVMResponseValidationError_EVM_RESPONSE_VALIDATION_ERROR_CONSENSUS_DISAGREEMENT
+
+	endpointObs.ResponseObservation = &qosobservations.EVMEndpointObservation_UnrecognizedResponse{
+		UnrecognizedResponse: &qosobservations.EVMUnrecognizedResponse{
+			HttpStatusCode:          int32(httpStatusCode),
+			JsonrpcResponse:         endpointObs.GetParsedJsonrpcResponse(),
+			ResponseValidationError: &validationError,
+		},
+	}
+}
@@ -0,0 +1,306 @@
+package evm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// methodGetLogs is the JSON-RPC method for getting the logs matching a filter.
+// Reference: https://ethereum.org/en/developers/docs/apis/json-rpc/#eth_getlogs
+const methodGetLogs = jsonrpc.Method("eth_getLogs")
+
+// maxGetLogsChunks is the maximum number of chunks a single `eth_getLogs` request can be split into.
+// Requests spanning more chunks are sent as-is, to avoid fanning out an unbounded number of relays.
+const maxGetLogsChunks = 10
+
+// getLogsChunkIDPrefix is the prefix of the JSON-RPC IDs assigned to `eth_getLogs` chunk requests.
+const getLogsChunkIDPrefix = "eth_getLogs_chunk_"
+
+// getLogsSplit tracks an `eth_getLogs` request which was split into multiple block range chunks.
+//   - Each chunk is sent as a separate service payload, with its own JSON-RPC ID.
+//   - The chunks' results are merged into a single response, using the original request's ID.
+type getLogsSplit struct {
+	// originalID is the JSON-RPC ID of the user's `eth_getLogs` request.
+	originalID jsonrpc.ID
+
+	// chunkIDs holds the JSON-RPC IDs of the chunk requests, in block range order.
+	chunkIDs []jsonrpc.ID
+}
+
+// getLogsLog captures the fields of a log entry used for ordering and de-duplicating merged results.
+// Reference: https://ethereum.org/en/developers/docs/apis/json-rpc/#eth_getfilterchanges
+type getLogsLog struct {
+	BlockNumber     string `json:"blockNumber"`
+	BlockHash       string `json:"blockHash"`
+	TransactionHash string `json:"transactionHash"`
+	LogIndex        string `json:"logIndex"`
+}
+
+// splitGetLogsRequest splits an `eth_getLogs` request into block range chunks of at most chunkSize blocks.
+// Returns false if the request should be sent as-is, e.g.:
+//   - Splitting is disabled, i.e. chunkSize is 0.
+//   - The filter uses a `blockHash`, or a block tag (e.g. "latest") for `fromBlock`/`toBlock`.
+//   - The block range fits in a single chunk, or spans more than maxGetLogsChunks chunks.
+func splitGetLogsRequest(
+	jsonrpcReq jsonrpc.Request,
+	chunkSize uint64,
+) (*getLogsSplit, map[jsonrpc.ID]protocol.Payload, bool) {
+	if chunkSize == 0 || jsonrpcReq.Method != methodGetLogs {
+		return nil, nil, false
+	}
+
+	paramsBz, err := json.Marshal(jsonrpcReq.Params)
+	if err != nil {
+		return nil, nil, false
+	}
+
+	var params []map[string]json.RawMessage
+	if err := json.Unmarshal(paramsBz, &params); err != nil || len(params) != 1 {
+		return nil, nil, false
+	}
+	filter := params[0]
+
+	// A blockHash filter targets a single block: nothing to split.
+	if _, found := filter["blockHash"]; found {
+		return nil, nil, false
+	}
+
+	fromBlock, ok := parseGetLogsBlockNumber(filter["fromBlock"])
+	if !ok {
+		return nil, nil, false
+	}
+	toBlock, ok := parseGetLogsBlockNumber(filter["toBlock"])
+	if !ok || toBlock < fromBlock {
+		return nil, nil, false
+	}
+
+	numChunks := (toBlock-fromBlock)/chunkSize + 1
+	if numChunks <= 1 || numChunks > maxGetLogsChunks {
+		return nil, nil, false
+	}
+
+	split := &getLogsSplit{originalID: jsonrpcReq.ID}
+	payloads := make(map[jsonrpc.ID]protocol.Payload, numChunks)
+
+	for chunkIdx := uint64(0); chunkIdx < numChunks; chunkIdx++ {
+		chunkFrom := fromBlock + chunkIdx*chunkSize
+		chunkTo := min(chunkFrom+chunkSize-1, toBlock)
+
+		payload, chunkID, err := buildGetLogsChunkPayload(jsonrpcReq, filter, chunkIdx, chunkFrom, chunkTo)
+		if err != nil {
+			return nil, nil, false
+		}
+
+		split.chunkIDs = append(split.chunkIDs, chunkID)
+		payloads[chunkID] = payload
+	}
+
+	return split, payloads, true
+}
+
+// buildGetLogsChunkPayload builds the service payload of a single `eth_getLogs` chunk.
+// The chunk uses the original filter, with the block range replaced by the chunk's range.
+func buildGetLogsChunkPayload(
+	jsonrpcReq jsonrpc.Request,
+	filter map[string]json.RawMessage,
+	chunkIdx, chunkFrom, chunkTo uint64,
+) (protocol.Payload, jsonrpc.ID, error) {
+	chunkFilter := make(map[string]json.RawMessage, len(filter))
+	for key, value := range filter {
+		chunkFilter[key] = value
+	}
+
+	chunkFilter["fromBlock"] = json.RawMessage(strconv.Quote(fmt.Sprintf("0x%x", chunkFrom)))
+	chunkFilter["toBlock"] = json.RawMessage(strconv.Quote(fmt.Sprintf("0x%x", chunkTo)))
+
+	paramsBz, err := json.Marshal([]map[string]json.RawMessage{chunkFilter})
+	if err != nil {
+		return protocol.Payload{}, jsonrpc.ID{}, err
+	}
+
+	chunkID := jsonrpc.IDFromStr(fmt.Sprintf("%s%d", getLogsChunkIDPrefix, chunkIdx))
+	chunkReq := jsonrpc.Request{
+		ID:      chunkID,
+		JSONRPC: jsonrpcReq.JSONRPC,
+		Method:  jsonrpcReq.Method,
+	}
+	chunkReq.SetParams(paramsBz)
+
+	payload, err := chunkReq.BuildPayload()
+	if err != nil {
+		return protocol.Payload{}, jsonrpc.ID{}, err
+	}
+
+	return payload, chunkID, nil
+}
+
+// parseGetLogsBlockNumber parses a `fromBlock`/`toBlock` filter field.
+// Only explicit hex block numbers are accepted: block tags (e.g. "latest") return false.
+func parseGetLogsBlockNumber(field json.RawMessage) (uint64, bool) {
+	if len(field) == 0 {
+		return 0, false
+	}
+
+	var blockNumberStr string
+	if err := json.Unmarshal(field, &blockNumberStr); err != nil {
+		return 0, false
+	}
+
+	return parseHexUint64(blockNumberStr)
+}
+
+// parseHexUint64 parses a "0x"-prefixed hex string, e.g. a block number.
+func parseHexUint64(hexStr string) (uint64, bool) {
+	if !strings.HasPrefix(hexStr, "0x") && !strings.HasPrefix(hexStr, "0X") {
+		return 0, false
+	}
+
+	value, err := strconv.ParseUint(hexStr[2:], 16, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return value, true
+}
+
+// buildHTTPResponse merges the chunks' responses into a single response to the original `eth_getLogs` request.
+//   - Any missing chunk response fails the whole request: partial logs must not be returned to the user.
+//   - A JSON-RPC error returned for any chunk is returned to the user, using the original request's ID.
+//   - Logs from all chunks are de-duplicated, and ordered by block number and log index.
+func (s *getLogsSplit) buildHTTPResponse(chunkResponses map[string]jsonrpc.Response) jsonrpc.HTTPResponse {
+	chunkResults := make([][]json.RawMessage, 0, len(s.chunkIDs))
+
+	for _, chunkID := range s.chunkIDs {
+		chunkResponse, found := chunkResponses[chunkID.String()]
+		if !found {
+			return buildJSONRPCHTTPResponse(
+				jsonrpc.NewErrResponseNoEndpointResponse(s.originalID),
+				jsonrpc.HTTPStatusResponseValidationFailureNoResponse,
+			)
+		}
+
+		if chunkResponse.Error != nil {
+			errResponse := jsonrpc.Response{
+				ID:      s.originalID,
+				Version: jsonrpc.Version2,
+				Error:   chunkResponse.Error,
+			}
+			return buildJSONRPCHTTPResponse(errResponse, errResponse.GetRecommendedHTTPStatusCode())
+		}
+
+		var logs []json.RawMessage
+		if err := chunkResponse.UnmarshalResult(&logs); err != nil {
+			return buildJSONRPCHTTPResponse(
+				jsonrpc.NewErrResponseInternalErr(s.originalID, fmt.Errorf("failed to parse eth_getLogs chunk result: %w", err)),
+				http.StatusInternalServerError,
+			)
+		}
+		chunkResults = append(chunkResults, logs)
+	}
+
+	mergedLogs, err := mergeGetLogsResults(chunkResults)
+	if err != nil {
+		return buildJSONRPCHTTPResponse(
+			jsonrpc.NewErrResponseInternalErr(s.originalID, err),
+			http.StatusInternalServerError,
+		)
+	}
+
+	resultBz, err := json.Marshal(mergedLogs)
+	if err != nil {
+		return buildJSONRPCHTTPResponse(
+			jsonrpc.NewErrResponseInternalErr(s.originalID, err),
+			http.StatusInternalServerError,
+		)
+	}
+
+	result := json.RawMessage(resultBz)
+	return buildJSONRPCHTTPResponse(
+		jsonrpc.Response{
+			ID:      s.originalID,
+			Version: jsonrpc.Version2,
+			Result:  &result,
+		},
+		http.StatusOK,
+	)
+}
+
+// mergeGetLogsResults merges the logs returned for multiple block range chunks.
+// Logs are de-duplicated by block hash (or block number) and log index, and ordered by block number and log index.
+func mergeGetLogsResults(chunkResults [][]json.RawMessage) ([]json.RawMessage, error) {
+	type sortableLog struct {
+		blockNumber uint64
+		logIndex    uint64
+		raw         json.RawMessage
+	}
+
+	seen := make(map[string]struct{})
+	// Initialize as empty, to return `[]` rather than `null` if no logs were found.
+	logs := make([]sortableLog, 0)
+
+	for _, chunkLogs := range chunkResults {
+		for _, rawLog := range chunkLogs {
+			var log getLogsLog
+			if err := json.Unmarshal(rawLog, &log); err != nil {
+				return nil, fmt.Errorf("failed to parse eth_getLogs log entry: %w", err)
+			}
+
+			blockNumber, ok := parseHexUint64(log.BlockNumber)
+			if !ok {
+				return nil, fmt.Errorf("invalid eth_getLogs log entry block number: %q", log.BlockNumber)
+			}
+			logIndex, ok := parseHexUint64(log.LogIndex)
+			if !ok {
+				return nil, fmt.Errorf("invalid eth_getLogs log entry log index: %q", log.LogIndex)
+			}
+
+			blockKey := log.BlockHash
+			if blockKey == "" {
+				blockKey = log.BlockNumber
+			}
+			dedupKey := fmt.Sprintf("%s-%d", strings.ToLower(blockKey), logIndex)
+			if _, found := seen[dedupKey]; found {
+				continue
+			}
+			seen[dedupKey] = struct{}{}
+
+			logs = append(logs, sortableLog{
+				blockNumber: blockNumber,
+				logIndex:    logIndex,
+				raw:         rawLog,
+			})
+		}
+	}
+
+	sort.SliceStable(logs, func(i, j int) bool {
+		if logs[i].blockNumber != logs[j].blockNumber {
+			return logs[i].blockNumber < logs[j].blockNumber
+		}
+		return logs[i].logIndex < logs[j].logIndex
+	})
+
+	mergedLogs := make([]json.RawMessage, 0, len(logs))
+	for _, log := range logs {
+		mergedLogs = append(mergedLogs, log.raw)
+	}
+
+	return mergedLogs, nil
+}
+
+// buildJSONRPCHTTPResponse marshals the supplied JSON-RPC response into an HTTP response.
+func buildJSONRPCHTTPResponse(jsonrpcResponse jsonrpc.Response, httpStatusCode int) jsonrpc.HTTPResponse {
+	// Marshaling a JSON-RPC response struct should never fail.
+	responseBz, _ := json.Marshal(jsonrpcResponse)
+
+	return jsonrpc.HTTPResponse{
+		ResponsePayload: responseBz,
+		HTTPStatusCode:  httpStatusCode,
+	}
+}
@@ -0,0 +1,182 @@
+package evm
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+func TestSplitGetLogsRequest(t *testing.T) {
+	tests := []struct {
+		name           string
+		params         string
+		chunkSize      uint64
+		expectSplit    bool
+		expectedRanges [][2]string
+	}{
+		{
+			name:        "splitting disabled",
+			params:      `[{"fromBlock":"0x1","toBlock":"0x64"}]`,
+			chunkSize:   0,
+			expectSplit: false,
+		},
+		{
+			name:        "block range fits in a single chunk",
+			params:      `[{"fromBlock":"0x1","toBlock":"0xa"}]`,
+			chunkSize:   10,
+			expectSplit: false,
+		},
+		{
+			name:        "block tag is not split",
+			params:      `[{"fromBlock":"0x1","toBlock":"latest"}]`,
+			chunkSize:   10,
+			expectSplit: false,
+		},
+		{
+			name:        "blockHash filter is not split",
+			params:      `[{"blockHash":"0xabc"}]`,
+			chunkSize:   10,
+			expectSplit: false,
+		},
+		{
+			name:        "too many chunks",
+			params:      `[{"fromBlock":"0x1","toBlock":"0x3e8"}]`,
+			chunkSize:   10,
+			expectSplit: false,
+		},
+		{
+			name:        "block range split into chunks",
+			params:      `[{"fromBlock":"0x1","toBlock":"0x19","address":"0xdead"}]`,
+			chunkSize:   10,
+			expectSplit: true,
+			expectedRanges: [][2]string{
+				{"0x1", "0xa"},
+				{"0xb", "0x14"},
+				{"0x15", "0x19"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := jsonrpc.Request{
+				ID:      jsonrpc.IDFromInt(7),
+				JSONRPC: jsonrpc.Version2,
+				Method:  methodGetLogs,
+			}
+			req.SetParams([]byte(tt.params))
+
+			split, payloads, ok := splitGetLogsRequest(req, tt.chunkSize)
+			require.Equal(t, tt.expectSplit, ok)
+			if !tt.expectSplit {
+				return
+			}
+
+			require.True(t, split.originalID.Equal(jsonrpc.IDFromInt(7)))
+			require.Len(t, split.chunkIDs, len(tt.expectedRanges))
+			require.Len(t, payloads, len(tt.expectedRanges))
+
+			for i, chunkID := range split.chunkIDs {
+				chunkReq, err := jsonrpc.GetJsonRpcReqFromServicePayload(payloads[chunkID])
+				require.NoError(t, err)
+				require.Equal(t, methodGetLogs, chunkReq.Method)
+
+				paramsBz, err := json.Marshal(chunkReq.Params)
+				require.NoError(t, err)
+
+				var params []map[string]string
+				require.NoError(t, json.Unmarshal(paramsBz, &params))
+				require.Len(t, params, 1)
+				require.Equal(t, tt.expectedRanges[i][0], params[0]["fromBlock"])
+				require.Equal(t, tt.expectedRanges[i][1], params[0]["toBlock"])
+				require.Equal(t, "0xdead", params[0]["address"])
+			}
+		})
+	}
+}
+
+func TestGetLogsSplit_BuildHTTPResponse(t *testing.T) {
+	split := &getLogsSplit{
+		originalID: jsonrpc.IDFromInt(1),
+		chunkIDs: []jsonrpc.ID{
+			jsonrpc.IDFromStr(getLogsChunkIDPrefix + "0"),
+			jsonrpc.IDFromStr(getLogsChunkIDPrefix + "1"),
+		},
+	}
+
+	buildResult := func(result string) *json.RawMessage {
+		raw := json.RawMessage(result)
+		return &raw
+	}
+
+	t.Run("logs are merged, de-duplicated and ordered", func(t *testing.T) {
+		chunkResponses := map[string]jsonrpc.Response{
+			getLogsChunkIDPrefix + "0": {
+				ID:      split.chunkIDs[0],
+				Version: jsonrpc.Version2,
+				Result:  buildResult(`[{"blockNumber":"0x2","blockHash":"0xb2","logIndex":"0x1"},{"blockNumber":"0x1","blockHash":"0xb1","logIndex":"0x0"}]`),
+			},
+			getLogsChunkIDPrefix + "1": {
+				ID:      split.chunkIDs[1],
+				Version: jsonrpc.Version2,
+				Result:  buildResult(`[{"blockNumber":"0x2","blockHash":"0xb2","logIndex":"0x1"},{"blockNumber":"0x2","blockHash":"0xb2","logIndex":"0x0"}]`),
+			},
+		}
+
+		httpResponse := split.buildHTTPResponse(chunkResponses)
+		require.Equal(t, http.StatusOK, httpResponse.GetHTTPStatusCode())
+
+		var response jsonrpc.Response
+		require.NoError(t, json.Unmarshal(httpResponse.GetPayload(), &response))
+		require.True(t, response.ID.Equal(jsonrpc.IDFromInt(1)))
+
+		var logs []getLogsLog
+		require.NoError(t, response.UnmarshalResult(&logs))
+		require.Equal(t, []getLogsLog{
+			{BlockNumber: "0x1", BlockHash: "0xb1", LogIndex: "0x0"},
+			{BlockNumber: "0x2", BlockHash: "0xb2", LogIndex: "0x0"},
+			{BlockNumber: "0x2", BlockHash: "0xb2", LogIndex: "0x1"},
+		}, logs)
+	})
+
+	t.Run("missing chunk response fails the request", func(t *testing.T) {
+		chunkResponses := map[string]jsonrpc.Response{
+			getLogsChunkIDPrefix + "0": {
+				ID:      split.chunkIDs[0],
+				Version: jsonrpc.Version2,
+				Result:  buildResult(`[]`),
+			},
+		}
+
+		httpResponse := split.buildHTTPResponse(chunkResponses)
+		require.Equal(t, http.StatusInternalServerError, httpResponse.GetHTTPStatusCode())
+
+		var response jsonrpc.Response
+		require.NoError(t, json.Unmarshal(httpResponse.GetPayload(), &response))
+		require.True(t, response.ID.Equal(jsonrpc.IDFromInt(1)))
+		require.NotNil(t, response.Error)
+	})
+
+	t.Run("chunk error is returned with the original ID", func(t *testing.T) {
+		chunkResponses := map[string]jsonrpc.Response{
+			getLogsChunkIDPrefix + "0": {
+				ID:      split.chunkIDs[0],
+				Version: jsonrpc.Version2,
+				Result:  buildResult(`[]`),
+			},
+			getLogsChunkIDPrefix + "1": jsonrpc.GetErrorResponse(split.chunkIDs[1], -32005, "query returned more than 10000 results", nil),
+		}
+
+		httpResponse := split.buildHTTPResponse(chunkResponses)
+
+		var response jsonrpc.Response
+		require.NoError(t, json.Unmarshal(httpResponse.GetPayload(), &response))
+		require.True(t, response.ID.Equal(jsonrpc.IDFromInt(1)))
+		require.NotNil(t, response.Error)
+		require.Equal(t, -32005, response.Error.Code)
+	})
+}
@@ -1,14 +1,18 @@
 package evm
 
 import (
-	"io"
+	"errors"
+	"maps"
 	"net/http"
+	"slices"
+	"time"
 
 	"github.com/pokt-network/poktroll/pkg/polylog"
 
 	"github.com/buildwithgrove/path/gateway"
 	qosobservations "github.com/buildwithgrove/path/observation/qos"
 	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos"
 	"github.com/buildwithgrove/path/qos/jsonrpc"
 )
 
@@ -27,6 +31,37 @@ type evmRequestValidator struct {
 	chainID      string
 	serviceID    protocol.ServiceID
 	serviceState *serviceState
+
+	// getLogsBlockRangeChunkSize is the maximum block range of a single `eth_getLogs` chunk.
+	// Splitting of `eth_getLogs` requests is disabled if set to 0.
+	getLogsBlockRangeChunkSize uint64
+
+	// consensusReadMethods maps JSON-RPC methods to their consensus-read config.
+	consensusReadMethods map[string]*qos.ConsensusReadConfig
+
+	// consensusReadHeaderEnabled is set if users can opt into consensus-read mode using the qos.HTTPHeaderConsensusRead header.
+	consensusReadHeaderEnabled bool
+
+	// blockTagPinningEnabled is set if block tags should be pinned to a concrete block number.
+	blockTagPinningEnabled bool
+
+	// blockTagPinningLag is the number of blocks below the perceived block number block tags are pinned to.
+	blockTagPinningLag uint64
+
+	// coalescedMethods is the allowlist of JSON-RPC methods eligible for request coalescing.
+	coalescedMethods map[string]struct{}
+
+	// requestLimits bounds the size of the JSON-RPC requests accepted by the service.
+	requestLimits jsonrpc.RequestLimits
+
+	// methodTimeouts maps JSON-RPC methods to their relay timeout.
+	methodTimeouts map[string]time.Duration
+
+	// txBroadcastNumEndpoints is the number of endpoints a transaction submission is broadcast to.
+	txBroadcastNumEndpoints uint
+
+	// batchNumEndpoints is the maximum number of endpoints the members of a batch request are distributed across.
+	batchNumEndpoints uint
 }
 
 // validateHTTPRequest validates an HTTP request, extracting and validating its EVM JSONRPC payload.
@@ -39,38 +74,129 @@ func (erv *evmRequestValidator) validateHTTPRequest(req *http.Request) (gateway.
 	)
 
 	// Read the HTTP request body
-	body, err := io.ReadAll(req.Body)
+	body, err := erv.requestLimits.ReadRequestBody(req.Body)
+	if errors.Is(err, jsonrpc.ErrRequestBodyTooLarge) {
+		logger.Info().Err(err).Msg("Rejecting request: request body too large")
+		return erv.createRequestUnmarshalingFailureContext(jsonrpc.ID{}, err), false
+	}
 	if err != nil {
 		logger.Warn().Err(err).Msg("HTTP request body read failed - returning generic error response")
 		return erv.createHTTPBodyReadFailureContext(err), false
 	}
 
 	// Parse and validate the JSONRPC request(s) - handles both single and batch requests
-	jsonrpcReqs, isBatch, err := jsonrpc.ParseJSONRPCFromRequestBody(logger, body)
+	jsonrpcReqs, jsonrpcBatchRequest, isBatch, err := jsonrpc.ParseOrderedJSONRPCFromRequestBody(logger, body)
 	if err != nil {
 		// If no requests parsed or empty ID, requestID will be zero value (empty)
 		return erv.createRequestUnmarshalingFailureContext(jsonrpc.ID{}, err), false
 	}
 
+	// Reject batch requests exceeding the service's maximum batch size.
+	if isBatch {
+		if err := erv.requestLimits.ValidateBatchSize(len(jsonrpcBatchRequest.Requests)); err != nil {
+			return erv.createRequestUnmarshalingFailureContext(jsonrpc.ID{}, err), false
+		}
+	}
+
 	// TODO_MVP(@adshmh): Add JSON-RPC request validation to block invalid requests
 	// TODO_IMPROVE(@adshmh): Add method-specific JSONRPC request validation
 
+	// Pin block tags (e.g. "latest") to a concrete block number, if enabled for the service.
+	// Done before any splitting, so pinned `eth_getLogs` block ranges can be split.
+	minEndpointBlockNumber, pin := erv.pinBlockTags(req, jsonrpcReqs, isBatch)
+
 	servicePayloads := erv.buildServicePayloads(jsonrpcReqs)
 
+	// Split large `eth_getLogs` block ranges into chunks, if enabled for the service.
+	// Only applies to single (i.e. non-batch) requests.
+	var logsSplit *getLogsSplit
+	if !isBatch && len(jsonrpcReqs) == 1 {
+		for _, jsonrpcReq := range jsonrpcReqs {
+			if split, chunkPayloads, ok := splitGetLogsRequest(jsonrpcReq, erv.getLogsBlockRangeChunkSize); ok {
+				logger.Debug().Msgf("Split eth_getLogs request into %d block range chunks", len(chunkPayloads))
+				logsSplit = split
+				servicePayloads = chunkPayloads
+			}
+		}
+	}
+
+	isTxBroadcast := isTxBroadcastRequest(jsonrpcReqs, isBatch)
+	var txBroadcastNumEndpoints uint
+	if isTxBroadcast {
+		txBroadcastNumEndpoints = erv.txBroadcastNumEndpoints
+	}
+
+	// Consensus-read mode: only applies to single (i.e. non-batch) requests sent as-is.
+	var consensusRead *qos.ConsensusReadConfig
+	if logsSplit == nil && !isTxBroadcast {
+		consensusRead = erv.getConsensusReadConfig(req, jsonrpcReqs, isBatch)
+	}
+
+	// Request coalescing: only applies to single requests sent as-is to a single endpoint.
+	var coalescingKey string
+	if logsSplit == nil && !isTxBroadcast && consensusRead == nil {
+		coalescingKey = erv.getCoalescingKey(jsonrpcReqs, isBatch, minEndpointBlockNumber)
+	}
+
 	// Request is valid, return a fully initialized requestContext
 	return &requestContext{
-		logger:               erv.logger,
-		chainID:              erv.chainID,
-		serviceID:            erv.serviceID,
-		requestPayloadLength: uint(len(body)),
-		servicePayloads:      servicePayloads,
-		isBatch:              isBatch,
-		serviceState:         erv.serviceState,
+		logger:                  erv.logger,
+		chainID:                 erv.chainID,
+		serviceID:               erv.serviceID,
+		requestPayloadLength:    uint(len(body)),
+		servicePayloads:         servicePayloads,
+		isBatch:                 isBatch,
+		jsonrpcBatchRequest:     jsonrpcBatchRequest,
+		getLogsSplit:            logsSplit,
+		isTxBroadcast:           isTxBroadcast,
+		txBroadcastNumEndpoints: txBroadcastNumEndpoints,
+		batchNumEndpoints:       erv.batchNumEndpoints,
+		consensusRead:           consensusRead,
+		blockTagPin:             pin,
+		minEndpointBlockNumber:  minEndpointBlockNumber,
+		requiresArchival:        erv.serviceState.requiresArchivalData(jsonrpcReqs),
+		coalescingKey:           coalescingKey,
+		relayTimeout:            qos.GetJSONRPCRelayTimeout(erv.methodTimeouts, slices.Collect(maps.Values(jsonrpcReqs))...),
+		serviceState:            erv.serviceState,
 		// Set the origin of the request as ORGANIC (i.e. from a user).
 		requestOrigin: qosobservations.RequestOrigin_REQUEST_ORIGIN_ORGANIC,
 	}, true
 }
 
+// pinBlockTags rewrites the pinnable block tags of the JSON-RPC requests, in place, if block-tag pinning is enabled.
+// It returns:
+//   - The minimum block number of endpoints eligible to serve the request, or 0 if there is no minimum.
+//   - The block tag pin, or nil if block tags were not pinned.
+func (erv *evmRequestValidator) pinBlockTags(
+	httpReq *http.Request,
+	jsonrpcReqs map[jsonrpc.ID]jsonrpc.Request,
+	isBatch bool,
+) (uint64, *blockTagPin) {
+	minBlockNumber := getMinBlockNumberFromHTTPRequest(httpReq)
+	if !erv.blockTagPinningEnabled {
+		return minBlockNumber, nil
+	}
+
+	pinnedBlockNumber, ok := erv.serviceState.getPinnedBlockNumber(erv.blockTagPinningLag, minBlockNumber)
+	if !ok {
+		return minBlockNumber, nil
+	}
+
+	pin := &blockTagPin{blockNumber: pinnedBlockNumber}
+	for reqID, jsonrpcReq := range jsonrpcReqs {
+		if jsonrpcReq.Method == methodBlockNumber && !isBatch {
+			pin.capBlockNumberResult = true
+			continue
+		}
+
+		if pinnedReq, pinned := pinRequestBlockTags(jsonrpcReq, pinnedBlockNumber); pinned {
+			jsonrpcReqs[reqID] = pinnedReq
+		}
+	}
+
+	return pinnedBlockNumber, pin
+}
+
 func (erv *evmRequestValidator) buildServicePayloads(
 	jsonrpcReqs map[jsonrpc.ID]jsonrpc.Request,
 ) map[jsonrpc.ID]protocol.Payload {
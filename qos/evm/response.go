@@ -28,12 +28,19 @@ var (
 	_ response = &responseToChainID{}
 	_ response = &responseToBlockNumber{}
 	_ response = &responseToGetBalance{}
+	_ response = &responseToGetBlockByNumber{}
+	_ response = &responseToSyncing{}
+	_ response = &responseToPeerCount{}
+	_ response = &responseToArchivalProbe{}
 	_ response = &responseGeneric{}
 
 	methodResponseMappings = map[jsonrpc.Method]responseUnmarshaller{
-		methodChainID:     responseUnmarshallerChainID,
-		methodBlockNumber: responseUnmarshallerBlockNumber,
-		methodGetBalance:  responseUnmarshallerGetBalance,
+		methodChainID:          responseUnmarshallerChainID,
+		methodBlockNumber:      responseUnmarshallerBlockNumber,
+		methodGetBalance:       responseUnmarshallerGetBalance,
+		methodGetBlockByNumber: responseUnmarshallerGetBlockByNumber,
+		methodSyncing:          responseUnmarshallerSyncing,
+		methodPeerCount:        responseUnmarshallerPeerCount,
 	}
 )
 
@@ -42,12 +49,17 @@ var (
 //   - eth_chainId
 //   - eth_blockNumber
 //   - eth_getBalance
+//   - eth_getBlockByNumber
+//   - eth_syncing
+//   - net_peerCount
+//   - archival probes, if archivalProbe is set
 //   - any empty response, regardless of method
 func unmarshalResponse(
 	logger polylog.Logger,
 	servicePayloads map[jsonrpc.ID]protocol.Payload,
 	data []byte,
 	endpointAddr protocol.EndpointAddr,
+	archivalProbe *archivalProbeRequest,
 ) (response, error) {
 	// Create a specialized response for empty endpoint response.
 	if len(data) == 0 {
@@ -118,6 +130,12 @@ func unmarshalResponse(
 		).Error().Msg("❌ Endpoint returned JSONRPC response with error")
 	}
 
+	// Archival probe responses are identified by the request context rather than the method:
+	// probe methods, e.g. `eth_call`, are also used by organic requests.
+	if archivalProbe != nil {
+		return responseUnmarshallerArchivalProbe(logger, *archivalProbe, jsonrpcResponse)
+	}
+
 	// Unmarshal the JSONRPC response into a method-specific response.
 	unmarshaller, found := methodResponseMappings[jsonrpcReq.Method]
 	if found {
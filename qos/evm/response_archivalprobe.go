@@ -0,0 +1,133 @@
+package evm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// maxArchivalProbeValueLength is the maximum length of an archival probe value stored as-is, i.e. a 32-byte hex string.
+// Longer values, e.g. a contract's code, are replaced by their SHA-256 digest.
+const maxArchivalProbeValueLength = len("0x") + 64
+
+// responseToArchivalProbe provides the functionality required from a response by a requestContext instance.
+var _ response = responseToArchivalProbe{}
+
+// responseUnmarshallerArchivalProbe deserializes the provided JSONRPC payload into
+// a responseToArchivalProbe struct, adding any encountered errors to the returned struct.
+//
+// It is used for all archival probe methods, i.e. `eth_getBalance`, `eth_getCode` and `eth_call`:
+// the result of each is a hex string.
+func responseUnmarshallerArchivalProbe(
+	logger polylog.Logger,
+	probeRequest archivalProbeRequest,
+	jsonrpcResp jsonrpc.Response,
+) (response, error) {
+	// The endpoint returned an error, e.g. a pruned node missing the historical state: no value is observed.
+	if jsonrpcResp.IsError() {
+		return responseToArchivalProbe{
+			logger:          logger,
+			jsonrpcResponse: jsonrpcResp,
+			probeRequest:    probeRequest,
+			validationError: nil, // Intentionally set to nil to indicate a valid JSONRPC error response.
+		}, nil
+	}
+
+	responseBz, err := jsonrpcResp.GetResultAsBytes()
+	if err != nil {
+		validationError := qosobservations.EVMResponseValidationError_EVM_RESPONSE_VALIDATION_ERROR_UNMARSHAL
+		return responseToArchivalProbe{
+			logger:          logger,
+			jsonrpcResponse: jsonrpcResp,
+			probeRequest:    probeRequest,
+			validationError: &validationError,
+		}, err
+	}
+
+	var validationError *qosobservations.EVMResponseValidationError
+	var value string
+	if err := json.Unmarshal(responseBz, &value); err != nil {
+		errValue := qosobservations.EVMResponseValidationError_EVM_RESPONSE_VALIDATION_ERROR_UNMARSHAL
+		validationError = &errValue
+	}
+
+	return responseToArchivalProbe{
+		logger:          logger,
+		jsonrpcResponse: jsonrpcResp,
+		probeRequest:    probeRequest,
+		value:           normalizeArchivalProbeValue(value),
+		validationError: validationError,
+	}, nil
+}
+
+// normalizeArchivalProbeValue replaces values longer than maxArchivalProbeValueLength by their SHA-256 digest.
+// This keeps observations small, e.g. for `eth_getCode` probes, while preserving value comparison across endpoints.
+func normalizeArchivalProbeValue(value string) string {
+	if len(value) <= maxArchivalProbeValueLength {
+		return value
+	}
+	digest := sha256.Sum256([]byte(value))
+	return "sha256:" + hex.EncodeToString(digest[:])
+}
+
+// responseToArchivalProbe captures the fields expected in a response to an archival probe request.
+type responseToArchivalProbe struct {
+	logger polylog.Logger
+
+	// jsonrpcResponse stores the JSONRPC response parsed from an endpoint's response bytes.
+	jsonrpcResponse jsonrpc.Response
+
+	// probeRequest identifies the archival probe, and the block number, of the request.
+	probeRequest archivalProbeRequest
+
+	// value is the result returned by the endpoint: empty if the endpoint returned an error.
+	value string
+
+	// validationError indicates why the response failed validation, if it did.
+	validationError *qosobservations.EVMResponseValidationError
+}
+
+// GetObservation returns an observation based on the archival probe response.
+// Implements the response interface.
+func (r responseToArchivalProbe) GetObservation() qosobservations.EVMEndpointObservation {
+	return qosobservations.EVMEndpointObservation{
+		ParsedJsonrpcResponse: r.jsonrpcResponse.GetObservation(),
+		ResponseObservation: &qosobservations.EVMEndpointObservation_ArchivalProbeResponse{
+			ArchivalProbeResponse: &qosobservations.EVMArchivalProbeResponse{
+				HttpStatusCode:          int32(r.getHTTPStatusCode()),
+				ProbeName:               r.probeRequest.probeName,
+				BlockNumber:             r.probeRequest.blockNumberHex,
+				Value:                   r.value,
+				ResponseValidationError: r.validationError,
+			},
+		},
+	}
+}
+
+// GetHTTPResponse returns the HTTP response corresponding to the JSON-RPC response.
+// Implements the response interface.
+func (r responseToArchivalProbe) GetHTTPResponse() jsonrpc.HTTPResponse {
+	return jsonrpc.HTTPResponse{
+		ResponsePayload: r.getResponsePayload(),
+		HTTPStatusCode:  r.getHTTPStatusCode(),
+	}
+}
+
+// getResponsePayload returns the JSON-RPC response payload as a byte slice.
+func (r responseToArchivalProbe) getResponsePayload() []byte {
+	responseBz, err := json.Marshal(r.jsonrpcResponse)
+	if err != nil {
+		r.logger.Warn().Err(err).Msg("responseToArchivalProbe: Marshaling JSONRPC response failed.")
+	}
+	return responseBz
+}
+
+// getHTTPStatusCode returns an HTTP status code corresponding to the underlying JSON-RPC response.
+func (r responseToArchivalProbe) getHTTPStatusCode() int {
+	return r.jsonrpcResponse.GetRecommendedHTTPStatusCode()
+}
@@ -144,23 +144,6 @@ func getGenericJSONRPCErrResponse(_ polylog.Logger, id jsonrpc.ID, malformedResp
 	}
 }
 
-// getGenericJSONRPCErrResponseBatchMarshalFailure creates a generic response for batch marshaling failures.
-// This occurs when individual responses are valid but combining them into a JSON array fails.
-// Uses null ID per JSON-RPC spec for batch-level errors that cannot be correlated to specific requests.
-func getGenericJSONRPCErrResponseBatchMarshalFailure(logger polylog.Logger, err error) responseGeneric {
-	logger.Error().Err(err).Msg("Failed to marshal batch response")
-
-	// Create the batch marshal failure response using the error function
-	jsonrpcResponse := jsonrpc.NewErrResponseBatchMarshalFailure(err)
-
-	// No validation error since this is an internal processing issue, not an endpoint issue
-	return responseGeneric{
-		logger:          logger,
-		jsonrpcResponse: jsonrpcResponse,
-		validationError: nil, // No validation error - this is an internal marshaling issue
-	}
-}
-
 // getGenericResponseBatchEmpty creates a responseGeneric instance for handling empty batch responses.
 // This follows JSON-RPC 2.0 specification requirement to return "nothing at all" when
 // no Response objects are contained in the batch response array.
@@ -15,8 +15,8 @@ var _ response = responseToGetBalance{}
 // responseUnmarshallerGetBalance deserializes the provided JSONRPC payload into
 // a responseToGetBalance struct, adding any encountered errors to the returned struct.
 //
-// The results from this method are used to update the endpoint's archival state
-// only if they are for the currently selected archival block number.
+// The results from this method are only used to validate the response:
+// responses to archival probes are unmarshaled by responseUnmarshallerArchivalProbe.
 //
 // Reference: https://ethereum.org/en/developers/docs/apis/json-rpc/#eth_getbalance
 func responseUnmarshallerGetBalance(
@@ -0,0 +1,157 @@
+package evm
+
+import (
+	"encoding/json"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// responseToGetBlockByNumber provides the functionality required from a response by a requestContext instance.
+var _ response = responseToGetBlockByNumber{}
+
+// responseUnmarshallerGetBlockByNumber deserializes the provided JSONRPC payload into
+// a responseToGetBlockByNumber struct, adding any encountered errors to the returned struct.
+//
+// The results from this method are used to update the endpoint's fork check
+// only if they are for the currently selected fork check block number.
+//
+// Reference: https://ethereum.org/en/developers/docs/apis/json-rpc/#eth_getblockbynumber
+func responseUnmarshallerGetBlockByNumber(
+	logger polylog.Logger,
+	jsonrpcReq jsonrpc.Request,
+	jsonrpcResp jsonrpc.Response,
+) (response, error) {
+	// The endpoint returned an error: no need to do further processing of the response.
+	if jsonrpcResp.IsError() {
+		return responseToGetBlockByNumber{
+			logger:          logger,
+			jsonrpcResponse: jsonrpcResp,
+			validationError: nil, // Intentionally set to nil to indicate a valid JSONRPC error response.
+		}, nil
+	}
+
+	responseBz, err := jsonrpcResp.GetResultAsBytes()
+	if err != nil {
+		validationError := qosobservations.EVMResponseValidationError_EVM_RESPONSE_VALIDATION_ERROR_UNMARSHAL
+		return responseToGetBlockByNumber{
+			logger:          logger,
+			jsonrpcResponse: jsonrpcResp,
+			validationError: &validationError,
+		}, err
+	}
+
+	// A null result is valid: the endpoint does not have the requested block.
+	// Only the block hash is used, so the rest of the block's fields are not unmarshaled.
+	var validationError *qosobservations.EVMResponseValidationError
+	var blockResponse *struct {
+		Hash string `json:"hash"`
+	}
+	if err := json.Unmarshal(responseBz, &blockResponse); err != nil {
+		errValue := qosobservations.EVMResponseValidationError_EVM_RESPONSE_VALIDATION_ERROR_UNMARSHAL
+		validationError = &errValue
+	}
+
+	var blockHash string
+	if blockResponse != nil {
+		blockHash = blockResponse.Hash
+	}
+
+	return responseToGetBlockByNumber{
+		logger:          logger,
+		jsonrpcResponse: jsonrpcResp,
+		blockNumber:     getBlockNumberParam(jsonrpcReq),
+		blockHash:       blockHash,
+		validationError: validationError,
+	}, nil
+}
+
+// responseToGetBlockByNumber captures the fields expected in a response to an `eth_getBlockByNumber` request.
+type responseToGetBlockByNumber struct {
+	logger polylog.Logger
+
+	// jsonrpcResponse stores the JSONRPC response parsed from an endpoint's response bytes.
+	jsonrpcResponse jsonrpc.Response
+
+	// the block number from the request params (first item in the params array)
+	blockNumber string
+
+	// the hash of the block returned in the response
+	blockHash string
+
+	// validationError indicates why the response failed validation, if it did.
+	validationError *qosobservations.EVMResponseValidationError
+}
+
+// GetObservation returns an observation based on the block response.
+// Implements the response interface.
+func (r responseToGetBlockByNumber) GetObservation() qosobservations.EVMEndpointObservation {
+	return qosobservations.EVMEndpointObservation{
+		ParsedJsonrpcResponse: r.jsonrpcResponse.GetObservation(),
+		ResponseObservation: &qosobservations.EVMEndpointObservation_GetBlockByNumberResponse{
+			GetBlockByNumberResponse: &qosobservations.EVMGetBlockByNumberResponse{
+				HttpStatusCode:          int32(r.getHTTPStatusCode()),
+				BlockNumber:             r.blockNumber,
+				BlockHash:               r.blockHash,
+				ResponseValidationError: r.validationError,
+			},
+		},
+	}
+}
+
+// GetHTTPResponse returns the HTTP response corresponding to the JSON-RPC response.
+// Implements the response interface.
+func (r responseToGetBlockByNumber) GetHTTPResponse() jsonrpc.HTTPResponse {
+	return jsonrpc.HTTPResponse{
+		ResponsePayload: r.getResponsePayload(),
+		HTTPStatusCode:  r.getHTTPStatusCode(),
+	}
+}
+
+// getResponsePayload returns the JSON-RPC response payload as a byte slice.
+func (r responseToGetBlockByNumber) getResponsePayload() []byte {
+	responseBz, err := json.Marshal(r.jsonrpcResponse)
+	if err != nil {
+		r.logger.Warn().Err(err).Msg("responseToGetBlockByNumber: Marshaling JSONRPC response failed.")
+	}
+	return responseBz
+}
+
+// getHTTPStatusCode returns an HTTP status code corresponding to the underlying JSON-RPC response.
+func (r responseToGetBlockByNumber) getHTTPStatusCode() int {
+	return r.jsonrpcResponse.GetRecommendedHTTPStatusCode()
+}
+
+// getBlockNumberParam extracts the block number from the JSONRPC request.
+// For 'eth_getBlockByNumber', the params contain ["block_number", include_full_transactions] in that order.
+//
+// For example, the following JSON-RPC request:
+//
+//	`{"jsonrpc": "2.0", "method": "eth_getBlockByNumber", "params": ["0x1b4", false]}`
+//
+// will return the following block number: `0x1b4`
+func getBlockNumberParam(req jsonrpc.Request) string {
+	if req.Params.IsEmpty() {
+		return ""
+	}
+
+	paramsBz, err := json.Marshal(req.Params)
+	if err != nil {
+		return ""
+	}
+
+	var paramsArray []json.RawMessage
+	if err := json.Unmarshal(paramsBz, &paramsArray); err != nil || len(paramsArray) == 0 {
+		return ""
+	}
+
+	// The block number may also be a tag, e.g. "latest": it is returned as-is.
+	var blockNumber string
+	if err := json.Unmarshal(paramsArray[0], &blockNumber); err != nil {
+		return ""
+	}
+
+	return blockNumber
+}
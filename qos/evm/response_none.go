@@ -7,6 +7,7 @@ import (
 
 	qosobservations "github.com/buildwithgrove/path/observation/qos"
 	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos"
 	"github.com/buildwithgrove/path/qos/jsonrpc"
 )
 
@@ -20,6 +21,10 @@ var _ response = responseNone{}
 type responseNone struct {
 	logger          polylog.Logger
 	servicePayloads map[jsonrpc.ID]protocol.Payload
+
+	// stallTracker is used to report a stalled service in the error response.
+	// It is nil if stall detection is not enabled for the service.
+	stallTracker *qos.StallTracker
 }
 
 // GetObservation returns an observation indicating no endpoint provided a response.
@@ -51,6 +56,8 @@ func (r responseNone) GetHTTPResponse() jsonrpc.HTTPResponse {
 // Uses request ID in response per JSONRPC spec: https://www.jsonrpc.org/specification#response_object
 func (r responseNone) getResponsePayload() []byte {
 	userResponse := jsonrpc.NewErrResponseNoEndpointResponse(getJsonRpcIDForErrorResponse(r.servicePayloads))
+	// Let the user know if the service is stalled, e.g. a chain halt, which explains the missing response.
+	userResponse = r.stallTracker.AddToErrorResponse(userResponse)
 	bz, err := json.Marshal(userResponse)
 	if err != nil {
 		// This should never happen: log an entry but return the response anyway.
@@ -0,0 +1,117 @@
+package evm
+
+import (
+	"encoding/json"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// responseToPeerCount provides the functionality required from a response by a requestContext instance.
+var _ response = responseToPeerCount{}
+
+// responseUnmarshallerPeerCount deserializes the provided payload
+// into a responseToPeerCount struct, adding any encountered errors
+// to the returned struct.
+func responseUnmarshallerPeerCount(
+	logger polylog.Logger,
+	_ jsonrpc.Request,
+	jsonrpcResp jsonrpc.Response,
+) (response, error) {
+	// The endpoint returned an error: no need to do further processing of the response.
+	if jsonrpcResp.IsError() {
+		return responseToPeerCount{
+			logger:          logger,
+			jsonrpcResponse: jsonrpcResp,
+			validationError: nil, // Intentionally set to nil to indicate a valid JSONRPC error response.
+		}, nil
+	}
+
+	resultBz, err := jsonrpcResp.GetResultAsBytes()
+	if err != nil {
+		validationError := qosobservations.EVMResponseValidationError_EVM_RESPONSE_VALIDATION_ERROR_UNMARSHAL
+		return responseToPeerCount{
+			logger:          logger,
+			jsonrpcResponse: jsonrpcResp,
+			validationError: &validationError,
+		}, err
+	}
+
+	var (
+		result          string
+		validationError *qosobservations.EVMResponseValidationError
+	)
+
+	// The result is expected to be a hex-encoded peer count, e.g. "0x19".
+	err = json.Unmarshal(resultBz, &result)
+	if err != nil {
+		errValue := qosobservations.EVMResponseValidationError_EVM_RESPONSE_VALIDATION_ERROR_UNMARSHAL
+		validationError = &errValue
+	}
+
+	return responseToPeerCount{
+		logger:          logger,
+		jsonrpcResponse: jsonrpcResp,
+		result:          result,
+		validationError: validationError,
+	}, err
+}
+
+// responseToPeerCount captures the fields expected in a
+// response to a `net_peerCount` request.
+type responseToPeerCount struct {
+	logger polylog.Logger
+
+	// jsonrpcResponse stores the JSONRPC response parsed from an endpoint's response bytes.
+	jsonrpcResponse jsonrpc.Response
+
+	// result stores the result field of a response to a `net_peerCount` request.
+	result string
+
+	// Why the response has failed validation.
+	// Only set if the response is invalid.
+	// Used when generating observations.
+	validationError *qosobservations.EVMResponseValidationError
+}
+
+// GetObservation returns an observation using a `net_peerCount` request's response.
+// Implements the response interface.
+// Reference: https://ethereum.org/en/developers/docs/apis/json-rpc/#net_peercount
+func (r responseToPeerCount) GetObservation() qosobservations.EVMEndpointObservation {
+	return qosobservations.EVMEndpointObservation{
+		ParsedJsonrpcResponse: r.jsonrpcResponse.GetObservation(),
+		ResponseObservation: &qosobservations.EVMEndpointObservation_PeerCountResponse{
+			PeerCountResponse: &qosobservations.EVMPeerCountResponse{
+				HttpStatusCode:          int32(r.getHTTPStatusCode()),
+				PeerCountResponse:       r.result,
+				ResponseValidationError: r.validationError,
+			},
+		},
+	}
+}
+
+// GetHTTPResponse builds and returns the httpResponse matching the responseToPeerCount instance.
+// Implements the response interface.
+func (r responseToPeerCount) GetHTTPResponse() jsonrpc.HTTPResponse {
+	return jsonrpc.HTTPResponse{
+		ResponsePayload: r.getResponsePayload(),
+		HTTPStatusCode:  r.getHTTPStatusCode(),
+	}
+}
+
+// getResponsePayload returns the raw byte slice payload to be returned as the response to the JSONRPC request.
+func (r responseToPeerCount) getResponsePayload() []byte {
+	bz, err := json.Marshal(r.jsonrpcResponse)
+	if err != nil {
+		// This should never happen: log an entry but return the response anyway.
+		r.logger.Warn().Err(err).Msg("responseToPeerCount: Marshaling JSONRPC response failed.")
+	}
+	return bz
+}
+
+// getHTTPStatusCode returns an HTTP status code corresponding to the underlying JSON-RPC response code.
+func (r responseToPeerCount) getHTTPStatusCode() int {
+	return r.jsonrpcResponse.GetRecommendedHTTPStatusCode()
+}
@@ -0,0 +1,134 @@
+package evm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// responseToSyncing provides the functionality required from a response by a requestContext instance.
+var _ response = responseToSyncing{}
+
+// responseUnmarshallerSyncing deserializes the provided payload
+// into a responseToSyncing struct, adding any encountered errors
+// to the returned struct.
+func responseUnmarshallerSyncing(
+	logger polylog.Logger,
+	_ jsonrpc.Request,
+	jsonrpcResp jsonrpc.Response,
+) (response, error) {
+	// The endpoint returned an error: no need to do further processing of the response.
+	if jsonrpcResp.IsError() {
+		return responseToSyncing{
+			logger:          logger,
+			jsonrpcResponse: jsonrpcResp,
+			validationError: nil, // Intentionally set to nil to indicate a valid JSONRPC error response.
+		}, nil
+	}
+
+	resultBz, err := jsonrpcResp.GetResultAsBytes()
+	if err != nil {
+		validationError := qosobservations.EVMResponseValidationError_EVM_RESPONSE_VALIDATION_ERROR_UNMARSHAL
+		return responseToSyncing{
+			logger:          logger,
+			jsonrpcResponse: jsonrpcResp,
+			validationError: &validationError,
+		}, err
+	}
+
+	isSyncing, err := parseSyncingResult(resultBz)
+	if err != nil {
+		validationError := qosobservations.EVMResponseValidationError_EVM_RESPONSE_VALIDATION_ERROR_UNMARSHAL
+		return responseToSyncing{
+			logger:          logger,
+			jsonrpcResponse: jsonrpcResp,
+			validationError: &validationError,
+		}, err
+	}
+
+	return responseToSyncing{
+		logger:          logger,
+		jsonrpcResponse: jsonrpcResp,
+		isSyncing:       isSyncing,
+	}, nil
+}
+
+// parseSyncingResult parses the result field of a response to an `eth_syncing` request:
+//   - `false` if the endpoint is not syncing.
+//   - An object with the sync status if the endpoint is syncing, e.g. {"startingBlock":"0x384","currentBlock":"0x386","highestBlock":"0x454"}
+func parseSyncingResult(resultBz []byte) (bool, error) {
+	var result any
+	if err := json.Unmarshal(resultBz, &result); err != nil {
+		return false, err
+	}
+
+	switch result := result.(type) {
+	case bool:
+		return result, nil
+	case map[string]any:
+		return true, nil
+	default:
+		return false, fmt.Errorf("unexpected %q result type: %T", methodSyncing, result)
+	}
+}
+
+// responseToSyncing captures the fields expected in a
+// response to an `eth_syncing` request.
+type responseToSyncing struct {
+	logger polylog.Logger
+
+	// jsonrpcResponse stores the JSONRPC response parsed from an endpoint's response bytes.
+	jsonrpcResponse jsonrpc.Response
+
+	// isSyncing is set if the endpoint returned a sync status object, instead of `false`, in the result field.
+	isSyncing bool
+
+	// Why the response has failed validation.
+	// Only set if the response is invalid.
+	// Used when generating observations.
+	validationError *qosobservations.EVMResponseValidationError
+}
+
+// GetObservation returns an observation using an `eth_syncing` request's response.
+// Implements the response interface.
+// Reference: https://ethereum.org/en/developers/docs/apis/json-rpc/#eth_syncing
+func (r responseToSyncing) GetObservation() qosobservations.EVMEndpointObservation {
+	return qosobservations.EVMEndpointObservation{
+		ParsedJsonrpcResponse: r.jsonrpcResponse.GetObservation(),
+		ResponseObservation: &qosobservations.EVMEndpointObservation_SyncingResponse{
+			SyncingResponse: &qosobservations.EVMSyncingResponse{
+				HttpStatusCode:          int32(r.getHTTPStatusCode()),
+				IsSyncing:               r.isSyncing,
+				ResponseValidationError: r.validationError,
+			},
+		},
+	}
+}
+
+// GetHTTPResponse builds and returns the httpResponse matching the responseToSyncing instance.
+// Implements the response interface.
+func (r responseToSyncing) GetHTTPResponse() jsonrpc.HTTPResponse {
+	return jsonrpc.HTTPResponse{
+		ResponsePayload: r.getResponsePayload(),
+		HTTPStatusCode:  r.getHTTPStatusCode(),
+	}
+}
+
+// getResponsePayload returns the raw byte slice payload to be returned as the response to the JSONRPC request.
+func (r responseToSyncing) getResponsePayload() []byte {
+	bz, err := json.Marshal(r.jsonrpcResponse)
+	if err != nil {
+		// This should never happen: log an entry but return the response anyway.
+		r.logger.Warn().Err(err).Msg("responseToSyncing: Marshaling JSONRPC response failed.")
+	}
+	return bz
+}
+
+// getHTTPStatusCode returns an HTTP status code corresponding to the underlying JSON-RPC response code.
+func (r responseToSyncing) getHTTPStatusCode() int {
+	return r.jsonrpcResponse.GetRecommendedHTTPStatusCode()
+}
@@ -0,0 +1,43 @@
+package evm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSyncingResult(t *testing.T) {
+	tests := []struct {
+		name              string
+		result            string
+		expectedIsSyncing bool
+		expectErr         bool
+	}{
+		{
+			name:   "endpoint which is not syncing",
+			result: `false`,
+		},
+		{
+			name:              "endpoint which is syncing",
+			result:            `{"startingBlock":"0x384","currentBlock":"0x386","highestBlock":"0x454"}`,
+			expectedIsSyncing: true,
+		},
+		{
+			name:      "unexpected result type",
+			result:    `"0x1"`,
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isSyncing, err := parseSyncingResult([]byte(tt.result))
+			if tt.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedIsSyncing, isSyncing)
+		})
+	}
+}
@@ -1,9 +1,16 @@
 package evm
 
 import (
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
 	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 
 	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
 )
 
 // QoSType is the QoS type for the EVM blockchain.
@@ -14,6 +21,13 @@ const QoSType = "evm"
 // practices for defining what constitutes an archival block.
 const DefaultEVMArchivalThreshold = 128
 
+// DefaultEVMArchivalConsensusThreshold is the default # of endpoints that must agree on
+// an archival probe's value at a historical block for it to be set as the expected value.
+const DefaultEVMArchivalConsensusThreshold = 5
+
+// DefaultArchivalProbeName is the name of the `eth_getBalance` probe created by NewEVMArchivalCheckConfig.
+const DefaultArchivalProbeName = "balance"
+
 // defaultEVMBlockNumberSyncAllowance is the default sync allowance for EVM-based chains.
 // This number indicates how many blocks behind the perceived
 // block number the endpoint may be and still be considered valid.
@@ -34,24 +48,298 @@ type EVMServiceQoSConfig interface {
 	getEVMArchivalCheckConfig() evmArchivalCheckConfig
 	archivalCheckEnabled() bool
 	getSupportedAPIs() map[sharedtypes.RPCType]struct{}
+	getGetLogsBlockRangeChunkSize() uint64
+	getConsensusReadMethods() map[string]*qos.ConsensusReadConfig
+	consensusReadHeaderEnabled() bool
+	getBlockTagPinning() (lag uint64, enabled bool)
+	getCoalescedMethods() map[string]struct{}
+	getRequestLimits() jsonrpc.RequestLimits
+	getExpectedBlockTime() time.Duration
+	getMinPeerCount() uint64
+	getMethodTimeouts() map[string]time.Duration
+	getTxBroadcastNumEndpoints() uint
+	getBatchNumEndpoints() uint
+}
+
+// EVMServiceQoSConfigOption customizes an optional setting of an EVM service QoS configuration.
+type EVMServiceQoSConfigOption func(*evmServiceQoSConfig)
+
+// WithEVMChainID sets the EVM chain ID of the service, e.g. "0x1".
+// Used to override the chain ID of an existing config: see OverrideEVMServiceQoSConfig.
+func WithEVMChainID(evmChainID string) EVMServiceQoSConfigOption {
+	return func(c *evmServiceQoSConfig) {
+		c.evmChainID = evmChainID
+	}
+}
+
+// WithSupportedAPIs sets the RPC types supported by the service.
+// Used to override the supported APIs of an existing config: see OverrideEVMServiceQoSConfig.
+func WithSupportedAPIs(supportedAPIs map[sharedtypes.RPCType]struct{}) EVMServiceQoSConfigOption {
+	return func(c *evmServiceQoSConfig) {
+		c.supportedAPIs = supportedAPIs
+	}
+}
+
+// WithArchivalCheck enables the archival check using the supplied configuration.
+// Replaces the archival check of an existing config: see OverrideEVMServiceQoSConfig.
+func WithArchivalCheck(archivalCheckConfig *evmArchivalCheckConfig) EVMServiceQoSConfigOption {
+	return func(c *evmServiceQoSConfig) {
+		c.archivalCheckConfig = archivalCheckConfig
+	}
+}
+
+// WithGetLogsBlockRangeChunkSize enables splitting of `eth_getLogs` requests:
+//   - Applies to requests with explicit `fromBlock` and `toBlock` block numbers.
+//   - Block ranges larger than chunkSize are split into chunks of (at most) chunkSize blocks.
+//   - Chunks are sent to different endpoints in parallel, and the results are merged.
+//
+// A chunkSize of 0 disables the splitting, which is the default.
+func WithGetLogsBlockRangeChunkSize(chunkSize uint64) EVMServiceQoSConfigOption {
+	return func(c *evmServiceQoSConfig) {
+		c.getLogsBlockRangeChunkSize = chunkSize
+	}
+}
+
+// WithConsensusReadMethods enables consensus-read mode for the specified JSON-RPC methods:
+//   - Requests with a matching method are sent to numEndpoints endpoints, in parallel.
+//   - The result is returned only if at least quorum endpoints agree on it.
+//   - A quorum of 0 defaults to the majority of the endpoints.
+//
+// Users can also opt into consensus-read mode for any request, if allowed using WithConsensusReadHeader.
+func WithConsensusReadMethods(numEndpoints, quorum uint, methods ...string) EVMServiceQoSConfigOption {
+	return func(c *evmServiceQoSConfig) {
+		if c.consensusReadMethods == nil {
+			c.consensusReadMethods = make(map[string]*qos.ConsensusReadConfig)
+		}
+
+		consensusReadConfig := qos.NewConsensusReadConfig(numEndpoints, quorum)
+		for _, method := range methods {
+			c.consensusReadMethods[method] = consensusReadConfig
+		}
+	}
+}
+
+// WithConsensusReadHeader allows users to opt into consensus-read mode for any request, using the qos.HTTPHeaderConsensusRead header.
+// Disabled by default: every opted-in request is sent to, and costs, multiple relays.
+func WithConsensusReadHeader() EVMServiceQoSConfigOption {
+	return func(c *evmServiceQoSConfig) {
+		c.consensusReadHeaderAllowed = true
+	}
+}
+
+// WithBlockTagPinning enables pinning of block tags to a concrete block number:
+//   - The `latest` block tag is rewritten to the perceived block number minus lag.
+//   - Only endpoints at or above the pinned block number are selected to serve the request.
+//   - Responses to `eth_blockNumber` are capped at the pinned block number.
+//
+// This ensures consistent reads across endpoints at slightly different heights.
+// Users can enforce monotonic reads using the HTTPHeaderMinBlockNumber header.
+func WithBlockTagPinning(lag uint64) EVMServiceQoSConfigOption {
+	return func(c *evmServiceQoSConfig) {
+		c.blockTagPinningEnabled = true
+		c.blockTagPinningLag = lag
+	}
+}
+
+// WithCoalescedMethods sets the JSON-RPC methods eligible for request coalescing:
+//   - Concurrent identical requests with these methods share a single relay, if enabled for the gateway.
+//   - Only read-only methods should be included: e.g. never `eth_sendRawTransaction`.
+//
+// Replaces the default allowlist, i.e. defaultCoalescedMethods.
+func WithCoalescedMethods(methods ...string) EVMServiceQoSConfigOption {
+	return func(c *evmServiceQoSConfig) {
+		c.coalescedMethods = make(map[string]struct{}, len(methods))
+		for _, method := range methods {
+			c.coalescedMethods[method] = struct{}{}
+		}
+	}
+}
+
+// WithSyncAllowance sets the number of blocks an endpoint may be behind the perceived block number and still be considered valid.
+// Defaults to defaultEVMBlockNumberSyncAllowance if not set.
+func WithSyncAllowance(syncAllowance uint64) EVMServiceQoSConfigOption {
+	return func(c *evmServiceQoSConfig) {
+		c.syncAllowance = syncAllowance
+	}
+}
+
+// WithArchivalThreshold sets the number of blocks below the perceived block number considered "archival" data.
+// Defaults to DefaultEVMArchivalThreshold if not set. No-op if the service has no archival check.
+func WithArchivalThreshold(threshold uint64) EVMServiceQoSConfigOption {
+	return func(c *evmServiceQoSConfig) {
+		if c.archivalCheckConfig == nil || threshold == 0 {
+			return
+		}
+		archivalCheckConfig := *c.archivalCheckConfig
+		archivalCheckConfig.threshold = threshold
+		c.archivalCheckConfig = &archivalCheckConfig
+	}
+}
+
+// WithArchivalConsensusThreshold sets the number of endpoints which must agree on an archival probe's value for it to be the expected value.
+// Defaults to DefaultEVMArchivalConsensusThreshold if not set. No-op if the service has no archival check.
+func WithArchivalConsensusThreshold(consensusThreshold int) EVMServiceQoSConfigOption {
+	return func(c *evmServiceQoSConfig) {
+		if c.archivalCheckConfig == nil || consensusThreshold == 0 {
+			return
+		}
+		archivalCheckConfig := *c.archivalCheckConfig
+		archivalCheckConfig.consensusThreshold = consensusThreshold
+		c.archivalCheckConfig = &archivalCheckConfig
+	}
+}
+
+// WithMaxBatchSize sets the maximum number of requests in a JSONRPC batch request.
+// Defaults to jsonrpc.DefaultMaxBatchSize if not set.
+func WithMaxBatchSize(maxBatchSize int) EVMServiceQoSConfigOption {
+	return func(c *evmServiceQoSConfig) {
+		c.maxBatchSize = maxBatchSize
+	}
+}
+
+// WithMaxRequestBodyBytes sets the maximum size of a JSONRPC request body, in bytes.
+// Defaults to jsonrpc.DefaultMaxRequestBodyBytes if not set.
+func WithMaxRequestBodyBytes(maxRequestBodyBytes int64) EVMServiceQoSConfigOption {
+	return func(c *evmServiceQoSConfig) {
+		c.maxRequestBodyBytes = maxRequestBodyBytes
+	}
+}
+
+// WithExpectedBlockTime enables stall detection for the service:
+//   - The service is considered stalled if the perceived block number does not advance for several expected block times.
+//   - A stalled service is reported by the health check, metrics and error responses.
+//
+// An expectedBlockTime of 0 disables stall detection, which is the default.
+func WithExpectedBlockTime(expectedBlockTime time.Duration) EVMServiceQoSConfigOption {
+	return func(c *evmServiceQoSConfig) {
+		c.expectedBlockTime = expectedBlockTime
+	}
+}
+
+// WithMinPeerCount enables the `net_peerCount` check for the service:
+//   - Endpoints connected to fewer than minPeerCount peers are disqualified.
+//   - Endpoints which do not support `net_peerCount` are not disqualified.
+//
+// A minPeerCount of 0 disables the check, which is the default.
+func WithMinPeerCount(minPeerCount uint64) EVMServiceQoSConfigOption {
+	return func(c *evmServiceQoSConfig) {
+		c.minPeerCount = minPeerCount
+	}
+}
+
+// WithMethodTimeouts sets the relay timeout of the specified JSON-RPC methods:
+//   - e.g. a longer timeout for `eth_getLogs` and `debug_trace*` methods, or a shorter one for `eth_blockNumber`.
+//   - Batch requests use the longest timeout of their methods.
+//
+// Methods without a configured timeout use the gateway's default relay timeout.
+func WithMethodTimeouts(methodTimeouts map[string]time.Duration) EVMServiceQoSConfigOption {
+	return func(c *evmServiceQoSConfig) {
+		c.methodTimeouts = methodTimeouts
+	}
+}
+
+// WithTxBroadcastNumEndpoints sets the number of endpoints a transaction submission, i.e. `eth_sendRawTransaction`, is broadcast to.
+// Defaults to qos.DefaultTxBroadcastNumEndpoints if not set. A numEndpoints of 1 disables broadcasting.
+func WithTxBroadcastNumEndpoints(numEndpoints uint) EVMServiceQoSConfigOption {
+	return func(c *evmServiceQoSConfig) {
+		c.txBroadcastNumEndpoints = numEndpoints
+	}
+}
+
+// WithBatchNumEndpoints sets the maximum number of endpoints the members of a JSON-RPC batch request are distributed across.
+// Defaults to qos.DefaultBatchNumEndpoints if not set. A numEndpoints of 1 disables distribution: batches are sent to a single endpoint.
+func WithBatchNumEndpoints(numEndpoints uint) EVMServiceQoSConfigOption {
+	return func(c *evmServiceQoSConfig) {
+		c.batchNumEndpoints = numEndpoints
+	}
+}
+
+// The errors below list all the possible validation errors of an archival probe.
+var (
+	errArchivalProbeNameEmpty          = errors.New("archival probe name is required")
+	errArchivalProbeMethodInvalid      = errors.New("unsupported archival probe method")
+	errArchivalProbeContractEmpty      = errors.New("archival probe contract address is required")
+	errArchivalProbeStartBlockEmpty    = errors.New("archival probe contract start block is required")
+	errArchivalProbeCallDataEmpty      = fmt.Errorf("archival probe call data is required for the %q method", methodCall)
+	errArchivalProbeCallDataNotAllowed = fmt.Errorf("archival probe call data is only supported for the %q method", methodCall)
+)
+
+// ArchivalProbe is a query of a contract's historical state, run against every endpoint of a service with an archival check:
+//   - The endpoint is sent a request with the probe's method, for the contract, at a random historical block number.
+//   - The value agreed on by a majority of endpoints is the probe's expected value at that block number.
+//   - The endpoint passes the probe only if its returned value matches the expected value.
+type ArchivalProbe struct {
+	// Name identifies the probe, e.g. in observations and logs. Must be unique per service.
+	Name string
+
+	// Method is the JSON-RPC method of the probe's request: one of `eth_getBalance`, `eth_getCode` or `eth_call`.
+	Method jsonrpc.Method
+
+	// ContractAddress is the address of the contract whose historical state is queried.
+	ContractAddress string
+
+	// ContractStartBlock is the block at which the contract was deployed, or first had a balance.
+	// Historical block numbers are selected between this block and the archival threshold.
+	ContractStartBlock uint64
+
+	// CallData is the hex-encoded call data of an `eth_call` probe, e.g. "0x18160ddd" for `totalSupply()`.
+	// Only supported for `eth_call` probes.
+	CallData string
+}
+
+// Validate returns an error if the probe is incomplete or invalid.
+func (p ArchivalProbe) Validate() error {
+	if p.Name == "" {
+		return errArchivalProbeNameEmpty
+	}
+
+	switch p.Method {
+	case methodGetBalance, methodGetCode:
+		if p.CallData != "" {
+			return errArchivalProbeCallDataNotAllowed
+		}
+	case methodCall:
+		if p.CallData == "" {
+			return errArchivalProbeCallDataEmpty
+		}
+	default:
+		return fmt.Errorf("%w: %q", errArchivalProbeMethodInvalid, p.Method)
+	}
+
+	if p.ContractAddress == "" {
+		return errArchivalProbeContractEmpty
+	}
+	if p.ContractStartBlock == 0 {
+		return errArchivalProbeStartBlockEmpty
+	}
+
+	return nil
 }
 
 // evmArchivalCheckConfig is the configuration for the archival check.
 //
 // The basic methodology is:
-//  1. Select a `ContractAddress` for the chain with a frequent transaction volume and large balance.
-//  2. Determine its starting block height (`ContractStartBlock`).
+//  1. Select one or more probes: a contract, and a method querying its historical state, e.g. its balance.
+//  2. Determine each contract's starting block height (`ContractStartBlock`).
 //  3. Set a `Threshold` for how many blocks below the current block number are considered "archival" data.
 //
-// With all of this data, the QoS implementation can select a random block number to check using `eth_getBalance`.
+// With all of this data, the QoS implementation can select a random historical block number to check for each probe.
 type evmArchivalCheckConfig struct {
-	threshold          uint64 // The number of blocks below the current block number to be considered "archival" data
-	contractAddress    string // The address of the contract to check for the archival balance.
-	contractStartBlock uint64 // The start block of the contract address (ie. when it first had a balance)
+	threshold          uint64          // The number of blocks below the current block number to be considered "archival" data
+	probes             []ArchivalProbe // The probes run against every endpoint, at historical block numbers.
+	consensusThreshold int             // The number of endpoints which must agree on a probe's value for it to be the expected value.
 }
 
 func (c evmArchivalCheckConfig) IsEmpty() bool {
-	return c.contractAddress == "" || c.contractStartBlock == 0 || c.threshold == 0
+	return len(c.probes) == 0 || c.threshold == 0
+}
+
+// getConsensusThreshold returns the number of endpoints which must agree on a probe's value for it to be the expected value.
+func (c evmArchivalCheckConfig) getConsensusThreshold() int {
+	if c.consensusThreshold == 0 {
+		return DefaultEVMArchivalConsensusThreshold
+	}
+	return c.consensusThreshold
 }
 
 // NewEVMServiceQoSConfig creates a new EVM service configuration with the specified archival check settings.
@@ -60,23 +348,66 @@ func NewEVMServiceQoSConfig(
 	evmChainID string,
 	archivalCheckConfig *evmArchivalCheckConfig,
 	supportedAPIs map[sharedtypes.RPCType]struct{},
+	opts ...EVMServiceQoSConfigOption,
 ) EVMServiceQoSConfig {
-	return evmServiceQoSConfig{
+	config := evmServiceQoSConfig{
 		serviceID:           serviceID,
 		evmChainID:          evmChainID,
 		archivalCheckConfig: archivalCheckConfig,
 		supportedAPIs:       supportedAPIs,
 	}
+
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return config
 }
 
+// OverrideEVMServiceQoSConfig returns a copy of the supplied config, with the options applied on top of its settings.
+// e.g. used to change a single setting of a compiled-in service config, while keeping all its other settings.
+func OverrideEVMServiceQoSConfig(config EVMServiceQoSConfig, opts ...EVMServiceQoSConfigOption) EVMServiceQoSConfig {
+	overridden, ok := config.(evmServiceQoSConfig)
+	if !ok {
+		return config
+	}
+
+	// WithConsensusReadMethods adds to the map in place: copy it to leave the supplied config unchanged.
+	overridden.consensusReadMethods = maps.Clone(overridden.consensusReadMethods)
+
+	for _, opt := range opts {
+		opt(&overridden)
+	}
+
+	return overridden
+}
+
+// NewEVMArchivalCheckConfig creates an archival check configuration with a single probe,
+// checking the balance of the supplied contract using `eth_getBalance`.
 func NewEVMArchivalCheckConfig(
 	contractAddress string,
 	contractStartBlock uint64,
 ) *evmArchivalCheckConfig {
+	return NewEVMArchivalProbesCheckConfig(NewEVMBalanceArchivalProbe(contractAddress, contractStartBlock))
+}
+
+// NewEVMBalanceArchivalProbe creates an archival probe, named DefaultArchivalProbeName,
+// checking the balance of the supplied contract using `eth_getBalance`.
+func NewEVMBalanceArchivalProbe(contractAddress string, contractStartBlock uint64) ArchivalProbe {
+	return ArchivalProbe{
+		Name:               DefaultArchivalProbeName,
+		Method:             methodGetBalance,
+		ContractAddress:    contractAddress,
+		ContractStartBlock: contractStartBlock,
+	}
+}
+
+// NewEVMArchivalProbesCheckConfig creates an archival check configuration with the supplied probes.
+// The probes must be validated beforehand: see ArchivalProbe.Validate.
+func NewEVMArchivalProbesCheckConfig(probes ...ArchivalProbe) *evmArchivalCheckConfig {
 	return &evmArchivalCheckConfig{
-		threshold:          DefaultEVMArchivalThreshold,
-		contractAddress:    contractAddress,
-		contractStartBlock: contractStartBlock,
+		threshold: DefaultEVMArchivalThreshold,
+		probes:    probes,
 	}
 }
 
@@ -89,6 +420,53 @@ type evmServiceQoSConfig struct {
 	syncAllowance       uint64
 	archivalCheckConfig *evmArchivalCheckConfig
 	supportedAPIs       map[sharedtypes.RPCType]struct{}
+
+	// getLogsBlockRangeChunkSize is the maximum number of blocks covered by a single `eth_getLogs` chunk.
+	// Splitting of `eth_getLogs` requests is disabled if set to 0.
+	getLogsBlockRangeChunkSize uint64
+
+	// consensusReadMethods maps JSON-RPC methods to their consensus-read config.
+	// Requests with methods not in the map are sent to a single endpoint, unless requested otherwise by the user.
+	consensusReadMethods map[string]*qos.ConsensusReadConfig
+
+	// consensusReadHeaderAllowed is set if users can opt into consensus-read mode using the qos.HTTPHeaderConsensusRead header.
+	consensusReadHeaderAllowed bool
+
+	// blockTagPinningEnabled is set if block tags should be pinned to a concrete block number.
+	blockTagPinningEnabled bool
+
+	// blockTagPinningLag is the number of blocks below the perceived block number block tags are pinned to.
+	blockTagPinningLag uint64
+
+	// coalescedMethods is the allowlist of JSON-RPC methods eligible for request coalescing.
+	// The default allowlist is used if not set.
+	coalescedMethods map[string]struct{}
+
+	// maxBatchSize is the maximum number of requests in a JSONRPC batch request.
+	maxBatchSize int
+
+	// maxRequestBodyBytes is the maximum size of a JSONRPC request body, in bytes.
+	maxRequestBodyBytes int64
+
+	// expectedBlockTime is the expected time between blocks, used for stall detection.
+	// Stall detection is disabled if set to 0.
+	expectedBlockTime time.Duration
+
+	// minPeerCount is the minimum number of peers an endpoint must be connected to.
+	// The `net_peerCount` check is disabled if set to 0.
+	minPeerCount uint64
+
+	// methodTimeouts maps JSON-RPC methods to their relay timeout.
+	// Methods not in the map use the gateway's default relay timeout.
+	methodTimeouts map[string]time.Duration
+
+	// txBroadcastNumEndpoints is the number of endpoints a transaction submission is broadcast to.
+	// The default is used if set to 0.
+	txBroadcastNumEndpoints uint
+
+	// batchNumEndpoints is the maximum number of endpoints the members of a JSON-RPC batch request are distributed across.
+	// The default is used if set to 0.
+	batchNumEndpoints uint
 }
 
 // GetServiceID returns the ID of the service.
@@ -135,3 +513,72 @@ func (c evmServiceQoSConfig) getEVMArchivalCheckConfig() evmArchivalCheckConfig
 func (c evmServiceQoSConfig) getSupportedAPIs() map[sharedtypes.RPCType]struct{} {
 	return c.supportedAPIs
 }
+
+// getGetLogsBlockRangeChunkSize returns the maximum block range of a single `eth_getLogs` chunk.
+// Implements the EVMServiceQoSConfig interface.
+func (c evmServiceQoSConfig) getGetLogsBlockRangeChunkSize() uint64 {
+	return c.getLogsBlockRangeChunkSize
+}
+
+// getConsensusReadMethods returns the JSON-RPC methods which are served in consensus-read mode.
+// Implements the EVMServiceQoSConfig interface.
+func (c evmServiceQoSConfig) getConsensusReadMethods() map[string]*qos.ConsensusReadConfig {
+	return c.consensusReadMethods
+}
+
+// consensusReadHeaderEnabled returns true if users can opt into consensus-read mode using the qos.HTTPHeaderConsensusRead header.
+// Implements the EVMServiceQoSConfig interface.
+func (c evmServiceQoSConfig) consensusReadHeaderEnabled() bool {
+	return c.consensusReadHeaderAllowed
+}
+
+// getBlockTagPinning returns the block-tag pinning lag, and whether block-tag pinning is enabled.
+// Implements the EVMServiceQoSConfig interface.
+func (c evmServiceQoSConfig) getBlockTagPinning() (uint64, bool) {
+	return c.blockTagPinningLag, c.blockTagPinningEnabled
+}
+
+// getCoalescedMethods returns the JSON-RPC methods eligible for request coalescing.
+// Implements the EVMServiceQoSConfig interface.
+func (c evmServiceQoSConfig) getCoalescedMethods() map[string]struct{} {
+	if c.coalescedMethods == nil {
+		return defaultCoalescedMethods
+	}
+	return c.coalescedMethods
+}
+
+// getRequestLimits returns the limits on the size of JSONRPC requests, with defaults applied.
+// Implements the EVMServiceQoSConfig interface.
+func (c evmServiceQoSConfig) getRequestLimits() jsonrpc.RequestLimits {
+	return jsonrpc.NewRequestLimits(c.maxBatchSize, c.maxRequestBodyBytes)
+}
+
+// getExpectedBlockTime returns the expected time between blocks: 0 if stall detection is disabled.
+// Implements the EVMServiceQoSConfig interface.
+func (c evmServiceQoSConfig) getExpectedBlockTime() time.Duration {
+	return c.expectedBlockTime
+}
+
+// getMinPeerCount returns the minimum number of peers an endpoint must be connected to: 0 if the peer count check is disabled.
+// Implements the EVMServiceQoSConfig interface.
+func (c evmServiceQoSConfig) getMinPeerCount() uint64 {
+	return c.minPeerCount
+}
+
+// getMethodTimeouts returns the relay timeout of JSON-RPC methods with a configured timeout.
+// Implements the EVMServiceQoSConfig interface.
+func (c evmServiceQoSConfig) getMethodTimeouts() map[string]time.Duration {
+	return c.methodTimeouts
+}
+
+// getTxBroadcastNumEndpoints returns the number of endpoints a transaction submission is broadcast to, with the default applied.
+// Implements the EVMServiceQoSConfig interface.
+func (c evmServiceQoSConfig) getTxBroadcastNumEndpoints() uint {
+	return qos.GetTxBroadcastNumEndpoints(c.txBroadcastNumEndpoints)
+}
+
+// getBatchNumEndpoints returns the maximum number of endpoints the members of a JSON-RPC batch request are distributed across, with the default applied.
+// Implements the EVMServiceQoSConfig interface.
+func (c evmServiceQoSConfig) getBatchNumEndpoints() uint {
+	return qos.GetBatchNumEndpoints(c.batchNumEndpoints)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/buildwithgrove/path/metrics/devtools"
 	qosobservations "github.com/buildwithgrove/path/observation/qos"
 	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos"
 	"github.com/buildwithgrove/path/qos/jsonrpc"
 )
 
@@ -51,6 +52,19 @@ type serviceState struct {
 
 	// archivalState contains the current state of the EVM archival check for the service.
 	archivalState archivalState
+
+	// forkState contains the current state of the EVM fork check for the service.
+	forkState forkState
+
+	// stallTracker tracks whether the perceived block number is advancing.
+	// It is nil if stall detection is not enabled for the service.
+	stallTracker *qos.StallTracker
+}
+
+// IsStalled returns true if the perceived block number has stopped advancing, e.g. due to a chain halt.
+// Implements the health.StallReporter interface.
+func (ss *serviceState) IsStalled() bool {
+	return ss.stallTracker.IsStalled()
 }
 
 /* -------------------- QoS Endpoint Check Generator -------------------- */
@@ -84,18 +98,51 @@ func (ss *serviceState) GetRequiredQualityChecks(endpointAddr protocol.EndpointA
 		checks = append(checks, ss.getEndpointCheck(endpoint.checkChainID.getRequestID(), endpoint.checkChainID.getServicePayload()))
 	}
 
-	// Archival check runs infrequently as the result of a request for an archival block is not expected to change regularly.
+	// Sync status check runs frequently as an endpoint may start syncing at any time, e.g. after falling behind.
+	if ss.shouldSyncingCheckRun(endpoint.checkSyncing) {
+		checks = append(checks, ss.getEndpointCheck(endpoint.checkSyncing.getRequestID(), endpoint.checkSyncing.getServicePayload()))
+	}
+
+	// Peer count check only runs if the service is configured with a minimum peer count.
+	if ss.shouldPeerCountCheckRun(endpoint.checkPeerCount) {
+		checks = append(checks, ss.getEndpointCheck(endpoint.checkPeerCount.getRequestID(), endpoint.checkPeerCount.getServicePayload()))
+	}
+
+	// The archival and fork check states are updated from observations: read them under the service state lock.
+	ss.serviceStateLock.RLock()
+	defer ss.serviceStateLock.RUnlock()
+
+	// Archival probes run infrequently as the result of a request for an archival block is not expected to change regularly.
+	// Each probe runs again when a new archival block number is selected for it.
 	// Additionally, this check will only run if the service is configured to perform archival checks.
-	if ss.archivalState.shouldArchivalCheckRun(endpoint.checkArchival) {
+	for _, probeRequest := range ss.archivalState.getProbesToRun(endpoint.checkArchival) {
+		checks = append(checks, ss.getArchivalProbeCheck(endpoint.checkArchival, probeRequest))
+	}
+
+	// Fork check runs on every new fork check block number, and periodically to detect reorgs.
+	if ss.forkState.shouldForkCheckRun(endpoint.checkFork) {
 		checks = append(
 			checks,
-			ss.getEndpointCheck(endpoint.checkArchival.getRequestID(), endpoint.checkArchival.getServicePayload(ss.archivalState)),
+			ss.getEndpointCheck(endpoint.checkFork.getRequestID(), endpoint.checkFork.getServicePayload(&ss.forkState)),
 		)
 	}
 
 	return checks
 }
 
+// getArchivalProbeCheck prepares a request context for an archival probe.
+// The request context is marked as an archival probe, for the endpoint's response to update its archival check.
+func (ss *serviceState) getArchivalProbeCheck(check endpointCheckArchival, probeRequest archivalProbeRequest) *requestContext {
+	probe, _ := ss.archivalState.getProbe(probeRequest.probeName)
+
+	requestContext := ss.getEndpointCheck(
+		check.getRequestID(),
+		check.getServicePayload(ss.archivalState.logger, probe, probeRequest.blockNumberHex),
+	)
+	requestContext.archivalProbe = &probeRequest
+	return requestContext
+}
+
 // getEndpointCheck prepares a request context for a specific endpoint check.
 // The pre-selected endpoint address is assigned to the request context in the `endpoint.getChecks` method.
 // It is called in the individual `check_*.go` files to build the request context.
@@ -122,6 +169,19 @@ func (ss *serviceState) shouldChainIDCheckRun(check endpointCheckChainID) bool {
 	return check.expiresAt.IsZero() || check.expiresAt.Before(time.Now())
 }
 
+// shouldSyncingCheckRun returns true if the sync status check is not yet initialized or has expired.
+func (ss *serviceState) shouldSyncingCheckRun(check endpointCheckSyncing) bool {
+	return check.expiresAt.IsZero() || check.expiresAt.Before(time.Now())
+}
+
+// shouldPeerCountCheckRun returns true if the peer count check is enabled for the service, and is not yet initialized or has expired.
+func (ss *serviceState) shouldPeerCountCheckRun(check endpointCheckPeerCount) bool {
+	if ss.serviceQoSConfig.getMinPeerCount() == 0 {
+		return false
+	}
+	return check.expiresAt.IsZero() || check.expiresAt.Before(time.Now())
+}
+
 /* -------------------- QoS Endpoint State Updater -------------------- */
 
 // ApplyObservations updates endpoint storage and blockchain state from observations.
@@ -135,12 +195,97 @@ func (ss *serviceState) ApplyObservations(observations *qosobservations.Observat
 		return errNilApplyEVMObservations
 	}
 
+	ss.serviceStateLock.RLock()
+	forkCheckBlockNumber := ss.forkState.blockNumberHex
+	ss.serviceStateLock.RUnlock()
+
 	updatedEndpoints := ss.endpointStore.updateEndpointsFromObservations(
 		evmObservations,
-		ss.archivalState.blockNumberHex,
+		forkCheckBlockNumber,
 	)
 
-	return ss.updateFromEndpoints(updatedEndpoints)
+	if err := ss.updateFromEndpoints(updatedEndpoints); err != nil {
+		return err
+	}
+
+	// Block hashes are compared across all endpoints, so only update the fork state on a new fork check observation.
+	if hasForkCheckObservation(evmObservations, forkCheckBlockNumber) {
+		ss.updateForkState()
+	}
+
+	// Archival probe values are compared across all endpoints, so only update the archival state on a new probe observation.
+	if hasArchivalProbeObservation(evmObservations) {
+		ss.updateArchivalState()
+	}
+
+	return nil
+}
+
+// hasForkCheckObservation returns true if the observations contain a
+// response to an `eth_getBlockByNumber` request at the fork check block number.
+func hasForkCheckObservation(evmObservations *qosobservations.EVMRequestObservations, forkCheckBlockNumber string) bool {
+	if forkCheckBlockNumber == "" {
+		return false
+	}
+
+	for _, requestObservation := range evmObservations.GetRequestObservations() {
+		for _, endpointObservation := range requestObservation.GetEndpointObservations() {
+			if endpointObservation.GetGetBlockByNumberResponse().GetBlockNumber() == forkCheckBlockNumber {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasArchivalProbeObservation returns true if the observations contain a response to an archival probe.
+func hasArchivalProbeObservation(evmObservations *qosobservations.EVMRequestObservations) bool {
+	for _, requestObservation := range evmObservations.GetRequestObservations() {
+		for _, endpointObservation := range requestObservation.GetEndpointObservations() {
+			if endpointObservation.GetArchivalProbeResponse() != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// updateArchivalState recomputes the expected value of each archival probe.
+//
+// DEV_NOTE: the endpoints are read before acquiring the service state lock, as the
+// endpoint store lock is always acquired before the service state lock.
+func (ss *serviceState) updateArchivalState() {
+	if !ss.archivalState.isEnabled() {
+		return
+	}
+
+	endpoints := ss.endpointStore.getEndpoints()
+
+	ss.serviceStateLock.Lock()
+	defer ss.serviceStateLock.Unlock()
+
+	ss.archivalState.updateExpectedValues(endpoints)
+}
+
+// updateForkState updates the expected block hash at the fork check block number.
+//
+// DEV_NOTE: the endpoints are read before acquiring the service state lock, as the
+// endpoint store lock is always acquired before the service state lock.
+func (ss *serviceState) updateForkState() {
+	endpoints := ss.endpointStore.getEndpoints()
+
+	ss.serviceStateLock.Lock()
+	defer ss.serviceStateLock.Unlock()
+
+	ss.forkState.updateExpectedBlockHash(endpoints)
+}
+
+// getForkCheckObservation returns an observation of the service's fork check state.
+func (ss *serviceState) getForkCheckObservation() *qosobservations.EVMForkCheckObservation {
+	ss.serviceStateLock.RLock()
+	defer ss.serviceStateLock.RUnlock()
+
+	return ss.forkState.getObservation()
 }
 
 // updateFromEndpoints updates the service state based on new observations from endpoints.
@@ -178,16 +323,27 @@ func (ss *serviceState) updateFromEndpoints(updatedEndpoints map[protocol.Endpoi
 		}
 	}
 
-	// If archival checks are enabled for the service, update the archival state.
-	if ss.archivalState.isEnabled() {
-		// Update the archival state based on the perceived block number.
-		// When the expected balance at the archival block number is known, this becomes a no-op.
-		ss.archivalState.updateArchivalState(ss.perceivedBlockNumber, updatedEndpoints)
-	}
+	// Track the time at which the perceived block number last advanced, to detect a stalled service.
+	ss.stallTracker.UpdateHeight(ss.perceivedBlockNumber)
+
+	// Select the fork check block number based on the perceived block number.
+	ss.forkState.updateBlockNumber(ss.perceivedBlockNumber)
+
+	// Select, or rotate, the archival block number of each archival probe based on the perceived block number.
+	ss.archivalState.updateBlockNumbers(ss.perceivedBlockNumber)
 
 	return nil
 }
 
+// getArchivalProbeResults returns the endpoint's result for each archival probe, and an error if it fails any of them.
+// Returns nil results if archival checks are not enabled for the service.
+func (ss *serviceState) getArchivalProbeResults(check endpointCheckArchival) (map[string]devtools.QoSArchivalProbeResult, error) {
+	ss.serviceStateLock.RLock()
+	defer ss.serviceStateLock.RUnlock()
+
+	return ss.archivalState.getProbeResults(check), ss.archivalState.isArchivalValid(check)
+}
+
 // getDisqualifiedEndpointsResponse gets the QoSLevelDisqualifiedEndpoints map for a devtools.DisqualifiedEndpointResponse.
 // It checks the current service state and populates a map with QoS-level disqualified endpoints.
 // This data is useful for creating a snapshot of the current QoS state for a given service.
@@ -198,6 +354,18 @@ func (ss *serviceState) getDisqualifiedEndpointsResponse(serviceID protocol.Serv
 
 	// Populate the data response object using the endpoints in the endpoint store.
 	for endpointAddr, endpoint := range ss.endpointStore.endpoints {
+		// Endpoints failing the archival check are not disqualified: they only serve requests which do not need archival data.
+		if probeResults, err := ss.getArchivalProbeResults(endpoint.checkArchival); probeResults != nil {
+			if qosLevelDataResponse.ArchivalProbeResults == nil {
+				qosLevelDataResponse.ArchivalProbeResults = make(map[protocol.EndpointAddr]map[string]devtools.QoSArchivalProbeResult)
+			}
+			qosLevelDataResponse.ArchivalProbeResults[endpointAddr] = probeResults
+
+			if err != nil {
+				qosLevelDataResponse.ArchivalCheckErrorsCount++
+			}
+		}
+
 		if err := ss.basicEndpointValidation(endpoint); err != nil {
 			qosLevelDataResponse.DisqualifiedEndpoints[endpointAddr] = devtools.QoSDisqualifiedEndpoint{
 				EndpointAddr: endpointAddr,
@@ -221,10 +389,18 @@ func (ss *serviceState) getDisqualifiedEndpointsResponse(serviceID protocol.Serv
 				errors.Is(err, errInvalidChainIDObs):
 				qosLevelDataResponse.ChainIDCheckErrorsCount++
 
-			// Endpoint is disqualified due to a missing or invalid archival balance.
-			case errors.Is(err, errNoArchivalBalanceObs),
-				errors.Is(err, errInvalidArchivalBalanceObs):
-				qosLevelDataResponse.ArchivalCheckErrorsCount++
+			// Endpoint is disqualified due to a missing or mismatched block hash at the fork check block number.
+			case errors.Is(err, errNoBlockHashObs),
+				errors.Is(err, errBlockHashMismatchObs):
+				qosLevelDataResponse.ForkCheckErrorsCount++
+
+			// Endpoint is disqualified due to reporting it is syncing.
+			case errors.Is(err, errSyncingObs):
+				qosLevelDataResponse.SyncingCheckErrorsCount++
+
+			// Endpoint is disqualified due to a peer count below the minimum peer count.
+			case errors.Is(err, errBelowMinPeerCountObs):
+				qosLevelDataResponse.PeerCountCheckErrorsCount++
 
 			default:
 				ss.logger.Error().Err(err).Msgf("SHOULD NEVER HAPPEN: unknown error for endpoint: %s", endpointAddr)
@@ -3,90 +3,78 @@ package evm
 import (
 	"fmt"
 	"math/rand"
-	"sync"
 	"time"
 
 	"github.com/pokt-network/poktroll/pkg/polylog"
 
+	"github.com/buildwithgrove/path/metrics/devtools"
 	"github.com/buildwithgrove/path/protocol"
 )
 
-// archivalConsensusThreshold is the # of endpoints that must agree on the balance:
-//   - at the `archivalState.blockNumberHex`
-//   - for the contract specified in `archivalCheckConfig.ContractAddress`
-//
-// Once a consensus is reached, the balance is set in `expectedBalance`.
-const archivalConsensusThreshold = 5
+// archivalProbeRotationInterval is the interval after which a new historical block number is selected for each archival probe.
+// The expected value is then computed again from endpoints' responses at the new block number.
+const archivalProbeRotationInterval = time.Hour
 
 // The archival check verifies that nodes can provide accurate historical blockchain data.
 //
 // Here's how it works:
-//   - Uses a specific contract with frequent balance changes (e.g. USDC) - `archivalCheckConfig.ContractAddress`
-//   - Selects a random historical block from the past for `blockNumberHex`.
-//   - >= 5 endpoints agree on the balance at `blockNumberHex`, `expectedBalance` is set.
-//   - When filtering valid endpoints their `observedArchivalBalance` is validated against `expectedBalance`.
+//   - Each of the service's probes queries a contract's state, e.g. its balance, using `eth_getBalance`, `eth_getCode` or `eth_call`.
+//   - A random historical block is selected for each probe, and replaced every `archivalProbeRotationInterval`.
+//   - A majority of >= `consensusThreshold` endpoints agreeing on the probe's value at the block sets the expected value.
+//   - The expected value is recomputed on every new probe observation: a new majority overturns the previous one.
+//   - An endpoint is archival if its observed values match the expected values of all the probes.
 type archivalState struct {
 	logger polylog.Logger
 
 	// archivalCheckConfig contains all configurable values for an EVM archival check.
 	archivalCheckConfig evmArchivalCheckConfig
 
-	// balanceConsensus is a map where:
-	//   - key: hex balance value for the archival block number
-	//   - value: number of endpoints that reported the balance
-	// eg. {"0x1ce31607bc8f16a8c53d80": 5, "0x1ce31607bc8f16a8c53d81": 3}
-	//
-	// When a single hex balance is agreed on by >= 5 endpoints, it is set as the expected archival balance.
-	//
-	// Once the expected balance is set, the balanceConsensus map is cleared and no longer used.
-	balanceConsensus map[string]int
+	// probes holds the state of each of the archival check's probes, in the order they are configured.
+	probes []archivalProbeState
+}
+
+// archivalProbeState is the state of a single archival probe.
+//
+// The previous round is kept after a new block number is selected: endpoints are validated against
+// the previous round's expected value until they have been checked, and a consensus reached, at the new block number.
+type archivalProbeState struct {
+	probe ArchivalProbe
+
+	current  archivalProbeRound
+	previous archivalProbeRound
+}
 
-	// blockNumberHex is the archival block number for which to check the balance of the contract, eg. 0x3f8627c.
+// archivalProbeRound is an archival probe's expected value at a historical block number.
+type archivalProbeRound struct {
+	// blockNumberHex is the archival block number at which the probe is run, eg. 0x3f8627c.
 	//
-	// It is calculated using the `calculateArchivalBlockNumber` method, which selects a block from the range:
-	// 		- earliest possible block = <archivalCheckConfig.ContractStartBlock>
-	// 		- latest possible block = <perceivedBlockNumber> - <archivalCheckConfig.Threshold>
+	// It is selected using the `selectArchivalBlockNumber` function, from the range:
+	// 		- earliest possible block = <probe.ContractStartBlock>
+	// 		- latest possible block = <perceivedBlockNumber> - <archivalCheckConfig.threshold>
 	//
-	// Example: <archivalCheckConfig.ContractStartBlock> = 15, <perceivedBlockNumber> = 100, <archivalCheckConfig.Threshold> = 10
-	//     		the calculated block number will be between 15 and 90.
+	// Example: <probe.ContractStartBlock> = 15, <perceivedBlockNumber> = 100, <archivalCheckConfig.threshold> = 10
+	//     		the selected block number will be between 15 and 90.
 	blockNumberHex string
 
-	// expectedBalance is the agreed upon balance:
-	//   - at the block number specified in `blockNumberHex`
-	//   - for the contract specified in `archivalCheckConfig.ContractAddress`
-	//
-	// It is set once >= 5 endpoints agree on the balance at `blockNumberHex`.
-	//
-	// Once a consensus is reached, the balance is set in `expectedBalance` and `updateArchivalState` becomes a no-op.
-	expectedBalance string
+	// selectedAt is the time at which `blockNumberHex` was selected.
+	selectedAt time.Time
 
-	// TODO_IMPROVE(@commoddity): set an expiry time for the `expectedBalance` so
-	// that a new expected balance can be calculated, eg. every hour or two.
+	// expectedValue is the probe's value at `blockNumberHex` agreed on by a majority of endpoints.
+	// It is empty until a consensus is reached.
+	expectedValue string
 }
 
-// updateArchivalState updates the archival state, to determine the `archivalState.expectedBalance`.
-// This is done by:
-//  1. Calculating an archival block number based on the perceived block number.
-//  2. Getting the expected balance at the computed archival block number.
-//
-// IMPORTANT: This function become a no-op once `archivalState.expectedBalance` is set.
-func (as *archivalState) updateArchivalState(
-	perceivedBlockNumber uint64,
-	updatedEndpoints map[protocol.EndpointAddr]endpoint,
-) {
-	// If the expected archival balance is already set, there is no need to update the archival state.
-	if as.expectedBalance != "" {
-		return
+// newArchivalState returns the archival state for the supplied archival check configuration.
+func newArchivalState(logger polylog.Logger, archivalCheckConfig evmArchivalCheckConfig) archivalState {
+	probes := make([]archivalProbeState, 0, len(archivalCheckConfig.probes))
+	for _, probe := range archivalCheckConfig.probes {
+		probes = append(probes, archivalProbeState{probe: probe})
 	}
 
-	// If the archival block number is not yet set for the service, calculate it.
-	if perceivedBlockNumber != 0 && as.blockNumberHex == "" {
-		as.calculateArchivalBlockNumber(perceivedBlockNumber)
-	}
-
-	// If the expected archival balance is not yet set for the service, set it.
-	if as.blockNumberHex != "" && as.expectedBalance == "" {
-		as.updateExpectedBalance(updatedEndpoints)
+	return archivalState{
+		logger:              logger,
+		archivalCheckConfig: archivalCheckConfig,
+		probes:              probes,
 	}
 }
 
@@ -96,33 +84,51 @@ func (as *archivalState) isEnabled() bool {
 	return !as.archivalCheckConfig.IsEmpty()
 }
 
-// calculateArchivalBlockNumber determines a, archival block number based on the perceived block number.
-// See comment on `archivalState.blockNumberHex` in `archivalState` struct for more details on the calculation.
-func (as *archivalState) calculateArchivalBlockNumber(perceivedBlockNumber uint64) {
-	archivalThreshold := as.archivalCheckConfig.threshold
-	minArchivalBlock := as.archivalCheckConfig.contractStartBlock
+// updateBlockNumbers selects the archival block number of each probe based on the perceived block number.
+// A new block number is selected if it is not yet set, or if the current one is older than `archivalProbeRotationInterval`.
+func (as *archivalState) updateBlockNumbers(perceivedBlockNumber uint64) {
+	if perceivedBlockNumber == 0 {
+		return
+	}
+
+	for i := range as.probes {
+		probeState := &as.probes[i]
+		if probeState.current.blockNumberHex != "" && time.Since(probeState.current.selectedAt) <= archivalProbeRotationInterval {
+			continue
+		}
+
+		probeState.previous = probeState.current
+		probeState.current = archivalProbeRound{
+			blockNumberHex: selectArchivalBlockNumber(perceivedBlockNumber, as.archivalCheckConfig.threshold, probeState.probe.ContractStartBlock),
+			selectedAt:     time.Now(),
+		}
 
-	var blockNumHex string
+		as.logger.Info().
+			Str("archival_probe", probeState.probe.Name).
+			Msgf("Selected archival block number: %s", probeState.current.blockNumberHex)
+	}
+}
+
+// selectArchivalBlockNumber selects a random archival block number based on the perceived block number.
+// See comment on `archivalProbeRound.blockNumberHex` for more details on the calculation.
+func selectArchivalBlockNumber(perceivedBlockNumber, archivalThreshold, minArchivalBlock uint64) string {
 	// Case 1: Block number is below or equal to the archival threshold
 	if perceivedBlockNumber <= archivalThreshold {
-		blockNumHex = blockNumberToHex(1)
-	} else {
-		// Case 2: Block number is above the archival threshold
-		maxBlockNumber := perceivedBlockNumber - archivalThreshold
-
-		// Ensure we don't go below the minimum archival block
-		if maxBlockNumber < minArchivalBlock {
-			blockNumHex = blockNumberToHex(minArchivalBlock)
-		} else {
-			// Generate a random block number within valid range
-			r := rand.New(rand.NewSource(time.Now().UnixNano()))
-			rangeSize := maxBlockNumber - minArchivalBlock + 1
-			blockNumHex = blockNumberToHex(minArchivalBlock + (r.Uint64() % rangeSize))
-		}
+		return blockNumberToHex(1)
 	}
 
-	as.logger.Info().Msgf("Calculated archival block number: %s", blockNumHex)
-	as.blockNumberHex = blockNumHex
+	// Case 2: Block number is above the archival threshold
+	maxBlockNumber := perceivedBlockNumber - archivalThreshold
+
+	// Ensure we don't go below the minimum archival block
+	if maxBlockNumber < minArchivalBlock {
+		return blockNumberToHex(minArchivalBlock)
+	}
+
+	// Generate a random block number within valid range
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	rangeSize := maxBlockNumber - minArchivalBlock + 1
+	return blockNumberToHex(minArchivalBlock + (r.Uint64() % rangeSize))
 }
 
 // blockNumberToHex converts a integer block number to its hexadecimal representation.
@@ -131,87 +137,179 @@ func blockNumberToHex(blockNumber uint64) string {
 	return fmt.Sprintf("0x%x", blockNumber)
 }
 
-// updateExpectedBalance checks for consensus of the expected balance at a specific height.
+// updateExpectedValues recomputes the expected value of each probe at its current archival block number.
+// All endpoints are considered, not only the updated ones, as a majority is computed from their latest observations.
 //
-// `archivalConsensusThreshold` endpoints must agree on the same balance for it to be set as the expected archival balance.
-func (as *archivalState) updateExpectedBalance(updatedEndpoints map[protocol.EndpointAddr]endpoint) {
-	// Parallelize balance fetching and consensus because some chains have very low block latencies (e.g. arb-one).
-	balanceCh := make(chan string, len(updatedEndpoints))
-	timeout := time.After(5 * time.Second)
-	var wg sync.WaitGroup
-
-	// Loop over all updated endpoints and fetch their balance.
-	for endpointAddr, updatedEndpoint := range updatedEndpoints {
-		wg.Add(1)
-		go func(endpointAddr protocol.EndpointAddr, updatedEndpoint endpoint) {
-			defer wg.Done()
-			balance, err := updatedEndpoint.checkArchival.getArchivalBalance()
-			if err != nil {
-				as.logger.Warn().Err(err).Msgf("⚠️ SKIPPING endpoint because it has no observed archival balance: %s", endpointAddr)
-				return
-			}
-			balanceCh <- balance
-		}(endpointAddr, updatedEndpoint)
-	}
-
-	// Close the channel when all goroutines are done
-	go func() {
-		wg.Wait()
-		close(balanceCh)
-	}()
-
-	// Tally consensus as results arrive
-	for {
-		select {
-		case balance, ok := <-balanceCh:
-			if !ok {
-				// Channel closed, exit function
-				return
+// At least `consensusThreshold` endpoints, and a majority of the endpoints which returned a value, must agree on
+// the same value for it to be set as the expected value. A new majority value overturns the previous expected value.
+func (as *archivalState) updateExpectedValues(endpoints map[protocol.EndpointAddr]endpoint) {
+	consensusThreshold := as.archivalCheckConfig.getConsensusThreshold()
+
+	for i := range as.probes {
+		probeState := &as.probes[i]
+		if probeState.current.blockNumberHex == "" {
+			continue
+		}
+
+		// valueConsensus maps a probe value to the number of endpoints that reported it.
+		valueConsensus := make(map[string]int)
+		var numCheckedEndpoints int
+		for _, endpoint := range endpoints {
+			observation := endpoint.checkArchival.getProbe(probeState.probe.Name).latest
+			if observation.blockNumberHex != probeState.current.blockNumberHex || observation.value == "" {
+				continue
 			}
-			count := as.balanceConsensus[balance] + 1
-			as.balanceConsensus[balance] = count
-			if count >= archivalConsensusThreshold {
-				as.expectedBalance = balance
-				as.logger.Info().
-					Str("archival_block_number", as.blockNumberHex).
-					Str("contract_address", as.archivalCheckConfig.contractAddress).
-					Str("expected_balance", balance).
-					Msg("Updated expected archival balance")
-				as.balanceConsensus = make(map[string]int)
-				return
+			valueConsensus[observation.value]++
+			numCheckedEndpoints++
+		}
+
+		var majorityValue string
+		for value, count := range valueConsensus {
+			if count >= consensusThreshold && count*2 > numCheckedEndpoints {
+				majorityValue = value
+				break
 			}
-		case <-timeout:
-			as.logger.Warn().Msg("Timeout while waiting for archival balance consensus")
-			return
 		}
+
+		// No majority value: keep the current expected value, if any.
+		if majorityValue == "" || majorityValue == probeState.current.expectedValue {
+			continue
+		}
+
+		if probeState.current.expectedValue != "" {
+			as.logger.Warn().
+				Str("archival_probe", probeState.probe.Name).
+				Str("archival_block_number", probeState.current.blockNumberHex).
+				Str("previous_expected_value", probeState.current.expectedValue).
+				Str("new_expected_value", majorityValue).
+				Msg("Overturned expected archival probe value: a new majority of endpoints agrees on a different value")
+		} else {
+			as.logger.Info().
+				Str("archival_probe", probeState.probe.Name).
+				Str("archival_block_number", probeState.current.blockNumberHex).
+				Str("contract_address", probeState.probe.ContractAddress).
+				Str("expected_value", majorityValue).
+				Msg("Updated expected archival probe value")
+		}
+
+		probeState.current.expectedValue = majorityValue
 	}
 }
 
-// isArchivalBalanceValid returns an error if the endpoint's observed
-// archival balance does not match the expected archival balance.
-func (as *archivalState) isArchivalBalanceValid(check endpointCheckArchival) error {
+// isArchivalValid returns an error if the endpoint fails any of the archival probes.
+// Always returns nil if archival checks are not enabled for the service.
+func (as *archivalState) isArchivalValid(check endpointCheckArchival) error {
 	if !as.isEnabled() {
 		return nil
 	}
 
-	if check.observedArchivalBalance == "" {
-		return errNoArchivalBalanceObs
-	}
-	if check.observedArchivalBalance != as.expectedBalance {
-		return errInvalidArchivalBalanceObs
+	for _, probeState := range as.probes {
+		if err := probeState.isValid(check.getProbe(probeState.probe.Name)); err != nil {
+			return fmt.Errorf("archival probe %q: %w", probeState.probe.Name, err)
+		}
 	}
 
 	return nil
 }
 
-// shouldArchivalCheckRun returns true if the archival check is not yet initialized or has expired.
-func (as *archivalState) shouldArchivalCheckRun(check endpointCheckArchival) bool {
-	// Do not perform an archival check if:
-	// 	- The archival check is not enabled for the service.
-	// 	- The archival block number has not yet been set in the archival state.
-	if !as.isEnabled() || as.blockNumberHex == "" {
-		return false
+// isValid returns an error if none of the endpoint's observations match the expected value of the probe's current or previous round.
+func (ps archivalProbeState) isValid(check endpointCheckArchivalProbe) error {
+	for _, round := range []archivalProbeRound{ps.current, ps.previous} {
+		for _, observation := range []archivalProbeObservation{check.latest, check.previous} {
+			if round.matches(observation) {
+				return nil
+			}
+		}
+	}
+
+	switch {
+	case ps.current.expectedValue == "" && ps.previous.expectedValue == "":
+		return errNoArchivalProbeConsensus
+	case !ps.hasObservedRound(check):
+		return errNoArchivalProbeObs
+	default:
+		return fmt.Errorf("%w: value %q at block number %s", errInvalidArchivalProbeObs, check.latest.value, check.latest.blockNumberHex)
+	}
+}
+
+// hasObservedRound returns true if the endpoint returned a value at the block number of a round with an expected value.
+func (ps archivalProbeState) hasObservedRound(check endpointCheckArchivalProbe) bool {
+	for _, round := range []archivalProbeRound{ps.current, ps.previous} {
+		for _, observation := range []archivalProbeObservation{check.latest, check.previous} {
+			if round.expectedValue != "" && observation.value != "" && observation.blockNumberHex == round.blockNumberHex {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matches returns true if the observation is a value, at the round's block number, matching the round's expected value.
+func (r archivalProbeRound) matches(observation archivalProbeObservation) bool {
+	return r.expectedValue != "" &&
+		observation.blockNumberHex == r.blockNumberHex &&
+		observation.value == r.expectedValue
+}
+
+// getProbesToRun returns the requests of the archival probes which the endpoint should be checked with:
+// those not yet run at the probe's current block number, or whose check has expired.
+func (as *archivalState) getProbesToRun(check endpointCheckArchival) []archivalProbeRequest {
+	// Do not perform an archival check if the archival check is not enabled for the service.
+	if !as.isEnabled() {
+		return nil
+	}
+
+	var probeRequests []archivalProbeRequest
+	for _, probeState := range as.probes {
+		// Do not run the probe if its archival block number has not yet been set.
+		if probeState.current.blockNumberHex == "" {
+			continue
+		}
+
+		probeCheck := check.getProbe(probeState.probe.Name)
+		if probeCheck.latest.blockNumberHex == probeState.current.blockNumberHex && probeCheck.expiresAt.After(time.Now()) {
+			continue
+		}
+
+		probeRequests = append(probeRequests, archivalProbeRequest{
+			probeName:      probeState.probe.Name,
+			blockNumberHex: probeState.current.blockNumberHex,
+		})
+	}
+
+	return probeRequests
+}
+
+// getProbe returns the archival probe with the supplied name.
+func (as *archivalState) getProbe(probeName string) (ArchivalProbe, bool) {
+	for _, probeState := range as.probes {
+		if probeState.probe.Name == probeName {
+			return probeState.probe, true
+		}
+	}
+	return ArchivalProbe{}, false
+}
+
+// getProbeResults returns the endpoint's pass/fail state for each of the archival probes.
+// Returns nil if archival checks are not enabled for the service.
+func (as *archivalState) getProbeResults(check endpointCheckArchival) map[string]devtools.QoSArchivalProbeResult {
+	if !as.isEnabled() {
+		return nil
+	}
+
+	probeResults := make(map[string]devtools.QoSArchivalProbeResult, len(as.probes))
+	for _, probeState := range as.probes {
+		probeCheck := check.getProbe(probeState.probe.Name)
+		probeResult := devtools.QoSArchivalProbeResult{
+			BlockNumber: probeCheck.latest.blockNumberHex,
+			Passed:      true,
+		}
+		if err := probeState.isValid(probeCheck); err != nil {
+			probeResult.Passed = false
+			probeResult.Reason = err.Error()
+		}
+		probeResults[probeState.probe.Name] = probeResult
 	}
 
-	return check.expiresAt.IsZero() || check.expiresAt.Before(time.Now())
+	return probeResults
 }
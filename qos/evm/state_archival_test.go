@@ -0,0 +1,185 @@
+package evm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildwithgrove/path/protocol"
+)
+
+// newTestArchivalState returns an archival state with a balance probe and a code probe.
+func newTestArchivalState() archivalState {
+	archivalCheckConfig := NewEVMArchivalProbesCheckConfig(
+		ArchivalProbe{Name: "balance", Method: methodGetBalance, ContractAddress: "0xdead", ContractStartBlock: 100},
+		ArchivalProbe{Name: "code", Method: methodGetCode, ContractAddress: "0xbeef", ContractStartBlock: 200},
+	)
+	archivalCheckConfig.consensusThreshold = 3
+	return newArchivalState(polyzero.NewLogger(), *archivalCheckConfig)
+}
+
+func TestArchivalState_UpdateBlockNumbers(t *testing.T) {
+	as := newTestArchivalState()
+
+	as.updateBlockNumbers(0)
+	require.Empty(t, as.probes[0].current.blockNumberHex, "archival block number should not be set before the perceived block number is known")
+
+	as.updateBlockNumbers(10_000)
+	for _, probeState := range as.probes {
+		blockNumber, ok := parseHexUint64(probeState.current.blockNumberHex)
+		require.True(t, ok)
+		require.GreaterOrEqual(t, blockNumber, probeState.probe.ContractStartBlock)
+		require.LessOrEqual(t, blockNumber, uint64(10_000-DefaultEVMArchivalThreshold))
+	}
+
+	// The block number is not rotated until the current one is outdated.
+	as.probes[0].current.expectedValue = "0xaaa"
+	currentRound := as.probes[0].current
+	as.updateBlockNumbers(20_000)
+	require.Equal(t, currentRound, as.probes[0].current)
+
+	// An outdated block number is rotated: the current round becomes the previous round.
+	as.probes[0].current.selectedAt = time.Now().Add(-2 * archivalProbeRotationInterval)
+	outdatedRound := as.probes[0].current
+	as.updateBlockNumbers(20_000)
+	require.Equal(t, outdatedRound, as.probes[0].previous)
+	require.NotEmpty(t, as.probes[0].current.blockNumberHex)
+	require.Empty(t, as.probes[0].current.expectedValue)
+	require.Empty(t, as.probes[1].previous.blockNumberHex, "only the outdated probe should be rotated")
+}
+
+func TestArchivalState_UpdateExpectedValues(t *testing.T) {
+	const blockNumberHex = "0x3e8"
+
+	tests := []struct {
+		name                 string
+		values               []string
+		initialExpectedValue string
+		expectedValue        string
+	}{
+		{
+			name:   "no expected value without the consensus threshold of agreeing endpoints",
+			values: []string{"0xaaa", "0xaaa"},
+		},
+		{
+			name:   "no expected value without a majority of agreeing endpoints",
+			values: []string{"0xaaa", "0xaaa", "0xaaa", "0xbbb", "0xbbb", "0xbbb"},
+		},
+		{
+			name:          "errors are not counted against the majority",
+			values:        []string{"0xaaa", "0xaaa", "0xaaa", "", "", "", ""},
+			expectedValue: "0xaaa",
+		},
+		{
+			name:                 "a new majority value overturns the previous expected value",
+			values:               []string{"0xbbb", "0xbbb", "0xbbb", "0xaaa"},
+			initialExpectedValue: "0xaaa",
+			expectedValue:        "0xbbb",
+		},
+		{
+			name:                 "the expected value is kept without a majority",
+			values:               []string{"0xbbb", "0xaaa"},
+			initialExpectedValue: "0xaaa",
+			expectedValue:        "0xaaa",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			as := newTestArchivalState()
+			as.probes[0].current = archivalProbeRound{blockNumberHex: blockNumberHex, expectedValue: tt.initialExpectedValue}
+
+			endpoints := make(map[protocol.EndpointAddr]endpoint)
+			for i, value := range tt.values {
+				var check endpointCheckArchival
+				endpoints[protocol.EndpointAddr(blockNumberToHex(uint64(i)))] = endpoint{
+					checkArchival: check.withObservation("balance", archivalProbeObservation{blockNumberHex: blockNumberHex, value: value}),
+				}
+			}
+			// Endpoints checked at a different block number are ignored.
+			var staleCheck endpointCheckArchival
+			endpoints["stale-endpoint"] = endpoint{
+				checkArchival: staleCheck.withObservation("balance", archivalProbeObservation{blockNumberHex: "0x1", value: "0xccc"}),
+			}
+
+			as.updateExpectedValues(endpoints)
+
+			require.Equal(t, tt.expectedValue, as.probes[0].current.expectedValue)
+			require.Empty(t, as.probes[1].current.expectedValue, "other probes should not be affected")
+		})
+	}
+}
+
+func TestArchivalState_IsArchivalValid(t *testing.T) {
+	as := newTestArchivalState()
+	as.probes[0].current = archivalProbeRound{blockNumberHex: "0x3e8", expectedValue: "0xaaa"}
+	as.probes[1].current = archivalProbeRound{blockNumberHex: "0x7d0", expectedValue: "0xc0de"}
+
+	var check endpointCheckArchival
+	require.ErrorIs(t, as.isArchivalValid(check), errNoArchivalProbeObs)
+
+	check = check.withObservation("balance", archivalProbeObservation{blockNumberHex: "0x3e8", value: "0xaaa"})
+	require.ErrorIs(t, as.isArchivalValid(check), errNoArchivalProbeObs, "the endpoint must pass all the probes")
+
+	check = check.withObservation("code", archivalProbeObservation{blockNumberHex: "0x7d0", value: "0xbad"})
+	require.ErrorIs(t, as.isArchivalValid(check), errInvalidArchivalProbeObs)
+
+	check = check.withObservation("code", archivalProbeObservation{blockNumberHex: "0x7d0", value: "0xc0de"})
+	require.NoError(t, as.isArchivalValid(check))
+
+	// Pruned endpoints return an error instead of a value.
+	check = check.withObservation("code", archivalProbeObservation{blockNumberHex: "0x7d0"})
+	require.ErrorIs(t, as.isArchivalValid(check), errNoArchivalProbeObs)
+
+	// Endpoints are not archival until a consensus is reached.
+	as.probes[1].current.expectedValue = ""
+	check = check.withObservation("code", archivalProbeObservation{blockNumberHex: "0x7d0", value: "0xc0de"})
+	require.ErrorIs(t, as.isArchivalValid(check), errNoArchivalProbeConsensus)
+}
+
+func TestArchivalState_IsArchivalValid_AfterRotation(t *testing.T) {
+	as := newTestArchivalState()
+	as.probes = as.probes[:1]
+	as.probes[0].previous = archivalProbeRound{blockNumberHex: "0x3e8", expectedValue: "0xaaa"}
+	as.probes[0].current = archivalProbeRound{blockNumberHex: "0x5dc"}
+
+	var check endpointCheckArchival
+	check = check.withObservation("balance", archivalProbeObservation{blockNumberHex: "0x3e8", value: "0xaaa"})
+
+	// The endpoint passed the previous round, and is checked at the new block number before a consensus is reached.
+	check = check.withObservation("balance", archivalProbeObservation{blockNumberHex: "0x5dc", value: "0xbbb"})
+	require.Equal(t, "0x3e8", check.getProbe("balance").previous.blockNumberHex)
+	require.NoError(t, as.isArchivalValid(check))
+
+	// Once a consensus is reached on the current round, the endpoint is still valid through the previous round.
+	as.probes[0].current.expectedValue = "0xccc"
+	require.NoError(t, as.isArchivalValid(check))
+
+	// The previous round is dropped on the next rotation.
+	as.probes[0].previous = as.probes[0].current
+	as.probes[0].current = archivalProbeRound{blockNumberHex: "0x7d0"}
+	require.ErrorIs(t, as.isArchivalValid(check), errInvalidArchivalProbeObs)
+}
+
+func TestArchivalState_GetProbesToRun(t *testing.T) {
+	as := newTestArchivalState()
+
+	var check endpointCheckArchival
+	require.Empty(t, as.getProbesToRun(check), "probes should not run before their archival block number is set")
+
+	as.probes[0].current = archivalProbeRound{blockNumberHex: "0x3e8"}
+	as.probes[1].current = archivalProbeRound{blockNumberHex: "0x7d0"}
+	require.Equal(t, []archivalProbeRequest{
+		{probeName: "balance", blockNumberHex: "0x3e8"},
+		{probeName: "code", blockNumberHex: "0x7d0"},
+	}, as.getProbesToRun(check))
+
+	check = check.withObservation("balance", archivalProbeObservation{blockNumberHex: "0x3e8", value: "0xaaa"})
+	require.Equal(t, []archivalProbeRequest{{probeName: "code", blockNumberHex: "0x7d0"}}, as.getProbesToRun(check))
+
+	// A probe runs again once a new archival block number is selected.
+	as.probes[0].current = archivalProbeRound{blockNumberHex: "0x5dc"}
+	require.Len(t, as.getProbesToRun(check), 2)
+}
@@ -0,0 +1,201 @@
+package evm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+)
+
+const (
+	// forkCheckConfirmations is the number of blocks behind the perceived block number at which block hashes are compared.
+	// The block is expected to be finalized, i.e. all endpoints on the canonical chain agree on its hash.
+	// 64 blocks is 2 epochs, i.e. the finality delay of Ethereum mainnet.
+	forkCheckConfirmations = 64
+
+	// forkCheckBlockNumberInterval is the interval after which a new, more recent, fork check block number is selected.
+	forkCheckBlockNumberInterval = 10 * time.Minute
+
+	// forkConsensusMinEndpoints is the minimum # of endpoints that must agree on the block hash for it to be
+	// set as the expected block hash. The agreeing endpoints must also be a majority of the checked endpoints.
+	forkConsensusMinEndpoints = 3
+)
+
+// The fork check verifies that endpoints are on the same chain as the majority of endpoints.
+// It detects endpoints on a minority fork, or serving a different network which reuses the chain ID.
+//
+// Here's how it works:
+//   - Selects a recent, expected to be finalized, block number: `blockNumberHex`.
+//   - Endpoints are asked for the hash of the block at `blockNumberHex`.
+//   - A majority of >= 3 endpoints agreeing on the block hash sets `expectedBlockHash`.
+//   - When filtering valid endpoints their observed block hash is validated against `expectedBlockHash`.
+//   - A change in the majority block hash at the same block number is logged as a reorg.
+//   - When a new block number is selected, the previous expected block hash is kept to validate
+//     endpoints until they are checked at the new block number.
+type forkState struct {
+	logger polylog.Logger
+
+	// blockNumber is the block number at which block hashes are compared across endpoints.
+	blockNumber uint64
+	// blockNumberHex is the hex representation of `blockNumber`, eg. 0x3f8627c.
+	// It is empty until the service's perceived block number is known.
+	blockNumberHex string
+	// selectedAt is the time at which `blockNumber` was selected.
+	selectedAt time.Time
+
+	// expectedBlockHash is the block hash at `blockNumberHex` agreed on by a majority of endpoints.
+	expectedBlockHash string
+
+	// previousBlockNumberHex and previousExpectedBlockHash are the block number and expected block hash of the last
+	// fork check block number for which a majority was reached. They are used to validate endpoints which have not
+	// yet been checked at the current block number, i.e. until a majority is reached again.
+	previousBlockNumberHex    string
+	previousExpectedBlockHash string
+
+	// numForkedEndpoints is the # of endpoints whose block hash at `blockNumberHex` does not match `expectedBlockHash`.
+	numForkedEndpoints int
+}
+
+// updateBlockNumber selects the fork check block number based on the perceived block number.
+// A new block number is selected if it is not yet set, or if the current one is outdated.
+func (fs *forkState) updateBlockNumber(perceivedBlockNumber uint64) {
+	if perceivedBlockNumber == 0 {
+		return
+	}
+
+	if fs.blockNumberHex == "" || time.Since(fs.selectedAt) > forkCheckBlockNumberInterval {
+		fs.selectBlockNumber(perceivedBlockNumber)
+	}
+}
+
+// selectBlockNumber sets the fork check block number to the most recent block expected to be finalized.
+// The expected block hash, if any, is kept as the previous expected block hash: the expected block hash
+// is computed again from endpoints' responses at the new block number.
+func (fs *forkState) selectBlockNumber(perceivedBlockNumber uint64) {
+	var blockNumber uint64
+	if perceivedBlockNumber > forkCheckConfirmations {
+		blockNumber = perceivedBlockNumber - forkCheckConfirmations
+	}
+
+	if fs.expectedBlockHash != "" {
+		fs.previousBlockNumberHex = fs.blockNumberHex
+		fs.previousExpectedBlockHash = fs.expectedBlockHash
+	}
+
+	fs.blockNumber = blockNumber
+	fs.blockNumberHex = blockNumberToHex(blockNumber)
+	fs.selectedAt = time.Now()
+	fs.expectedBlockHash = ""
+	fs.numForkedEndpoints = 0
+
+	fs.logger.Info().Msgf("Selected fork check block number: %s", fs.blockNumberHex)
+}
+
+// updateExpectedBlockHash checks for a majority block hash at the fork check block number.
+// All endpoints are considered, not only the updated ones, as a majority is computed from their latest block hashes.
+//
+// At least `forkConsensusMinEndpoints` endpoints, and a majority of the checked endpoints, must agree on
+// the same block hash for it to be set as the expected block hash.
+// If the majority block hash changes, a reorg deeper than the fork check confirmations is assumed.
+// The depth of such a reorg is not known: only the block hashes at the fork check block number are compared.
+func (fs *forkState) updateExpectedBlockHash(endpoints map[protocol.EndpointAddr]endpoint) {
+	// hashConsensus maps a block hash to the number of endpoints that reported it.
+	hashConsensus := make(map[string]int)
+	var numCheckedEndpoints int
+	for _, endpoint := range endpoints {
+		if endpoint.checkFork.blockNumberHex != fs.blockNumberHex || endpoint.checkFork.blockHash == "" {
+			continue
+		}
+		hashConsensus[endpoint.checkFork.blockHash]++
+		numCheckedEndpoints++
+	}
+
+	var majorityBlockHash string
+	for blockHash, count := range hashConsensus {
+		if count >= forkConsensusMinEndpoints && count*2 > numCheckedEndpoints {
+			majorityBlockHash = blockHash
+			break
+		}
+	}
+
+	// No majority block hash: keep the current expected block hash, if any.
+	if majorityBlockHash == "" {
+		return
+	}
+
+	if fs.expectedBlockHash != "" && fs.expectedBlockHash != majorityBlockHash {
+		fs.logger.Warn().
+			Str("fork_check_block_number", fs.blockNumberHex).
+			Str("previous_block_hash", fs.expectedBlockHash).
+			Str("new_block_hash", majorityBlockHash).
+			Msg("Detected a reorg: the majority block hash at the fork check block number has changed")
+	}
+
+	if fs.expectedBlockHash != majorityBlockHash {
+		fs.logger.Info().
+			Str("fork_check_block_number", fs.blockNumberHex).
+			Str("expected_block_hash", majorityBlockHash).
+			Msg("Updated expected fork check block hash")
+	}
+
+	fs.expectedBlockHash = majorityBlockHash
+	fs.numForkedEndpoints = numCheckedEndpoints - hashConsensus[majorityBlockHash]
+}
+
+// isBlockHashValid returns an error if the endpoint's observed block hash
+// does not match the expected block hash at the block number it was checked at.
+// Endpoints checked at the previous fork check block number are validated against the previous expected block hash.
+//
+// Endpoints are not disqualified until an expected block hash is known
+// at the fork check block number they have been checked at.
+func (fs *forkState) isBlockHashValid(check endpointCheckFork) error {
+	var expectedBlockHash string
+	switch check.blockNumberHex {
+	case fs.blockNumberHex:
+		expectedBlockHash = fs.expectedBlockHash
+	case fs.previousBlockNumberHex:
+		expectedBlockHash = fs.previousExpectedBlockHash
+	}
+
+	if expectedBlockHash == "" {
+		return nil
+	}
+
+	if check.blockHash == "" {
+		return fmt.Errorf("%w: block number %s", errNoBlockHashObs, check.blockNumberHex)
+	}
+	if check.blockHash != expectedBlockHash {
+		return fmt.Errorf("%w: block hash %s at block number %s does not match expected block hash %s",
+			errBlockHashMismatchObs, check.blockHash, check.blockNumberHex, expectedBlockHash)
+	}
+
+	return nil
+}
+
+// shouldForkCheckRun returns true if the endpoint has not been checked at the
+// current fork check block number, or if the check has expired.
+func (fs *forkState) shouldForkCheckRun(check endpointCheckFork) bool {
+	// Do not perform a fork check if the fork check block number has not yet been set.
+	if fs.blockNumberHex == "" {
+		return false
+	}
+
+	return check.blockNumberHex != fs.blockNumberHex || check.expiresAt.Before(time.Now())
+}
+
+// getObservation returns an observation of the fork check state.
+// Returns nil if the fork check block number has not yet been set.
+func (fs *forkState) getObservation() *qosobservations.EVMForkCheckObservation {
+	if fs.blockNumberHex == "" {
+		return nil
+	}
+
+	return &qosobservations.EVMForkCheckObservation{
+		BlockNumber:        fs.blockNumberHex,
+		ExpectedBlockHash:  fs.expectedBlockHash,
+		NumForkedEndpoints: uint32(fs.numForkedEndpoints),
+	}
+}
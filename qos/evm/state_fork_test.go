@@ -0,0 +1,127 @@
+package evm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildwithgrove/path/protocol"
+)
+
+func TestForkState_UpdateBlockNumber(t *testing.T) {
+	fs := &forkState{logger: polyzero.NewLogger()}
+
+	fs.updateBlockNumber(0)
+	require.Empty(t, fs.blockNumberHex, "fork check block number should not be set before the perceived block number is known")
+
+	fs.updateBlockNumber(1000)
+	require.Equal(t, blockNumberToHex(1000-forkCheckConfirmations), fs.blockNumberHex)
+
+	// The block number is not updated until the current one is outdated.
+	fs.expectedBlockHash = "0xaaa"
+	fs.updateBlockNumber(1100)
+	require.Equal(t, blockNumberToHex(1000-forkCheckConfirmations), fs.blockNumberHex)
+	require.Equal(t, "0xaaa", fs.expectedBlockHash)
+
+	// An outdated block number is replaced, and the expected block hash is kept as the previous one.
+	fs.selectedAt = time.Now().Add(-2 * forkCheckBlockNumberInterval)
+	fs.updateBlockNumber(1100)
+	require.Equal(t, blockNumberToHex(1100-forkCheckConfirmations), fs.blockNumberHex)
+	require.Empty(t, fs.expectedBlockHash)
+	require.Equal(t, blockNumberToHex(1000-forkCheckConfirmations), fs.previousBlockNumberHex)
+	require.Equal(t, "0xaaa", fs.previousExpectedBlockHash)
+
+	// The previous expected block hash is kept until a majority is reached at a newer block number.
+	fs.selectedAt = time.Now().Add(-2 * forkCheckBlockNumberInterval)
+	fs.updateBlockNumber(1200)
+	require.Equal(t, blockNumberToHex(1000-forkCheckConfirmations), fs.previousBlockNumberHex)
+	require.Equal(t, "0xaaa", fs.previousExpectedBlockHash)
+}
+
+func TestForkState_UpdateExpectedBlockHash(t *testing.T) {
+	const blockNumberHex = "0x3e8"
+
+	tests := []struct {
+		name                       string
+		blockHashes                []string
+		initialExpectedBlockHash   string
+		expectedBlockHash          string
+		expectedNumForkedEndpoints int
+	}{
+		{
+			name:        "no expected block hash without the minimum number of agreeing endpoints",
+			blockHashes: []string{"0xaaa", "0xaaa"},
+		},
+		{
+			name:        "no expected block hash without a majority of agreeing endpoints",
+			blockHashes: []string{"0xaaa", "0xaaa", "0xaaa", "0xbbb", "0xbbb", "0xbbb"},
+		},
+		{
+			name:                       "majority block hash is set as the expected block hash",
+			blockHashes:                []string{"0xaaa", "0xaaa", "0xaaa", "0xbbb"},
+			expectedBlockHash:          "0xaaa",
+			expectedNumForkedEndpoints: 1,
+		},
+		{
+			name:                       "change of the majority block hash is detected as a reorg",
+			blockHashes:                []string{"0xbbb", "0xbbb", "0xbbb", "0xaaa"},
+			initialExpectedBlockHash:   "0xaaa",
+			expectedBlockHash:          "0xbbb",
+			expectedNumForkedEndpoints: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := &forkState{
+				logger:            polyzero.NewLogger(),
+				blockNumber:       1000,
+				blockNumberHex:    blockNumberHex,
+				expectedBlockHash: tt.initialExpectedBlockHash,
+			}
+
+			endpoints := make(map[protocol.EndpointAddr]endpoint)
+			for i, blockHash := range tt.blockHashes {
+				endpoints[protocol.EndpointAddr(blockNumberToHex(uint64(i)))] = endpoint{
+					checkFork: endpointCheckFork{blockNumberHex: blockNumberHex, blockHash: blockHash},
+				}
+			}
+			// Endpoints checked at a different block number are ignored.
+			endpoints["stale-endpoint"] = endpoint{
+				checkFork: endpointCheckFork{blockNumberHex: "0x1", blockHash: "0xccc"},
+			}
+
+			fs.updateExpectedBlockHash(endpoints)
+
+			require.Equal(t, tt.expectedBlockHash, fs.expectedBlockHash)
+			require.Equal(t, tt.expectedNumForkedEndpoints, fs.numForkedEndpoints)
+		})
+	}
+}
+
+func TestForkState_IsBlockHashValid(t *testing.T) {
+	fs := &forkState{
+		blockNumberHex:    "0x3e8",
+		expectedBlockHash: "0xaaa",
+	}
+
+	require.NoError(t, fs.isBlockHashValid(endpointCheckFork{blockNumberHex: "0x3e8", blockHash: "0xaaa"}))
+	require.ErrorIs(t, fs.isBlockHashValid(endpointCheckFork{blockNumberHex: "0x3e8", blockHash: "0xbbb"}), errBlockHashMismatchObs)
+	require.ErrorIs(t, fs.isBlockHashValid(endpointCheckFork{blockNumberHex: "0x3e8"}), errNoBlockHashObs)
+
+	// Endpoints not yet checked at the fork check block number are not disqualified.
+	require.NoError(t, fs.isBlockHashValid(endpointCheckFork{}))
+	require.NoError(t, fs.isBlockHashValid(endpointCheckFork{blockNumberHex: "0x1", blockHash: "0xbbb"}))
+
+	// Endpoints are not disqualified until an expected block hash is known.
+	fs.expectedBlockHash = ""
+	require.NoError(t, fs.isBlockHashValid(endpointCheckFork{blockNumberHex: "0x3e8", blockHash: "0xbbb"}))
+
+	// Endpoints not yet checked at a new fork check block number are validated against the previous expected block hash.
+	fs.previousBlockNumberHex = "0x3a8"
+	fs.previousExpectedBlockHash = "0x999"
+	require.NoError(t, fs.isBlockHashValid(endpointCheckFork{blockNumberHex: "0x3a8", blockHash: "0x999"}))
+	require.ErrorIs(t, fs.isBlockHashValid(endpointCheckFork{blockNumberHex: "0x3a8", blockHash: "0xbbb"}), errBlockHashMismatchObs)
+}
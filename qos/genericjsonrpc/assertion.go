@@ -0,0 +1,67 @@
+package genericjsonrpc
+
+import (
+	"fmt"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/qos/jsonpath"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// evaluate returns the result of the check's assertion on the endpoint's JSON-RPC response.
+//   - The hex_number_near_max assertion only extracts the number here: it is compared to the perceived max at endpoint selection time.
+func (c Check) evaluate(jsonrpcResp jsonrpc.Response) *qosobservations.GenericJsonRpcCheckResult {
+	checkResult := &qosobservations.GenericJsonRpcCheckResult{
+		CheckName: c.Name,
+	}
+
+	if err := c.evaluateAssertion(jsonrpcResp, checkResult); err != nil {
+		checkResult.FailureReason = err.Error()
+		return checkResult
+	}
+
+	checkResult.Passed = true
+	return checkResult
+}
+
+// evaluateAssertion returns an error if the JSON-RPC response does not satisfy the check's assertion.
+// Sets the extracted number on the check result for the hex_number_near_max assertion.
+func (c Check) evaluateAssertion(jsonrpcResp jsonrpc.Response, checkResult *qosobservations.GenericJsonRpcCheckResult) error {
+	if jsonrpcResp.IsError() {
+		return fmt.Errorf("endpoint returned a JSON-RPC error: code %d, message %q", jsonrpcResp.Error.Code, jsonrpcResp.Error.Message)
+	}
+
+	result, err := jsonrpcResp.GetResultAsBytes()
+	if err != nil {
+		return err
+	}
+
+	value, err := jsonpath.Extract(result, c.ResultPath)
+	if err != nil {
+		return err
+	}
+
+	switch c.Assertion {
+	case AssertionEquals:
+		if !jsonpath.ValueEquals(value, c.ExpectedValue) {
+			return fmt.Errorf("value %s at path %q does not equal %q", value, c.ResultPath, c.ExpectedValue)
+		}
+
+	case AssertionNonEmpty:
+		if jsonpath.IsEmptyValue(value) {
+			return fmt.Errorf("value at path %q is empty", c.ResultPath)
+		}
+
+	case AssertionHexNumberNearMax:
+		number, err := jsonpath.ParseNumber(value)
+		if err != nil {
+			return fmt.Errorf("value at path %q is not a number: %w", c.ResultPath, err)
+		}
+		checkResult.Number = &number
+
+	default:
+		return fmt.Errorf("%w: %q", errCheckAssertionInvalid, c.Assertion)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package genericjsonrpc
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+func TestCheck_evaluate(t *testing.T) {
+	tests := []struct {
+		name           string
+		check          Check
+		result         string
+		wantPassed     bool
+		expectedNumber uint64
+	}{
+		{
+			name:       "equals assertion should pass on matching string value",
+			check:      Check{Name: "chain", ResultPath: "chain", Assertion: AssertionEquals, ExpectedValue: "main"},
+			result:     `{"chain":"main"}`,
+			wantPassed: true,
+		},
+		{
+			name:       "equals assertion should pass on matching non-string value",
+			check:      Check{Name: "synced", ResultPath: "synced", Assertion: AssertionEquals, ExpectedValue: "true"},
+			result:     `{"synced":true}`,
+			wantPassed: true,
+		},
+		{
+			name:       "equals assertion should fail on mismatching value",
+			check:      Check{Name: "chain", ResultPath: "chain", Assertion: AssertionEquals, ExpectedValue: "main"},
+			result:     `{"chain":"test"}`,
+			wantPassed: false,
+		},
+		{
+			name:       "non_empty assertion should pass on non-empty value",
+			check:      Check{Name: "peers", Assertion: AssertionNonEmpty},
+			result:     `["peer1"]`,
+			wantPassed: true,
+		},
+		{
+			name:       "non_empty assertion should fail on empty value",
+			check:      Check{Name: "peers", Assertion: AssertionNonEmpty},
+			result:     `[]`,
+			wantPassed: false,
+		},
+		{
+			name:           "hex_number_near_max assertion should extract hex number",
+			check:          Check{Name: "height", Assertion: AssertionHexNumberNearMax},
+			result:         `"0x1b4"`,
+			wantPassed:     true,
+			expectedNumber: 436,
+		},
+		{
+			name:           "hex_number_near_max assertion should extract JSON number",
+			check:          Check{Name: "height", ResultPath: "height", Assertion: AssertionHexNumberNearMax},
+			result:         `{"height":436}`,
+			wantPassed:     true,
+			expectedNumber: 436,
+		},
+		{
+			name:       "hex_number_near_max assertion should fail on non-number value",
+			check:      Check{Name: "height", Assertion: AssertionHexNumberNearMax},
+			result:     `"latest"`,
+			wantPassed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := require.New(t)
+
+			result := json.RawMessage(test.result)
+			checkResult := test.check.evaluate(jsonrpc.Response{
+				ID:      jsonrpc.IDFromInt(1),
+				Version: jsonrpc.Version2,
+				Result:  &result,
+			})
+
+			c.Equal(test.check.Name, checkResult.GetCheckName())
+			c.Equal(test.wantPassed, checkResult.GetPassed())
+			c.Equal(test.expectedNumber, checkResult.GetNumber())
+			if !test.wantPassed {
+				c.NotEmpty(checkResult.GetFailureReason())
+			}
+		})
+	}
+}
+
+func TestCheck_evaluate_JSONRPCError(t *testing.T) {
+	c := require.New(t)
+
+	check := Check{Name: "height", Assertion: AssertionNonEmpty}
+	checkResult := check.evaluate(jsonrpc.GetErrorResponse(jsonrpc.IDFromInt(1), -32601, "method not found", nil))
+
+	c.False(checkResult.GetPassed())
+	c.Contains(checkResult.GetFailureReason(), "method not found")
+}
@@ -0,0 +1,60 @@
+package genericjsonrpc
+
+import (
+	"github.com/buildwithgrove/path/gateway"
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// checkRequestIDBase is the base of the JSON-RPC IDs of check requests.
+// Each check uses its own ID, i.e. checkRequestIDBase plus the check's index, to avoid potential conflicts.
+const checkRequestIDBase = 1000
+
+// endpointStore provides the endpoint check generator required by
+// the gateway package to augment endpoints' quality data,
+// using synthetic service requests.
+var _ gateway.QoSEndpointCheckGenerator = &endpointStore{}
+
+// CheckWebsocketConnection returns false: the generic JSON-RPC QoS does not check Websocket connections.
+func (es *endpointStore) CheckWebsocketConnection() bool {
+	return false
+}
+
+// GetRequiredQualityChecks returns a request context for each of the config-declared checks.
+// TODO_IMPROVE: skip checks for which the endpoint has a recent result.
+func (es *endpointStore) GetRequiredQualityChecks(_ protocol.EndpointAddr) []gateway.RequestQoSContext {
+	checks := es.serviceState.checks
+
+	requestContexts := make([]gateway.RequestQoSContext, 0, len(checks))
+	for idx := range checks {
+		requestContexts = append(requestContexts, es.getEndpointCheck(idx))
+	}
+
+	return requestContexts
+}
+
+// getEndpointCheck prepares a request context for the check with the supplied index.
+func (es *endpointStore) getEndpointCheck(checkIdx int) *requestContext {
+	check := es.serviceState.checks[checkIdx]
+
+	jsonrpcReq := jsonrpc.Request{
+		JSONRPC: jsonrpc.Version2,
+		ID:      jsonrpc.IDFromInt(checkRequestIDBase + checkIdx),
+		Method:  check.Method,
+	}
+	if len(check.Params) > 0 {
+		jsonrpcReq.SetParams(check.Params)
+	}
+
+	return &requestContext{
+		logger:        es.logger,
+		serviceID:     es.serviceState.serviceID,
+		endpointStore: es,
+		jsonrpcReq:    jsonrpcReq,
+		check:         &check,
+		// Set the origin of the request as Synthetic.
+		// The request is generated by the QoS service to collect extra observations on endpoints.
+		requestOrigin: qosobservations.RequestOrigin_REQUEST_ORIGIN_SYNTHETIC,
+	}
+}
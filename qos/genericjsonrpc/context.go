@@ -0,0 +1,260 @@
+package genericjsonrpc
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/buildwithgrove/path/gateway"
+	"github.com/buildwithgrove/path/log"
+	pathhttp "github.com/buildwithgrove/path/network/http"
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+const (
+	// errMsgUnmarshaling is the generic message returned to the user if the endpoint returns a malformed response.
+	errMsgUnmarshaling = "the response returned by the endpoint is not a valid JSON-RPC response"
+
+	// errDataFieldRawBytes is the key of the entry in the JSON-RPC error response's "data" map which holds the endpoint's original response.
+	errDataFieldRawBytes = "endpoint_response"
+
+	// errDataFieldUnmarshalingErr is the key of the entry in the JSON-RPC error response's "data" map which holds the unmarshaling error.
+	errDataFieldUnmarshalingErr = "unmarshaling_error"
+)
+
+// requestContext provides the support required by the gateway
+// package for handling service requests.
+var _ gateway.RequestQoSContext = &requestContext{}
+
+// endpointResponse is an endpoint's response to the request handled by the request context.
+type endpointResponse struct {
+	// jsonrpcResponse is the endpoint's response to a single JSON-RPC request.
+	// A generic error response is used if the endpoint's payload is not a valid JSON-RPC response.
+	jsonrpcResponse jsonrpc.Response
+
+	// batchResponses are the endpoint's responses to the members of a JSON-RPC batch request.
+	batchResponses []jsonrpc.Response
+
+	observation *qosobservations.GenericJsonRpcEndpointObservation
+}
+
+// requestContext provides the functionality required
+// to support QoS for a generic JSON-RPC service.
+type requestContext struct {
+	logger polylog.Logger
+
+	serviceID protocol.ServiceID
+
+	// The length of the request payload in bytes.
+	requestPayloadLength uint
+
+	endpointStore *endpointStore
+
+	// jsonrpcReq is the JSON-RPC request, if the request is not a batch.
+	jsonrpcReq jsonrpc.Request
+
+	// jsonrpcBatchRequest is the JSON-RPC batch request, if isBatch is set.
+	// The batch is sent as-is to a single endpoint.
+	jsonrpcBatchRequest jsonrpc.BatchRequest
+	isBatch             bool
+
+	// check is the config-declared check the request was built for.
+	// Only set for synthetic requests, i.e. endpoint checks.
+	check *Check
+
+	// The origin of the request handled by the context.
+	// Either:
+	// - User: user requests
+	// - QoS: requests built by the QoS service to get additional data points on endpoints.
+	requestOrigin qosobservations.RequestOrigin
+
+	// endpointResponses is the set of responses received from one or
+	// more endpoints as part of handling this service request.
+	endpointResponses []endpointResponse
+}
+
+// GetServicePayloads returns the payload of the request: a batch request is sent as a single payload.
+// Implements the gateway.RequestQoSContext interface.
+func (rc *requestContext) GetServicePayloads() []protocol.Payload {
+	if !rc.isBatch {
+		payload, err := rc.jsonrpcReq.BuildPayload()
+		if err != nil {
+			rc.logger.Error().Err(err).Msg("SHOULD RARELY HAPPEN: requestContext.GetServicePayloads() should never fail marshaling the JSONRPC request.")
+			return []protocol.Payload{protocol.EmptyErrorPayload()}
+		}
+		return []protocol.Payload{payload}
+	}
+
+	batchBz, err := json.Marshal(rc.jsonrpcBatchRequest.Requests)
+	if err != nil {
+		rc.logger.Error().Err(err).Msg("SHOULD RARELY HAPPEN: requestContext.GetServicePayloads() should never fail marshaling the JSONRPC batch request.")
+		return []protocol.Payload{protocol.EmptyErrorPayload()}
+	}
+
+	return []protocol.Payload{{
+		Data:    string(batchBz),
+		Method:  http.MethodPost, // Method is always POST for JSON-RPC.
+		Headers: map[string]string{},
+		RPCType: sharedtypes.RPCType_JSON_RPC,
+	}}
+}
+
+// UpdateWithResponse is NOT safe for concurrent use
+// Implements the gateway.RequestQoSContext interface.
+func (rc *requestContext) UpdateWithResponse(endpointAddr protocol.EndpointAddr, responseBz []byte) {
+	var response endpointResponse
+	if rc.isBatch {
+		response = rc.parseBatchResponse(responseBz)
+	} else {
+		response = rc.parseResponse(endpointAddr, responseBz)
+	}
+
+	response.observation.EndpointAddr = string(endpointAddr)
+	rc.endpointResponses = append(rc.endpointResponses, response)
+}
+
+// parseResponse parses the endpoint's payload as a response to a single JSON-RPC request.
+// The response to a check request is evaluated against the check's assertion.
+func (rc *requestContext) parseResponse(endpointAddr protocol.EndpointAddr, responseBz []byte) endpointResponse {
+	var jsonrpcResponse jsonrpc.Response
+	err := json.Unmarshal(responseBz, &jsonrpcResponse)
+	if err == nil {
+		err = jsonrpcResponse.Validate(rc.jsonrpcReq.ID)
+	}
+
+	if err != nil {
+		rc.logger.With(
+			"jsonrpc_request_method", rc.jsonrpcReq.Method,
+			"raw_payload", log.Preview(string(responseBz)),
+			"endpoint_addr", endpointAddr,
+		).Debug().Err(err).Msg("Endpoint payload is not a valid JSON-RPC response")
+
+		errResponse := getGenericJSONRPCErrResponse(rc.jsonrpcReq.ID, responseBz, err)
+		observation := &qosobservations.GenericJsonRpcEndpointObservation{
+			HttpStatusCode:  int32(errResponse.GetRecommendedHTTPStatusCode()),
+			JsonrpcResponse: errResponse.GetObservation(),
+			ValidationError: newValidationErrorObservation(),
+		}
+		return endpointResponse{jsonrpcResponse: errResponse, observation: observation}
+	}
+
+	observation := &qosobservations.GenericJsonRpcEndpointObservation{
+		HttpStatusCode:  int32(jsonrpcResponse.GetRecommendedHTTPStatusCode()),
+		JsonrpcResponse: jsonrpcResponse.GetObservation(),
+	}
+	if rc.check != nil {
+		observation.CheckResult = rc.check.evaluate(jsonrpcResponse)
+	}
+
+	return endpointResponse{jsonrpcResponse: jsonrpcResponse, observation: observation}
+}
+
+// parseBatchResponse parses the endpoint's payload as the responses to the members of a JSON-RPC batch request.
+func (rc *requestContext) parseBatchResponse(responseBz []byte) endpointResponse {
+	var batchResponses []jsonrpc.Response
+	if err := json.Unmarshal(responseBz, &batchResponses); err != nil {
+		rc.logger.Debug().Err(err).Msgf("Endpoint payload is not a valid JSON-RPC batch response: %s", log.Preview(string(responseBz)))
+		return endpointResponse{
+			observation: &qosobservations.GenericJsonRpcEndpointObservation{
+				HttpStatusCode:  http.StatusOK,
+				ValidationError: newValidationErrorObservation(),
+			},
+		}
+	}
+
+	return endpointResponse{
+		batchResponses: batchResponses,
+		observation: &qosobservations.GenericJsonRpcEndpointObservation{
+			HttpStatusCode: http.StatusOK,
+		},
+	}
+}
+
+// GetHTTPResponse builds the HTTP response that should be returned for the request.
+// Implements the gateway.RequestQoSContext interface.
+func (rc *requestContext) GetHTTPResponse() pathhttp.HTTPResponse {
+	// No responses received: this is an internal error:
+	// e.g. protocol-level errors like endpoint timing out.
+	if len(rc.endpointResponses) == 0 {
+		jsonrpcErrorResponse := jsonrpc.NewErrResponseInternalErr(rc.jsonrpcReq.ID, errors.New("protocol-level error: no endpoint responses received"))
+		return qos.BuildHTTPResponseFromJSONRPCResponse(rc.logger, jsonrpcErrorResponse)
+	}
+
+	// Use the most recent endpoint response.
+	selectedResponse := rc.endpointResponses[len(rc.endpointResponses)-1]
+
+	if rc.isBatch {
+		// Batch members with no response from the endpoint get a synthesized error response.
+		return jsonrpc.HTTPResponse{
+			ResponsePayload: rc.jsonrpcBatchRequest.BuildResponseBytes(selectedResponse.batchResponses),
+			// According to the JSON-RPC 2.0 specification, even if individual responses
+			// in a batch contain errors, the entire batch should still return HTTP 200 OK.
+			HTTPStatusCode: http.StatusOK,
+		}
+	}
+
+	return qos.BuildHTTPResponseFromJSONRPCResponse(rc.logger, selectedResponse.jsonrpcResponse)
+}
+
+// GetObservations returns all the observations contained in the request context.
+// Implements the gateway.RequestQoSContext interface.
+func (rc *requestContext) GetObservations() qosobservations.Observations {
+	observations := &qosobservations.GenericJsonRpcRequestObservations{
+		ServiceId:            string(rc.serviceID),
+		RequestPayloadLength: uint32(rc.requestPayloadLength),
+		RequestOrigin:        rc.requestOrigin,
+	}
+
+	if !rc.isBatch {
+		observations.JsonrpcRequest = rc.jsonrpcReq.GetObservation()
+	}
+
+	// No endpoint responses received.
+	// Set request error.
+	if len(rc.endpointResponses) == 0 {
+		observations.RequestError = qos.GetRequestErrorForProtocolError()
+	}
+
+	for _, endpointResponse := range rc.endpointResponses {
+		observations.EndpointObservations = append(observations.EndpointObservations, endpointResponse.observation)
+	}
+
+	return qosobservations.Observations{
+		ServiceObservations: &qosobservations.Observations_GenericJsonrpc{
+			GenericJsonrpc: observations,
+		},
+	}
+}
+
+// GetEndpointSelector is required to satisfy the gateway package's RequestQoSContext interface.
+func (rc *requestContext) GetEndpointSelector() protocol.EndpointSelector {
+	return rc.endpointStore
+}
+
+// getGenericJSONRPCErrResponse returns a JSON-RPC error response for an endpoint payload which is not a valid JSON-RPC response.
+// Includes the supplied ID, error, and invalid payload in the "data" field.
+func getGenericJSONRPCErrResponse(id jsonrpc.ID, malformedResponsePayload []byte, err error) jsonrpc.Response {
+	errData := map[string]string{
+		errDataFieldRawBytes:        string(malformedResponsePayload),
+		errDataFieldUnmarshalingErr: err.Error(),
+	}
+
+	// `jsonrpc.ResponseCodeBackendServerErr`, i.e. code -31002, will result in returning a 500 HTTP Status Code to the client.
+	return jsonrpc.GetErrorResponse(id, jsonrpc.ResponseCodeBackendServerErr, errMsgUnmarshaling, errData)
+}
+
+// newValidationErrorObservation returns the observation of an endpoint payload which is not a valid JSON-RPC response.
+func newValidationErrorObservation() *qosobservations.JsonRpcResponseValidationError {
+	return &qosobservations.JsonRpcResponseValidationError{
+		ErrorType: qosobservations.JsonRpcValidationErrorType_JSON_RPC_VALIDATION_ERROR_TYPE_NON_JSONRPC_RESPONSE,
+		Timestamp: timestamppb.New(time.Now()),
+	}
+}
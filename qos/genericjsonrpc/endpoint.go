@@ -0,0 +1,38 @@
+package genericjsonrpc
+
+import (
+	"maps"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/qos"
+)
+
+// endpoint captures details required to validate an endpoint of a generic JSON-RPC service.
+type endpoint struct {
+	// checkResults maps the name of each check to the result of the endpoint's latest response to the check.
+	checkResults map[string]*qosobservations.GenericJsonRpcCheckResult
+
+	// JSONRPCValidationErrorTracker tracks the endpoint's most recent JSON-RPC response validation error.
+	qos.JSONRPCValidationErrorTracker
+}
+
+// applyObservation updates endpoint data using provided observation.
+// Returns true if observation was recognized.
+// IMPORTANT: This function mutates the endpoint.
+func (e *endpoint) applyObservation(obs *qosobservations.GenericJsonRpcEndpointObservation) bool {
+	// Update latest validation error if observation contains more recent error
+	isMutated := e.ApplyValidationError(obs.GetValidationError())
+
+	if checkResult := obs.GetCheckResult(); checkResult != nil {
+		// Copy the check results: the map is shared with copies of the endpoint, e.g. the set of updated endpoints.
+		checkResults := maps.Clone(e.checkResults)
+		if checkResults == nil {
+			checkResults = make(map[string]*qosobservations.GenericJsonRpcCheckResult)
+		}
+		checkResults[checkResult.GetCheckName()] = checkResult
+		e.checkResults = checkResults
+		isMutated = true
+	}
+
+	return isMutated
+}
@@ -0,0 +1,102 @@
+// Package genericjsonrpc provides a QoS implementation for JSON-RPC services with no dedicated QoS package.
+// Endpoints are validated using synthetic checks declared in config, rather than chain-specific Go code:
+// each check is a JSON-RPC request, a path into the result, and an assertion on the value at that path.
+package genericjsonrpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
+	"github.com/buildwithgrove/path/gateway"
+	"github.com/buildwithgrove/path/metrics/devtools"
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+)
+
+// QoS implements gateway.QoSService by providing:
+//  1. QoSRequestParser - Builds RequestQoSContext objects from HTTP requests
+//  2. EndpointSelector - Selects endpoints for service requests
+//  3. QoSEndpointCheckGenerator - Builds the config-declared synthetic checks
+var _ gateway.QoSService = &QoS{}
+
+// QoS implements the gateway.QoSService interface for generic JSON-RPC services.
+type QoS struct {
+	logger polylog.Logger
+	*endpointStore
+	*requestValidator
+}
+
+// NewQoSInstance builds and returns an instance of the generic JSON-RPC QoS service.
+func NewQoSInstance(logger polylog.Logger, serviceConfig GenericJSONRPCServiceQoSConfig) *QoS {
+	serviceID := serviceConfig.GetServiceID()
+
+	logger = logger.With(
+		"qos_instance", "generic_jsonrpc",
+		"service_id", serviceID,
+	)
+
+	serviceState := &serviceState{
+		logger:              logger,
+		serviceID:           serviceID,
+		checks:              serviceConfig.getChecks(),
+		perceivedMaxNumbers: make(map[string]uint64),
+	}
+
+	endpointStore := newEndpointStore(logger, serviceState)
+
+	requestValidator := &requestValidator{
+		logger:        logger,
+		serviceID:     serviceID,
+		endpointStore: endpointStore,
+		requestLimits: serviceConfig.getRequestLimits(),
+	}
+
+	return &QoS{
+		logger:           logger,
+		endpointStore:    endpointStore,
+		requestValidator: requestValidator,
+	}
+}
+
+// ParseHTTPRequest builds a request context from the provided HTTP request.
+// Implements the gateway.QoSService interface.
+func (q *QoS) ParseHTTPRequest(_ context.Context, req *http.Request) (gateway.RequestQoSContext, bool) {
+	return q.validateHTTPRequest(req)
+}
+
+// ParseWebsocketRequest builds a request context from the provided Websocket request.
+// Websocket connection requests do not have a body, so we don't need to parse it.
+// Implements the gateway.QoSService interface.
+func (q *QoS) ParseWebsocketRequest(_ context.Context) (gateway.RequestQoSContext, bool) {
+	return &requestContext{
+		logger:        q.logger,
+		serviceID:     q.serviceState.serviceID,
+		endpointStore: q.endpointStore,
+		requestOrigin: qosobservations.RequestOrigin_REQUEST_ORIGIN_ORGANIC,
+	}, true
+}
+
+// ApplyObservations updates the stored endpoints and the perceived service state using the supplied observations.
+// Implements the gateway.QoSService interface.
+func (q *QoS) ApplyObservations(observations *qosobservations.Observations) error {
+	if observations == nil {
+		return errors.New("ApplyObservations: received nil observations")
+	}
+
+	genericJSONRPCObservations := observations.GetGenericJsonrpc()
+	if genericJSONRPCObservations == nil {
+		return errors.New("ApplyObservations: received nil generic JSON-RPC observation")
+	}
+
+	updatedEndpoints := q.updateEndpointsFromObservations(genericJSONRPCObservations.GetEndpointObservations())
+	q.serviceState.updateFromEndpoints(updatedEndpoints)
+	return nil
+}
+
+// HydrateDisqualifiedEndpointsResponse is a no-op for the generic JSON-RPC QoS.
+// TODO_IMPROVE: report the endpoints failing the config-declared checks.
+func (QoS) HydrateDisqualifiedEndpointsResponse(_ protocol.ServiceID, _ *devtools.DisqualifiedEndpointResponse) {
+}
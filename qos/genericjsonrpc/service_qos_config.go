@@ -0,0 +1,192 @@
+package genericjsonrpc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// QoSType is the QoS type for JSON-RPC services using config-declared synthetic checks.
+const QoSType = "generic_jsonrpc"
+
+// AssertionType is the assertion a check makes on the value extracted from an endpoint's response.
+type AssertionType string
+
+const (
+	// AssertionEquals requires the extracted value to equal the check's expected value.
+	// A JSON string is compared using its unquoted value, any other JSON value using its compact encoding.
+	AssertionEquals AssertionType = "equals"
+
+	// AssertionNonEmpty requires the extracted value to be present and not null, "", [] or {}.
+	AssertionNonEmpty AssertionType = "non_empty"
+
+	// AssertionHexNumberNearMax requires the extracted value to be a number, e.g. a block height,
+	// which is at least the highest number reported by any endpoint (i.e. perceived max), minus the check's tolerance.
+	// Hex-encoded strings (e.g. "0x1b4") and JSON numbers are both accepted.
+	AssertionHexNumberNearMax AssertionType = "hex_number_near_max"
+)
+
+// The errors below list all the possible validation errors of a check.
+var (
+	errCheckNameEmpty          = errors.New("check name is required")
+	errCheckMethodEmpty        = errors.New("check method is required")
+	errCheckParamsInvalid      = errors.New("check params must be a JSON array or object")
+	errCheckAssertionInvalid   = errors.New("unsupported check assertion")
+	errCheckExpectedValueEmpty = errors.New("expected value is required for the equals assertion")
+)
+
+// Check is a synthetic check, declared in config, run against every endpoint of the service:
+//   - The endpoint is sent a JSON-RPC request with the check's method and params.
+//   - The value at the check's path into the JSON-RPC result is extracted from the endpoint's response.
+//   - The endpoint is only valid if the extracted value satisfies the check's assertion.
+type Check struct {
+	// Name identifies the check, e.g. in observations and logs. Must be unique per service.
+	Name string
+
+	// Method is the JSON-RPC method of the check's request, e.g. "getblockcount".
+	Method jsonrpc.Method
+
+	// Params are the JSON-RPC params of the check's request, as a JSON array or object.
+	// The request has no params if not set.
+	Params json.RawMessage
+
+	// ResultPath is the dot-separated path into the JSON-RPC result of the value to assert on, e.g. "sync_info.latest_block_height".
+	// Array elements are referenced by their index, e.g. "blocks.0.height".
+	// The whole result is used if not set.
+	ResultPath string
+
+	// Assertion is the assertion made on the extracted value.
+	Assertion AssertionType
+
+	// ExpectedValue is the value required by the equals assertion.
+	ExpectedValue string
+
+	// Tolerance is the number of units (e.g. blocks) the extracted number may be behind
+	// the perceived max and still satisfy the hex_number_near_max assertion.
+	Tolerance uint64
+}
+
+// Validate returns an error if the check is incomplete or invalid.
+func (c Check) Validate() error {
+	if c.Name == "" {
+		return errCheckNameEmpty
+	}
+
+	if c.Method == "" {
+		return errCheckMethodEmpty
+	}
+
+	if len(c.Params) > 0 {
+		var params jsonrpc.Params
+		if err := json.Unmarshal(c.Params, &params); err != nil {
+			return fmt.Errorf("%w: %v", errCheckParamsInvalid, err)
+		}
+	}
+
+	switch c.Assertion {
+	case AssertionEquals:
+		if c.ExpectedValue == "" {
+			return errCheckExpectedValueEmpty
+		}
+	case AssertionNonEmpty, AssertionHexNumberNearMax:
+	default:
+		return fmt.Errorf("%w: %q", errCheckAssertionInvalid, c.Assertion)
+	}
+
+	return nil
+}
+
+// ServiceQoSConfig defines the base interface for service QoS configurations.
+// This avoids circular dependency with the config package.
+type ServiceQoSConfig interface {
+	GetServiceID() protocol.ServiceID
+	GetServiceQoSType() string
+}
+
+// GenericJSONRPCServiceQoSConfig is the configuration for the generic JSON-RPC service QoS.
+type GenericJSONRPCServiceQoSConfig interface {
+	ServiceQoSConfig // Using locally defined interface to avoid circular dependency
+	getChecks() []Check
+	getRequestLimits() jsonrpc.RequestLimits
+}
+
+// GenericJSONRPCServiceQoSConfigOption customizes an optional setting of a generic JSON-RPC service QoS configuration.
+type GenericJSONRPCServiceQoSConfigOption func(*genericJSONRPCServiceQoSConfig)
+
+// WithMaxBatchSize sets the maximum number of requests in a JSONRPC batch request.
+// Defaults to jsonrpc.DefaultMaxBatchSize if not set.
+func WithMaxBatchSize(maxBatchSize int) GenericJSONRPCServiceQoSConfigOption {
+	return func(c *genericJSONRPCServiceQoSConfig) {
+		c.maxBatchSize = maxBatchSize
+	}
+}
+
+// WithMaxRequestBodyBytes sets the maximum size of a JSONRPC request body, in bytes.
+// Defaults to jsonrpc.DefaultMaxRequestBodyBytes if not set.
+func WithMaxRequestBodyBytes(maxRequestBodyBytes int64) GenericJSONRPCServiceQoSConfigOption {
+	return func(c *genericJSONRPCServiceQoSConfig) {
+		c.maxRequestBodyBytes = maxRequestBodyBytes
+	}
+}
+
+// NewGenericJSONRPCServiceQoSConfig creates a new generic JSON-RPC service configuration.
+// The checks must be validated beforehand: see Check.Validate.
+func NewGenericJSONRPCServiceQoSConfig(
+	serviceID protocol.ServiceID,
+	checks []Check,
+	opts ...GenericJSONRPCServiceQoSConfigOption,
+) GenericJSONRPCServiceQoSConfig {
+	config := genericJSONRPCServiceQoSConfig{
+		serviceID: serviceID,
+		checks:    checks,
+	}
+
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return config
+}
+
+// Ensure implementation satisfies interface
+var _ GenericJSONRPCServiceQoSConfig = (*genericJSONRPCServiceQoSConfig)(nil)
+
+type genericJSONRPCServiceQoSConfig struct {
+	serviceID protocol.ServiceID
+
+	// checks are the synthetic checks run against every endpoint of the service.
+	checks []Check
+
+	// maxBatchSize is the maximum number of requests in a JSONRPC batch request.
+	maxBatchSize int
+
+	// maxRequestBodyBytes is the maximum size of a JSONRPC request body, in bytes.
+	maxRequestBodyBytes int64
+}
+
+// GetServiceID returns the ID of the service.
+// Implements the ServiceQoSConfig interface.
+func (c genericJSONRPCServiceQoSConfig) GetServiceID() protocol.ServiceID {
+	return c.serviceID
+}
+
+// GetServiceQoSType returns the QoS type of the service.
+// Implements the ServiceQoSConfig interface.
+func (genericJSONRPCServiceQoSConfig) GetServiceQoSType() string {
+	return QoSType
+}
+
+// getChecks returns the synthetic checks run against every endpoint of the service.
+// Implements the GenericJSONRPCServiceQoSConfig interface.
+func (c genericJSONRPCServiceQoSConfig) getChecks() []Check {
+	return c.checks
+}
+
+// getRequestLimits returns the limits on the size of JSONRPC requests, with defaults applied.
+// Implements the GenericJSONRPCServiceQoSConfig interface.
+func (c genericJSONRPCServiceQoSConfig) getRequestLimits() jsonrpc.RequestLimits {
+	return jsonrpc.NewRequestLimits(c.maxBatchSize, c.maxRequestBodyBytes)
+}
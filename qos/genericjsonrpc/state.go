@@ -0,0 +1,92 @@
+package genericjsonrpc
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
+	"github.com/buildwithgrove/path/protocol"
+)
+
+// serviceState keeps the expected current state of the service,
+// based on the endpoints' responses to the config-declared checks.
+type serviceState struct {
+	logger polylog.Logger
+
+	// serviceID to add to endpoint checks.
+	// Used by observations of Synthetic requests.
+	serviceID protocol.ServiceID
+
+	// checks are the synthetic checks every endpoint must pass.
+	checks []Check
+
+	serviceStateLock sync.RWMutex
+	// perceivedMaxNumbers maps the name of each hex_number_near_max check to the highest number reported by any endpoint.
+	perceivedMaxNumbers map[string]uint64
+}
+
+// validateEndpoint returns an error if the supplied endpoint is not valid based on the perceived state of the service:
+//   - The endpoint must have no recent JSON-RPC validation errors.
+//   - The endpoint must have passed every check.
+//   - For hex_number_near_max checks, the endpoint's number must be within the check's tolerance of the perceived max.
+func (s *serviceState) validateEndpoint(endpoint endpoint) error {
+	if err := endpoint.ValidateNoRecentValidationError(); err != nil {
+		return err
+	}
+
+	s.serviceStateLock.RLock()
+	defer s.serviceStateLock.RUnlock()
+
+	for _, check := range s.checks {
+		checkResult, found := endpoint.checkResults[check.Name]
+		if !found {
+			return fmt.Errorf("endpoint has not had an observation of its response to the %q check", check.Name)
+		}
+
+		if !checkResult.GetPassed() {
+			return fmt.Errorf("endpoint failed the %q check: %s", check.Name, checkResult.GetFailureReason())
+		}
+
+		if check.Assertion != AssertionHexNumberNearMax {
+			continue
+		}
+
+		perceivedMaxNumber := s.perceivedMaxNumbers[check.Name]
+		if checkResult.GetNumber()+check.Tolerance < perceivedMaxNumber {
+			return fmt.Errorf("endpoint number %d for the %q check is more than %d behind the perceived max %d",
+				checkResult.GetNumber(), check.Name, check.Tolerance, perceivedMaxNumber)
+		}
+	}
+
+	return nil
+}
+
+// updateFromEndpoints updates the service state using the numbers reported by the set of updated endpoints.
+// NOTE: This only includes the set of endpoints for which an observation was received.
+func (s *serviceState) updateFromEndpoints(updatedEndpoints map[protocol.EndpointAddr]endpoint) {
+	s.serviceStateLock.Lock()
+	defer s.serviceStateLock.Unlock()
+
+	for endpointAddr, endpoint := range updatedEndpoints {
+		for checkName, checkResult := range endpoint.checkResults {
+			if !checkResult.GetPassed() || checkResult.Number == nil {
+				continue
+			}
+
+			// TODO_TECHDEBT: use a more resilient method for updating the perceived max.
+			// e.g. one endpoint returning a very large number should not result in all other endpoints being marked as invalid.
+			if checkResult.GetNumber() <= s.perceivedMaxNumbers[checkName] {
+				continue
+			}
+
+			s.perceivedMaxNumbers[checkName] = checkResult.GetNumber()
+
+			s.logger.With(
+				"endpoint", endpointAddr,
+				"check", checkName,
+				"perceived_max", checkResult.GetNumber(),
+			).Debug().Msg("Updating the perceived max number")
+		}
+	}
+}
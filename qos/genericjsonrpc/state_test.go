@@ -0,0 +1,145 @@
+package genericjsonrpc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/stretchr/testify/require"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+)
+
+func TestServiceState_validateEndpoint(t *testing.T) {
+	checks := []Check{
+		{Name: "chain", Assertion: AssertionEquals, ExpectedValue: "main"},
+		{Name: "height", Assertion: AssertionHexNumberNearMax, Tolerance: 2},
+	}
+
+	height := func(number uint64) *qosobservations.GenericJsonRpcCheckResult {
+		return &qosobservations.GenericJsonRpcCheckResult{CheckName: "height", Passed: true, Number: &number}
+	}
+	chain := &qosobservations.GenericJsonRpcCheckResult{CheckName: "chain", Passed: true}
+
+	tests := []struct {
+		name         string
+		observations []*qosobservations.GenericJsonRpcEndpointObservation
+		expectError  bool
+		errorMsg     string
+	}{
+		{
+			name: "valid endpoint within the tolerance of the perceived max",
+			observations: []*qosobservations.GenericJsonRpcEndpointObservation{
+				{CheckResult: chain},
+				{CheckResult: height(98)},
+			},
+		},
+		{
+			name: "endpoint lagging beyond the tolerance of the perceived max",
+			observations: []*qosobservations.GenericJsonRpcEndpointObservation{
+				{CheckResult: chain},
+				{CheckResult: height(97)},
+			},
+			expectError: true,
+			errorMsg:    `endpoint number 97 for the "height" check is more than 2 behind the perceived max 100`,
+		},
+		{
+			name: "endpoint which failed a check",
+			observations: []*qosobservations.GenericJsonRpcEndpointObservation{
+				{CheckResult: &qosobservations.GenericJsonRpcCheckResult{CheckName: "chain", FailureReason: `expected "main", got "test"`}},
+				{CheckResult: height(100)},
+			},
+			expectError: true,
+			errorMsg:    `endpoint failed the "chain" check: expected "main", got "test"`,
+		},
+		{
+			name: "endpoint missing a check result",
+			observations: []*qosobservations.GenericJsonRpcEndpointObservation{
+				{CheckResult: height(100)},
+			},
+			expectError: true,
+			errorMsg:    `endpoint has not had an observation of its response to the "chain" check`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &serviceState{
+				logger:              polyzero.NewLogger(),
+				checks:              checks,
+				perceivedMaxNumbers: make(map[string]uint64),
+			}
+			// A reference endpoint sets the perceived max of the height check.
+			var referenceEndpoint endpoint
+			referenceEndpoint.applyObservation(&qosobservations.GenericJsonRpcEndpointObservation{CheckResult: height(100)})
+			state.updateFromEndpoints(map[protocol.EndpointAddr]endpoint{"reference": referenceEndpoint})
+
+			var endpoint endpoint
+			for _, observation := range tt.observations {
+				endpoint.applyObservation(observation)
+			}
+
+			err := state.validateEndpoint(endpoint)
+			if !tt.expectError {
+				require.NoError(t, err)
+				return
+			}
+
+			require.EqualError(t, err, tt.errorMsg)
+		})
+	}
+}
+
+func TestServiceState_updateFromEndpoints(t *testing.T) {
+	number := func(n uint64) *uint64 { return &n }
+
+	tests := []struct {
+		name                 string
+		checkResults         []*qosobservations.GenericJsonRpcCheckResult
+		expectedPerceivedMax uint64
+	}{
+		{
+			name: "perceived max is the highest passed number",
+			checkResults: []*qosobservations.GenericJsonRpcCheckResult{
+				{CheckName: "height", Passed: true, Number: number(100)},
+				{CheckName: "height", Passed: true, Number: number(97)},
+			},
+			expectedPerceivedMax: 100,
+		},
+		{
+			name: "failed check results do not update the perceived max",
+			checkResults: []*qosobservations.GenericJsonRpcCheckResult{
+				{CheckName: "height", Passed: true, Number: number(100)},
+				{CheckName: "height", Passed: false, Number: number(500)},
+			},
+			expectedPerceivedMax: 100,
+		},
+		{
+			name: "check results with no number do not update the perceived max",
+			checkResults: []*qosobservations.GenericJsonRpcCheckResult{
+				{CheckName: "height", Passed: true},
+			},
+			expectedPerceivedMax: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &serviceState{
+				logger:              polyzero.NewLogger(),
+				perceivedMaxNumbers: make(map[string]uint64),
+			}
+
+			updatedEndpoints := make(map[protocol.EndpointAddr]endpoint)
+			for i, checkResult := range tt.checkResults {
+				var endpoint endpoint
+				endpoint.applyObservation(&qosobservations.GenericJsonRpcEndpointObservation{CheckResult: checkResult})
+				updatedEndpoints[protocol.EndpointAddr(fmt.Sprintf("endpoint_%d", i))] = endpoint
+			}
+			state.updateFromEndpoints(updatedEndpoints)
+
+			require.Equal(t, tt.expectedPerceivedMax, state.perceivedMaxNumbers["height"])
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package genericjsonrpc
+
+import (
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos"
+)
+
+// endpointStore provides the endpoint selection capability required
+// by the protocol package for handling a service request.
+var _ protocol.EndpointSelector = &endpointStore{}
+
+// endpointStore holds the latest result of each configured check, and the latest validation error, of each endpoint of a generic JSON-RPC service.
+type endpointStore struct {
+	*qos.EndpointStore[endpoint]
+
+	logger polylog.Logger
+
+	serviceState *serviceState
+}
+
+// newEndpointStore returns an empty endpoint store, validating endpoints against the service's configured checks.
+func newEndpointStore(logger polylog.Logger, serviceState *serviceState) *endpointStore {
+	return &endpointStore{
+		EndpointStore: qos.NewEndpointStore(logger, serviceState.validateEndpoint),
+		logger:        logger,
+		serviceState:  serviceState,
+	}
+}
+
+// updateEndpointsFromObservations stores the check results and validation errors of the observed endpoints.
+// It returns the set of created/updated endpoints, used to update the perceived max of numeric checks.
+func (es *endpointStore) updateEndpointsFromObservations(
+	endpointObservations []*qosobservations.GenericJsonRpcEndpointObservation,
+) map[protocol.EndpointAddr]endpoint {
+	return qos.UpdateEndpointsFromObservations(es.EndpointStore, endpointObservations, (*endpoint).applyObservation)
+}
@@ -0,0 +1,47 @@
+package genericrest
+
+import (
+	"net/http"
+
+	"github.com/buildwithgrove/path/gateway"
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+)
+
+// endpointStore provides the endpoint check generator required by
+// the gateway package to augment endpoints' quality data,
+// using synthetic service requests.
+var _ gateway.QoSEndpointCheckGenerator = &endpointStore{}
+
+// CheckWebsocketConnection returns false: the generic REST QoS does not check Websocket connections.
+func (es *endpointStore) CheckWebsocketConnection() bool {
+	return false
+}
+
+// GetRequiredQualityChecks returns a request context for each of the config-declared probes.
+// TODO_IMPROVE: skip probes for which the endpoint has a recent result.
+func (es *endpointStore) GetRequiredQualityChecks(_ protocol.EndpointAddr) []gateway.RequestQoSContext {
+	probes := es.serviceState.probes
+
+	requestContexts := make([]gateway.RequestQoSContext, 0, len(probes))
+	for _, probe := range probes {
+		requestContexts = append(requestContexts, es.getEndpointProbe(probe))
+	}
+
+	return requestContexts
+}
+
+// getEndpointProbe prepares a request context for the supplied probe.
+func (es *endpointStore) getEndpointProbe(probe Probe) *requestContext {
+	return &requestContext{
+		logger:            es.logger,
+		serviceID:         es.serviceState.serviceID,
+		endpointStore:     es,
+		httpRequestMethod: http.MethodGet,
+		httpRequestPath:   probe.Path,
+		probe:             &probe,
+		// Set the origin of the request as Synthetic.
+		// The request is generated by the QoS service to collect extra observations on endpoints.
+		requestOrigin: qosobservations.RequestOrigin_REQUEST_ORIGIN_SYNTHETIC,
+	}
+}
@@ -0,0 +1,167 @@
+package genericrest
+
+import (
+	"net/http"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+
+	"github.com/buildwithgrove/path/gateway"
+	pathhttp "github.com/buildwithgrove/path/network/http"
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos"
+)
+
+// requestContext provides the support required by the gateway
+// package for handling service requests.
+var _ gateway.RequestQoSContext = &requestContext{}
+
+// requestContext tracks the non-2xx responses of endpoints, which are not reported through UpdateWithResponse.
+var _ gateway.EndpointErrorQoSContext = &requestContext{}
+
+// endpointResponse is an endpoint's response to the request handled by the request context.
+type endpointResponse struct {
+	// payload is the endpoint's response payload: only set for 2xx responses.
+	payload []byte
+
+	observation *qosobservations.GenericRestEndpointObservation
+}
+
+// requestContext provides the functionality required
+// to support QoS for a generic REST service.
+type requestContext struct {
+	logger polylog.Logger
+
+	serviceID protocol.ServiceID
+
+	endpointStore *endpointStore
+
+	// httpRequestMethod is the HTTP method of the request, e.g. "GET".
+	httpRequestMethod string
+
+	// httpRequestPath is the URL path of the request, including any query.
+	httpRequestPath string
+
+	// httpRequestBody is the body of the request, if any.
+	httpRequestBody []byte
+
+	// probe is the config-declared probe the request was built for.
+	// Only set for synthetic requests, i.e. endpoint probes.
+	probe *Probe
+
+	// The origin of the request handled by the context.
+	// Either:
+	// - User: user requests
+	// - QoS: requests built by the QoS service to get additional data points on endpoints.
+	requestOrigin qosobservations.RequestOrigin
+
+	// endpointResponses is the set of responses received from one or
+	// more endpoints as part of handling this service request.
+	endpointResponses []endpointResponse
+}
+
+// GetServicePayloads returns the payload of the request.
+// Implements the gateway.RequestQoSContext interface.
+func (rc *requestContext) GetServicePayloads() []protocol.Payload {
+	return []protocol.Payload{{
+		Data:    string(rc.httpRequestBody),
+		Method:  rc.httpRequestMethod,
+		Path:    rc.httpRequestPath,
+		Headers: map[string]string{},
+		RPCType: sharedtypes.RPCType_REST,
+	}}
+}
+
+// UpdateWithResponse is NOT safe for concurrent use
+// The protocol only reports the payload of 2xx responses: the response is recorded with a 200 HTTP status code.
+// Implements the gateway.RequestQoSContext interface.
+func (rc *requestContext) UpdateWithResponse(endpointAddr protocol.EndpointAddr, responseBz []byte) {
+	rc.addEndpointResponse(endpointAddr, http.StatusOK, responseBz)
+}
+
+// UpdateWithEndpointError is NOT safe for concurrent use
+// Records a failed relay: httpStatusCode is 0 if no response was received from the endpoint.
+// Implements the gateway.EndpointErrorQoSContext interface.
+func (rc *requestContext) UpdateWithEndpointError(endpointAddr protocol.EndpointAddr, httpStatusCode int) {
+	// The relay failed before an endpoint was selected, e.g. an internal protocol error.
+	if endpointAddr == "" {
+		return
+	}
+
+	rc.addEndpointResponse(endpointAddr, httpStatusCode, nil)
+}
+
+// addEndpointResponse records the endpoint's response, evaluating it against the probe the request was built for, if any.
+func (rc *requestContext) addEndpointResponse(endpointAddr protocol.EndpointAddr, httpStatusCode int, responseBz []byte) {
+	observation := &qosobservations.GenericRestEndpointObservation{
+		EndpointAddr:   string(endpointAddr),
+		HttpStatusCode: int32(httpStatusCode),
+	}
+
+	if rc.probe != nil {
+		observation.ProbeResult = rc.probe.evaluate(httpStatusCode, responseBz)
+	}
+
+	rc.endpointResponses = append(rc.endpointResponses, endpointResponse{
+		payload:     responseBz,
+		observation: observation,
+	})
+}
+
+// GetHTTPResponse builds the HTTP response that should be returned for the request.
+// The payload of the most recent 2xx response is returned as-is.
+// Implements the gateway.RequestQoSContext interface.
+func (rc *requestContext) GetHTTPResponse() pathhttp.HTTPResponse {
+	// No responses received: this is an internal error:
+	// e.g. protocol-level errors like endpoint timing out.
+	if len(rc.endpointResponses) == 0 {
+		return getNoEndpointResponse()
+	}
+
+	// Use the most recent endpoint response.
+	selectedResponse := rc.endpointResponses[len(rc.endpointResponses)-1]
+	httpStatusCode := int(selectedResponse.observation.GetHttpStatusCode())
+
+	if httpStatusCode != http.StatusOK {
+		return getEndpointErrorResponse(httpStatusCode)
+	}
+
+	return &httpResponse{
+		httpStatusCode: http.StatusOK,
+		payload:        selectedResponse.payload,
+	}
+}
+
+// GetObservations returns all the observations contained in the request context.
+// Implements the gateway.RequestQoSContext interface.
+func (rc *requestContext) GetObservations() qosobservations.Observations {
+	observations := &qosobservations.GenericRestRequestObservations{
+		ServiceId:            string(rc.serviceID),
+		RequestPayloadLength: uint32(len(rc.httpRequestBody)),
+		RequestOrigin:        rc.requestOrigin,
+		RequestMethod:        rc.httpRequestMethod,
+		RequestPath:          rc.httpRequestPath,
+	}
+
+	// No endpoint responses received.
+	// Set request error.
+	if len(rc.endpointResponses) == 0 {
+		observations.RequestError = qos.GetRequestErrorForProtocolError()
+	}
+
+	for _, endpointResponse := range rc.endpointResponses {
+		observations.EndpointObservations = append(observations.EndpointObservations, endpointResponse.observation)
+	}
+
+	return qosobservations.Observations{
+		ServiceObservations: &qosobservations.Observations_GenericRest{
+			GenericRest: observations,
+		},
+	}
+}
+
+// GetEndpointSelector is required to satisfy the gateway package's RequestQoSContext interface.
+func (rc *requestContext) GetEndpointSelector() protocol.EndpointSelector {
+	return rc.endpointStore
+}
@@ -0,0 +1,87 @@
+package genericrest
+
+import (
+	"errors"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
+	"github.com/buildwithgrove/path/gateway"
+	pathhttp "github.com/buildwithgrove/path/network/http"
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+)
+
+// errInvalidSelectorUsage records that endpoint selection was attempted on a request which could not be read.
+var errInvalidSelectorUsage = errors.New("endpoint selection attempted on failed request")
+
+// requestErrorContext provides the support required by the gateway package for handling service requests.
+var _ gateway.RequestQoSContext = &requestErrorContext{}
+
+// requestErrorContext terminates the processing of a REST request which could not be read, e.g. a body over the size limit.
+// It is the REST counterpart of qos.RequestErrorContext, which returns JSON-RPC error responses.
+type requestErrorContext struct {
+	logger polylog.Logger
+
+	// The response to be returned to the user.
+	response pathhttp.HTTPResponse
+
+	// The observations to use for the error.
+	observations *qosobservations.GenericRestRequestObservations
+}
+
+// GetHTTPResponse returns the preset error response.
+// Implements the gateway.RequestQoSContext interface.
+func (rec *requestErrorContext) GetHTTPResponse() pathhttp.HTTPResponse {
+	return rec.response
+}
+
+// GetObservations returns the observations of the request error.
+// Implements the gateway.RequestQoSContext interface.
+func (rec *requestErrorContext) GetObservations() qosobservations.Observations {
+	return qosobservations.Observations{
+		ServiceObservations: &qosobservations.Observations_GenericRest{
+			GenericRest: rec.observations,
+		},
+	}
+}
+
+// GetServicePayloads should never be called.
+// It logs a warning and returns an empty error payload.
+// Implements the gateway.RequestQoSContext interface.
+func (rec *requestErrorContext) GetServicePayloads() []protocol.Payload {
+	rec.logger.Warn().Msg("SHOULD NEVER HAPPEN: requestErrorContext.GetServicePayloads() should never be called.")
+	return []protocol.Payload{protocol.EmptyErrorPayload()}
+}
+
+// UpdateWithResponse should never be called.
+// Only logs a warning.
+// Implements the gateway.RequestQoSContext interface.
+func (rec *requestErrorContext) UpdateWithResponse(endpointAddr protocol.EndpointAddr, endpointSerializedResponse []byte) {
+	rec.logger.With(
+		"endpoint_addr", endpointAddr,
+		"endpoint_response_len", len(endpointSerializedResponse),
+	).Warn().Msg("SHOULD NEVER HAPPEN: requestErrorContext.UpdateWithResponse() should never be called.")
+}
+
+// GetEndpointSelector should never be called.
+// It logs a warning and returns a selector which fails all selection attempts.
+// Implements the gateway.RequestQoSContext interface.
+func (rec *requestErrorContext) GetEndpointSelector() protocol.EndpointSelector {
+	rec.logger.Warn().Msg("SHOULD NEVER HAPPEN: requestErrorContext.GetEndpointSelector() should never be called.")
+	return failingSelector{}
+}
+
+// failingSelector fails all endpoint selection attempts.
+type failingSelector struct{}
+
+// Select always returns an invalid usage error.
+// Implements the protocol.EndpointSelector interface.
+func (failingSelector) Select(_ protocol.EndpointAddrList) (protocol.EndpointAddr, error) {
+	return protocol.EndpointAddr(""), errInvalidSelectorUsage
+}
+
+// SelectMultiple always returns an invalid usage error.
+// Implements the protocol.EndpointSelector interface.
+func (failingSelector) SelectMultiple(_ protocol.EndpointAddrList, _ uint) (protocol.EndpointAddrList, error) {
+	return nil, errInvalidSelectorUsage
+}
@@ -0,0 +1,105 @@
+package genericrest
+
+import (
+	"maps"
+	"net/http"
+	"time"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+)
+
+// TODO_TECHDEBT: Make configurable via service config.
+// organicStatsWindow is the duration over which the error rates of an endpoint's organic responses are computed.
+// The window is reset once expired, so that a recovered endpoint becomes valid again.
+const organicStatsWindow = 10 * time.Minute
+
+// minOrganicResponsesForErrorRate is the minimum number of organic responses in the current window
+// required before the error rates are used to disqualify an endpoint.
+const minOrganicResponsesForErrorRate = 20
+
+// organicStats tracks the outcome of the organic, i.e. user, requests sent to an endpoint in the current window.
+type organicStats struct {
+	windowStart time.Time
+
+	numResponses uint64
+
+	// numNon2XX is the number of responses with a non-2xx HTTP status code, or no response at all.
+	numNon2XX uint64
+
+	// numServerErrors is the number of responses with a 5xx HTTP status code, or no response at all.
+	numServerErrors uint64
+}
+
+// isExpired returns true if the window of the stats has expired.
+// Expired stats are not used for validation: an endpoint disqualified for its error rates receives no organic traffic to reset them.
+func (s organicStats) isExpired(now time.Time) bool {
+	return now.Sub(s.windowStart) > organicStatsWindow
+}
+
+// non2XXRate returns the rate of organic requests which failed with a non-2xx HTTP status code, or no response.
+func (s organicStats) non2XXRate() float64 {
+	if s.numResponses == 0 {
+		return 0
+	}
+	return float64(s.numNon2XX) / float64(s.numResponses)
+}
+
+// serverErrorRate returns the rate of organic requests which failed with a 5xx HTTP status code, or no response.
+func (s organicStats) serverErrorRate() float64 {
+	if s.numResponses == 0 {
+		return 0
+	}
+	return float64(s.numServerErrors) / float64(s.numResponses)
+}
+
+// endpoint captures details required to validate an endpoint of a generic REST service.
+type endpoint struct {
+	// probeResults maps the name of each probe to the result of the endpoint's latest response to the probe.
+	probeResults map[string]*qosobservations.GenericRestProbeResult
+
+	organicStats organicStats
+}
+
+// applyObservation updates endpoint data using provided observation.
+// Organic observations update the endpoint's error rates, while synthetic ones update its probe results.
+// Returns true if observation was recognized.
+// IMPORTANT: This function mutates the endpoint.
+func (e *endpoint) applyObservation(obs *qosobservations.GenericRestEndpointObservation, requestOrigin qosobservations.RequestOrigin) bool {
+	if requestOrigin == qosobservations.RequestOrigin_REQUEST_ORIGIN_ORGANIC {
+		e.applyOrganicResponse(int(obs.GetHttpStatusCode()), time.Now())
+		return true
+	}
+
+	probeResult := obs.GetProbeResult()
+	if probeResult == nil {
+		return false
+	}
+
+	// Copy the probe results: the map is shared with copies of the endpoint, e.g. the set of updated endpoints.
+	probeResults := maps.Clone(e.probeResults)
+	if probeResults == nil {
+		probeResults = make(map[string]*qosobservations.GenericRestProbeResult)
+	}
+	probeResults[probeResult.GetProbeName()] = probeResult
+	e.probeResults = probeResults
+
+	return true
+}
+
+// applyOrganicResponse counts the HTTP status code of an organic response in the endpoint's error rates.
+// A status code of 0 indicates no response was received from the endpoint.
+func (e *endpoint) applyOrganicResponse(httpStatusCode int, now time.Time) {
+	if e.organicStats.isExpired(now) {
+		e.organicStats = organicStats{windowStart: now}
+	}
+
+	e.organicStats.numResponses++
+
+	if httpStatusCode < http.StatusOK || httpStatusCode >= http.StatusMultipleChoices {
+		e.organicStats.numNon2XX++
+	}
+
+	if httpStatusCode == 0 || httpStatusCode >= http.StatusInternalServerError {
+		e.organicStats.numServerErrors++
+	}
+}
@@ -0,0 +1,78 @@
+package genericrest
+
+import (
+	"fmt"
+	"net/http"
+
+	pathhttp "github.com/buildwithgrove/path/network/http"
+)
+
+// errTemplate is the template for the error responses returned to the user, to ensure they are valid JSON.
+const errTemplate = `{"error":"%s","msg":"%s"}`
+
+// httpResponse provides all the functionality required by the pathhttp.HTTPResponse interface.
+var _ pathhttp.HTTPResponse = &httpResponse{}
+
+// httpResponse stores the data required for building and returning a user-facing HTTP response.
+type httpResponse struct {
+	// payload is the raw payload received from the endpoint servicing the request, or an error payload.
+	payload []byte
+	// httpStatusCode is the HTTP status code to be returned to the user.
+	httpStatusCode int
+}
+
+// GetPayload returns the payload of the user-facing HTTP response.
+// Implements the pathhttp.HTTPResponse interface.
+func (h *httpResponse) GetPayload() []byte {
+	return h.payload
+}
+
+// GetHTTPStatusCode returns the HTTP status code of the user-facing HTTP response.
+// Implements the pathhttp.HTTPResponse interface.
+func (h *httpResponse) GetHTTPStatusCode() int {
+	return h.httpStatusCode
+}
+
+// GetHTTPHeaders returns nil: the generic REST QoS does not set any HTTP headers.
+// Implements the pathhttp.HTTPResponse interface.
+func (h *httpResponse) GetHTTPHeaders() map[string]string {
+	return nil
+}
+
+// getRequestErrorResponse returns the HTTP response for a request which could not be read, e.g. a body over the size limit.
+func getRequestErrorResponse(httpStatusCode int, err error) pathhttp.HTTPResponse {
+	return &httpResponse{
+		httpStatusCode: httpStatusCode,
+		payload:        fmt.Appendf(nil, errTemplate, err.Error(), "generic REST qos service error: error processing the request"),
+	}
+}
+
+// getNoEndpointResponse returns the HTTP response for a request with no endpoint responses.
+func getNoEndpointResponse() pathhttp.HTTPResponse {
+	return &httpResponse{
+		httpStatusCode: http.StatusInternalServerError,
+		payload:        fmt.Appendf(nil, errTemplate, "no protocol endpoint responses", "generic REST qos service error: no responses received from any service endpoints"),
+	}
+}
+
+// getEndpointErrorResponse returns the HTTP response for a request whose endpoint returned a non-2xx response, or no response.
+//   - 4xx status codes are returned to the user as-is: e.g. a request for a missing resource.
+//   - Any other status code is returned as a 502 Bad Gateway.
+//
+// DEV_NOTE: the protocol does not report the payload of non-2xx responses, so a generic error payload is returned.
+func getEndpointErrorResponse(endpointHTTPStatusCode int) pathhttp.HTTPResponse {
+	httpStatusCode := http.StatusBadGateway
+	if endpointHTTPStatusCode >= http.StatusBadRequest && endpointHTTPStatusCode < http.StatusInternalServerError {
+		httpStatusCode = endpointHTTPStatusCode
+	}
+
+	errMsg := fmt.Sprintf("endpoint returned HTTP status code %d", endpointHTTPStatusCode)
+	if endpointHTTPStatusCode == 0 {
+		errMsg = "no response received from the endpoint"
+	}
+
+	return &httpResponse{
+		httpStatusCode: httpStatusCode,
+		payload:        fmt.Appendf(nil, errTemplate, errMsg, "generic REST qos service error: endpoint request failed"),
+	}
+}
@@ -0,0 +1,73 @@
+package genericrest
+
+import (
+	"fmt"
+	"slices"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/qos/jsonpath"
+)
+
+// evaluate returns the result of the probe on the endpoint's response.
+//   - httpStatusCode is 0 if no response was received from the endpoint.
+//   - The height is only extracted here: it is compared to the perceived latest height at endpoint selection time.
+func (p Probe) evaluate(httpStatusCode int, responseBz []byte) *qosobservations.GenericRestProbeResult {
+	probeResult := &qosobservations.GenericRestProbeResult{
+		ProbeName: p.Name,
+	}
+
+	if err := p.evaluateResponse(httpStatusCode, responseBz, probeResult); err != nil {
+		probeResult.FailureReason = err.Error()
+		return probeResult
+	}
+
+	probeResult.Passed = true
+	return probeResult
+}
+
+// evaluateResponse returns an error if the endpoint's response does not satisfy the probe.
+// Sets the extracted height on the probe result if the probe declares a height field.
+func (p Probe) evaluateResponse(httpStatusCode int, responseBz []byte, probeResult *qosobservations.GenericRestProbeResult) error {
+	if httpStatusCode == 0 {
+		return fmt.Errorf("no response received from the endpoint")
+	}
+
+	if !slices.Contains(p.getExpectedStatusCodes(), httpStatusCode) {
+		return fmt.Errorf("unexpected HTTP status code %d: expected one of %v", httpStatusCode, p.getExpectedStatusCodes())
+	}
+
+	for _, assertion := range p.FieldAssertions {
+		value, err := jsonpath.Extract(responseBz, assertion.Path)
+		if err != nil {
+			return err
+		}
+
+		if assertion.ExpectedValue == "" {
+			if jsonpath.IsEmptyValue(value) {
+				return fmt.Errorf("field %q is empty", assertion.Path)
+			}
+			continue
+		}
+
+		if !jsonpath.ValueEquals(value, assertion.ExpectedValue) {
+			return fmt.Errorf("field %q value %s does not equal %q", assertion.Path, value, assertion.ExpectedValue)
+		}
+	}
+
+	if p.HeightField == "" {
+		return nil
+	}
+
+	value, err := jsonpath.Extract(responseBz, p.HeightField)
+	if err != nil {
+		return err
+	}
+
+	height, err := jsonpath.ParseNumber(value)
+	if err != nil {
+		return fmt.Errorf("height field %q is not a number: %w", p.HeightField, err)
+	}
+	probeResult.Height = &height
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package genericrest
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProbe_evaluate(t *testing.T) {
+	probe := Probe{
+		Name: "status",
+		Path: "/status",
+		FieldAssertions: []FieldAssertion{
+			{Path: "sync_info.catching_up", ExpectedValue: "false"},
+			{Path: "node_info.network"},
+		},
+		HeightField: "sync_info.latest_block_height",
+	}
+
+	tests := []struct {
+		name           string
+		probe          Probe
+		httpStatusCode int
+		response       string
+		wantPassed     bool
+		expectedHeight uint64
+	}{
+		{
+			name:           "should pass and extract height on healthy response",
+			probe:          probe,
+			httpStatusCode: http.StatusOK,
+			response:       `{"node_info":{"network":"mainnet"},"sync_info":{"catching_up":false,"latest_block_height":"1024"}}`,
+			wantPassed:     true,
+			expectedHeight: 1024,
+		},
+		{
+			name:           "should fail on field not equal to expected value",
+			probe:          probe,
+			httpStatusCode: http.StatusOK,
+			response:       `{"node_info":{"network":"mainnet"},"sync_info":{"catching_up":true,"latest_block_height":"1024"}}`,
+		},
+		{
+			name:           "should fail on empty field",
+			probe:          probe,
+			httpStatusCode: http.StatusOK,
+			response:       `{"node_info":{"network":""},"sync_info":{"catching_up":false,"latest_block_height":"1024"}}`,
+		},
+		{
+			name:           "should fail on non-numeric height",
+			probe:          probe,
+			httpStatusCode: http.StatusOK,
+			response:       `{"node_info":{"network":"mainnet"},"sync_info":{"catching_up":false,"latest_block_height":"latest"}}`,
+		},
+		{
+			name:           "should fail on unexpected status code",
+			probe:          probe,
+			httpStatusCode: http.StatusServiceUnavailable,
+		},
+		{
+			name:           "should pass on expected non-2xx status code",
+			probe:          Probe{Name: "missing", Path: "/missing", ExpectedStatusCodes: []int{http.StatusNotFound}},
+			httpStatusCode: http.StatusNotFound,
+			wantPassed:     true,
+		},
+		{
+			name:  "should fail on no response",
+			probe: Probe{Name: "health", Path: "/health"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := require.New(t)
+
+			probeResult := test.probe.evaluate(test.httpStatusCode, []byte(test.response))
+
+			c.Equal(test.probe.Name, probeResult.GetProbeName())
+			c.Equal(test.wantPassed, probeResult.GetPassed())
+			c.Equal(test.expectedHeight, probeResult.GetHeight())
+			if !test.wantPassed {
+				c.NotEmpty(probeResult.GetFailureReason())
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+// Package genericrest provides a QoS implementation for REST services with no dedicated QoS package,
+// e.g. indexers or other HTTP APIs staked on Shannon.
+// Endpoints are validated using:
+//   - Health probes declared in config: a path, expected status codes, JSON field assertions and an optional height field.
+//   - The rate of 5xx (or missing) responses to organic requests.
+package genericrest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
+	"github.com/buildwithgrove/path/gateway"
+	"github.com/buildwithgrove/path/metrics/devtools"
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+)
+
+// QoS implements gateway.QoSService by providing:
+//  1. QoSRequestParser - Builds RequestQoSContext objects from HTTP requests
+//  2. EndpointSelector - Selects endpoints for service requests
+//  3. QoSEndpointCheckGenerator - Builds the config-declared health probes
+var _ gateway.QoSService = &QoS{}
+
+// QoS implements the gateway.QoSService interface for generic REST services.
+type QoS struct {
+	logger polylog.Logger
+	*endpointStore
+	*requestValidator
+}
+
+// NewQoSInstance builds and returns an instance of the generic REST QoS service.
+func NewQoSInstance(logger polylog.Logger, serviceConfig GenericRESTServiceQoSConfig) *QoS {
+	serviceID := serviceConfig.GetServiceID()
+
+	logger = logger.With(
+		"qos_instance", "generic_rest",
+		"service_id", serviceID,
+	)
+
+	serviceState := &serviceState{
+		logger:                 logger,
+		serviceID:              serviceID,
+		probes:                 serviceConfig.getProbes(),
+		maxServerErrorRate:     serviceConfig.getMaxServerErrorRate(),
+		perceivedLatestHeights: make(map[string]uint64),
+	}
+
+	endpointStore := newEndpointStore(logger, serviceState)
+
+	requestValidator := &requestValidator{
+		logger:        logger,
+		serviceID:     serviceID,
+		endpointStore: endpointStore,
+		requestLimits: serviceConfig.getRequestLimits(),
+	}
+
+	return &QoS{
+		logger:           logger,
+		endpointStore:    endpointStore,
+		requestValidator: requestValidator,
+	}
+}
+
+// ParseHTTPRequest builds a request context from the provided HTTP request.
+// Implements the gateway.QoSService interface.
+func (q *QoS) ParseHTTPRequest(_ context.Context, req *http.Request) (gateway.RequestQoSContext, bool) {
+	return q.validateHTTPRequest(req)
+}
+
+// ParseWebsocketRequest builds a request context from the provided Websocket request.
+// Websocket connection requests do not have a body, so we don't need to parse it.
+// Implements the gateway.QoSService interface.
+func (q *QoS) ParseWebsocketRequest(_ context.Context) (gateway.RequestQoSContext, bool) {
+	return &requestContext{
+		logger:        q.logger,
+		serviceID:     q.serviceState.serviceID,
+		endpointStore: q.endpointStore,
+		requestOrigin: qosobservations.RequestOrigin_REQUEST_ORIGIN_ORGANIC,
+	}, true
+}
+
+// ApplyObservations updates the stored endpoints and the perceived service state using the supplied observations.
+// Implements the gateway.QoSService interface.
+func (q *QoS) ApplyObservations(observations *qosobservations.Observations) error {
+	if observations == nil {
+		return errors.New("ApplyObservations: received nil observations")
+	}
+
+	genericRESTObservations := observations.GetGenericRest()
+	if genericRESTObservations == nil {
+		return errors.New("ApplyObservations: received nil generic REST observation")
+	}
+
+	updatedEndpoints := q.updateEndpointsFromObservations(
+		genericRESTObservations.GetEndpointObservations(),
+		genericRESTObservations.GetRequestOrigin(),
+	)
+	q.serviceState.updateFromEndpoints(updatedEndpoints)
+	return nil
+}
+
+// HydrateDisqualifiedEndpointsResponse hydrates the disqualified endpoint response with the QoS-specific data.
+//   - takes a pointer to the DisqualifiedEndpointResponse
+//   - called by the devtools.DisqualifiedEndpointReporter to fill it with the QoS-specific data.
+func (q *QoS) HydrateDisqualifiedEndpointsResponse(serviceID protocol.ServiceID, details *devtools.DisqualifiedEndpointResponse) {
+	q.logger.Info().Msgf("hydrating disqualified endpoints response for service ID: %s", serviceID)
+	details.QoSLevelDisqualifiedEndpoints = q.getDisqualifiedEndpointsResponse(serviceID)
+}
@@ -0,0 +1,79 @@
+package genericrest
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
+	"github.com/buildwithgrove/path/gateway"
+	pathhttp "github.com/buildwithgrove/path/network/http"
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// requestValidator:
+// - Reads the body of REST requests, up to the maximum request body size
+// - Generates error contexts if reading the body fails
+// - Generates request context otherwise: REST requests are relayed as-is
+type requestValidator struct {
+	logger        polylog.Logger
+	serviceID     protocol.ServiceID
+	endpointStore *endpointStore
+
+	// requestLimits bounds the size of the requests accepted by the service.
+	requestLimits jsonrpc.RequestLimits
+}
+
+// validateHTTPRequest:
+// - Returns (errorContext, false) if the HTTP request body could not be read
+// - Returns (requestContext, true) otherwise
+func (rv *requestValidator) validateHTTPRequest(req *http.Request) (gateway.RequestQoSContext, bool) {
+	logger := rv.logger.With("method", "validateHTTPRequest")
+
+	// Read the HTTP request body, up to the maximum request body size.
+	body, err := rv.requestLimits.ReadRequestBody(req.Body)
+	if errors.Is(err, jsonrpc.ErrRequestBodyTooLarge) {
+		logger.Warn().Err(err).Msg("HTTP request body exceeds the maximum size - returning request too large error response")
+		return rv.createRequestErrorContext(getRequestErrorResponse(http.StatusRequestEntityTooLarge, err), err), false
+	}
+	if err != nil {
+		logger.Warn().Err(err).Msg("HTTP request body read failed - returning generic error response")
+		return rv.createRequestErrorContext(getRequestErrorResponse(http.StatusInternalServerError, err), err), false
+	}
+
+	path := req.URL.Path
+	if req.URL.RawQuery != "" {
+		path += "?" + req.URL.RawQuery
+	}
+
+	return &requestContext{
+		logger:            rv.logger,
+		serviceID:         rv.serviceID,
+		endpointStore:     rv.endpointStore,
+		httpRequestMethod: req.Method,
+		httpRequestPath:   path,
+		httpRequestBody:   body,
+		// Set the origin of the request as USER (i.e. organic relay)
+		// The request is from a user.
+		requestOrigin: qosobservations.RequestOrigin_REQUEST_ORIGIN_ORGANIC,
+	}, true
+}
+
+// createRequestErrorContext creates an error context for a request which could not be read.
+func (rv *requestValidator) createRequestErrorContext(response pathhttp.HTTPResponse, err error) gateway.RequestQoSContext {
+	return &requestErrorContext{
+		logger:   rv.logger,
+		response: response,
+		observations: &qosobservations.GenericRestRequestObservations{
+			ServiceId:     string(rv.serviceID),
+			RequestOrigin: qosobservations.RequestOrigin_REQUEST_ORIGIN_ORGANIC,
+			RequestError: &qosobservations.RequestError{
+				ErrorKind:      qosobservations.RequestErrorKind_REQUEST_ERROR_INTERNAL_READ_HTTP_ERROR,
+				ErrorDetails:   err.Error(),
+				HttpStatusCode: int32(response.GetHTTPStatusCode()),
+			},
+		},
+	}
+}
@@ -0,0 +1,202 @@
+package genericrest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// QoSType is the QoS type for REST services using config-declared health probes.
+const QoSType = "generic_rest"
+
+// DefaultMaxServerErrorRate is the default maximum rate of organic requests an endpoint may fail with a 5xx,
+// or with no response at all, and still be considered valid.
+const DefaultMaxServerErrorRate = 0.5
+
+// The errors below list all the possible validation errors of a probe.
+var (
+	errProbeNameEmpty          = errors.New("probe name is required")
+	errProbePathInvalid        = errors.New("probe path must start with '/'")
+	errProbeStatusCodeInvalid  = errors.New("probe expected status codes must be valid HTTP status codes")
+	errProbeAssertionPathEmpty = errors.New("probe field assertion path is required")
+)
+
+// FieldAssertion is an assertion on a field of a probe's JSON response.
+type FieldAssertion struct {
+	// Path is the dot-separated path into the JSON response of the field, e.g. "sync_info.catching_up".
+	// Array elements are referenced by their index, e.g. "blocks.0.height".
+	Path string
+
+	// ExpectedValue is the value the field must equal.
+	// A JSON string is compared using its unquoted value, any other JSON value using its compact encoding.
+	// If not set, the field is only required to be present and not null, "", [] or {}.
+	ExpectedValue string
+}
+
+// Probe is a health probe, declared in config, run against every endpoint of the service:
+//   - The endpoint is sent an HTTP GET request to the probe's path.
+//   - The endpoint's response must have one of the expected status codes, and satisfy all field assertions.
+//   - If a height field is declared, the endpoint must not lag the highest height reported by any endpoint, i.e. perceived latest.
+type Probe struct {
+	// Name identifies the probe, e.g. in observations and logs. Must be unique per service.
+	Name string
+
+	// Path is the URL path, including any query, of the probe's request, e.g. "/status".
+	Path string
+
+	// ExpectedStatusCodes are the HTTP status codes of a healthy response.
+	// Defaults to 200 if not set.
+	// DEV_NOTE: the protocol only reports the payload of 2xx responses: any 2xx response is reported as a 200.
+	ExpectedStatusCodes []int
+
+	// FieldAssertions are the assertions on the fields of the probe's JSON response.
+	FieldAssertions []FieldAssertion
+
+	// HeightField is the dot-separated path into the JSON response of a number, e.g. a block height.
+	// Hex-encoded strings, decimal strings and JSON numbers are all accepted.
+	// Endpoints lagging the perceived latest height by more than HeightTolerance are disqualified.
+	HeightField string
+
+	// HeightTolerance is the number of units (e.g. blocks) an endpoint may lag the perceived latest height.
+	HeightTolerance uint64
+}
+
+// Validate returns an error if the probe is incomplete or invalid.
+func (p Probe) Validate() error {
+	if p.Name == "" {
+		return errProbeNameEmpty
+	}
+
+	if !strings.HasPrefix(p.Path, "/") {
+		return fmt.Errorf("%w: %q", errProbePathInvalid, p.Path)
+	}
+
+	for _, statusCode := range p.ExpectedStatusCodes {
+		if http.StatusText(statusCode) == "" {
+			return fmt.Errorf("%w: %d", errProbeStatusCodeInvalid, statusCode)
+		}
+	}
+
+	for _, assertion := range p.FieldAssertions {
+		if assertion.Path == "" {
+			return errProbeAssertionPathEmpty
+		}
+	}
+
+	return nil
+}
+
+// getExpectedStatusCodes returns the HTTP status codes of a healthy response, with defaults applied.
+func (p Probe) getExpectedStatusCodes() []int {
+	if len(p.ExpectedStatusCodes) == 0 {
+		return []int{http.StatusOK}
+	}
+	return p.ExpectedStatusCodes
+}
+
+// ServiceQoSConfig defines the base interface for service QoS configurations.
+// This avoids circular dependency with the config package.
+type ServiceQoSConfig interface {
+	GetServiceID() protocol.ServiceID
+	GetServiceQoSType() string
+}
+
+// GenericRESTServiceQoSConfig is the configuration for the generic REST service QoS.
+type GenericRESTServiceQoSConfig interface {
+	ServiceQoSConfig // Using locally defined interface to avoid circular dependency
+	getProbes() []Probe
+	getMaxServerErrorRate() float64
+	getRequestLimits() jsonrpc.RequestLimits
+}
+
+// GenericRESTServiceQoSConfigOption customizes an optional setting of a generic REST service QoS configuration.
+type GenericRESTServiceQoSConfigOption func(*genericRESTServiceQoSConfig)
+
+// WithMaxServerErrorRate sets the maximum rate of organic requests an endpoint may fail with a 5xx, or with no response.
+// Defaults to DefaultMaxServerErrorRate if not set.
+func WithMaxServerErrorRate(maxServerErrorRate float64) GenericRESTServiceQoSConfigOption {
+	return func(c *genericRESTServiceQoSConfig) {
+		c.maxServerErrorRate = maxServerErrorRate
+	}
+}
+
+// WithMaxRequestBodyBytes sets the maximum size of a request body, in bytes.
+// Defaults to jsonrpc.DefaultMaxRequestBodyBytes if not set.
+func WithMaxRequestBodyBytes(maxRequestBodyBytes int64) GenericRESTServiceQoSConfigOption {
+	return func(c *genericRESTServiceQoSConfig) {
+		c.maxRequestBodyBytes = maxRequestBodyBytes
+	}
+}
+
+// NewGenericRESTServiceQoSConfig creates a new generic REST service configuration.
+// The probes must be validated beforehand: see Probe.Validate.
+func NewGenericRESTServiceQoSConfig(
+	serviceID protocol.ServiceID,
+	probes []Probe,
+	opts ...GenericRESTServiceQoSConfigOption,
+) GenericRESTServiceQoSConfig {
+	config := genericRESTServiceQoSConfig{
+		serviceID: serviceID,
+		probes:    probes,
+	}
+
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return config
+}
+
+// Ensure implementation satisfies interface
+var _ GenericRESTServiceQoSConfig = (*genericRESTServiceQoSConfig)(nil)
+
+type genericRESTServiceQoSConfig struct {
+	serviceID protocol.ServiceID
+
+	// probes are the health probes run against every endpoint of the service.
+	probes []Probe
+
+	// maxServerErrorRate is the maximum rate of organic requests an endpoint may fail with a 5xx, or with no response.
+	maxServerErrorRate float64
+
+	// maxRequestBodyBytes is the maximum size of a request body, in bytes.
+	maxRequestBodyBytes int64
+}
+
+// GetServiceID returns the ID of the service.
+// Implements the ServiceQoSConfig interface.
+func (c genericRESTServiceQoSConfig) GetServiceID() protocol.ServiceID {
+	return c.serviceID
+}
+
+// GetServiceQoSType returns the QoS type of the service.
+// Implements the ServiceQoSConfig interface.
+func (genericRESTServiceQoSConfig) GetServiceQoSType() string {
+	return QoSType
+}
+
+// getProbes returns the health probes run against every endpoint of the service.
+// Implements the GenericRESTServiceQoSConfig interface.
+func (c genericRESTServiceQoSConfig) getProbes() []Probe {
+	return c.probes
+}
+
+// getMaxServerErrorRate returns the maximum server error rate of an endpoint, with defaults applied.
+// Implements the GenericRESTServiceQoSConfig interface.
+func (c genericRESTServiceQoSConfig) getMaxServerErrorRate() float64 {
+	if c.maxServerErrorRate <= 0 {
+		return DefaultMaxServerErrorRate
+	}
+	return c.maxServerErrorRate
+}
+
+// getRequestLimits returns the limits on the size of requests, with defaults applied.
+// Only the maximum request body size applies to REST requests.
+// Implements the GenericRESTServiceQoSConfig interface.
+func (c genericRESTServiceQoSConfig) getRequestLimits() jsonrpc.RequestLimits {
+	return jsonrpc.NewRequestLimits(0, c.maxRequestBodyBytes)
+}
@@ -0,0 +1,111 @@
+package genericrest
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
+	"github.com/buildwithgrove/path/protocol"
+)
+
+// The errors below list all the possible reasons an endpoint is disqualified.
+var (
+	errProbeResultMissing = errors.New("endpoint has no observation of its response to a probe")
+	errProbeFailed        = errors.New("endpoint failed a probe")
+	errHeightLagging      = errors.New("endpoint height lags the perceived latest height")
+	errServerErrorRate    = errors.New("endpoint server error rate exceeds the maximum")
+)
+
+// serviceState keeps the expected current state of the service,
+// based on the endpoints' responses to the config-declared probes.
+type serviceState struct {
+	logger polylog.Logger
+
+	// serviceID to add to endpoint probes.
+	// Used by observations of Synthetic requests.
+	serviceID protocol.ServiceID
+
+	// probes are the health probes every endpoint must pass.
+	probes []Probe
+
+	// maxServerErrorRate is the maximum rate of organic requests an endpoint may fail with a 5xx, or with no response.
+	maxServerErrorRate float64
+
+	serviceStateLock sync.RWMutex
+	// perceivedLatestHeights maps the name of each probe with a height field to the highest height reported by any endpoint.
+	perceivedLatestHeights map[string]uint64
+}
+
+// validateEndpoint returns an error if the supplied endpoint is not valid based on the perceived state of the service:
+//   - The endpoint must have passed every probe.
+//   - For probes with a height field, the endpoint's height must be within the probe's tolerance of the perceived latest height.
+//   - The endpoint's server error rate on organic requests must not exceed the maximum.
+func (s *serviceState) validateEndpoint(endpoint endpoint) error {
+	s.serviceStateLock.RLock()
+	defer s.serviceStateLock.RUnlock()
+
+	for _, probe := range s.probes {
+		probeResult, found := endpoint.probeResults[probe.Name]
+		if !found {
+			return fmt.Errorf("%w: %q", errProbeResultMissing, probe.Name)
+		}
+
+		if !probeResult.GetPassed() {
+			return fmt.Errorf("%w: %q: %s", errProbeFailed, probe.Name, probeResult.GetFailureReason())
+		}
+
+		if probe.HeightField == "" {
+			continue
+		}
+
+		perceivedLatestHeight := s.perceivedLatestHeights[probe.Name]
+		if probeResult.GetHeight()+probe.HeightTolerance < perceivedLatestHeight {
+			return fmt.Errorf("%w: probe %q: height %d is more than %d behind %d",
+				errHeightLagging, probe.Name, probeResult.GetHeight(), probe.HeightTolerance, perceivedLatestHeight)
+		}
+	}
+
+	stats := endpoint.organicStats
+	if stats.isExpired(time.Now()) || stats.numResponses < minOrganicResponsesForErrorRate {
+		return nil
+	}
+
+	if stats.serverErrorRate() > s.maxServerErrorRate {
+		return fmt.Errorf("%w: 5xx rate %.2f, non-2xx rate %.2f over %d organic requests: maximum 5xx rate %.2f",
+			errServerErrorRate, stats.serverErrorRate(), stats.non2XXRate(), stats.numResponses, s.maxServerErrorRate)
+	}
+
+	return nil
+}
+
+// updateFromEndpoints updates the service state using the heights reported by the set of updated endpoints.
+// NOTE: This only includes the set of endpoints for which an observation was received.
+func (s *serviceState) updateFromEndpoints(updatedEndpoints map[protocol.EndpointAddr]endpoint) {
+	s.serviceStateLock.Lock()
+	defer s.serviceStateLock.Unlock()
+
+	for endpointAddr, endpoint := range updatedEndpoints {
+		for probeName, probeResult := range endpoint.probeResults {
+			if !probeResult.GetPassed() || probeResult.Height == nil {
+				continue
+			}
+
+			// TODO_TECHDEBT: use a more resilient method for updating the perceived latest height.
+			// e.g. one endpoint returning a very large height should not result in all other endpoints being marked as invalid.
+			if probeResult.GetHeight() <= s.perceivedLatestHeights[probeName] {
+				continue
+			}
+
+			s.perceivedLatestHeights[probeName] = probeResult.GetHeight()
+
+			s.logger.With(
+				"endpoint", endpointAddr,
+				"probe", probeName,
+				"perceived_latest_height", probeResult.GetHeight(),
+			).Debug().Msg("Updating the perceived latest height")
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package genericrest
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/stretchr/testify/require"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+)
+
+func TestServiceState_validateEndpoint(t *testing.T) {
+	probes := []Probe{
+		{Name: "health", Path: "/health"},
+		{Name: "status", Path: "/status", HeightField: "height", HeightTolerance: 2},
+	}
+
+	health := func(passed bool) *qosobservations.GenericRestProbeResult {
+		return &qosobservations.GenericRestProbeResult{ProbeName: "health", Passed: passed}
+	}
+	status := func(height uint64) *qosobservations.GenericRestProbeResult {
+		return &qosobservations.GenericRestProbeResult{ProbeName: "status", Passed: true, Height: &height}
+	}
+
+	tests := []struct {
+		name         string
+		probeResults []*qosobservations.GenericRestProbeResult
+		expectedErr  error
+	}{
+		{
+			name:         "valid endpoint within the tolerance of the perceived latest height",
+			probeResults: []*qosobservations.GenericRestProbeResult{health(true), status(98)},
+		},
+		{
+			name:         "endpoint lagging beyond the tolerance of the perceived latest height",
+			probeResults: []*qosobservations.GenericRestProbeResult{health(true), status(97)},
+			expectedErr:  errHeightLagging,
+		},
+		{
+			name:         "endpoint which failed a probe",
+			probeResults: []*qosobservations.GenericRestProbeResult{health(false), status(100)},
+			expectedErr:  errProbeFailed,
+		},
+		{
+			name:         "endpoint missing a probe result",
+			probeResults: []*qosobservations.GenericRestProbeResult{status(100)},
+			expectedErr:  errProbeResultMissing,
+		},
+		{
+			name:        "endpoint with no probe results",
+			expectedErr: errProbeResultMissing,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &serviceState{
+				logger:                 polyzero.NewLogger(),
+				probes:                 probes,
+				maxServerErrorRate:     DefaultMaxServerErrorRate,
+				perceivedLatestHeights: make(map[string]uint64),
+			}
+			// A reference endpoint sets the perceived latest height of the status probe.
+			var referenceEndpoint endpoint
+			referenceEndpoint.applyObservation(
+				&qosobservations.GenericRestEndpointObservation{ProbeResult: status(100)},
+				qosobservations.RequestOrigin_REQUEST_ORIGIN_SYNTHETIC,
+			)
+			state.updateFromEndpoints(map[protocol.EndpointAddr]endpoint{"reference": referenceEndpoint})
+
+			var endpoint endpoint
+			for _, probeResult := range tt.probeResults {
+				endpoint.applyObservation(
+					&qosobservations.GenericRestEndpointObservation{ProbeResult: probeResult},
+					qosobservations.RequestOrigin_REQUEST_ORIGIN_SYNTHETIC,
+				)
+			}
+
+			err := state.validateEndpoint(endpoint)
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestServiceState_validateEndpoint_ServerErrorRate(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name             string
+		httpStatusCodes  []int
+		windowStart      time.Time
+		expectedErr      error
+		expectedNon2XX   float64
+		expectedServer5X float64
+	}{
+		{
+			name:             "server errors below the minimum number of organic responses are ignored",
+			httpStatusCodes:  repeatStatusCode(http.StatusBadGateway, minOrganicResponsesForErrorRate-1),
+			windowStart:      now,
+			expectedNon2XX:   1,
+			expectedServer5X: 1,
+		},
+		{
+			name:             "server error rate above the maximum disqualifies the endpoint",
+			httpStatusCodes:  repeatStatusCode(http.StatusBadGateway, minOrganicResponsesForErrorRate),
+			windowStart:      now,
+			expectedErr:      errServerErrorRate,
+			expectedNon2XX:   1,
+			expectedServer5X: 1,
+		},
+		{
+			name:             "no response counts as a server error",
+			httpStatusCodes:  repeatStatusCode(0, minOrganicResponsesForErrorRate),
+			windowStart:      now,
+			expectedErr:      errServerErrorRate,
+			expectedNon2XX:   1,
+			expectedServer5X: 1,
+		},
+		{
+			name:            "4xx responses count towards the non-2xx rate only",
+			httpStatusCodes: repeatStatusCode(http.StatusNotFound, minOrganicResponsesForErrorRate),
+			windowStart:     now,
+			expectedNon2XX:  1,
+		},
+		{
+			name:            "expired stats are ignored",
+			httpStatusCodes: repeatStatusCode(http.StatusBadGateway, minOrganicResponsesForErrorRate),
+			windowStart:     now.Add(-2 * organicStatsWindow),
+			// The stats are not reset until the next organic response.
+			expectedNon2XX:   1,
+			expectedServer5X: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &serviceState{
+				logger:                 polyzero.NewLogger(),
+				maxServerErrorRate:     DefaultMaxServerErrorRate,
+				perceivedLatestHeights: make(map[string]uint64),
+			}
+
+			var endpoint endpoint
+			for _, httpStatusCode := range tt.httpStatusCodes {
+				endpoint.applyOrganicResponse(httpStatusCode, now)
+			}
+			endpoint.organicStats.windowStart = tt.windowStart
+
+			err := state.validateEndpoint(endpoint)
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tt.expectedErr)
+			}
+			require.Equal(t, tt.expectedNon2XX, endpoint.organicStats.non2XXRate())
+			require.Equal(t, tt.expectedServer5X, endpoint.organicStats.serverErrorRate())
+		})
+	}
+}
+
+func TestEndpoint_applyOrganicResponse_ResetsExpiredStats(t *testing.T) {
+	now := time.Now()
+
+	var endpoint endpoint
+	for _, httpStatusCode := range repeatStatusCode(http.StatusBadGateway, minOrganicResponsesForErrorRate) {
+		endpoint.applyOrganicResponse(httpStatusCode, now.Add(-2*organicStatsWindow))
+	}
+
+	endpoint.applyOrganicResponse(http.StatusOK, now)
+	require.Equal(t, organicStats{windowStart: now, numResponses: 1}, endpoint.organicStats)
+}
+
+func repeatStatusCode(httpStatusCode, count int) []int {
+	httpStatusCodes := make([]int, count)
+	for i := range httpStatusCodes {
+		httpStatusCodes[i] = httpStatusCode
+	}
+	return httpStatusCodes
+}
@@ -0,0 +1,75 @@
+package genericrest
+
+import (
+	"errors"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
+	"github.com/buildwithgrove/path/metrics/devtools"
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos"
+)
+
+// endpointStore provides the endpoint selection capability required
+// by the protocol package for handling a service request.
+var _ protocol.EndpointSelector = &endpointStore{}
+
+// endpointStore holds the latest result of each configured probe, and the organic error rates, of each endpoint of a generic REST service.
+type endpointStore struct {
+	*qos.EndpointStore[endpoint]
+
+	logger polylog.Logger
+
+	serviceState *serviceState
+}
+
+// newEndpointStore returns an empty endpoint store, validating endpoints against the service's probes and maximum server error rate.
+func newEndpointStore(logger polylog.Logger, serviceState *serviceState) *endpointStore {
+	return &endpointStore{
+		EndpointStore: qos.NewEndpointStore(logger, serviceState.validateEndpoint),
+		logger:        logger,
+		serviceState:  serviceState,
+	}
+}
+
+// updateEndpointsFromObservations stores the probe results or organic responses of the observed endpoints:
+// synthetic observations update the probe results, and organic observations update the error rates.
+// It returns the set of created/updated endpoints, used to update the perceived latest heights.
+func (es *endpointStore) updateEndpointsFromObservations(
+	endpointObservations []*qosobservations.GenericRestEndpointObservation,
+	requestOrigin qosobservations.RequestOrigin,
+) map[protocol.EndpointAddr]endpoint {
+	return qos.UpdateEndpointsFromObservations(
+		es.EndpointStore,
+		endpointObservations,
+		func(e *endpoint, obs *qosobservations.GenericRestEndpointObservation) bool {
+			return e.applyObservation(obs, requestOrigin)
+		},
+	)
+}
+
+// getDisqualifiedEndpointsResponse returns the endpoints failing a probe or exceeding the server error rate, for a devtools.DisqualifiedEndpointResponse.
+func (es *endpointStore) getDisqualifiedEndpointsResponse(serviceID protocol.ServiceID) devtools.QoSLevelDataResponse {
+	return es.GetDisqualifiedEndpointsResponse(serviceID, countDisqualifiedEndpoint)
+}
+
+// countDisqualifiedEndpoint counts a generic REST endpoint's validation error towards the matching devtools counter.
+// Only lagging heights have a matching counter: other failures are reported through the disqualification reason.
+func countDisqualifiedEndpoint(response *devtools.QoSLevelDataResponse, err error) bool {
+	switch {
+	// Endpoint is disqualified due to lagging the perceived latest height.
+	case errors.Is(err, errHeightLagging):
+		response.BlockNumberCheckErrorsCount++
+		return true
+
+	// Other probe failures and organic error rates are only reported through the disqualification reason.
+	case errors.Is(err, errProbeResultMissing),
+		errors.Is(err, errProbeFailed),
+		errors.Is(err, errServerErrorRate):
+		return true
+
+	default:
+		return false
+	}
+}
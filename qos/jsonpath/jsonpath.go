@@ -0,0 +1,105 @@
+// Package jsonpath extracts and asserts on values at dot-separated paths into JSON documents.
+// It is used by QoS implementations whose checks are declared in config, e.g. generic JSON-RPC and REST services.
+package jsonpath
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Extract returns the value at the supplied dot-separated path into the JSON value.
+// Array elements are referenced by their index, e.g. "blocks.0.height".
+func Extract(data json.RawMessage, path string) (json.RawMessage, error) {
+	if path == "" {
+		return data, nil
+	}
+
+	value := data
+	for _, key := range strings.Split(path, ".") {
+		trimmedValue := bytes.TrimSpace(value)
+
+		if len(trimmedValue) > 0 && trimmedValue[0] == '[' {
+			var elements []json.RawMessage
+			if err := json.Unmarshal(trimmedValue, &elements); err != nil {
+				return nil, fmt.Errorf("error parsing the array at path element %q: %w", key, err)
+			}
+
+			idx, err := strconv.Atoi(key)
+			if err != nil || idx < 0 || idx >= len(elements) {
+				return nil, fmt.Errorf("path element %q is not a valid index into an array of %d elements", key, len(elements))
+			}
+
+			value = elements[idx]
+			continue
+		}
+
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(trimmedValue, &fields); err != nil {
+			return nil, fmt.Errorf("error parsing the object at path element %q: %w", key, err)
+		}
+
+		fieldValue, found := fields[key]
+		if !found {
+			return nil, fmt.Errorf("path element %q not found", key)
+		}
+		value = fieldValue
+	}
+
+	return value, nil
+}
+
+// ValueEquals returns true if the JSON value equals the expected value:
+//   - A JSON string is compared using its unquoted value.
+//   - Any other JSON value is compared using its compact encoding, e.g. `true` or `{"a":1}`.
+func ValueEquals(value json.RawMessage, expected string) bool {
+	var strValue string
+	if err := json.Unmarshal(value, &strValue); err == nil {
+		return strValue == expected
+	}
+
+	var compactValue bytes.Buffer
+	if err := json.Compact(&compactValue, value); err != nil {
+		return false
+	}
+
+	var compactExpected bytes.Buffer
+	if err := json.Compact(&compactExpected, []byte(expected)); err != nil {
+		return compactValue.String() == expected
+	}
+
+	return compactValue.String() == compactExpected.String()
+}
+
+// IsEmptyValue returns true if the JSON value is missing, null, or an empty string, array or object.
+func IsEmptyValue(value json.RawMessage) bool {
+	var compactValue bytes.Buffer
+	if err := json.Compact(&compactValue, value); err != nil {
+		return true
+	}
+
+	switch compactValue.String() {
+	case "", "null", `""`, "[]", "{}":
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseNumber parses the JSON value as an unsigned number.
+// Accepts hex-encoded strings (e.g. "0x1b4"), decimal strings (e.g. "436") and JSON numbers (e.g. 436).
+func ParseNumber(value json.RawMessage) (uint64, error) {
+	var strValue string
+	if err := json.Unmarshal(value, &strValue); err != nil {
+		// Not a JSON string: parse as a JSON number.
+		return strconv.ParseUint(string(bytes.TrimSpace(value)), 10, 64)
+	}
+
+	if hexValue, isHex := strings.CutPrefix(strings.ToLower(strValue), "0x"); isHex {
+		return strconv.ParseUint(hexValue, 16, 64)
+	}
+
+	return strconv.ParseUint(strValue, 10, 64)
+}
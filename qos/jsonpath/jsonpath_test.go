@@ -0,0 +1,90 @@
+package jsonpath
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtract(t *testing.T) {
+	data := json.RawMessage(`{"header":{"height":"0x10"},"blocks":[{"id":"a"},{"id":"b"}]}`)
+
+	tests := []struct {
+		name     string
+		path     string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "should return the whole value for an empty path",
+			path:     "",
+			expected: string(data),
+		},
+		{
+			name:     "should return a nested object field",
+			path:     "header.height",
+			expected: `"0x10"`,
+		},
+		{
+			name:     "should return an array element by index",
+			path:     "blocks.1.id",
+			expected: `"b"`,
+		},
+		{
+			name:    "should return error for a missing field",
+			path:    "header.hash",
+			wantErr: true,
+		},
+		{
+			name:    "should return error for an out of range index",
+			path:    "blocks.2",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := require.New(t)
+
+			value, err := Extract(data, test.path)
+			if test.wantErr {
+				c.Error(err)
+				return
+			}
+
+			c.NoError(err)
+			c.Equal(test.expected, string(value))
+		})
+	}
+}
+
+func TestParseNumber(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected uint64
+		wantErr  bool
+	}{
+		{name: "should parse hex-encoded string", value: `"0x1b4"`, expected: 436},
+		{name: "should parse decimal string", value: `"436"`, expected: 436},
+		{name: "should parse JSON number", value: `436`, expected: 436},
+		{name: "should return error for non-numeric string", value: `"latest"`, wantErr: true},
+		{name: "should return error for negative number", value: `-1`, wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := require.New(t)
+
+			number, err := ParseNumber(json.RawMessage(test.value))
+			if test.wantErr {
+				c.Error(err)
+				return
+			}
+
+			c.NoError(err)
+			c.Equal(test.expected, number)
+		})
+	}
+}
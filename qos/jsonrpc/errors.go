@@ -105,3 +105,19 @@ func NewErrResponseBatchMarshalFailure(err error) Response {
 		},
 	)
 }
+
+// NewErrResponseBatchMemberMissing creates a JSON-RPC error response for a batch member with no response:
+//   - Preserves the request's ID, so the client can correlate the error with its request.
+//   - Marks error as retryable for safe client retry
+func NewErrResponseBatchMemberMissing(requestID ID) Response {
+	return GetErrorResponse(
+		requestID,                      // Use request's original ID
+		ResponseCodeDefaultInternalErr, // Used to indicate an internal PATH/protocol error (e.g. endpoint timed out).
+		"Failed to receive a response for the request in the batch. Please try again.", // Error Response Message
+		map[string]string{
+			// Custom extension - not part of the official JSON-RPC spec
+			// Marks the error as retryable to allow clients to safely retry their request.
+			"retryable": "true",
+		},
+	)
+}
@@ -22,19 +22,30 @@ func ParseJSONRPCFromRequestBody(
 	logger polylog.Logger,
 	requestBody []byte,
 ) (map[ID]Request, bool, error) {
+	requestsMap, _, isBatch, err := ParseOrderedJSONRPCFromRequestBody(logger, requestBody)
+	return requestsMap, isBatch, err
+}
+
+// ParseOrderedJSONRPCFromRequestBody parses HTTP request bodies the same way as ParseJSONRPCFromRequestBody.
+// It additionally returns the parsed requests as a batch, in their original order.
+// Used to return the responses to a batch request in request order.
+func ParseOrderedJSONRPCFromRequestBody(
+	logger polylog.Logger,
+	requestBody []byte,
+) (map[ID]Request, BatchRequest, bool, error) {
 	// Validate and parse the request body into a slice of requests
 	requests, isBatch, err := parseRequestsFromBody(logger, requestBody)
 	if err != nil {
-		return nil, false, err
+		return nil, BatchRequest{}, false, err
 	}
 
 	// Validate batch constraints and convert to map
 	requestsMap, err := validateAndMapRequests(logger, requests)
 	if err != nil {
-		return nil, false, err
+		return nil, BatchRequest{}, false, err
 	}
 
-	return requestsMap, isBatch, nil
+	return requestsMap, BatchRequest{Requests: requests}, isBatch, nil
 }
 
 // parseRequestsFromBody converts raw request body into a slice of JSON-RPC requests.
@@ -100,23 +111,15 @@ func tryUnmarshalAsSingle(logger polylog.Logger, requestBody []byte) ([]Request,
 // validateAndMapRequests validates batch constraints and converts requests to a map.
 // Ensures no duplicate IDs exist and batch is not empty per JSON-RPC specification.
 func validateAndMapRequests(logger polylog.Logger, requests []Request) (map[ID]Request, error) {
-	// Validate batch is not empty (per JSON-RPC spec)
-	if len(requests) == 0 {
-		logger.Error().
-			Msg("❌ Empty batch request not allowed per JSON-RPC specification")
-		return nil, fmt.Errorf("empty batch request not allowed")
+	// Validate batch is not empty, and has no duplicate IDs (notifications, which have empty IDs, are exempt).
+	if err := validateBatchRequests(requests); err != nil {
+		logger.Error().Err(err).Msg("❌ Batch request failed JSON-RPC validation")
+		return nil, err
 	}
 
-	// Convert to map and validate no duplicate IDs exist
+	// Convert to map
 	requestsMap := make(map[ID]Request)
 	for _, req := range requests {
-		// Check for duplicate IDs (skip notifications which have empty IDs)
-		if !req.ID.IsEmpty() {
-			if _, exists := requestsMap[req.ID]; exists {
-				logger.Error().Msg("❌ Duplicate ID found in batch request")
-				return nil, fmt.Errorf("duplicate ID '%s' found in batch request - IDs must be unique for proper request-response correlation", req.ID.String())
-			}
-		}
 		requestsMap[req.ID] = req
 	}
 
@@ -2,44 +2,141 @@ package jsonrpc
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 )
 
+var (
+	ErrBatchRequestEmpty       = errors.New("empty batch request not allowed per JSON-RPC specification")
+	ErrBatchRequestDuplicateID = errors.New("duplicate ID found in batch request")
+)
+
+// BatchRequest is a JSON-RPC batch request.
+//   - Notifications, i.e. requests with no ID, are allowed and get no response.
+//   - IDs of all other requests must be unique, to correlate each response with its request.
+//
+// Reference: https://www.jsonrpc.org/specification#batch
 type BatchRequest struct {
 	Requests []Request
 }
 
-// TODO_UPNEXT(@adshmh): Validate ID values, e.g. for duplicate values, when unmarshaling.
-//
 // GetRequestPayloads returns the slice of serialized forms of JSONRPC requests.
-func (br *BatchRequest) GetRequestsPayloads() [][]byte {
+// Returns an error if any of the requests fails to marshal.
+func (br *BatchRequest) GetRequestsPayloads() ([][]byte, error) {
 	requestPayloads := make([][]byte, len(br.Requests))
 	for i, req := range br.Requests {
-		// TODO_TECHDEBT(@adshmh): Log an entry if there is an error marshaling.
-		// A marshaling error here should never happen here.
-		payload, _ := json.Marshal(req)
+		payload, err := json.Marshal(req)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling request with ID %s in the batch: %w", req.ID.String(), err)
+		}
 		requestPayloads[i] = payload
 	}
 
-	return requestPayloads
+	return requestPayloads, nil
 }
 
 // Custom unmarshaller to support requests of the format `[{"jsonrpc":"2.0","id":1},{"jsonrpc":"2.0","id":2}]`
+// Returns an error if the batch is empty, or contains duplicate IDs.
 func (br *BatchRequest) UnmarshalJSON(data []byte) error {
 	var requests []Request
 	if err := json.Unmarshal(data, &requests); err != nil {
 		return err
 	}
 
+	if err := validateBatchRequests(requests); err != nil {
+		return err
+	}
+
 	br.Requests = requests
 	return nil
 }
 
-// TODO_UPNEXT(@adshmh): Validate responses in the batch
-//
+// validateBatchRequests validates the requests of a batch per the JSON-RPC specification:
+//   - The batch must not be empty.
+//   - The IDs must be unique. Notifications, i.e. requests with no ID, are exempt.
+func validateBatchRequests(requests []Request) error {
+	if len(requests) == 0 {
+		return ErrBatchRequestEmpty
+	}
+
+	for i, req := range requests {
+		if req.ID.IsEmpty() {
+			continue
+		}
+
+		for _, otherReq := range requests[:i] {
+			if req.ID.Equal(otherReq.ID) {
+				return fmt.Errorf("%w: '%s' - IDs must be unique for proper request-response correlation", ErrBatchRequestDuplicateID, req.ID.String())
+			}
+		}
+	}
+
+	return nil
+}
+
 // BuildResponseBytes constructs a Batch JSONRPC response from the slice of response payloads.
+//   - Responses are matched to requests by ID, and ordered to match the order of the requests in the batch.
+//   - A request may have multiple responses, e.g. if it was retried on a different endpoint: see getBatchMemberResponse.
+//   - Notifications, i.e. requests with no ID, get no response.
+//   - A request with no matching response gets a synthesized JSON-RPC error response with its ID.
+//   - Responses which do not match any request, e.g. an error response with a null ID for a failed relay, are dropped.
 func (br *BatchRequest) BuildResponseBytes(jsonrpcResponses []Response) []byte {
+	orderedResponses := make([]Response, 0, len(br.Requests))
+	for _, req := range br.Requests {
+		if req.ID.IsEmpty() {
+			continue
+		}
+
+		responseIdx, found := getBatchMemberResponse(req.ID, jsonrpcResponses)
+		if !found {
+			orderedResponses = append(orderedResponses, NewErrResponseBatchMemberMissing(req.ID))
+			continue
+		}
+
+		orderedResponses = append(orderedResponses, jsonrpcResponses[responseIdx])
+	}
+
 	// TODO_TECHDEBT(@adshmh): Refactor so marshaling a Response never fails.
-	responseBz, _ := json.Marshal(jsonrpcResponses)
+	responseBz, _ := json.Marshal(orderedResponses)
 
 	return responseBz
 }
+
+// GetFailedRequests returns the requests in the batch with no successful response among the supplied responses.
+//   - A request with no matching response, or only error responses indicating a backend failure, has failed.
+//   - Notifications, i.e. requests with no ID, are never considered failed as they do not get a response.
+//
+// Used to retry the failed members of a batch, e.g. on a different endpoint.
+func (br *BatchRequest) GetFailedRequests(jsonrpcResponses []Response) []Request {
+	var failedRequests []Request
+	for _, req := range br.Requests {
+		if req.ID.IsEmpty() {
+			continue
+		}
+
+		responseIdx, found := getBatchMemberResponse(req.ID, jsonrpcResponses)
+		if !found || jsonrpcResponses[responseIdx].IsBackendFailure() {
+			failedRequests = append(failedRequests, req)
+		}
+	}
+
+	return failedRequests
+}
+
+// getBatchMemberResponse returns the index of the response to use for the request with the supplied ID.
+// The first response not indicating a backend failure is preferred, otherwise the last matching response is used.
+func getBatchMemberResponse(id ID, jsonrpcResponses []Response) (int, bool) {
+	responseIdx := -1
+	for idx, jsonrpcResponse := range jsonrpcResponses {
+		if !id.Equal(jsonrpcResponse.ID) {
+			continue
+		}
+
+		if !jsonrpcResponse.IsBackendFailure() {
+			return idx, true
+		}
+		responseIdx = idx
+	}
+
+	return responseIdx, responseIdx >= 0
+}
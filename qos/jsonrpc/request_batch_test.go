@@ -0,0 +1,171 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchRequest_BuildResponseBytes(t *testing.T) {
+	resultResponse := func(id ID, result string) Response {
+		rawResult := json.RawMessage(result)
+		return Response{ID: id, Version: Version2, Result: &rawResult}
+	}
+
+	batchRequest := BatchRequest{
+		Requests: []Request{
+			{ID: IDFromInt(1), JSONRPC: Version2, Method: "eth_blockNumber"},
+			{ID: IDFromInt(2), JSONRPC: Version2, Method: "eth_chainId"},
+			// Notification: no response expected.
+			{JSONRPC: Version2, Method: "eth_gasPrice"},
+			{ID: IDFromStr("three"), JSONRPC: Version2, Method: "eth_gasPrice"},
+		},
+	}
+
+	tests := []struct {
+		name                 string
+		responses            []Response
+		expectedResponses    string
+		expectedFailedReqIDs []ID
+	}{
+		{
+			name: "responses are returned in request order",
+			responses: []Response{
+				resultResponse(IDFromStr("three"), `"0x3"`),
+				resultResponse(IDFromInt(2), `"0x2"`),
+				resultResponse(IDFromInt(1), `"0x1"`),
+			},
+			expectedResponses: `[
+				{"jsonrpc":"2.0","id":1,"result":"0x1"},
+				{"jsonrpc":"2.0","id":2,"result":"0x2"},
+				{"jsonrpc":"2.0","id":"three","result":"0x3"}
+			]`,
+		},
+		{
+			name: "successful response of a retried request is preferred over the failed one",
+			responses: []Response{
+				resultResponse(IDFromInt(1), `"0x1"`),
+				NewErrResponseInternalErr(IDFromInt(2), errors.New("endpoint timed out")),
+				resultResponse(IDFromStr("three"), `"0x3"`),
+				resultResponse(IDFromInt(2), `"0x2"`),
+			},
+			expectedResponses: `[
+				{"jsonrpc":"2.0","id":1,"result":"0x1"},
+				{"jsonrpc":"2.0","id":2,"result":"0x2"},
+				{"jsonrpc":"2.0","id":"three","result":"0x3"}
+			]`,
+		},
+		{
+			name: "an error response is synthesized for a request with no matching response",
+			responses: []Response{
+				resultResponse(IDFromInt(1), `"0x1"`),
+				GetErrorResponse(ID{}, ResponseCodeBackendServerErr, "malformed endpoint payload", map[string]string{"endpoint": "1"}),
+				resultResponse(IDFromStr("three"), `"0x3"`),
+			},
+			expectedResponses: `[
+				{"jsonrpc":"2.0","id":1,"result":"0x1"},
+				{"jsonrpc":"2.0","id":2,"error":{"code":-31001,"message":"Failed to receive a response for the request in the batch. Please try again.","data":{"retryable":"true"}}},
+				{"jsonrpc":"2.0","id":"three","result":"0x3"}
+			]`,
+			expectedFailedReqIDs: []ID{IDFromInt(2)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := require.New(t)
+
+			c.JSONEq(test.expectedResponses, string(batchRequest.BuildResponseBytes(test.responses)))
+
+			failedRequests := batchRequest.GetFailedRequests(test.responses)
+			c.Len(failedRequests, len(test.expectedFailedReqIDs))
+			for i, failedReq := range failedRequests {
+				c.True(failedReq.ID.Equal(test.expectedFailedReqIDs[i]))
+			}
+		})
+	}
+}
+
+func TestBatchRequest_GetFailedRequests(t *testing.T) {
+	c := require.New(t)
+
+	batchRequest := BatchRequest{
+		Requests: []Request{
+			{ID: IDFromInt(1), JSONRPC: Version2, Method: "eth_call"},
+			{ID: IDFromInt(2), JSONRPC: Version2, Method: "eth_call"},
+			{ID: IDFromInt(3), JSONRPC: Version2, Method: "eth_call"},
+		},
+	}
+
+	failedRequests := batchRequest.GetFailedRequests([]Response{
+		// Errors returned by the backend service, e.g. a reverted call, are not retried.
+		GetErrorResponse(IDFromInt(1), 3, "execution reverted", nil),
+		NewErrResponseInternalErr(IDFromInt(2), errors.New("relay failed")),
+	})
+
+	c.Len(failedRequests, 2)
+	c.True(failedRequests[0].ID.Equal(IDFromInt(2)))
+	c.True(failedRequests[1].ID.Equal(IDFromInt(3)))
+}
+
+func TestBatchRequest_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		expectedErr error
+	}{
+		{
+			name: "valid batch with a notification",
+			body: `[{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"},{"jsonrpc":"2.0","method":"eth_gasPrice"}]`,
+		},
+		{
+			name:        "empty batch is rejected",
+			body:        `[]`,
+			expectedErr: ErrBatchRequestEmpty,
+		},
+		{
+			name:        "duplicate IDs are rejected",
+			body:        `[{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"},{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}]`,
+			expectedErr: ErrBatchRequestDuplicateID,
+		},
+		{
+			name: "multiple notifications are allowed",
+			body: `[{"jsonrpc":"2.0","method":"eth_blockNumber"},{"jsonrpc":"2.0","method":"eth_chainId"}]`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var batchRequest BatchRequest
+			err := json.Unmarshal([]byte(test.body), &batchRequest)
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRequestLimits(t *testing.T) {
+	c := require.New(t)
+
+	limits := NewRequestLimits(2, 16)
+
+	_, err := limits.ReadRequestBody(strings.NewReader(`{"jsonrpc":"2.0"}`))
+	c.ErrorIs(err, ErrRequestBodyTooLarge)
+
+	body, err := limits.ReadRequestBody(strings.NewReader(`{"id":1}`))
+	c.NoError(err)
+	c.Equal(`{"id":1}`, string(body))
+
+	c.NoError(limits.ValidateBatchSize(2))
+	c.ErrorIs(limits.ValidateBatchSize(3), ErrBatchTooLarge)
+
+	defaultLimits := NewRequestLimits(0, 0)
+	c.Equal(DefaultMaxBatchSize, defaultLimits.MaxBatchSize)
+	c.Equal(int64(DefaultMaxRequestBodyBytes), defaultLimits.MaxRequestBodyBytes)
+}
@@ -0,0 +1,69 @@
+package jsonrpc
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	// DefaultMaxBatchSize is the default maximum number of requests in a JSON-RPC batch request.
+	DefaultMaxBatchSize = 1000
+
+	// DefaultMaxRequestBodyBytes is the default maximum size of a JSON-RPC request body: 10 MiB.
+	DefaultMaxRequestBodyBytes = 10 * 1024 * 1024
+)
+
+var (
+	ErrRequestBodyTooLarge = errors.New("request body exceeds the maximum allowed size")
+	ErrBatchTooLarge       = errors.New("batch request exceeds the maximum allowed number of requests")
+)
+
+// RequestLimits bounds the size of the JSON-RPC requests accepted by a service.
+type RequestLimits struct {
+	// MaxBatchSize is the maximum number of requests in a batch request.
+	MaxBatchSize int
+
+	// MaxRequestBodyBytes is the maximum size of the HTTP request body, in bytes.
+	MaxRequestBodyBytes int64
+}
+
+// NewRequestLimits returns the request limits for the supplied values.
+// A value of 0 is replaced with the corresponding default, i.e. DefaultMaxBatchSize or DefaultMaxRequestBodyBytes.
+func NewRequestLimits(maxBatchSize int, maxRequestBodyBytes int64) RequestLimits {
+	if maxBatchSize <= 0 {
+		maxBatchSize = DefaultMaxBatchSize
+	}
+	if maxRequestBodyBytes <= 0 {
+		maxRequestBodyBytes = DefaultMaxRequestBodyBytes
+	}
+
+	return RequestLimits{
+		MaxBatchSize:        maxBatchSize,
+		MaxRequestBodyBytes: maxRequestBodyBytes,
+	}
+}
+
+// ReadRequestBody reads the HTTP request body, up to the maximum request body size.
+// Returns ErrRequestBodyTooLarge if the body exceeds the maximum size.
+func (l RequestLimits) ReadRequestBody(body io.Reader) ([]byte, error) {
+	// Read one extra byte to detect bodies exceeding the limit.
+	bodyBz, err := io.ReadAll(io.LimitReader(body, l.MaxRequestBodyBytes+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(bodyBz)) > l.MaxRequestBodyBytes {
+		return nil, fmt.Errorf("%w: limit is %d bytes", ErrRequestBodyTooLarge, l.MaxRequestBodyBytes)
+	}
+
+	return bodyBz, nil
+}
+
+// ValidateBatchSize returns ErrBatchTooLarge if the number of requests exceeds the maximum batch size.
+func (l RequestLimits) ValidateBatchSize(numRequests int) error {
+	if numRequests > l.MaxBatchSize {
+		return fmt.Errorf("%w: got %d requests, limit is %d", ErrBatchTooLarge, numRequests, l.MaxBatchSize)
+	}
+	return nil
+}
@@ -77,6 +77,16 @@ func (r *Response) IsError() bool {
 	return r.Error != nil
 }
 
+// IsBackendFailure returns true if the response is an error generated by PATH,
+// indicating the request could not be served by the backend service, e.g. a malformed endpoint payload.
+// Such requests can be retried, as opposed to errors returned by the backend service itself.
+func (r *Response) IsBackendFailure() bool {
+	if r.Error == nil {
+		return false
+	}
+	return r.Error.Code == ResponseCodeBackendServerErr || r.Error.Code == ResponseCodeDefaultInternalErr
+}
+
 // TODO_TECHDEBT(@adshmh): Validate the results JSONRPC result struct:
 // - Return an error if invalid: e.g. if missing both result and error fields.
 // - Define and use exported errors for each validation failure scenario.
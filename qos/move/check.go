@@ -0,0 +1,89 @@
+package move
+
+import (
+	"net/http"
+
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+
+	"github.com/buildwithgrove/path/gateway"
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+const (
+	// Each endpoint check should use its own ID to avoid potential conflicts.
+	// ID of JSON-RPC requests for any new checks should be added to the list below.
+	_                    = iota
+	idSuiChainIdentifier = 1000 + iota
+	idSuiLatestCheckpoint
+)
+
+// endpointStore provides the endpoint check generator required by
+// the gateway package to augment endpoints' quality data,
+// using synthetic service requests.
+var _ gateway.QoSEndpointCheckGenerator = &endpointStore{}
+
+// CheckWebsocketConnection returns false: the Move QoS does not check Websocket connections.
+func (es *endpointStore) CheckWebsocketConnection() bool {
+	return false
+}
+
+// GetRequiredQualityChecks returns the chain identity and freshness checks of each API supported by the service:
+//   - JSON-RPC (Sui): `sui_getChainIdentifier` and `sui_getLatestCheckpointSequenceNumber`.
+//   - REST (Aptos): `GET /v1`, i.e. the ledger info.
+//
+// TODO_IMPROVE: skip checks for which the endpoint has a recent observation.
+func (es *endpointStore) GetRequiredQualityChecks(_ protocol.EndpointAddr) []gateway.RequestQoSContext {
+	var checks []gateway.RequestQoSContext
+
+	if es.serviceState.isAPISupported(sharedtypes.RPCType_JSON_RPC) {
+		checks = append(checks,
+			es.getJSONRPCEndpointCheck(idSuiChainIdentifier, methodSuiGetChainIdentifier),
+			es.getJSONRPCEndpointCheck(idSuiLatestCheckpoint, methodSuiGetLatestCheckpointSequenceNumber),
+		)
+	}
+
+	if es.serviceState.isAPISupported(sharedtypes.RPCType_REST) {
+		checks = append(checks, es.getRESTEndpointCheck(pathAptosLedgerInfo))
+	}
+
+	return checks
+}
+
+// getJSONRPCEndpointCheck prepares a request context for a JSON-RPC request with the supplied ID and method, and no params.
+func (es *endpointStore) getJSONRPCEndpointCheck(id int, method jsonrpc.Method) *requestContext {
+	return &requestContext{
+		logger:        es.logger,
+		endpointStore: es,
+		// Set the chain and Service ID: this is required to generate observations with the correct chain ID.
+		chainID:   es.serviceState.chainID,
+		serviceID: es.serviceState.serviceID,
+		rpcType:   sharedtypes.RPCType_JSON_RPC,
+		jsonrpcReq: jsonrpc.Request{
+			JSONRPC: jsonrpc.Version2,
+			ID:      jsonrpc.IDFromInt(id),
+			Method:  method,
+		},
+		// Set the origin of the request as Synthetic.
+		// The request is generated by the QoS service to collect extra observations on endpoints.
+		requestOrigin: qosobservations.RequestOrigin_REQUEST_ORIGIN_SYNTHETIC,
+	}
+}
+
+// getRESTEndpointCheck prepares a request context for an HTTP GET request to the supplied path.
+func (es *endpointStore) getRESTEndpointCheck(path string) *requestContext {
+	return &requestContext{
+		logger:        es.logger,
+		endpointStore: es,
+		// Set the chain and Service ID: this is required to generate observations with the correct chain ID.
+		chainID:           es.serviceState.chainID,
+		serviceID:         es.serviceState.serviceID,
+		rpcType:           sharedtypes.RPCType_REST,
+		httpRequestMethod: http.MethodGet,
+		httpRequestPath:   path,
+		// Set the origin of the request as Synthetic.
+		// The request is generated by the QoS service to collect extra observations on endpoints.
+		requestOrigin: qosobservations.RequestOrigin_REQUEST_ORIGIN_SYNTHETIC,
+	}
+}
@@ -0,0 +1,314 @@
+package move
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+
+	"github.com/buildwithgrove/path/gateway"
+	"github.com/buildwithgrove/path/log"
+	pathhttp "github.com/buildwithgrove/path/network/http"
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// requestContext provides the support required by the gateway
+// package for handling service requests.
+var _ gateway.RequestQoSContext = &requestContext{}
+
+// requestContext tracks the non-2xx responses of endpoints, which are not reported through UpdateWithResponse.
+// This allows returning the 4xx responses of REST endpoints, e.g. an Aptos request for a missing account, to the user.
+var _ gateway.EndpointErrorQoSContext = &requestContext{}
+
+// endpointResponse is an endpoint's response to the request handled by the request context.
+type endpointResponse struct {
+	// httpResponse is the user-facing HTTP response built from the endpoint's response.
+	httpResponse pathhttp.HTTPResponse
+
+	observation *qosobservations.MoveEndpointObservation
+}
+
+// requestContext provides the functionality required
+// to support QoS for a Move-based blockchain service.
+// It handles both JSON-RPC (e.g. Sui) and REST (e.g. Aptos) requests.
+type requestContext struct {
+	logger polylog.Logger
+
+	// chainID is the chain ID expected from endpoints, e.g. "35834a8a" for Sui mainnet or "1" for Aptos mainnet.
+	chainID   string
+	serviceID protocol.ServiceID
+
+	// The length of the request payload in bytes.
+	requestPayloadLength uint
+
+	endpointStore *endpointStore
+
+	// rpcType is the API of the request: JSON_RPC or REST.
+	rpcType sharedtypes.RPCType
+
+	// jsonrpcReq is the JSON-RPC request, if the request is a single JSON-RPC request.
+	jsonrpcReq jsonrpc.Request
+
+	// jsonrpcBatchRequest is the JSON-RPC batch request, if isBatch is set.
+	// The batch is sent as-is to a single endpoint.
+	jsonrpcBatchRequest jsonrpc.BatchRequest
+	isBatch             bool
+
+	// httpRequestMethod, httpRequestPath and httpRequestBody are the details of a REST request.
+	// The path includes any query.
+	httpRequestMethod string
+	httpRequestPath   string
+	httpRequestBody   []byte
+
+	// The origin of the request handled by the context.
+	// Either:
+	// - User: user requests
+	// - QoS: requests built by the QoS service to get additional data points on endpoints.
+	requestOrigin qosobservations.RequestOrigin
+
+	// endpointResponses is the set of responses received from one or
+	// more endpoints as part of handling this service request.
+	endpointResponses []endpointResponse
+}
+
+// GetServicePayloads returns the payload of the request: a batch request is sent as a single payload.
+// Implements the gateway.RequestQoSContext interface.
+func (rc *requestContext) GetServicePayloads() []protocol.Payload {
+	if rc.rpcType == sharedtypes.RPCType_REST {
+		return []protocol.Payload{{
+			Data:    string(rc.httpRequestBody),
+			Method:  rc.httpRequestMethod,
+			Path:    rc.httpRequestPath,
+			Headers: map[string]string{},
+			RPCType: sharedtypes.RPCType_REST,
+		}}
+	}
+
+	if !rc.isBatch {
+		payload, err := rc.jsonrpcReq.BuildPayload()
+		if err != nil {
+			rc.logger.Error().Err(err).Msg("SHOULD RARELY HAPPEN: requestContext.GetServicePayloads() should never fail marshaling the JSONRPC request.")
+			return []protocol.Payload{protocol.EmptyErrorPayload()}
+		}
+		return []protocol.Payload{payload}
+	}
+
+	batchBz, err := json.Marshal(rc.jsonrpcBatchRequest.Requests)
+	if err != nil {
+		rc.logger.Error().Err(err).Msg("SHOULD RARELY HAPPEN: requestContext.GetServicePayloads() should never fail marshaling the JSONRPC batch request.")
+		return []protocol.Payload{protocol.EmptyErrorPayload()}
+	}
+
+	return []protocol.Payload{{
+		Data:    string(batchBz),
+		Method:  http.MethodPost, // Method is always POST for JSON-RPC.
+		Headers: map[string]string{},
+		RPCType: sharedtypes.RPCType_JSON_RPC,
+	}}
+}
+
+// UpdateWithResponse is NOT safe for concurrent use
+// Implements the gateway.RequestQoSContext interface.
+func (rc *requestContext) UpdateWithResponse(endpointAddr protocol.EndpointAddr, responseBz []byte) {
+	var response endpointResponse
+	switch {
+	case rc.rpcType == sharedtypes.RPCType_REST:
+		response = rc.parseRESTResponse(responseBz)
+	case rc.isBatch:
+		response = rc.parseBatchResponse(responseBz)
+	default:
+		jsonrpcResponse, observation := unmarshalJSONRPCResponse(rc.logger, rc.jsonrpcReq, responseBz, endpointAddr)
+		response = endpointResponse{
+			httpResponse: qos.BuildHTTPResponseFromJSONRPCResponse(rc.logger, jsonrpcResponse),
+			observation:  observation,
+		}
+	}
+
+	response.observation.EndpointAddr = string(endpointAddr)
+	rc.endpointResponses = append(rc.endpointResponses, response)
+}
+
+// UpdateWithEndpointError is NOT safe for concurrent use
+// Records a failed relay: httpStatusCode is 0 if no response was received from the endpoint.
+// A failed relay of an endpoint check, e.g. `GET /v1`, marks the check's observation as invalid.
+// Implements the gateway.EndpointErrorQoSContext interface.
+func (rc *requestContext) UpdateWithEndpointError(endpointAddr protocol.EndpointAddr, httpStatusCode int) {
+	// The relay failed before an endpoint was selected, e.g. an internal protocol error.
+	if endpointAddr == "" {
+		return
+	}
+
+	observation := &qosobservations.MoveEndpointObservation{
+		EndpointAddr:   string(endpointAddr),
+		HttpStatusCode: int32(httpStatusCode),
+	}
+
+	var httpResponse pathhttp.HTTPResponse
+	switch {
+	case rc.rpcType == sharedtypes.RPCType_REST:
+		httpResponse = getRESTEndpointErrorResponse(httpStatusCode)
+		if isAptosLedgerInfoRequest(rc.httpRequestMethod, rc.httpRequestPath) {
+			observation.ResponseObservation = &qosobservations.MoveEndpointObservation_AptosLedgerInfoResponse{
+				AptosLedgerInfoResponse: &qosobservations.MoveAptosLedgerInfoResponse{Invalid: true},
+			}
+		}
+	case rc.isBatch:
+		// Every member of the batch gets a synthesized error response.
+		httpResponse = qos.BuildHTTPResponseFromBytes(rc.jsonrpcBatchRequest.BuildResponseBytes(nil), http.StatusOK)
+	default:
+		httpResponse = qos.BuildHTTPResponseFromJSONRPCResponse(
+			rc.logger,
+			jsonrpc.NewErrResponseInternalErr(rc.jsonrpcReq.ID, errors.New(getEndpointErrorMessage(httpStatusCode))),
+		)
+		setFailedJSONRPCCheckObservation(observation, rc.jsonrpcReq.Method)
+	}
+
+	rc.endpointResponses = append(rc.endpointResponses, endpointResponse{
+		httpResponse: httpResponse,
+		observation:  observation,
+	})
+}
+
+// setFailedJSONRPCCheckObservation marks the observation of a failed relay of a JSON-RPC endpoint check as invalid.
+// Failed relays of any other JSON-RPC request have no response observation.
+func setFailedJSONRPCCheckObservation(observation *qosobservations.MoveEndpointObservation, method jsonrpc.Method) {
+	switch method {
+	case methodSuiGetChainIdentifier:
+		observation.ResponseObservation = &qosobservations.MoveEndpointObservation_SuiChainIdentifierResponse{
+			SuiChainIdentifierResponse: &qosobservations.MoveSuiChainIdentifierResponse{Invalid: true},
+		}
+	case methodSuiGetLatestCheckpointSequenceNumber:
+		observation.ResponseObservation = &qosobservations.MoveEndpointObservation_SuiLatestCheckpointResponse{
+			SuiLatestCheckpointResponse: &qosobservations.MoveSuiLatestCheckpointResponse{Invalid: true},
+		}
+	}
+}
+
+// parseRESTResponse builds the endpoint response for the 2xx response of a REST endpoint: the payload is returned to the user as-is.
+// The protocol only reports the payload of 2xx responses: the response is recorded with a 200 HTTP status code.
+func (rc *requestContext) parseRESTResponse(responseBz []byte) endpointResponse {
+	observation := &qosobservations.MoveEndpointObservation{
+		HttpStatusCode: http.StatusOK,
+	}
+
+	if isAptosLedgerInfoRequest(rc.httpRequestMethod, rc.httpRequestPath) {
+		observation.ResponseObservation = &qosobservations.MoveEndpointObservation_AptosLedgerInfoResponse{
+			AptosLedgerInfoResponse: getAptosLedgerInfoObservation(rc.logger, http.StatusOK, responseBz),
+		}
+	} else {
+		observation.ResponseObservation = &qosobservations.MoveEndpointObservation_UnrecognizedResponse{
+			UnrecognizedResponse: &qosobservations.MoveUnrecognizedResponse{},
+		}
+	}
+
+	return endpointResponse{
+		httpResponse: qos.BuildHTTPResponseFromBytes(responseBz, http.StatusOK),
+		observation:  observation,
+	}
+}
+
+// parseBatchResponse parses the endpoint's payload as the responses to the members of a JSON-RPC batch request.
+// Batch members with no response from the endpoint get a synthesized error response.
+func (rc *requestContext) parseBatchResponse(responseBz []byte) endpointResponse {
+	var batchResponses []jsonrpc.Response
+	if err := json.Unmarshal(responseBz, &batchResponses); err != nil {
+		rc.logger.Debug().Err(err).Msgf("Endpoint payload is not a valid JSON-RPC batch response: %s", log.Preview(string(responseBz)))
+		return endpointResponse{
+			httpResponse: qos.BuildHTTPResponseFromBytes(rc.jsonrpcBatchRequest.BuildResponseBytes(nil), http.StatusOK),
+			observation: &qosobservations.MoveEndpointObservation{
+				HttpStatusCode: http.StatusOK,
+				ResponseObservation: &qosobservations.MoveEndpointObservation_UnrecognizedResponse{
+					UnrecognizedResponse: &qosobservations.MoveUnrecognizedResponse{
+						ValidationError: newValidationErrorObservation(),
+					},
+				},
+			},
+		}
+	}
+
+	return endpointResponse{
+		// According to the JSON-RPC 2.0 specification, even if individual responses
+		// in a batch contain errors, the entire batch should still return HTTP 200 OK.
+		httpResponse: qos.BuildHTTPResponseFromBytes(rc.jsonrpcBatchRequest.BuildResponseBytes(batchResponses), http.StatusOK),
+		observation: &qosobservations.MoveEndpointObservation{
+			HttpStatusCode: http.StatusOK,
+			ResponseObservation: &qosobservations.MoveEndpointObservation_UnrecognizedResponse{
+				UnrecognizedResponse: &qosobservations.MoveUnrecognizedResponse{},
+			},
+		},
+	}
+}
+
+// GetHTTPResponse builds the HTTP response that should be returned for the request.
+// Implements the gateway.RequestQoSContext interface.
+func (rc *requestContext) GetHTTPResponse() pathhttp.HTTPResponse {
+	// Use the most recent endpoint response.
+	if len(rc.endpointResponses) > 0 {
+		return rc.endpointResponses[len(rc.endpointResponses)-1].httpResponse
+	}
+
+	// No responses received: this is an internal error:
+	// e.g. protocol-level errors like endpoint timing out.
+	if rc.rpcType == sharedtypes.RPCType_REST {
+		return getRESTNoEndpointResponse()
+	}
+
+	jsonrpcErrorResponse := jsonrpc.NewErrResponseInternalErr(rc.jsonrpcReq.ID, errors.New("protocol-level error: no endpoint responses received"))
+	return qos.BuildHTTPResponseFromJSONRPCResponse(rc.logger, jsonrpcErrorResponse)
+}
+
+// GetObservations returns all the observations contained in the request context.
+// Implements the gateway.RequestQoSContext interface.
+func (rc *requestContext) GetObservations() qosobservations.Observations {
+	observations := &qosobservations.MoveRequestObservations{
+		ChainId:              rc.chainID,
+		ServiceId:            string(rc.serviceID),
+		RequestPayloadLength: uint32(rc.requestPayloadLength),
+		RequestOrigin:        rc.requestOrigin,
+	}
+
+	if rc.rpcType == sharedtypes.RPCType_REST {
+		observations.BackendServiceType = qosobservations.BackendServiceType_BACKEND_SERVICE_TYPE_REST
+		observations.ParsedRequest = &qosobservations.MoveRequestObservations_RestRequest{
+			RestRequest: &qosobservations.RESTRequest{
+				ApiPath:       rc.httpRequestPath,
+				HttpMethod:    rc.httpRequestMethod,
+				PayloadLength: uint32(len(rc.httpRequestBody)),
+			},
+		}
+	} else {
+		observations.BackendServiceType = qosobservations.BackendServiceType_BACKEND_SERVICE_TYPE_JSONRPC
+		// The batch is relayed as a single payload: there is no single JSON-RPC request to observe.
+		if !rc.isBatch {
+			observations.ParsedRequest = &qosobservations.MoveRequestObservations_JsonrpcRequest{
+				JsonrpcRequest: rc.jsonrpcReq.GetObservation(),
+			}
+		}
+	}
+
+	// No endpoint responses received.
+	// Set request error.
+	if len(rc.endpointResponses) == 0 {
+		observations.RequestError = qos.GetRequestErrorForProtocolError()
+	}
+
+	for _, endpointResponse := range rc.endpointResponses {
+		observations.EndpointObservations = append(observations.EndpointObservations, endpointResponse.observation)
+	}
+
+	return qosobservations.Observations{
+		ServiceObservations: &qosobservations.Observations_Move{
+			Move: observations,
+		},
+	}
+}
+
+// GetEndpointSelector is required to satisfy the gateway package's RequestQoSContext interface.
+func (rc *requestContext) GetEndpointSelector() protocol.EndpointSelector {
+	return rc.endpointStore
+}
@@ -0,0 +1,116 @@
+package move
+
+import (
+	"errors"
+	"fmt"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/qos"
+)
+
+// The errors below list all the possible basic validation errors on an endpoint.
+var (
+	errNoSuiChainIdentifierObs      = fmt.Errorf("endpoint has not had an observation of its response to a %q request", methodSuiGetChainIdentifier)
+	errInvalidSuiChainIdentifierObs = fmt.Errorf("endpoint returned an invalid response to a %q request", methodSuiGetChainIdentifier)
+	errNoSuiCheckpointObs           = fmt.Errorf("endpoint has not had an observation of its response to a %q request", methodSuiGetLatestCheckpointSequenceNumber)
+	errInvalidSuiCheckpointObs      = fmt.Errorf("endpoint returned an invalid response to a %q request", methodSuiGetLatestCheckpointSequenceNumber)
+	errNoAptosLedgerInfoObs         = fmt.Errorf("endpoint has not had an observation of its response to a %q ledger info request", pathAptosLedgerInfo)
+	errInvalidAptosLedgerInfoObs    = fmt.Errorf("endpoint returned an invalid response to a %q ledger info request", pathAptosLedgerInfo)
+	errInvalidChainIDObs            = errors.New("endpoint is on a different chain than the service")
+)
+
+// endpoint captures details required to validate an endpoint of a Move-based blockchain.
+// Pointers distinguish between no observation vs. observed response scenarios.
+type endpoint struct {
+	// suiChainIdentifierResponse stores the result of processing the endpoint's `sui_getChainIdentifier` response.
+	suiChainIdentifierResponse *qosobservations.MoveSuiChainIdentifierResponse
+
+	// suiLatestCheckpointResponse stores the result of processing the endpoint's `sui_getLatestCheckpointSequenceNumber` response.
+	suiLatestCheckpointResponse *qosobservations.MoveSuiLatestCheckpointResponse
+
+	// aptosLedgerInfoResponse stores the result of processing the endpoint's `GET /v1` ledger info response.
+	aptosLedgerInfoResponse *qosobservations.MoveAptosLedgerInfoResponse
+
+	// JSONRPCValidationErrorTracker tracks the endpoint's most recent JSON-RPC response validation error.
+	qos.JSONRPCValidationErrorTracker
+}
+
+// validateJSONRPC checks if the endpoint has the observations required to serve JSON-RPC (Sui) requests,
+// independent of other endpoints:
+//   - No recent JSON-RPC validation errors.
+//   - Valid responses to both `sui_getChainIdentifier` and `sui_getLatestCheckpointSequenceNumber` requests.
+//   - On the expected chain.
+func (e endpoint) validateJSONRPC(chainID string) error {
+	// Check for recent validation errors first
+	if err := e.ValidateNoRecentValidationError(); err != nil {
+		return err
+	}
+
+	switch {
+	case e.suiChainIdentifierResponse == nil:
+		return errNoSuiChainIdentifierObs
+
+	case e.suiChainIdentifierResponse.GetInvalid():
+		return errInvalidSuiChainIdentifierObs
+
+	case e.suiChainIdentifierResponse.GetChainIdentifier() != chainID:
+		return fmt.Errorf("%w: expected %q, got %q", errInvalidChainIDObs, chainID, e.suiChainIdentifierResponse.GetChainIdentifier())
+
+	case e.suiLatestCheckpointResponse == nil:
+		return errNoSuiCheckpointObs
+
+	case e.suiLatestCheckpointResponse.GetInvalid():
+		return errInvalidSuiCheckpointObs
+
+	default:
+		return nil
+	}
+}
+
+// validateREST checks if the endpoint has the observations required to serve REST (Aptos) requests,
+// independent of other endpoints:
+//   - A valid response to a `GET /v1` ledger info request.
+//   - On the expected chain.
+func (e endpoint) validateREST(chainID string) error {
+	switch {
+	case e.aptosLedgerInfoResponse == nil:
+		return errNoAptosLedgerInfoObs
+
+	case e.aptosLedgerInfoResponse.GetInvalid():
+		return errInvalidAptosLedgerInfoObs
+
+	case e.aptosLedgerInfoResponse.GetChainId() != chainID:
+		return fmt.Errorf("%w: expected %q, got %q", errInvalidChainIDObs, chainID, e.aptosLedgerInfoResponse.GetChainId())
+
+	default:
+		return nil
+	}
+}
+
+// applyObservation updates endpoint data using provided observation.
+// Returns true if observation was recognized.
+// IMPORTANT: This function mutates the endpoint.
+func (e *endpoint) applyObservation(obs *qosobservations.MoveEndpointObservation) bool {
+	if chainIdentifierResponse := obs.GetSuiChainIdentifierResponse(); chainIdentifierResponse != nil {
+		e.suiChainIdentifierResponse = chainIdentifierResponse
+		return true
+	}
+
+	if latestCheckpointResponse := obs.GetSuiLatestCheckpointResponse(); latestCheckpointResponse != nil {
+		e.suiLatestCheckpointResponse = latestCheckpointResponse
+		return true
+	}
+
+	if ledgerInfoResponse := obs.GetAptosLedgerInfoResponse(); ledgerInfoResponse != nil {
+		e.aptosLedgerInfoResponse = ledgerInfoResponse
+		return true
+	}
+
+	if unrecognizedResponse := obs.GetUnrecognizedResponse(); unrecognizedResponse != nil {
+		// Update latest validation error if observation contains more recent error
+		e.ApplyValidationError(unrecognizedResponse.GetValidationError())
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,45 @@
+package move
+
+import (
+	"fmt"
+	"net/http"
+
+	pathhttp "github.com/buildwithgrove/path/network/http"
+	"github.com/buildwithgrove/path/qos"
+)
+
+// restErrTemplate is the template for the error responses returned to users of the REST API, to ensure they are valid JSON.
+const restErrTemplate = `{"error":"%s","msg":"%s"}`
+
+// getRESTNoEndpointResponse returns the HTTP response for a REST request with no endpoint responses.
+func getRESTNoEndpointResponse() pathhttp.HTTPResponse {
+	return qos.BuildHTTPResponseFromBytes(
+		fmt.Appendf(nil, restErrTemplate, "no protocol endpoint responses", "move qos service error: no responses received from any service endpoints"),
+		http.StatusInternalServerError,
+	)
+}
+
+// getRESTEndpointErrorResponse returns the HTTP response for a REST request whose endpoint returned a non-2xx response, or no response.
+//   - 4xx status codes are returned to the user as-is: e.g. an Aptos request for a missing account.
+//   - Any other status code is returned as a 502 Bad Gateway.
+//
+// DEV_NOTE: the protocol does not report the payload of non-2xx responses, so a generic error payload is returned.
+func getRESTEndpointErrorResponse(endpointHTTPStatusCode int) pathhttp.HTTPResponse {
+	httpStatusCode := http.StatusBadGateway
+	if endpointHTTPStatusCode >= http.StatusBadRequest && endpointHTTPStatusCode < http.StatusInternalServerError {
+		httpStatusCode = endpointHTTPStatusCode
+	}
+
+	return qos.BuildHTTPResponseFromBytes(
+		fmt.Appendf(nil, restErrTemplate, getEndpointErrorMessage(endpointHTTPStatusCode), "move qos service error: endpoint request failed"),
+		httpStatusCode,
+	)
+}
+
+// getEndpointErrorMessage returns the message describing a failed relay: httpStatusCode is 0 if no response was received.
+func getEndpointErrorMessage(endpointHTTPStatusCode int) string {
+	if endpointHTTPStatusCode == 0 {
+		return "no response received from the endpoint"
+	}
+	return fmt.Sprintf("endpoint returned HTTP status code %d", endpointHTTPStatusCode)
+}
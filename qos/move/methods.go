@@ -0,0 +1,19 @@
+package move
+
+import (
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+const (
+	// methodSuiGetChainIdentifier is the Sui JSON-RPC method for getting the identifier of the endpoint's chain.
+	// Reference: https://docs.sui.io/sui-api-ref#sui_getchainidentifier
+	methodSuiGetChainIdentifier = jsonrpc.Method("sui_getChainIdentifier")
+
+	// methodSuiGetLatestCheckpointSequenceNumber is the Sui JSON-RPC method for getting the endpoint's latest checkpoint.
+	// Reference: https://docs.sui.io/sui-api-ref#sui_getlatestcheckpointsequencenumber
+	methodSuiGetLatestCheckpointSequenceNumber = jsonrpc.Method("sui_getLatestCheckpointSequenceNumber")
+)
+
+// pathAptosLedgerInfo is the Aptos REST path for getting the endpoint's ledger info, e.g. chain ID and ledger version.
+// Reference: https://aptos.dev/en/build/apis/fullnode-rest-api-reference#tag/general/GET/
+const pathAptosLedgerInfo = "/v1"
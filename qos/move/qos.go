@@ -0,0 +1,120 @@
+// Package move provides the support required for interacting with
+// Move-based blockchains through the gateway, e.g. Sui or Aptos.
+// Requests are detected as JSON-RPC (e.g. Sui) or REST (e.g. Aptos), and rejected if the service does not support their API.
+// Endpoints are validated, for each API supported by the service, using:
+//   - JSON-RPC: `sui_getChainIdentifier` for chain identity, and `sui_getLatestCheckpointSequenceNumber` for freshness
+//     against the perceived latest checkpoint.
+//   - REST: `GET /v1` ledger info, i.e. `chain_id` for chain identity, and `ledger_version` for freshness
+//     against the perceived latest ledger version.
+package move
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
+	"github.com/buildwithgrove/path/gateway"
+	"github.com/buildwithgrove/path/metrics/devtools"
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+)
+
+// QoS implements gateway.QoSService by providing:
+//  1. QoSRequestParser - Builds Move-specific RequestQoSContext objects from HTTP requests
+//  2. EndpointSelector - Selects endpoints for service requests
+//  3. QoSEndpointCheckGenerator - Builds the chain identity and freshness checks
+var _ gateway.QoSService = &QoS{}
+
+// devtools.QoSDisqualifiedEndpointsReporter is fulfilled by the QoS struct below.
+// This allows the QoS service to report its disqualified endpoints data to the devtools.DisqualifiedEndpointReporter.
+var _ devtools.QoSDisqualifiedEndpointsReporter = &QoS{}
+
+// QoS implements the gateway.QoSService interface for Move-based blockchains.
+type QoS struct {
+	logger polylog.Logger
+	*endpointStore
+	*requestValidator
+}
+
+// NewQoSInstance builds and returns an instance of the Move QoS service.
+func NewQoSInstance(logger polylog.Logger, serviceConfig MoveServiceQoSConfig) *QoS {
+	serviceID := serviceConfig.GetServiceID()
+	chainID := serviceConfig.getChainID()
+
+	logger = logger.With(
+		"qos_instance", "move",
+		"service_id", serviceID,
+		"chain_id", chainID,
+	)
+
+	serviceState := &serviceState{
+		logger:                     logger,
+		chainID:                    chainID,
+		serviceID:                  serviceID,
+		supportedAPIs:              serviceConfig.getSupportedAPIs(),
+		checkpointSyncAllowance:    serviceConfig.getCheckpointSyncAllowance(),
+		ledgerVersionSyncAllowance: serviceConfig.getLedgerVersionSyncAllowance(),
+	}
+
+	endpointStore := newEndpointStore(logger, serviceState)
+
+	requestValidator := &requestValidator{
+		logger:        logger,
+		chainID:       chainID,
+		serviceID:     serviceID,
+		endpointStore: endpointStore,
+		requestLimits: serviceConfig.getRequestLimits(),
+	}
+
+	return &QoS{
+		logger:           logger,
+		endpointStore:    endpointStore,
+		requestValidator: requestValidator,
+	}
+}
+
+// ParseHTTPRequest builds a request context from the provided HTTP request.
+// Implements the gateway.QoSService interface.
+func (q *QoS) ParseHTTPRequest(_ context.Context, req *http.Request) (gateway.RequestQoSContext, bool) {
+	return q.validateHTTPRequest(req)
+}
+
+// ParseWebsocketRequest builds a request context from the provided Websocket request.
+// Websocket connection requests do not have a body, so we don't need to parse it.
+// Implements the gateway.QoSService interface.
+func (q *QoS) ParseWebsocketRequest(_ context.Context) (gateway.RequestQoSContext, bool) {
+	return &requestContext{
+		logger:        q.logger,
+		chainID:       q.serviceState.chainID,
+		serviceID:     q.serviceState.serviceID,
+		endpointStore: q.endpointStore,
+		requestOrigin: qosobservations.RequestOrigin_REQUEST_ORIGIN_ORGANIC,
+	}, true
+}
+
+// ApplyObservations updates the stored endpoints and the perceived blockchain state using the supplied observations.
+// Implements the gateway.QoSService interface.
+func (q *QoS) ApplyObservations(observations *qosobservations.Observations) error {
+	if observations == nil {
+		return errors.New("ApplyObservations: received nil observations")
+	}
+
+	moveObservations := observations.GetMove()
+	if moveObservations == nil {
+		return errors.New("ApplyObservations: received nil Move observation")
+	}
+
+	updatedEndpoints := q.updateEndpointsFromObservations(moveObservations.GetEndpointObservations())
+	q.serviceState.updateFromEndpoints(updatedEndpoints)
+	return nil
+}
+
+// HydrateDisqualifiedEndpointsResponse hydrates the disqualified endpoint response with the QoS-specific data.
+//   - takes a pointer to the DisqualifiedEndpointResponse
+//   - called by the devtools.DisqualifiedEndpointReporter to fill it with the QoS-specific data.
+func (q *QoS) HydrateDisqualifiedEndpointsResponse(serviceID protocol.ServiceID, details *devtools.DisqualifiedEndpointResponse) {
+	q.logger.Info().Msgf("hydrating disqualified endpoints response for service ID: %s", serviceID)
+	details.QoSLevelDisqualifiedEndpoints = q.getDisqualifiedEndpointsResponse(serviceID)
+}
@@ -0,0 +1,189 @@
+package move
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+
+	"github.com/buildwithgrove/path/gateway"
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// requestValidator:
+// - Handles request validation for JSON-RPC (e.g. Sui) and REST (e.g. Aptos) requests
+// - Generates error contexts if validation fails (e.g. error parsing JSONRPC request, or an unsupported API)
+// - Generates request context if validation succeeds
+type requestValidator struct {
+	logger        polylog.Logger
+	chainID       string
+	serviceID     protocol.ServiceID
+	endpointStore *endpointStore
+
+	// requestLimits bounds the size of the requests accepted by the service.
+	requestLimits jsonrpc.RequestLimits
+}
+
+// validateHTTPRequest:
+// - Reads the HTTP request body and detects the request's API: JSON-RPC or REST
+// - Returns (errorContext, false) if validation fails
+// - Returns (requestContext, true) if validation succeeds
+func (rv *requestValidator) validateHTTPRequest(req *http.Request) (gateway.RequestQoSContext, bool) {
+	logger := rv.logger.With(
+		"method", "validateHTTPRequest",
+		"path", req.URL.Path,
+		"http_method", req.Method,
+	)
+
+	// Read the HTTP request body, up to the maximum request body size.
+	// This is necessary to distinguish REST vs. JSONRPC on request with POST HTTP method.
+	body, err := rv.requestLimits.ReadRequestBody(req.Body)
+	if errors.Is(err, jsonrpc.ErrRequestBodyTooLarge) {
+		logger.Warn().Err(err).Msg("HTTP request body exceeds the maximum size - returning invalid request error response")
+		return rv.createRequestErrorContext(
+			jsonrpc.NewErrResponseInvalidRequest(jsonrpc.ID{}, err),
+			qosobservations.RequestErrorKind_REQUEST_ERROR_USER_ERROR_JSONRPC_PARSE_ERROR,
+			err,
+		), false
+	}
+	if err != nil {
+		logger.Warn().Err(err).Msg("HTTP request body read failed - returning generic error response")
+		return rv.createRequestErrorContext(
+			jsonrpc.NewErrResponseInternalErr(jsonrpc.ID{}, err),
+			qosobservations.RequestErrorKind_REQUEST_ERROR_INTERNAL_READ_HTTP_ERROR,
+			err,
+		), false
+	}
+
+	// Determine request type and route to appropriate validator
+	if isJSONRPCRequest(req.Method, body) {
+		return rv.validateJSONRPCRequest(logger, body)
+	}
+
+	return rv.validateRESTRequest(logger, req, body)
+}
+
+// isJSONRPCRequest determines if the incoming HTTP request is a JSONRPC request
+// Uses simple heuristics: POST method and specific content.
+func isJSONRPCRequest(httpMethod string, httpRequestBody []byte) bool {
+	// Non-POST requests are always REST
+	if httpMethod != http.MethodPost {
+		return false
+	}
+
+	// POST requests are JSONRPC if the payload contains the jsonrpc field, and REST otherwise, e.g. an Aptos transaction submission.
+	return strings.Contains(string(httpRequestBody), "jsonrpc")
+}
+
+// validateJSONRPCRequest parses and validates the JSONRPC request(s) - handles both single and batch requests.
+func (rv *requestValidator) validateJSONRPCRequest(logger polylog.Logger, body []byte) (gateway.RequestQoSContext, bool) {
+	if !rv.endpointStore.serviceState.isAPISupported(sharedtypes.RPCType_JSON_RPC) {
+		err := fmt.Errorf("unsupported RPC type: %s", sharedtypes.RPCType_JSON_RPC)
+		logger.Info().Err(err).Msg("Rejecting JSONRPC request - returning invalid request error response")
+		return rv.createRequestErrorContext(
+			jsonrpc.NewErrResponseInvalidRequest(jsonrpc.ID{}, err),
+			qosobservations.RequestErrorKind_REQUEST_ERROR_USER_ERROR_JSONRPC_UNSUPPORTED_RPC_TYPE,
+			err,
+		), false
+	}
+
+	jsonrpcReqs, jsonrpcBatchRequest, isBatch, err := jsonrpc.ParseOrderedJSONRPCFromRequestBody(logger, body)
+	if err == nil && isBatch {
+		err = rv.requestLimits.ValidateBatchSize(len(jsonrpcBatchRequest.Requests))
+	}
+	if err != nil {
+		logger.Info().Err(err).Msg("JSONRPC request could not be parsed - returning invalid request error response")
+		return rv.createRequestErrorContext(
+			jsonrpc.NewErrResponseInvalidRequest(jsonrpc.ID{}, err),
+			qosobservations.RequestErrorKind_REQUEST_ERROR_USER_ERROR_JSONRPC_PARSE_ERROR,
+			err,
+		), false
+	}
+
+	requestCtx := rv.newRequestContext(sharedtypes.RPCType_JSON_RPC, body)
+	requestCtx.jsonrpcBatchRequest = jsonrpcBatchRequest
+	requestCtx.isBatch = isBatch
+
+	// A single request is the only entry of the parsed requests.
+	if !isBatch {
+		for _, jsonrpcReq := range jsonrpcReqs {
+			requestCtx.jsonrpcReq = jsonrpcReq
+		}
+	}
+
+	return requestCtx, true
+}
+
+// validateRESTRequest builds the context of a REST request: REST requests are relayed as-is.
+func (rv *requestValidator) validateRESTRequest(logger polylog.Logger, req *http.Request, body []byte) (gateway.RequestQoSContext, bool) {
+	if !rv.endpointStore.serviceState.isAPISupported(sharedtypes.RPCType_REST) {
+		err := fmt.Errorf("unsupported RPC type: %s", sharedtypes.RPCType_REST)
+		logger.Info().Err(err).Msg("Rejecting REST request - returning invalid request error response")
+		return rv.createRequestErrorContext(
+			jsonrpc.NewErrResponseInvalidRequest(jsonrpc.ID{}, err),
+			qosobservations.RequestErrorKind_REQUEST_ERROR_USER_ERROR_REST_UNSUPPORTED_RPC_TYPE,
+			err,
+		), false
+	}
+
+	path := req.URL.Path
+	if req.URL.RawQuery != "" {
+		path += "?" + req.URL.RawQuery
+	}
+
+	requestCtx := rv.newRequestContext(sharedtypes.RPCType_REST, body)
+	requestCtx.httpRequestMethod = req.Method
+	requestCtx.httpRequestPath = path
+	requestCtx.httpRequestBody = body
+
+	return requestCtx, true
+}
+
+// newRequestContext returns a context for an organic request of the supplied RPC type.
+func (rv *requestValidator) newRequestContext(rpcType sharedtypes.RPCType, body []byte) *requestContext {
+	return &requestContext{
+		logger:               rv.logger,
+		chainID:              rv.chainID,
+		serviceID:            rv.serviceID,
+		requestPayloadLength: uint(len(body)),
+		endpointStore:        rv.endpointStore,
+		rpcType:              rpcType,
+		// Set the origin of the request as USER (i.e. organic relay)
+		// The request is from a user.
+		requestOrigin: qosobservations.RequestOrigin_REQUEST_ORIGIN_ORGANIC,
+	}
+}
+
+// createRequestErrorContext creates an error context for a request which could not be read, parsed, or is not supported.
+// A JSON-RPC error response is returned for both JSON-RPC and REST requests.
+// TODO_IMPROVE: return REST-formatted error responses to REST requests.
+func (rv *requestValidator) createRequestErrorContext(
+	response jsonrpc.Response,
+	errorKind qosobservations.RequestErrorKind,
+	err error,
+) gateway.RequestQoSContext {
+	return &qos.RequestErrorContext{
+		Logger:   rv.logger,
+		Response: response,
+		Observations: &qosobservations.Observations{
+			ServiceObservations: &qosobservations.Observations_Move{
+				Move: &qosobservations.MoveRequestObservations{
+					ChainId:       rv.chainID,
+					ServiceId:     string(rv.serviceID),
+					RequestOrigin: qosobservations.RequestOrigin_REQUEST_ORIGIN_ORGANIC,
+					RequestError: &qosobservations.RequestError{
+						ErrorKind:      errorKind,
+						ErrorDetails:   err.Error(),
+						HttpStatusCode: int32(response.GetRecommendedHTTPStatusCode()),
+					},
+				},
+			},
+		},
+	}
+}
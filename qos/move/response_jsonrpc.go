@@ -0,0 +1,145 @@
+package move
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/buildwithgrove/path/log"
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+const (
+	// errMsgUnmarshaling is the generic message returned to the user if the endpoint returns a malformed response.
+	errMsgUnmarshaling = "the response returned by the endpoint is not a valid JSON-RPC response"
+
+	// errDataFieldRawBytes is the key of the entry in the JSON-RPC error response's "data" map which holds the endpoint's original response.
+	errDataFieldRawBytes = "endpoint_response"
+
+	// errDataFieldUnmarshalingErr is the key of the entry in the JSON-RPC error response's "data" map which holds the unmarshaling error.
+	errDataFieldUnmarshalingErr = "unmarshaling_error"
+)
+
+// unmarshalJSONRPCResponse parses the supplied raw byte slice from an endpoint into a JSON-RPC response,
+// and builds the endpoint observation for the response.
+// A generic JSON-RPC error response is returned if the payload is not a valid JSON-RPC response.
+func unmarshalJSONRPCResponse(
+	logger polylog.Logger,
+	jsonrpcReq jsonrpc.Request,
+	data []byte,
+	endpointAddr protocol.EndpointAddr,
+) (jsonrpc.Response, *qosobservations.MoveEndpointObservation) {
+	var jsonrpcResponse jsonrpc.Response
+	err := json.Unmarshal(data, &jsonrpcResponse)
+	if err == nil {
+		err = jsonrpcResponse.Validate(jsonrpcReq.ID)
+	}
+
+	if err != nil {
+		logger.With(
+			"jsonrpc_request_method", jsonrpcReq.Method,
+			"raw_payload", log.Preview(string(data)),
+			"endpoint_addr", endpointAddr,
+		).Debug().Err(err).Msg("Endpoint payload is not a valid JSON-RPC response")
+
+		errResponse := getGenericJSONRPCErrResponse(jsonrpcReq.ID, data, err)
+		return errResponse, &qosobservations.MoveEndpointObservation{
+			HttpStatusCode: int32(errResponse.GetRecommendedHTTPStatusCode()),
+			ResponseObservation: &qosobservations.MoveEndpointObservation_UnrecognizedResponse{
+				UnrecognizedResponse: &qosobservations.MoveUnrecognizedResponse{
+					JsonrpcResponse: errResponse.GetObservation(),
+					ValidationError: newValidationErrorObservation(),
+				},
+			},
+		}
+	}
+
+	observation := &qosobservations.MoveEndpointObservation{
+		HttpStatusCode: int32(jsonrpcResponse.GetRecommendedHTTPStatusCode()),
+	}
+
+	switch jsonrpcReq.Method {
+	case methodSuiGetChainIdentifier:
+		observation.ResponseObservation = &qosobservations.MoveEndpointObservation_SuiChainIdentifierResponse{
+			SuiChainIdentifierResponse: getSuiChainIdentifierObservation(logger, jsonrpcResponse),
+		}
+
+	case methodSuiGetLatestCheckpointSequenceNumber:
+		observation.ResponseObservation = &qosobservations.MoveEndpointObservation_SuiLatestCheckpointResponse{
+			SuiLatestCheckpointResponse: getSuiLatestCheckpointObservation(logger, jsonrpcResponse),
+		}
+
+	default:
+		observation.ResponseObservation = &qosobservations.MoveEndpointObservation_UnrecognizedResponse{
+			UnrecognizedResponse: &qosobservations.MoveUnrecognizedResponse{
+				JsonrpcResponse: jsonrpcResponse.GetObservation(),
+			},
+		}
+	}
+
+	return jsonrpcResponse, observation
+}
+
+// getSuiChainIdentifierObservation builds the observation of an endpoint's response to a `sui_getChainIdentifier` request.
+// The observation is marked invalid if the endpoint returned an error or an unparsable result.
+func getSuiChainIdentifierObservation(logger polylog.Logger, jsonrpcResp jsonrpc.Response) *qosobservations.MoveSuiChainIdentifierResponse {
+	if jsonrpcResp.IsError() {
+		return &qosobservations.MoveSuiChainIdentifierResponse{Invalid: true}
+	}
+
+	var chainIdentifier string
+	if err := jsonrpcResp.UnmarshalResult(&chainIdentifier); err != nil || chainIdentifier == "" {
+		logger.Debug().Err(err).Msgf("❌ Move endpoint will fail QoS check because the %q result failed to parse.", methodSuiGetChainIdentifier)
+		return &qosobservations.MoveSuiChainIdentifierResponse{Invalid: true}
+	}
+
+	return &qosobservations.MoveSuiChainIdentifierResponse{ChainIdentifier: chainIdentifier}
+}
+
+// getSuiLatestCheckpointObservation builds the observation of an endpoint's response to a `sui_getLatestCheckpointSequenceNumber` request.
+// Sui encodes the sequence number as a decimal string, e.g. "123456": a JSON number is also accepted.
+// The observation is marked invalid if the endpoint returned an error or an unparsable result.
+func getSuiLatestCheckpointObservation(logger polylog.Logger, jsonrpcResp jsonrpc.Response) *qosobservations.MoveSuiLatestCheckpointResponse {
+	if jsonrpcResp.IsError() {
+		return &qosobservations.MoveSuiLatestCheckpointResponse{Invalid: true}
+	}
+
+	var sequenceNumber json.Number
+	if err := jsonrpcResp.UnmarshalResult(&sequenceNumber); err != nil {
+		logger.Debug().Err(err).Msgf("❌ Move endpoint will fail QoS check because the %q result failed to parse.", methodSuiGetLatestCheckpointSequenceNumber)
+		return &qosobservations.MoveSuiLatestCheckpointResponse{Invalid: true}
+	}
+
+	checkpoint, err := strconv.ParseUint(sequenceNumber.String(), 10, 64)
+	if err != nil {
+		logger.Debug().Err(err).Msgf("❌ Move endpoint will fail QoS check because the %q result is not a valid sequence number.", methodSuiGetLatestCheckpointSequenceNumber)
+		return &qosobservations.MoveSuiLatestCheckpointResponse{Invalid: true}
+	}
+
+	return &qosobservations.MoveSuiLatestCheckpointResponse{CheckpointSequenceNumber: checkpoint}
+}
+
+// getGenericJSONRPCErrResponse returns a JSON-RPC error response for an endpoint payload which is not a valid JSON-RPC response.
+// Includes the supplied ID, error, and invalid payload in the "data" field.
+func getGenericJSONRPCErrResponse(id jsonrpc.ID, malformedResponsePayload []byte, err error) jsonrpc.Response {
+	errData := map[string]string{
+		errDataFieldRawBytes:        string(malformedResponsePayload),
+		errDataFieldUnmarshalingErr: err.Error(),
+	}
+
+	// `jsonrpc.ResponseCodeBackendServerErr`, i.e. code -31002, will result in returning a 500 HTTP Status Code to the client.
+	return jsonrpc.GetErrorResponse(id, jsonrpc.ResponseCodeBackendServerErr, errMsgUnmarshaling, errData)
+}
+
+// newValidationErrorObservation returns the observation of an endpoint payload which is not a valid JSON-RPC response.
+func newValidationErrorObservation() *qosobservations.JsonRpcResponseValidationError {
+	return &qosobservations.JsonRpcResponseValidationError{
+		ErrorType: qosobservations.JsonRpcValidationErrorType_JSON_RPC_VALIDATION_ERROR_TYPE_NON_JSONRPC_RESPONSE,
+		Timestamp: timestamppb.New(time.Now()),
+	}
+}
@@ -0,0 +1,47 @@
+package move
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+)
+
+// aptosLedgerInfo captures the fields of an Aptos ledger info response used in endpoint validation.
+// The ledger version and block height are encoded as decimal strings, e.g. "2735578403".
+// Reference: https://aptos.dev/en/build/apis/fullnode-rest-api-reference#tag/general/GET/
+type aptosLedgerInfo struct {
+	ChainID       json.Number `json:"chain_id"`
+	LedgerVersion uint64      `json:"ledger_version,string"`
+	BlockHeight   uint64      `json:"block_height,string"`
+}
+
+// isAptosLedgerInfoRequest returns true if the REST request is for the Aptos ledger info, i.e. `GET /v1`.
+// Used to also collect ledger info observations from organic requests.
+func isAptosLedgerInfoRequest(httpRequestMethod, httpRequestPath string) bool {
+	return (httpRequestMethod == "" || httpRequestMethod == http.MethodGet) &&
+		strings.TrimSuffix(httpRequestPath, "/") == pathAptosLedgerInfo
+}
+
+// getAptosLedgerInfoObservation builds the observation of an endpoint's response to an Aptos ledger info request.
+// The observation is marked invalid if the endpoint returned a non-2xx response or an unparsable payload.
+func getAptosLedgerInfoObservation(logger polylog.Logger, httpStatusCode int, payload []byte) *qosobservations.MoveAptosLedgerInfoResponse {
+	if httpStatusCode < http.StatusOK || httpStatusCode >= http.StatusMultipleChoices {
+		return &qosobservations.MoveAptosLedgerInfoResponse{Invalid: true}
+	}
+
+	var ledgerInfo aptosLedgerInfo
+	if err := json.Unmarshal(payload, &ledgerInfo); err != nil || ledgerInfo.ChainID == "" {
+		logger.Debug().Err(err).Msgf("❌ Move endpoint will fail QoS check because the %q response failed to parse.", pathAptosLedgerInfo)
+		return &qosobservations.MoveAptosLedgerInfoResponse{Invalid: true}
+	}
+
+	return &qosobservations.MoveAptosLedgerInfoResponse{
+		ChainId:       ledgerInfo.ChainID.String(),
+		LedgerVersion: ledgerInfo.LedgerVersion,
+		BlockHeight:   ledgerInfo.BlockHeight,
+	}
+}
@@ -0,0 +1,106 @@
+package move
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/stretchr/testify/require"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+func TestUnmarshalJSONRPCResponse_SuiChecks(t *testing.T) {
+	tests := []struct {
+		name              string
+		method            jsonrpc.Method
+		payload           string
+		wantChainID       string
+		wantCheckpoint    uint64
+		wantInvalid       bool
+		wantUnrecognized  bool
+		wantValidationErr bool
+	}{
+		{
+			name:        "chain identifier",
+			method:      methodSuiGetChainIdentifier,
+			payload:     `{"jsonrpc":"2.0","id":1001,"result":"35834a8a"}`,
+			wantChainID: "35834a8a",
+		},
+		{
+			name:           "checkpoint sequence number encoded as a string",
+			method:         methodSuiGetLatestCheckpointSequenceNumber,
+			payload:        `{"jsonrpc":"2.0","id":1001,"result":"123456789"}`,
+			wantCheckpoint: 123456789,
+		},
+		{
+			name:           "checkpoint sequence number encoded as a number",
+			method:         methodSuiGetLatestCheckpointSequenceNumber,
+			payload:        `{"jsonrpc":"2.0","id":1001,"result":123456789}`,
+			wantCheckpoint: 123456789,
+		},
+		{
+			name:        "checkpoint error response is invalid",
+			method:      methodSuiGetLatestCheckpointSequenceNumber,
+			payload:     `{"jsonrpc":"2.0","id":1001,"error":{"code":-32603,"message":"internal error"}}`,
+			wantInvalid: true,
+		},
+		{
+			name:        "non-numeric checkpoint is invalid",
+			method:      methodSuiGetLatestCheckpointSequenceNumber,
+			payload:     `{"jsonrpc":"2.0","id":1001,"result":"latest"}`,
+			wantInvalid: true,
+		},
+		{
+			name:              "non-JSON-RPC payload is a validation error",
+			method:            "sui_getObject",
+			payload:           `<html>bad gateway</html>`,
+			wantUnrecognized:  true,
+			wantValidationErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := require.New(t)
+
+			req := jsonrpc.Request{JSONRPC: jsonrpc.Version2, ID: jsonrpc.IDFromInt(1001), Method: test.method}
+			_, observation := unmarshalJSONRPCResponse(polyzero.NewLogger(), req, []byte(test.payload), "endpoint")
+
+			switch {
+			case test.wantUnrecognized:
+				c.NotNil(observation.GetUnrecognizedResponse())
+				c.Equal(test.wantValidationErr, observation.GetUnrecognizedResponse().GetValidationError() != nil)
+			case test.method == methodSuiGetChainIdentifier:
+				c.Equal(test.wantChainID, observation.GetSuiChainIdentifierResponse().GetChainIdentifier())
+				c.Equal(test.wantInvalid, observation.GetSuiChainIdentifierResponse().GetInvalid())
+			default:
+				c.Equal(test.wantCheckpoint, observation.GetSuiLatestCheckpointResponse().GetCheckpointSequenceNumber())
+				c.Equal(test.wantInvalid, observation.GetSuiLatestCheckpointResponse().GetInvalid())
+			}
+		})
+	}
+}
+
+func TestGetAptosLedgerInfoObservation(t *testing.T) {
+	c := require.New(t)
+	logger := polyzero.NewLogger()
+
+	payload := []byte(`{"chain_id":1,"epoch":"9000","ledger_version":"2735578403","oldest_ledger_version":"0","ledger_timestamp":"1729000000000000","node_role":"full_node","oldest_block_height":"0","block_height":"310000000","git_hash":"abc"}`)
+	c.Equal(&qosobservations.MoveAptosLedgerInfoResponse{
+		ChainId:       "1",
+		LedgerVersion: 2735578403,
+		BlockHeight:   310000000,
+	}, getAptosLedgerInfoObservation(logger, http.StatusOK, payload))
+
+	// Non-2xx responses and unparsable payloads are invalid.
+	c.True(getAptosLedgerInfoObservation(logger, http.StatusServiceUnavailable, nil).GetInvalid())
+	c.True(getAptosLedgerInfoObservation(logger, http.StatusOK, []byte(`{"message":"not found"}`)).GetInvalid())
+
+	// Organic ledger info requests are recognized, with or without a trailing slash.
+	c.True(isAptosLedgerInfoRequest(http.MethodGet, "/v1"))
+	c.True(isAptosLedgerInfoRequest(http.MethodGet, "/v1/"))
+	c.False(isAptosLedgerInfoRequest(http.MethodGet, "/v1/accounts/0x1"))
+	c.False(isAptosLedgerInfoRequest(http.MethodPost, "/v1"))
+}
@@ -0,0 +1,163 @@
+package move
+
+import (
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// QoSType is the QoS type for Move-based blockchains, e.g. Sui or Aptos.
+const QoSType = "move"
+
+const (
+	// DefaultCheckpointSyncAllowance is the default number of checkpoints a JSON-RPC (Sui) endpoint
+	// may be behind the perceived latest checkpoint. Sui produces several checkpoints per second.
+	DefaultCheckpointSyncAllowance = 30
+
+	// DefaultLedgerVersionSyncAllowance is the default number of ledger versions, i.e. transactions,
+	// a REST (Aptos) endpoint may be behind the perceived latest ledger version.
+	DefaultLedgerVersionSyncAllowance = 10_000
+)
+
+// ServiceQoSConfig defines the base interface for service QoS configurations.
+// This avoids circular dependency with the config package.
+type ServiceQoSConfig interface {
+	GetServiceID() protocol.ServiceID
+	GetServiceQoSType() string
+}
+
+// MoveServiceQoSConfig is the configuration for the Move service QoS.
+type MoveServiceQoSConfig interface {
+	ServiceQoSConfig // Using locally defined interface to avoid circular dependency
+	getChainID() string
+	getSupportedAPIs() map[sharedtypes.RPCType]struct{}
+	getCheckpointSyncAllowance() uint64
+	getLedgerVersionSyncAllowance() uint64
+	getRequestLimits() jsonrpc.RequestLimits
+}
+
+// MoveServiceQoSConfigOption customizes an optional setting of a Move service QoS configuration.
+type MoveServiceQoSConfigOption func(*moveServiceQoSConfig)
+
+// WithSyncAllowance sets the number of checkpoints (JSON-RPC) or ledger versions (REST)
+// an endpoint may be behind the perceived latest one and still be considered valid.
+// Defaults to DefaultCheckpointSyncAllowance and DefaultLedgerVersionSyncAllowance respectively if not set.
+func WithSyncAllowance(syncAllowance uint64) MoveServiceQoSConfigOption {
+	return func(c *moveServiceQoSConfig) {
+		c.syncAllowance = syncAllowance
+	}
+}
+
+// WithMaxBatchSize sets the maximum number of requests in a JSONRPC batch request.
+// Defaults to jsonrpc.DefaultMaxBatchSize if not set.
+func WithMaxBatchSize(maxBatchSize int) MoveServiceQoSConfigOption {
+	return func(c *moveServiceQoSConfig) {
+		c.maxBatchSize = maxBatchSize
+	}
+}
+
+// WithMaxRequestBodyBytes sets the maximum size of a request body, in bytes.
+// Defaults to jsonrpc.DefaultMaxRequestBodyBytes if not set.
+func WithMaxRequestBodyBytes(maxRequestBodyBytes int64) MoveServiceQoSConfigOption {
+	return func(c *moveServiceQoSConfig) {
+		c.maxRequestBodyBytes = maxRequestBodyBytes
+	}
+}
+
+// NewMoveServiceQoSConfig creates a new Move service configuration.
+// The supported APIs determine both the accepted requests and the endpoint checks:
+//   - JSON_RPC (Sui): the chain ID is the chain identifier returned by `sui_getChainIdentifier`, e.g. "35834a8a".
+//   - REST (Aptos): the chain ID is the numeric `chain_id` returned by `GET /v1`, e.g. "1".
+func NewMoveServiceQoSConfig(
+	serviceID protocol.ServiceID,
+	chainID string,
+	supportedAPIs map[sharedtypes.RPCType]struct{},
+	opts ...MoveServiceQoSConfigOption,
+) MoveServiceQoSConfig {
+	config := moveServiceQoSConfig{
+		serviceID:     serviceID,
+		chainID:       chainID,
+		supportedAPIs: supportedAPIs,
+	}
+
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return config
+}
+
+// Ensure implementation satisfies interface
+var _ MoveServiceQoSConfig = (*moveServiceQoSConfig)(nil)
+
+type moveServiceQoSConfig struct {
+	serviceID protocol.ServiceID
+
+	// chainID is the chain ID expected from endpoints, e.g. "35834a8a" for Sui mainnet or "1" for Aptos mainnet.
+	chainID string
+
+	// supportedAPIs are the RPC types supported by the service: JSON_RPC and/or REST.
+	supportedAPIs map[sharedtypes.RPCType]struct{}
+
+	// syncAllowance is the number of checkpoints (JSON-RPC) or ledger versions (REST) an endpoint may be behind.
+	syncAllowance uint64
+
+	// maxBatchSize is the maximum number of requests in a JSONRPC batch request.
+	maxBatchSize int
+
+	// maxRequestBodyBytes is the maximum size of a request body, in bytes.
+	maxRequestBodyBytes int64
+}
+
+// GetServiceID returns the ID of the service.
+// Implements the ServiceQoSConfig interface.
+func (c moveServiceQoSConfig) GetServiceID() protocol.ServiceID {
+	return c.serviceID
+}
+
+// GetServiceQoSType returns the QoS type of the service.
+// Implements the ServiceQoSConfig interface.
+func (moveServiceQoSConfig) GetServiceQoSType() string {
+	return QoSType
+}
+
+// getChainID returns the chain ID expected from endpoints.
+// Implements the MoveServiceQoSConfig interface.
+func (c moveServiceQoSConfig) getChainID() string {
+	return c.chainID
+}
+
+// getSupportedAPIs returns the RPC types supported by the service.
+// This is used:
+// 1. to validate the request and whether this service supports the request's RPC type
+// 2. to determine the appropriate synthetic QoS endpoint checks to run
+//
+// Implements the MoveServiceQoSConfig interface.
+func (c moveServiceQoSConfig) getSupportedAPIs() map[sharedtypes.RPCType]struct{} {
+	return c.supportedAPIs
+}
+
+// getCheckpointSyncAllowance returns the checkpoint sync allowance of JSON-RPC endpoints, with the default applied.
+// Implements the MoveServiceQoSConfig interface.
+func (c moveServiceQoSConfig) getCheckpointSyncAllowance() uint64 {
+	if c.syncAllowance == 0 {
+		return DefaultCheckpointSyncAllowance
+	}
+	return c.syncAllowance
+}
+
+// getLedgerVersionSyncAllowance returns the ledger version sync allowance of REST endpoints, with the default applied.
+// Implements the MoveServiceQoSConfig interface.
+func (c moveServiceQoSConfig) getLedgerVersionSyncAllowance() uint64 {
+	if c.syncAllowance == 0 {
+		return DefaultLedgerVersionSyncAllowance
+	}
+	return c.syncAllowance
+}
+
+// getRequestLimits returns the limits on the size of requests, with defaults applied.
+// Implements the MoveServiceQoSConfig interface.
+func (c moveServiceQoSConfig) getRequestLimits() jsonrpc.RequestLimits {
+	return jsonrpc.NewRequestLimits(c.maxBatchSize, c.maxRequestBodyBytes)
+}
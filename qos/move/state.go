@@ -0,0 +1,119 @@
+package move
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+
+	"github.com/buildwithgrove/path/protocol"
+)
+
+// The errors below list the reasons an endpoint is disqualified for lagging the perceived state of the chain.
+var (
+	errCheckpointLagging    = errors.New("endpoint latest checkpoint is behind the perceived latest checkpoint")
+	errLedgerVersionLagging = errors.New("endpoint ledger version is behind the perceived latest ledger version")
+)
+
+// serviceState keeps the expected current state of the Move-based blockchain based on the endpoints' responses to:
+//   - JSON-RPC (Sui): `sui_getChainIdentifier` and `sui_getLatestCheckpointSequenceNumber` requests.
+//   - REST (Aptos): `GET /v1` ledger info requests.
+type serviceState struct {
+	logger polylog.Logger
+
+	// chainID is the chain ID expected from endpoints, e.g. "35834a8a" for Sui mainnet or "1" for Aptos mainnet.
+	chainID string
+
+	// serviceID to add to endpoint checks.
+	// Used by observations of Synthetic requests.
+	serviceID protocol.ServiceID
+
+	// supportedAPIs determines the validation endpoints must pass: an endpoint must serve every supported API.
+	supportedAPIs map[sharedtypes.RPCType]struct{}
+
+	// checkpointSyncAllowance is the number of checkpoints a JSON-RPC endpoint may be behind the perceived latest checkpoint.
+	checkpointSyncAllowance uint64
+
+	// ledgerVersionSyncAllowance is the number of ledger versions a REST endpoint may be behind the perceived latest ledger version.
+	ledgerVersionSyncAllowance uint64
+
+	serviceStateLock sync.RWMutex
+	// perceivedCheckpoint is the highest checkpoint sequence number reported by any valid JSON-RPC endpoint.
+	perceivedCheckpoint uint64
+	// perceivedLedgerVersion is the highest ledger version reported by any valid REST endpoint.
+	perceivedLedgerVersion uint64
+}
+
+// isAPISupported returns true if the service supports the supplied RPC type.
+func (s *serviceState) isAPISupported(rpcType sharedtypes.RPCType) bool {
+	_, supported := s.supportedAPIs[rpcType]
+	return supported
+}
+
+// validateEndpoint returns an error if the supplied endpoint is not valid based on the perceived state of the Move blockchain.
+// The endpoint is validated for each of the APIs supported by the service.
+func (s *serviceState) validateEndpoint(endpoint endpoint) error {
+	s.serviceStateLock.RLock()
+	defer s.serviceStateLock.RUnlock()
+
+	if s.isAPISupported(sharedtypes.RPCType_JSON_RPC) {
+		if err := endpoint.validateJSONRPC(s.chainID); err != nil {
+			return err
+		}
+
+		if checkpoint := endpoint.suiLatestCheckpointResponse.GetCheckpointSequenceNumber(); checkpoint+s.checkpointSyncAllowance < s.perceivedCheckpoint {
+			return fmt.Errorf("%w: %d is more than %d checkpoints behind %d",
+				errCheckpointLagging, checkpoint, s.checkpointSyncAllowance, s.perceivedCheckpoint)
+		}
+	}
+
+	if s.isAPISupported(sharedtypes.RPCType_REST) {
+		if err := endpoint.validateREST(s.chainID); err != nil {
+			return err
+		}
+
+		if ledgerVersion := endpoint.aptosLedgerInfoResponse.GetLedgerVersion(); ledgerVersion+s.ledgerVersionSyncAllowance < s.perceivedLedgerVersion {
+			return fmt.Errorf("%w: %d is more than %d versions behind %d",
+				errLedgerVersionLagging, ledgerVersion, s.ledgerVersionSyncAllowance, s.perceivedLedgerVersion)
+		}
+	}
+
+	return nil
+}
+
+// updateFromEndpoints updates the perceived latest checkpoint and ledger version using the set of updated endpoints.
+// Only endpoints passing the basic validation of the corresponding API, i.e. on the expected chain, are used.
+// NOTE: This only includes the set of endpoints for which an observation was received.
+func (s *serviceState) updateFromEndpoints(updatedEndpoints map[protocol.EndpointAddr]endpoint) {
+	s.serviceStateLock.Lock()
+	defer s.serviceStateLock.Unlock()
+
+	// TODO_TECHDEBT: use a more resilient method for updating the perceived state.
+	// e.g. one endpoint returning a very large checkpoint should
+	// not result in all other endpoints being marked as invalid.
+	for endpointAddr, endpoint := range updatedEndpoints {
+		if err := endpoint.validateJSONRPC(s.chainID); err == nil {
+			if checkpoint := endpoint.suiLatestCheckpointResponse.GetCheckpointSequenceNumber(); checkpoint > s.perceivedCheckpoint {
+				s.perceivedCheckpoint = checkpoint
+
+				s.logger.With(
+					"endpoint", endpointAddr,
+					"checkpoint", s.perceivedCheckpoint,
+				).Debug().Msg("Updating latest checkpoint")
+			}
+		}
+
+		if err := endpoint.validateREST(s.chainID); err == nil {
+			if ledgerVersion := endpoint.aptosLedgerInfoResponse.GetLedgerVersion(); ledgerVersion > s.perceivedLedgerVersion {
+				s.perceivedLedgerVersion = ledgerVersion
+
+				s.logger.With(
+					"endpoint", endpointAddr,
+					"ledger_version", s.perceivedLedgerVersion,
+				).Debug().Msg("Updating latest ledger version")
+			}
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package move
+
+import (
+	"testing"
+
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	"github.com/stretchr/testify/require"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+)
+
+// Sui endpoints are validated through JSON-RPC, and Aptos endpoints through REST:
+// only the checks of the service's supported API are applied.
+func TestServiceState_validateEndpoint(t *testing.T) {
+	sui := map[sharedtypes.RPCType]struct{}{sharedtypes.RPCType_JSON_RPC: {}}
+	aptos := map[sharedtypes.RPCType]struct{}{sharedtypes.RPCType_REST: {}}
+
+	suiEndpoint := func(chainIdentifier string, checkpoint uint64) endpoint {
+		return endpoint{
+			suiChainIdentifierResponse:  &qosobservations.MoveSuiChainIdentifierResponse{ChainIdentifier: chainIdentifier},
+			suiLatestCheckpointResponse: &qosobservations.MoveSuiLatestCheckpointResponse{CheckpointSequenceNumber: checkpoint},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		chainID       string
+		supportedAPIs map[sharedtypes.RPCType]struct{}
+		endpoint      endpoint
+		expectedErr   error
+	}{
+		{
+			name:          "Sui endpoint within the sync allowance of the perceived latest checkpoint",
+			chainID:       "35834a8a",
+			supportedAPIs: sui,
+			endpoint:      suiEndpoint("35834a8a", 970),
+		},
+		{
+			name:          "Sui endpoint lagging beyond the checkpoint sync allowance",
+			chainID:       "35834a8a",
+			supportedAPIs: sui,
+			endpoint:      suiEndpoint("35834a8a", 969),
+			expectedErr:   errCheckpointLagging,
+		},
+		{
+			name:          "Sui endpoint with a different chain identifier",
+			chainID:       "35834a8a",
+			supportedAPIs: sui,
+			endpoint:      suiEndpoint("4c78adac", 1000),
+			expectedErr:   errInvalidChainIDObs,
+		},
+		{
+			name:          "Sui endpoint is not required to return Aptos ledger info",
+			chainID:       "35834a8a",
+			supportedAPIs: sui,
+			endpoint: endpoint{
+				suiChainIdentifierResponse:  &qosobservations.MoveSuiChainIdentifierResponse{ChainIdentifier: "35834a8a"},
+				suiLatestCheckpointResponse: &qosobservations.MoveSuiLatestCheckpointResponse{CheckpointSequenceNumber: 1000},
+				aptosLedgerInfoResponse:     &qosobservations.MoveAptosLedgerInfoResponse{Invalid: true},
+			},
+		},
+		{
+			name:          "Aptos endpoint within the sync allowance of the perceived latest ledger version",
+			chainID:       "1",
+			supportedAPIs: aptos,
+			endpoint:      endpoint{aptosLedgerInfoResponse: &qosobservations.MoveAptosLedgerInfoResponse{ChainId: "1", LedgerVersion: 1_990_000}},
+		},
+		{
+			name:          "Aptos endpoint lagging beyond the ledger version sync allowance",
+			chainID:       "1",
+			supportedAPIs: aptos,
+			endpoint:      endpoint{aptosLedgerInfoResponse: &qosobservations.MoveAptosLedgerInfoResponse{ChainId: "1", LedgerVersion: 1_989_999}},
+			expectedErr:   errLedgerVersionLagging,
+		},
+		{
+			name:          "Aptos endpoint with a different chain ID",
+			chainID:       "1",
+			supportedAPIs: aptos,
+			endpoint:      endpoint{aptosLedgerInfoResponse: &qosobservations.MoveAptosLedgerInfoResponse{ChainId: "2", LedgerVersion: 2_000_000}},
+			expectedErr:   errInvalidChainIDObs,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &serviceState{
+				chainID:                    tt.chainID,
+				supportedAPIs:              tt.supportedAPIs,
+				checkpointSyncAllowance:    30,
+				ledgerVersionSyncAllowance: 10_000,
+				perceivedCheckpoint:        1000,
+				perceivedLedgerVersion:     2_000_000,
+			}
+
+			err := state.validateEndpoint(tt.endpoint)
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package move
+
+import (
+	"errors"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
+	"github.com/buildwithgrove/path/metrics/devtools"
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos"
+)
+
+// endpointStore provides the endpoint selection capability required
+// by the protocol package for handling a service request.
+var _ protocol.EndpointSelector = &endpointStore{}
+
+// endpointStore holds the latest chain and progress responses of each endpoint of a Move service:
+//   - Sui (JSON-RPC): the chain identifier and latest checkpoint.
+//   - Aptos (REST): the ledger info, i.e. chain ID and ledger version.
+type endpointStore struct {
+	*qos.EndpointStore[endpoint]
+
+	logger polylog.Logger
+
+	serviceState *serviceState
+}
+
+// newEndpointStore returns an empty endpoint store, validating endpoints against the checks of the service's supported APIs.
+func newEndpointStore(logger polylog.Logger, serviceState *serviceState) *endpointStore {
+	return &endpointStore{
+		EndpointStore: qos.NewEndpointStore(logger, serviceState.validateEndpoint),
+		logger:        logger,
+		serviceState:  serviceState,
+	}
+}
+
+// updateEndpointsFromObservations stores the Sui and Aptos responses, and validation errors, of the observed endpoints.
+// It returns the set of created/updated endpoints, used to update the perceived latest checkpoint and ledger version.
+func (es *endpointStore) updateEndpointsFromObservations(
+	endpointObservations []*qosobservations.MoveEndpointObservation,
+) map[protocol.EndpointAddr]endpoint {
+	return qos.UpdateEndpointsFromObservations(es.EndpointStore, endpointObservations, (*endpoint).applyObservation)
+}
+
+// getDisqualifiedEndpointsResponse returns the endpoints failing the Sui or Aptos checks, for a devtools.DisqualifiedEndpointResponse.
+func (es *endpointStore) getDisqualifiedEndpointsResponse(serviceID protocol.ServiceID) devtools.QoSLevelDataResponse {
+	return es.GetDisqualifiedEndpointsResponse(serviceID, countDisqualifiedEndpoint)
+}
+
+// countDisqualifiedEndpoint counts a Move endpoint's validation error towards the matching devtools counter.
+// Lagging checkpoints and ledger versions both count as block number check errors.
+func countDisqualifiedEndpoint(response *devtools.QoSLevelDataResponse, err error) bool {
+	switch {
+	// Endpoint is disqualified due to a missing or invalid response.
+	case errors.Is(err, errNoSuiChainIdentifierObs),
+		errors.Is(err, errInvalidSuiChainIdentifierObs),
+		errors.Is(err, errNoSuiCheckpointObs),
+		errors.Is(err, errInvalidSuiCheckpointObs),
+		errors.Is(err, errNoAptosLedgerInfoObs),
+		errors.Is(err, errInvalidAptosLedgerInfoObs),
+		errors.Is(err, qos.ErrRecentJSONRPCValidationError):
+		response.EmptyResponseCount++
+		return true
+
+	// Endpoint is disqualified due to being on a different chain.
+	case errors.Is(err, errInvalidChainIDObs):
+		response.ChainIDCheckErrorsCount++
+		return true
+
+	// Endpoint is disqualified due to lagging the perceived latest checkpoint or ledger version.
+	case errors.Is(err, errCheckpointLagging),
+		errors.Is(err, errLedgerVersionLagging):
+		response.BlockNumberCheckErrorsCount++
+		return true
+
+	default:
+		return false
+	}
+}
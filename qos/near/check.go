@@ -0,0 +1,51 @@
+package near
+
+import (
+	"github.com/buildwithgrove/path/gateway"
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+const (
+	// Each endpoint check should use its own ID to avoid potential conflicts.
+	// ID of JSON-RPC requests for any new checks should be added to the list below.
+	_        = iota
+	idStatus = 1000 + iota
+)
+
+// EndpointStore provides the endpoint check generator required by
+// the gateway package to augment endpoints' quality data,
+// using synthetic service requests.
+var _ gateway.QoSEndpointCheckGenerator = &EndpointStore{}
+
+// CheckWebsocketConnection returns false: the NEAR QoS does not check Websocket connections.
+func (es *EndpointStore) CheckWebsocketConnection() bool {
+	return false
+}
+
+// GetRequiredQualityChecks returns the `status` check.
+// TODO_IMPROVE: skip the check if the endpoint has a recent observation.
+func (es *EndpointStore) GetRequiredQualityChecks(_ protocol.EndpointAddr) []gateway.RequestQoSContext {
+	statusReq := jsonrpc.Request{
+		JSONRPC: jsonrpc.Version2,
+		ID:      jsonrpc.IDFromInt(idStatus),
+		Method:  methodStatus,
+	}
+	// NEAR expects a params field, even for methods with no params.
+	statusReq.SetParams([]byte("[]"))
+
+	return []gateway.RequestQoSContext{
+		&requestContext{
+			logger:        es.logger,
+			endpointStore: es,
+			// Set the chain and Service ID: this is required to generate observations with the correct chain ID.
+			chainID:    es.serviceState.chainID,
+			serviceID:  es.serviceState.serviceID,
+			jsonrpcReq: statusReq,
+			// Set the origin of the request as Synthetic.
+			// The request is generated by the QoS service to collect extra observations on endpoints.
+			requestOrigin: qosobservations.RequestOrigin_REQUEST_ORIGIN_SYNTHETIC,
+		},
+	}
+}
@@ -0,0 +1,141 @@
+package near
+
+import (
+	"errors"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
+	"github.com/buildwithgrove/path/gateway"
+	pathhttp "github.com/buildwithgrove/path/network/http"
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// requestContext provides the support required by the gateway
+// package for handling service requests.
+var _ gateway.RequestQoSContext = &requestContext{}
+
+// endpointResponse is an endpoint's response to the request handled by the request context.
+type endpointResponse struct {
+	// payload is the endpoint's payload, returned to the user as-is to preserve NEAR's structured errors.
+	// Only set if the payload is a valid JSON-RPC response.
+	payload []byte
+
+	// jsonrpcResponse is the endpoint's response, parsed as a JSON-RPC response.
+	// A generic error response is used if the endpoint's payload is not a valid JSON-RPC response.
+	jsonrpcResponse jsonrpc.Response
+
+	observation *qosobservations.NearEndpointObservation
+}
+
+// requestContext provides the functionality required
+// to support QoS for a NEAR blockchain service.
+type requestContext struct {
+	logger polylog.Logger
+
+	// chainID is the NEAR chain ID expected from endpoints, e.g. "mainnet".
+	chainID   string
+	serviceID protocol.ServiceID
+
+	// The length of the request payload in bytes.
+	requestPayloadLength uint
+
+	endpointStore *EndpointStore
+
+	jsonrpcReq jsonrpc.Request
+
+	// The origin of the request handled by the context.
+	// Either:
+	// - User: user requests
+	// - QoS: requests built by the QoS service to get additional data points on endpoints.
+	requestOrigin qosobservations.RequestOrigin
+
+	// endpointResponses is the set of responses received from one or
+	// more endpoints as part of handling this service request.
+	endpointResponses []endpointResponse
+}
+
+// GetServicePayloads returns the payload of the request.
+// Implements the gateway.RequestQoSContext interface.
+func (rc *requestContext) GetServicePayloads() []protocol.Payload {
+	payload, err := rc.jsonrpcReq.BuildPayload()
+	if err != nil {
+		rc.logger.Error().Err(err).Msg("SHOULD RARELY HAPPEN: requestContext.GetServicePayloads() should never fail marshaling the JSONRPC request.")
+		return []protocol.Payload{protocol.EmptyErrorPayload()}
+	}
+	return []protocol.Payload{payload}
+}
+
+// UpdateWithResponse is NOT safe for concurrent use
+// Implements the gateway.RequestQoSContext interface.
+func (rc *requestContext) UpdateWithResponse(endpointAddr protocol.EndpointAddr, responseBz []byte) {
+	jsonrpcResponse, observation, isValid := unmarshalResponse(rc.logger, rc.jsonrpcReq, responseBz, endpointAddr)
+	observation.EndpointAddr = string(endpointAddr)
+
+	response := endpointResponse{
+		jsonrpcResponse: jsonrpcResponse,
+		observation:     observation,
+	}
+	if isValid {
+		response.payload = responseBz
+	}
+
+	rc.endpointResponses = append(rc.endpointResponses, response)
+}
+
+// GetHTTPResponse builds the HTTP response that should be returned for the request.
+// Implements the gateway.RequestQoSContext interface.
+func (rc *requestContext) GetHTTPResponse() pathhttp.HTTPResponse {
+	// No responses received: this is an internal error:
+	// e.g. protocol-level errors like endpoint timing out.
+	if len(rc.endpointResponses) == 0 {
+		jsonrpcErrorResponse := jsonrpc.NewErrResponseInternalErr(rc.jsonrpcReq.ID, errors.New("protocol-level error: no endpoint responses received"))
+		return qos.BuildHTTPResponseFromJSONRPCResponse(rc.logger, jsonrpcErrorResponse)
+	}
+
+	// Use the most recent endpoint response.
+	selectedResponse := rc.endpointResponses[len(rc.endpointResponses)-1]
+
+	// The endpoint payload is not a valid JSON-RPC response: return the generic error response.
+	if selectedResponse.payload == nil {
+		return qos.BuildHTTPResponseFromJSONRPCResponse(rc.logger, selectedResponse.jsonrpcResponse)
+	}
+
+	// Return the endpoint's payload as-is: re-encoding the JSON-RPC response would drop the `name` and `cause` fields of NEAR errors.
+	return qos.BuildHTTPResponseFromBytes(selectedResponse.payload, int(selectedResponse.observation.GetHttpStatusCode()))
+}
+
+// GetObservations returns all the observations contained in the request context.
+// Implements the gateway.RequestQoSContext interface.
+func (rc *requestContext) GetObservations() qosobservations.Observations {
+	observations := &qosobservations.NearRequestObservations{
+		ChainId:              rc.chainID,
+		ServiceId:            string(rc.serviceID),
+		RequestPayloadLength: uint32(rc.requestPayloadLength),
+		RequestOrigin:        rc.requestOrigin,
+		JsonrpcRequest:       rc.jsonrpcReq.GetObservation(),
+	}
+
+	// No endpoint responses received.
+	// Set request error.
+	if len(rc.endpointResponses) == 0 {
+		observations.RequestError = qos.GetRequestErrorForProtocolError()
+	}
+
+	for _, endpointResponse := range rc.endpointResponses {
+		observations.EndpointObservations = append(observations.EndpointObservations, endpointResponse.observation)
+	}
+
+	return qosobservations.Observations{
+		ServiceObservations: &qosobservations.Observations_Near{
+			Near: observations,
+		},
+	}
+}
+
+// GetEndpointSelector is required to satisfy the gateway package's RequestQoSContext interface.
+func (rc *requestContext) GetEndpointSelector() protocol.EndpointSelector {
+	return rc.endpointStore
+}
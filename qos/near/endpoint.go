@@ -0,0 +1,102 @@
+package near
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/qos"
+)
+
+// endpointErrorWindow is the duration an endpoint is disqualified for after returning
+// a NEAR error attributed to the endpoint, e.g. NOT_SYNCED_YET.
+// Shorter than the JSON-RPC validation error window: such errors are usually transient.
+const endpointErrorWindow = 5 * time.Minute
+
+// The errors below list all the possible basic validation errors on an endpoint.
+var (
+	errNoStatusObs         = fmt.Errorf("endpoint has not had an observation of its response to a %q request", methodStatus)
+	errInvalidStatusObs    = fmt.Errorf("endpoint returned an invalid response to a %q request", methodStatus)
+	errInvalidChainIDObs   = errors.New("endpoint is on a different chain than the service")
+	errSyncingObs          = errors.New("endpoint is syncing")
+	errRecentEndpointError = errors.New("endpoint has recently returned a NEAR error attributed to the endpoint")
+)
+
+// endpoint captures details required to validate a NEAR endpoint.
+type endpoint struct {
+	// statusResponse stores the result of processing the endpoint's `status` response.
+	// Pointer distinguishes between no observation vs. observed response scenarios.
+	statusResponse *qosobservations.NearStatusResponse
+
+	// JSONRPCValidationErrorTracker tracks the endpoint's most recent JSON-RPC response validation error.
+	qos.JSONRPCValidationErrorTracker
+
+	// latestEndpointError tracks the most recent NEAR error attributed to the endpoint, e.g. NOT_SYNCED_YET.
+	latestEndpointError *qosobservations.NearError
+}
+
+// validateBasic checks if the endpoint has the required observations to be valid, independent of other endpoints:
+//   - No recent JSON-RPC validation errors, or NEAR errors attributed to the endpoint.
+//   - A valid response to a `status` request, on the expected chain and not syncing.
+func (e endpoint) validateBasic(chainID string) error {
+	// Check for recent errors first
+	if err := e.ValidateNoRecentValidationError(); err != nil {
+		return err
+	}
+
+	if e.hasRecentEndpointErrors() {
+		return fmt.Errorf("%w: %s: %s", errRecentEndpointError, e.latestEndpointError.GetName(), e.latestEndpointError.GetCauseName())
+	}
+
+	switch {
+	case e.statusResponse == nil:
+		return errNoStatusObs
+
+	case e.statusResponse.GetInvalid():
+		return errInvalidStatusObs
+
+	case e.statusResponse.GetChainId() != chainID:
+		return fmt.Errorf("%w: expected %q, got %q", errInvalidChainIDObs, chainID, e.statusResponse.GetChainId())
+
+	case e.statusResponse.GetSyncing():
+		return errSyncingObs
+
+	default:
+		return nil
+	}
+}
+
+// hasRecentEndpointErrors checks if endpoint has returned a NEAR error attributed to the endpoint within the endpoint error window.
+func (e endpoint) hasRecentEndpointErrors() bool {
+	if e.latestEndpointError == nil {
+		return false
+	}
+
+	return e.latestEndpointError.Timestamp.AsTime().After(time.Now().Add(-endpointErrorWindow))
+}
+
+// applyObservation updates endpoint data using provided observation.
+// Returns true if observation was recognized.
+// IMPORTANT: This function mutates the endpoint.
+func (e *endpoint) applyObservation(obs *qosobservations.NearEndpointObservation) bool {
+	var isMutated bool
+
+	if nearError := obs.GetNearError(); nearError.GetKind() == qosobservations.NearErrorKind_NEAR_ERROR_KIND_ENDPOINT {
+		e.latestEndpointError = nearError
+		isMutated = true
+	}
+
+	if statusResponse := obs.GetStatusResponse(); statusResponse != nil {
+		e.statusResponse = statusResponse
+		return true
+	}
+
+	if unrecognizedResponse := obs.GetUnrecognizedResponse(); unrecognizedResponse != nil {
+		// Update latest validation error if observation contains more recent error
+		e.ApplyValidationError(unrecognizedResponse.GetValidationError())
+		return true
+	}
+
+	return isMutated
+}
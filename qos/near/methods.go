@@ -0,0 +1,9 @@
+package near
+
+import (
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// methodStatus is the JSON-RPC method for getting the status of the node, including its chain ID and sync state.
+// Reference: https://docs.near.org/api/rpc/network#node-status
+const methodStatus = jsonrpc.Method("status")
@@ -0,0 +1,91 @@
+package near
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+)
+
+// NEAR error types, i.e. values of the `name` field of the JSON-RPC error object.
+// Reference: https://docs.near.org/api/rpc/setup#rpc-errors
+const (
+	errNameRequestValidation = "REQUEST_VALIDATION_ERROR"
+	errNameHandler           = "HANDLER_ERROR"
+	errNameInternal          = "INTERNAL_ERROR"
+)
+
+// endpointErrorCauses are the causes, i.e. values of the `cause.name` field of the JSON-RPC error object,
+// of HANDLER_ERROR errors which indicate a problem with the endpoint rather than the user's request.
+// All other causes, e.g. UNKNOWN_ACCOUNT or UNKNOWN_BLOCK, are attributed to the user's request.
+var endpointErrorCauses = map[string]struct{}{
+	// The endpoint has no synced blocks, or is still syncing.
+	"NO_SYNCED_BLOCKS": {},
+	"NOT_SYNCED_YET":   {},
+	// The endpoint does not track the shard required to serve the request.
+	"UNAVAILABLE_SHARD": {},
+	// The endpoint timed out serving the request, e.g. a transaction submission.
+	"TIMEOUT_ERROR": {},
+	// The endpoint failed internally.
+	"INTERNAL_ERROR": {},
+}
+
+// nearErrorResponse captures the fields of NEAR's structured JSON-RPC error object,
+// which are not part of the JSON-RPC specification and are dropped by jsonrpc.ResponseError.
+type nearErrorResponse struct {
+	Error *struct {
+		Name  string `json:"name"`
+		Cause struct {
+			Name string `json:"name"`
+		} `json:"cause"`
+	} `json:"error"`
+}
+
+// parseNearError returns the observation of the NEAR error in the supplied endpoint payload.
+// Returns nil if the payload contains no NEAR error.
+func parseNearError(payload []byte) *qosobservations.NearError {
+	var errResponse nearErrorResponse
+	if err := json.Unmarshal(payload, &errResponse); err != nil || errResponse.Error == nil || errResponse.Error.Name == "" {
+		return nil
+	}
+
+	return &qosobservations.NearError{
+		Name:      errResponse.Error.Name,
+		CauseName: errResponse.Error.Cause.Name,
+		Kind:      classifyNearError(errResponse.Error.Name, errResponse.Error.Cause.Name),
+		Timestamp: timestamppb.New(time.Now()),
+	}
+}
+
+// classifyNearError classifies a NEAR error, using its name and cause, by the party responsible for it.
+func classifyNearError(name, causeName string) qosobservations.NearErrorKind {
+	switch name {
+	case errNameInternal:
+		return qosobservations.NearErrorKind_NEAR_ERROR_KIND_ENDPOINT
+
+	case errNameHandler:
+		if _, found := endpointErrorCauses[causeName]; found {
+			return qosobservations.NearErrorKind_NEAR_ERROR_KIND_ENDPOINT
+		}
+		return qosobservations.NearErrorKind_NEAR_ERROR_KIND_USER
+
+	// Request validation errors, e.g. PARSE_ERROR or METHOD_NOT_FOUND.
+	case errNameRequestValidation:
+		return qosobservations.NearErrorKind_NEAR_ERROR_KIND_USER
+
+	default:
+		return qosobservations.NearErrorKind_NEAR_ERROR_KIND_UNSPECIFIED
+	}
+}
+
+// getNearErrorHTTPStatusCode returns the HTTP status code for a response containing the supplied NEAR error.
+// NEAR uses the -32000 JSON-RPC error code for most errors: the error's kind is used instead.
+func getNearErrorHTTPStatusCode(nearError *qosobservations.NearError) int {
+	if nearError.GetKind() == qosobservations.NearErrorKind_NEAR_ERROR_KIND_USER {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
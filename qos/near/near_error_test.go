@@ -0,0 +1,92 @@
+package near
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+)
+
+func TestParseNearError(t *testing.T) {
+	tests := []struct {
+		name           string
+		payload        string
+		wantNil        bool
+		wantName       string
+		wantCauseName  string
+		wantKind       qosobservations.NearErrorKind
+		wantHTTPStatus int
+	}{
+		{
+			name:    "successful response has no NEAR error",
+			payload: `{"jsonrpc":"2.0","id":1,"result":{"chain_id":"mainnet"}}`,
+			wantNil: true,
+		},
+		{
+			name:    "JSON-RPC error with no NEAR error name",
+			payload: `{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"Method not found"}}`,
+			wantNil: true,
+		},
+		{
+			name:           "unknown account is a user error",
+			payload:        `{"jsonrpc":"2.0","id":1,"error":{"name":"HANDLER_ERROR","cause":{"name":"UNKNOWN_ACCOUNT","info":{}},"code":-32000,"message":"Server error"}}`,
+			wantName:       errNameHandler,
+			wantCauseName:  "UNKNOWN_ACCOUNT",
+			wantKind:       qosobservations.NearErrorKind_NEAR_ERROR_KIND_USER,
+			wantHTTPStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "unsynced node is an endpoint error",
+			payload:        `{"jsonrpc":"2.0","id":1,"error":{"name":"HANDLER_ERROR","cause":{"name":"NOT_SYNCED_YET"},"code":-32000,"message":"Server error"}}`,
+			wantName:       errNameHandler,
+			wantCauseName:  "NOT_SYNCED_YET",
+			wantKind:       qosobservations.NearErrorKind_NEAR_ERROR_KIND_ENDPOINT,
+			wantHTTPStatus: http.StatusInternalServerError,
+		},
+		{
+			name:           "parse error is a user error",
+			payload:        `{"jsonrpc":"2.0","id":1,"error":{"name":"REQUEST_VALIDATION_ERROR","cause":{"name":"PARSE_ERROR"},"code":-32700,"message":"Parse error"}}`,
+			wantName:       errNameRequestValidation,
+			wantCauseName:  "PARSE_ERROR",
+			wantKind:       qosobservations.NearErrorKind_NEAR_ERROR_KIND_USER,
+			wantHTTPStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "internal error is an endpoint error",
+			payload:        `{"jsonrpc":"2.0","id":1,"error":{"name":"INTERNAL_ERROR","cause":{"name":"INTERNAL_ERROR"},"code":-32000,"message":"Server error"}}`,
+			wantName:       errNameInternal,
+			wantCauseName:  "INTERNAL_ERROR",
+			wantKind:       qosobservations.NearErrorKind_NEAR_ERROR_KIND_ENDPOINT,
+			wantHTTPStatus: http.StatusInternalServerError,
+		},
+		{
+			name:           "unknown error type is unspecified",
+			payload:        `{"jsonrpc":"2.0","id":1,"error":{"name":"NEW_ERROR_TYPE","cause":{"name":"SOMETHING"},"code":-32000,"message":"Server error"}}`,
+			wantName:       "NEW_ERROR_TYPE",
+			wantCauseName:  "SOMETHING",
+			wantKind:       qosobservations.NearErrorKind_NEAR_ERROR_KIND_UNSPECIFIED,
+			wantHTTPStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := require.New(t)
+
+			nearError := parseNearError([]byte(test.payload))
+			if test.wantNil {
+				c.Nil(nearError)
+				return
+			}
+
+			c.NotNil(nearError)
+			c.Equal(test.wantName, nearError.GetName())
+			c.Equal(test.wantCauseName, nearError.GetCauseName())
+			c.Equal(test.wantKind, nearError.GetKind())
+			c.NotNil(nearError.GetTimestamp())
+			c.Equal(test.wantHTTPStatus, getNearErrorHTTPStatusCode(nearError))
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package near
+
+import (
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos"
+)
+
+// UpdateEndpointsFromObservations CRUDs endpoint entries in the store based on the supplied observations.
+// It returns the set of created/updated endpoints.
+func (es *EndpointStore) UpdateEndpointsFromObservations(
+	nearObservations *qosobservations.NearRequestObservations,
+) map[protocol.EndpointAddr]endpoint {
+	endpointObservations := nearObservations.GetEndpointObservations()
+
+	es.logger.With(
+		"qos_instance", "near",
+		"method", "UpdateEndpointsFromObservations",
+	).Info().Msgf("About to update endpoints from %d observations.", len(endpointObservations))
+
+	return qos.UpdateEndpointsFromObservations(es.EndpointStore, endpointObservations, (*endpoint).applyObservation)
+}
@@ -0,0 +1,132 @@
+// Package near provides the support required for interacting
+// with the NEAR blockchain through the gateway.
+// Endpoints are validated using their responses to `status` requests:
+//   - The endpoint must be on the expected chain, e.g. "mainnet".
+//   - The endpoint must not be syncing.
+//   - The endpoint's latest block height must be within the sync allowance of the perceived block height.
+//
+// NEAR's structured errors, i.e. the `name` and `cause.name` fields of JSON-RPC error objects, are classified
+// as caused by the user's request or by the endpoint: endpoints returning the latter are temporarily disqualified.
+package near
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
+	"github.com/buildwithgrove/path/gateway"
+	"github.com/buildwithgrove/path/metrics/devtools"
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+)
+
+// QoS implements gateway.QoSService by providing:
+//  1. QoSRequestParser - Builds NEAR-specific RequestQoSContext objects from HTTP requests
+//  2. EndpointSelector - Selects endpoints for service requests
+//  3. QoSEndpointCheckGenerator - Builds the `status` check
+var _ gateway.QoSService = &QoS{}
+
+// devtools.QoSDisqualifiedEndpointsReporter is fulfilled by the QoS struct below.
+// This allows the QoS service to report its disqualified endpoints data to the devtools.DisqualifiedEndpointReporter.
+var _ devtools.QoSDisqualifiedEndpointsReporter = &QoS{}
+
+// QoS implements ServiceQoS for the NEAR blockchain.
+// It handles chain-specific:
+//   - Request parsing
+//   - Response building
+//   - Endpoint validation and selection
+type QoS struct {
+	logger polylog.Logger
+	*EndpointStore
+	*ServiceState
+	*requestValidator
+}
+
+// NewQoSInstance builds and returns an instance of the NEAR QoS service.
+func NewQoSInstance(logger polylog.Logger, serviceConfig NearServiceQoSConfig) *QoS {
+	chainID := serviceConfig.getChainID()
+	serviceID := serviceConfig.GetServiceID()
+
+	logger = logger.With(
+		"qos_instance", "near",
+		"chain_id", chainID,
+		"service_id", serviceID,
+	)
+
+	serviceState := &ServiceState{
+		logger:        logger,
+		syncAllowance: serviceConfig.getSyncAllowance(),
+		chainID:       chainID,
+		serviceID:     serviceID,
+	}
+
+	nearEndpointStore := newEndpointStore(logger, serviceState)
+
+	requestValidator := &requestValidator{
+		logger:        logger,
+		chainID:       chainID,
+		serviceID:     serviceID,
+		endpointStore: nearEndpointStore,
+		requestLimits: serviceConfig.getRequestLimits(),
+	}
+
+	return &QoS{
+		logger:           logger,
+		EndpointStore:    nearEndpointStore,
+		ServiceState:     serviceState,
+		requestValidator: requestValidator,
+	}
+}
+
+// ParseHTTPRequest builds a request context from the provided HTTP request.
+// It returns an error if the HTTP request cannot be parsed as a JSONRPC request.
+//
+// Implements the gateway.QoSService interface.
+func (q *QoS) ParseHTTPRequest(_ context.Context, req *http.Request) (gateway.RequestQoSContext, bool) {
+	return q.validateHTTPRequest(req)
+}
+
+// ParseWebsocketRequest builds a request context from the provided Websocket request.
+// Websocket connection requests do not have a body, so we don't need to parse it.
+//
+// Implements the gateway.QoSService interface.
+func (q *QoS) ParseWebsocketRequest(_ context.Context) (gateway.RequestQoSContext, bool) {
+	return &requestContext{
+		logger:        q.logger,
+		chainID:       q.ServiceState.chainID,
+		serviceID:     q.ServiceState.serviceID,
+		endpointStore: q.EndpointStore,
+		// Set the origin of the request as Organic (i.e. user request)
+		// The request is from a user.
+		requestOrigin: qosobservations.RequestOrigin_REQUEST_ORIGIN_ORGANIC,
+	}, true
+}
+
+// ApplyObservations updates the stored endpoints and the perceived blockchain state using the supplied observations.
+// Implements the gateway.QoSService interface.
+func (q *QoS) ApplyObservations(observations *qosobservations.Observations) error {
+	if observations == nil {
+		return errors.New("ApplyObservations: received nil observations")
+	}
+
+	nearObservations := observations.GetNear()
+	if nearObservations == nil {
+		return errors.New("ApplyObservations: received nil NEAR observation")
+	}
+
+	updatedEndpoints := q.UpdateEndpointsFromObservations(nearObservations)
+
+	// update the perceived current state of the blockchain.
+	q.UpdateFromEndpoints(updatedEndpoints)
+	return nil
+}
+
+// HydrateDisqualifiedEndpointsResponse hydrates the disqualified endpoint response with the QoS-specific data.
+//   - takes a pointer to the DisqualifiedEndpointResponse
+//   - called by the devtools.DisqualifiedEndpointReporter to fill it with the QoS-specific data.
+func (q *QoS) HydrateDisqualifiedEndpointsResponse(serviceID protocol.ServiceID, details *devtools.DisqualifiedEndpointResponse) {
+	q.logger.Info().Msgf("hydrating disqualified endpoints response for service ID: %s", serviceID)
+	details.QoSLevelDisqualifiedEndpoints = q.getDisqualifiedEndpointsResponse(serviceID)
+}
@@ -0,0 +1,138 @@
+package near
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/buildwithgrove/path/log"
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+const (
+	// errMsgUnmarshaling is the generic message returned to the user if the endpoint returns a malformed response.
+	errMsgUnmarshaling = "the response returned by the endpoint is not a valid JSON-RPC response"
+
+	// errDataFieldRawBytes is the key of the entry in the JSON-RPC error response's "data" map which holds the endpoint's original response.
+	errDataFieldRawBytes = "endpoint_response"
+
+	// errDataFieldUnmarshalingErr is the key of the entry in the JSON-RPC error response's "data" map which holds the unmarshaling error.
+	errDataFieldUnmarshalingErr = "unmarshaling_error"
+)
+
+// status captures the fields of a `status` result used in endpoint validation.
+// Reference: https://docs.near.org/api/rpc/network#node-status
+type status struct {
+	ChainID  string `json:"chain_id"`
+	SyncInfo struct {
+		LatestBlockHeight uint64 `json:"latest_block_height"`
+		Syncing           bool   `json:"syncing"`
+	} `json:"sync_info"`
+}
+
+// unmarshalResponse parses the supplied raw byte slice from an endpoint into a JSON-RPC response,
+// and builds the endpoint observation for the response, including the classification of any NEAR error.
+// A generic JSON-RPC error response is returned if the payload is not a valid JSON-RPC response.
+// Returns true if the payload is a valid JSON-RPC response, i.e. can be returned to the user as-is.
+func unmarshalResponse(
+	logger polylog.Logger,
+	jsonrpcReq jsonrpc.Request,
+	data []byte,
+	endpointAddr protocol.EndpointAddr,
+) (jsonrpc.Response, *qosobservations.NearEndpointObservation, bool) {
+	var jsonrpcResponse jsonrpc.Response
+	err := json.Unmarshal(data, &jsonrpcResponse)
+	if err == nil {
+		err = jsonrpcResponse.Validate(jsonrpcReq.ID)
+	}
+
+	if err != nil {
+		logger.With(
+			"jsonrpc_request_method", jsonrpcReq.Method,
+			"raw_payload", log.Preview(string(data)),
+			"endpoint_addr", endpointAddr,
+		).Debug().Err(err).Msg("Endpoint payload is not a valid JSON-RPC response")
+
+		errResponse := getGenericJSONRPCErrResponse(jsonrpcReq.ID, data, err)
+		return errResponse, &qosobservations.NearEndpointObservation{
+			HttpStatusCode: int32(errResponse.GetRecommendedHTTPStatusCode()),
+			ResponseObservation: &qosobservations.NearEndpointObservation_UnrecognizedResponse{
+				UnrecognizedResponse: &qosobservations.NearUnrecognizedResponse{
+					JsonrpcResponse: errResponse.GetObservation(),
+					ValidationError: newValidationErrorObservation(),
+				},
+			},
+		}, false
+	}
+
+	observation := &qosobservations.NearEndpointObservation{
+		HttpStatusCode: int32(jsonrpcResponse.GetRecommendedHTTPStatusCode()),
+	}
+
+	if jsonrpcResponse.IsError() {
+		if nearError := parseNearError(data); nearError != nil {
+			observation.NearError = nearError
+			observation.HttpStatusCode = int32(getNearErrorHTTPStatusCode(nearError))
+		}
+	}
+
+	switch jsonrpcReq.Method {
+	case methodStatus:
+		observation.ResponseObservation = &qosobservations.NearEndpointObservation_StatusResponse{
+			StatusResponse: getStatusObservation(logger, jsonrpcResponse),
+		}
+
+	default:
+		observation.ResponseObservation = &qosobservations.NearEndpointObservation_UnrecognizedResponse{
+			UnrecognizedResponse: &qosobservations.NearUnrecognizedResponse{
+				JsonrpcResponse: jsonrpcResponse.GetObservation(),
+			},
+		}
+	}
+
+	return jsonrpcResponse, observation, true
+}
+
+// getStatusObservation builds the observation of an endpoint's response to a `status` request.
+// The observation is marked invalid if the endpoint returned an error or an unparsable result.
+func getStatusObservation(logger polylog.Logger, jsonrpcResp jsonrpc.Response) *qosobservations.NearStatusResponse {
+	if jsonrpcResp.IsError() {
+		return &qosobservations.NearStatusResponse{Invalid: true}
+	}
+
+	var nodeStatus status
+	if err := jsonrpcResp.UnmarshalResult(&nodeStatus); err != nil {
+		logger.Debug().Err(err).Msgf("❌ NEAR endpoint will fail QoS check because the %q result failed to parse.", methodStatus)
+		return &qosobservations.NearStatusResponse{Invalid: true}
+	}
+
+	return &qosobservations.NearStatusResponse{
+		ChainId:           nodeStatus.ChainID,
+		LatestBlockHeight: nodeStatus.SyncInfo.LatestBlockHeight,
+		Syncing:           nodeStatus.SyncInfo.Syncing,
+	}
+}
+
+// getGenericJSONRPCErrResponse returns a JSON-RPC error response for an endpoint payload which is not a valid JSON-RPC response.
+// Includes the supplied ID, error, and invalid payload in the "data" field.
+func getGenericJSONRPCErrResponse(id jsonrpc.ID, malformedResponsePayload []byte, err error) jsonrpc.Response {
+	errData := map[string]string{
+		errDataFieldRawBytes:        string(malformedResponsePayload),
+		errDataFieldUnmarshalingErr: err.Error(),
+	}
+
+	// `jsonrpc.ResponseCodeBackendServerErr`, i.e. code -31002, will result in returning a 500 HTTP Status Code to the client.
+	return jsonrpc.GetErrorResponse(id, jsonrpc.ResponseCodeBackendServerErr, errMsgUnmarshaling, errData)
+}
+
+// newValidationErrorObservation returns the observation of an endpoint payload which is not a valid JSON-RPC response.
+func newValidationErrorObservation() *qosobservations.JsonRpcResponseValidationError {
+	return &qosobservations.JsonRpcResponseValidationError{
+		ErrorType: qosobservations.JsonRpcValidationErrorType_JSON_RPC_VALIDATION_ERROR_TYPE_NON_JSONRPC_RESPONSE,
+		Timestamp: timestamppb.New(time.Now()),
+	}
+}
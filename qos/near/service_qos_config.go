@@ -0,0 +1,137 @@
+package near
+
+import (
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// QoSType is the QoS type for the NEAR blockchain.
+const QoSType = "near"
+
+// DefaultSyncAllowance is the default number of blocks an endpoint may be behind the perceived block height.
+// NEAR produces a block roughly every second.
+const DefaultSyncAllowance = 10
+
+// ServiceQoSConfig defines the base interface for service QoS configurations.
+// This avoids circular dependency with the config package.
+type ServiceQoSConfig interface {
+	GetServiceID() protocol.ServiceID
+	GetServiceQoSType() string
+}
+
+// NearServiceQoSConfig is the configuration for the NEAR service QoS.
+type NearServiceQoSConfig interface {
+	ServiceQoSConfig // Using locally defined interface to avoid circular dependency
+	getChainID() string
+	getSyncAllowance() uint64
+	getRequestLimits() jsonrpc.RequestLimits
+}
+
+// NearServiceQoSConfigOption customizes an optional setting of a NEAR service QoS configuration.
+type NearServiceQoSConfigOption func(*nearServiceQoSConfig)
+
+// WithChainID sets the chain ID expected from endpoints' `status` responses, e.g. "mainnet".
+// Used to override the chain ID of an existing config: see OverrideNearServiceQoSConfig.
+func WithChainID(chainID string) NearServiceQoSConfigOption {
+	return func(c *nearServiceQoSConfig) {
+		c.chainID = chainID
+	}
+}
+
+// WithSyncAllowance sets the number of blocks an endpoint may be behind the perceived block height.
+// Defaults to DefaultSyncAllowance if not set.
+func WithSyncAllowance(syncAllowance uint64) NearServiceQoSConfigOption {
+	return func(c *nearServiceQoSConfig) {
+		c.syncAllowance = syncAllowance
+	}
+}
+
+// WithMaxRequestBodyBytes sets the maximum size of a JSONRPC request body, in bytes.
+// Defaults to jsonrpc.DefaultMaxRequestBodyBytes if not set.
+func WithMaxRequestBodyBytes(maxRequestBodyBytes int64) NearServiceQoSConfigOption {
+	return func(c *nearServiceQoSConfig) {
+		c.maxRequestBodyBytes = maxRequestBodyBytes
+	}
+}
+
+// NewNearServiceQoSConfig creates a new NEAR service configuration.
+// The chain ID is the value of the `chain_id` field reported by endpoints' `status` responses, e.g. "mainnet".
+func NewNearServiceQoSConfig(
+	serviceID protocol.ServiceID,
+	chainID string,
+	opts ...NearServiceQoSConfigOption,
+) NearServiceQoSConfig {
+	config := nearServiceQoSConfig{
+		serviceID: serviceID,
+		chainID:   chainID,
+	}
+
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return config
+}
+
+// OverrideNearServiceQoSConfig returns a copy of the supplied config, with the options applied on top of its settings.
+// e.g. used to change a single setting of a compiled-in service config, while keeping all its other settings.
+func OverrideNearServiceQoSConfig(config NearServiceQoSConfig, opts ...NearServiceQoSConfigOption) NearServiceQoSConfig {
+	overridden, ok := config.(nearServiceQoSConfig)
+	if !ok {
+		return config
+	}
+
+	for _, opt := range opts {
+		opt(&overridden)
+	}
+
+	return overridden
+}
+
+// Ensure implementation satisfies interface
+var _ NearServiceQoSConfig = (*nearServiceQoSConfig)(nil)
+
+type nearServiceQoSConfig struct {
+	serviceID protocol.ServiceID
+	chainID   string
+
+	// syncAllowance is the number of blocks an endpoint may be behind the perceived block height.
+	syncAllowance uint64
+
+	// maxRequestBodyBytes is the maximum size of a JSONRPC request body, in bytes.
+	maxRequestBodyBytes int64
+}
+
+// GetServiceID returns the ID of the service.
+// Implements the ServiceQoSConfig interface.
+func (c nearServiceQoSConfig) GetServiceID() protocol.ServiceID {
+	return c.serviceID
+}
+
+// GetServiceQoSType returns the QoS type of the service.
+// Implements the ServiceQoSConfig interface.
+func (nearServiceQoSConfig) GetServiceQoSType() string {
+	return QoSType
+}
+
+// getChainID returns the chain ID expected from endpoints.
+// Implements the NearServiceQoSConfig interface.
+func (c nearServiceQoSConfig) getChainID() string {
+	return c.chainID
+}
+
+// getSyncAllowance returns the sync allowance of the service, with the default applied.
+// Implements the NearServiceQoSConfig interface.
+func (c nearServiceQoSConfig) getSyncAllowance() uint64 {
+	if c.syncAllowance == 0 {
+		return DefaultSyncAllowance
+	}
+	return c.syncAllowance
+}
+
+// getRequestLimits returns the limits on the size of JSONRPC requests, with defaults applied.
+// NEAR does not support JSONRPC batch requests: the batch size limit is never applied.
+// Implements the NearServiceQoSConfig interface.
+func (c nearServiceQoSConfig) getRequestLimits() jsonrpc.RequestLimits {
+	return jsonrpc.NewRequestLimits(0, c.maxRequestBodyBytes)
+}
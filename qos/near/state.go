@@ -0,0 +1,80 @@
+package near
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
+	"github.com/buildwithgrove/path/protocol"
+)
+
+// errBlockHeightLagging is returned for endpoints behind the perceived block height by more than the sync allowance.
+var errBlockHeightLagging = errors.New("endpoint block height is behind the perceived block height")
+
+// ServiceState keeps the expected current state of the NEAR blockchain
+// based on the endpoints' responses to `status` requests.
+type ServiceState struct {
+	logger polylog.Logger
+
+	// syncAllowance is the number of blocks an endpoint may be behind the perceived block height.
+	syncAllowance uint64
+
+	serviceStateLock sync.RWMutex
+	// perceivedBlockHeight is the highest `sync_info.latest_block_height` reported by any valid endpoint.
+	perceivedBlockHeight uint64
+
+	// chainID and serviceID to add to endpoint checks.
+	// Used by observations of Synthetic requests.
+	// chainID is also the chain ID endpoints are expected to report, e.g. "mainnet".
+	chainID   string
+	serviceID protocol.ServiceID
+}
+
+// TODO_FUTURE: add an endpoint ranking method which can be used to assign a rank/score to a valid endpoint to guide endpoint selection.
+//
+// ValidateEndpoint returns an error if the supplied endpoint is not valid based on the perceived state of the NEAR blockchain.
+func (s *ServiceState) ValidateEndpoint(endpoint endpoint) error {
+	s.serviceStateLock.RLock()
+	defer s.serviceStateLock.RUnlock()
+
+	if err := endpoint.validateBasic(s.chainID); err != nil {
+		return err
+	}
+
+	if blockHeight := endpoint.statusResponse.GetLatestBlockHeight(); blockHeight+s.syncAllowance < s.perceivedBlockHeight {
+		return fmt.Errorf("%w: %d is more than %d blocks behind %d",
+			errBlockHeightLagging, blockHeight, s.syncAllowance, s.perceivedBlockHeight)
+	}
+
+	return nil
+}
+
+// UpdateFromEndpoints updates the service state using estimation(s) derived from the set of updated endpoints.
+// NOTE: This only includes the set of endpoints for which an observation was received.
+func (s *ServiceState) UpdateFromEndpoints(updatedEndpoints map[protocol.EndpointAddr]endpoint) {
+	s.serviceStateLock.Lock()
+	defer s.serviceStateLock.Unlock()
+
+	for endpointAddr, endpoint := range updatedEndpoints {
+		if err := endpoint.validateBasic(s.chainID); err != nil {
+			continue
+		}
+
+		// TODO_TECHDEBT: use a more resilient method for updating block height.
+		// e.g. one endpoint returning a very large number as block height should
+		// not result in all other endpoints being marked as invalid.
+		blockHeight := endpoint.statusResponse.GetLatestBlockHeight()
+		if blockHeight <= s.perceivedBlockHeight {
+			continue
+		}
+
+		s.perceivedBlockHeight = blockHeight
+
+		s.logger.With(
+			"endpoint", endpointAddr,
+			"block_height", s.perceivedBlockHeight,
+		).Debug().Msg("Updating latest block height")
+	}
+}
@@ -0,0 +1,103 @@
+package near
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+)
+
+func TestServiceState_ValidateEndpoint(t *testing.T) {
+	status := &qosobservations.NearStatusResponse{ChainId: "mainnet", LatestBlockHeight: 1000}
+	endpointError := func(age time.Duration) *qosobservations.NearError {
+		return &qosobservations.NearError{
+			Name:      errNameHandler,
+			CauseName: "NOT_SYNCED_YET",
+			Kind:      qosobservations.NearErrorKind_NEAR_ERROR_KIND_ENDPOINT,
+			Timestamp: timestamppb.New(time.Now().Add(-age)),
+		}
+	}
+
+	tests := []struct {
+		name        string
+		endpoint    endpoint
+		expectedErr error
+	}{
+		{
+			name:     "valid endpoint within the sync allowance of the perceived block height",
+			endpoint: endpoint{statusResponse: &qosobservations.NearStatusResponse{ChainId: "mainnet", LatestBlockHeight: 990}},
+		},
+		{
+			name:        "endpoint lagging beyond the sync allowance",
+			endpoint:    endpoint{statusResponse: &qosobservations.NearStatusResponse{ChainId: "mainnet", LatestBlockHeight: 989}},
+			expectedErr: errBlockHeightLagging,
+		},
+		{
+			name:        "endpoint on a different chain",
+			endpoint:    endpoint{statusResponse: &qosobservations.NearStatusResponse{ChainId: "testnet", LatestBlockHeight: 1000}},
+			expectedErr: errInvalidChainIDObs,
+		},
+		{
+			name:        "syncing endpoint",
+			endpoint:    endpoint{statusResponse: &qosobservations.NearStatusResponse{ChainId: "mainnet", LatestBlockHeight: 1000, Syncing: true}},
+			expectedErr: errSyncingObs,
+		},
+		{
+			name:        "endpoint with a recent NEAR error attributed to the endpoint",
+			endpoint:    endpoint{statusResponse: status, latestEndpointError: endpointError(time.Minute)},
+			expectedErr: errRecentEndpointError,
+		},
+		{
+			name:     "endpoint with an expired NEAR error attributed to the endpoint",
+			endpoint: endpoint{statusResponse: status, latestEndpointError: endpointError(2 * endpointErrorWindow)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &ServiceState{
+				chainID:              "mainnet",
+				syncAllowance:        10,
+				perceivedBlockHeight: 1000,
+			}
+
+			err := state.ValidateEndpoint(tt.endpoint)
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestEndpoint_applyObservation_NearErrorKind(t *testing.T) {
+	tests := []struct {
+		name                     string
+		kind                     qosobservations.NearErrorKind
+		expectRecentEndpointErrs bool
+	}{
+		{
+			name: "NEAR errors attributed to the user do not affect the endpoint",
+			kind: qosobservations.NearErrorKind_NEAR_ERROR_KIND_USER,
+		},
+		{
+			name:                     "NEAR errors attributed to the endpoint are recorded",
+			kind:                     qosobservations.NearErrorKind_NEAR_ERROR_KIND_ENDPOINT,
+			expectRecentEndpointErrs: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var e endpoint
+			e.applyObservation(&qosobservations.NearEndpointObservation{
+				NearError: &qosobservations.NearError{Kind: tt.kind, Timestamp: timestamppb.Now()},
+			})
+			require.Equal(t, tt.expectRecentEndpointErrs, e.hasRecentEndpointErrors())
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package near
+
+import (
+	"errors"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
+	"github.com/buildwithgrove/path/metrics/devtools"
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos"
+)
+
+// EndpointStore provides the endpoint selection capability required
+// by the protocol package for handling a service request.
+var _ protocol.EndpointSelector = &EndpointStore{}
+
+// EndpointStore holds the latest `status` response, and the latest NEAR error attributed to the endpoint, of each endpoint of a NEAR service.
+// Observations are applied through UpdateEndpointsFromObservations: see observe.go.
+type EndpointStore struct {
+	*qos.EndpointStore[endpoint]
+
+	logger polylog.Logger
+
+	serviceState *ServiceState
+}
+
+// newEndpointStore returns an empty endpoint store, validating endpoints against the NEAR chain ID and perceived block height.
+func newEndpointStore(logger polylog.Logger, serviceState *ServiceState) *EndpointStore {
+	return &EndpointStore{
+		EndpointStore: qos.NewEndpointStore(logger, serviceState.ValidateEndpoint),
+		logger:        logger,
+		serviceState:  serviceState,
+	}
+}
+
+// getDisqualifiedEndpointsResponse returns the endpoints failing the NEAR status checks, or with a recent endpoint error, for a devtools.DisqualifiedEndpointResponse.
+func (es *EndpointStore) getDisqualifiedEndpointsResponse(serviceID protocol.ServiceID) devtools.QoSLevelDataResponse {
+	return es.GetDisqualifiedEndpointsResponse(serviceID, countDisqualifiedEndpoint)
+}
+
+// countDisqualifiedEndpoint counts a NEAR endpoint's validation error towards the matching devtools counter.
+// Recent NEAR errors attributed to the endpoint, e.g. `NOT_SYNCED_YET`, count as empty responses.
+func countDisqualifiedEndpoint(response *devtools.QoSLevelDataResponse, err error) bool {
+	switch {
+	// Endpoint is disqualified due to a missing or invalid response, or a recent error attributed to the endpoint.
+	case errors.Is(err, errNoStatusObs),
+		errors.Is(err, errInvalidStatusObs),
+		errors.Is(err, qos.ErrRecentJSONRPCValidationError),
+		errors.Is(err, errRecentEndpointError):
+		response.EmptyResponseCount++
+		return true
+
+	// Endpoint is disqualified due to being on a different chain.
+	case errors.Is(err, errInvalidChainIDObs):
+		response.ChainIDCheckErrorsCount++
+		return true
+
+	// Endpoint is disqualified due to syncing or lagging the perceived block height.
+	case errors.Is(err, errSyncingObs),
+		errors.Is(err, errBlockHeightLagging):
+		response.BlockNumberCheckErrorsCount++
+		return true
+
+	default:
+		return false
+	}
+}
@@ -38,3 +38,15 @@ func GetRequestErrorForJSONRPCBackendServiceUnmarshalError() *qosobservations.Re
 		HttpStatusCode: int32(jsonrpcErrorResponse.GetRecommendedHTTPStatusCode()),
 	}
 }
+
+// GetRequestErrorForConsensusNotReached returns a request error for a consensus-read whose endpoint responses did not reach the quorum.
+func GetRequestErrorForConsensusNotReached(consensusErr error) *qosobservations.RequestError {
+	// initialize a JSONRPC error response to derive the HTTP status code.
+	jsonrpcErrorResponse := jsonrpc.NewErrResponseInternalErr(jsonrpc.ID{}, consensusErr)
+
+	return &qosobservations.RequestError{
+		ErrorKind:      qosobservations.RequestErrorKind_REQUEST_ERROR_INTERNAL_CONSENSUS_NOT_REACHED,
+		ErrorDetails:   consensusErr.Error(),
+		HttpStatusCode: int32(jsonrpcErrorResponse.GetRecommendedHTTPStatusCode()),
+	}
+}
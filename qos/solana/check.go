@@ -1,6 +1,8 @@
 package solana
 
 import (
+	"encoding/json"
+
 	"github.com/pokt-network/poktroll/pkg/polylog"
 
 	"github.com/buildwithgrove/path/gateway"
@@ -16,8 +18,18 @@ const (
 	idGetHealth = 1000 + iota
 	idGetEpochInfo
 	idGetBlock
+	idGetSlotProcessed
+	idGetSlotConfirmed
+	idGetSlotFinalized
 )
 
+// idGetSlotByCommitment maps the checked commitment levels to the ID of their `getSlot` check.
+var idGetSlotByCommitment = map[string]int{
+	commitmentProcessed: idGetSlotProcessed,
+	commitmentConfirmed: idGetSlotConfirmed,
+	commitmentFinalized: idGetSlotFinalized,
+}
+
 // EndpointStore provides the endpoint check generator required by
 // the gateway package to augment endpoints' quality data,
 // using synthetic service requests.
@@ -31,12 +43,28 @@ func (es *EndpointStore) CheckWebsocketConnection() bool {
 
 // TODO_IMPROVE(@commoddity): implement QoS check expiry functionality and use protocol.EndpointAddr
 // to filter out checks for any endpoint which has acurrently valid QoS data point.
-func (es *EndpointStore) GetRequiredQualityChecks(_ protocol.EndpointAddr) []gateway.RequestQoSContext {
-	return []gateway.RequestQoSContext{
+//
+// GetRequiredQualityChecks returns the `getHealth`, `getEpochInfo` and per-commitment `getSlot` checks,
+// and the archival `getBlock` check if it is enabled and due for the endpoint.
+func (es *EndpointStore) GetRequiredQualityChecks(endpointAddr protocol.EndpointAddr) []gateway.RequestQoSContext {
+	checks := []gateway.RequestQoSContext{
 		getEndpointCheck(es.logger, es, withGetHealth),
 		getEndpointCheck(es.logger, es, withGetEpochInfo),
-		// TODO_MVP(@adshmh): Add a check for a `getBlock` request
 	}
+
+	for _, commitment := range checkedCommitments {
+		checks = append(checks, getEndpointCheck(es.logger, es, withGetSlot(commitment)))
+	}
+
+	es.endpointsMu.RLock()
+	endpoint := es.endpoints[endpointAddr]
+	es.endpointsMu.RUnlock()
+
+	if es.serviceState.shouldArchivalCheckRun(endpoint.checkArchival) {
+		checks = append(checks, getEndpointCheck(es.logger, es, withGetBlock(es.serviceState.getArchivalSlot())))
+	}
+
+	return checks
 }
 
 // getEndpointCheck prepares a request context for a specific endpoint check.
@@ -73,6 +101,40 @@ func withGetEpochInfo(requestCtx *requestContext) {
 	requestCtx.JSONRPCReq = buildJSONRPCReq(idGetEpochInfo, methodGetEpochInfo)
 }
 
+// withGetSlot returns an option which updates the request context to make a Solana JSON-RPC getSlot request at the supplied commitment level.
+func withGetSlot(commitment string) func(*requestContext) {
+	return func(requestCtx *requestContext) {
+		requestCtx.JSONRPCReq = buildJSONRPCReq(idGetSlotByCommitment[commitment], methodGetSlot)
+
+		paramsBz, err := json.Marshal([1]map[string]string{{"commitment": commitment}})
+		if err != nil {
+			requestCtx.logger.Error().Err(err).Msg("SHOULD NEVER HAPPEN: failed to marshal the getSlot check params.")
+			return
+		}
+		requestCtx.JSONRPCReq.SetParams(paramsBz)
+	}
+}
+
+// withGetBlock returns an option which updates the request context to make a Solana JSON-RPC getBlock request for the supplied slot.
+// Transaction details and rewards are excluded: only the availability of the block is checked.
+func withGetBlock(slot uint64) func(*requestContext) {
+	return func(requestCtx *requestContext) {
+		requestCtx.JSONRPCReq = buildJSONRPCReq(idGetBlock, methodGetBlock)
+
+		params, err := jsonrpc.BuildParamsFromUint64AndObject(slot, map[string]any{
+			"encoding":                       "json",
+			"transactionDetails":             "none",
+			"rewards":                        false,
+			"maxSupportedTransactionVersion": 0,
+		})
+		if err != nil {
+			requestCtx.logger.Error().Err(err).Msg("SHOULD NEVER HAPPEN: failed to build the getBlock check params.")
+			return
+		}
+		requestCtx.JSONRPCReq.Params = params
+	}
+}
+
 func buildJSONRPCReq(id int, method jsonrpc.Method) jsonrpc.Request {
 	return jsonrpc.Request{
 		JSONRPC: jsonrpc.Version2,
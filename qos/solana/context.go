@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/pokt-network/poktroll/pkg/polylog"
 	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
@@ -20,6 +21,15 @@ import (
 // package for handling service requests.
 var _ gateway.RequestQoSContext = &requestContext{}
 
+// requestContext supports broadcasting transaction submissions to multiple endpoints.
+var _ gateway.BroadcastQoSContext = &requestContext{}
+
+// requestContext supports sharing the relay of identical in-flight requests.
+var _ gateway.CoalescableQoSContext = &requestContext{}
+
+// requestContext supports per-method relay timeouts.
+var _ gateway.RelayTimeoutQoSContext = &requestContext{}
+
 // TODO_TECHDEBT: Need a Validate() method here to allow
 // the caller, e.g. gateway, determine whether the endpoint's
 // response was valid, and whether a retry makes sense.
@@ -63,6 +73,16 @@ type requestContext struct {
 	// - QoS: requests built by the QoS service to get additional data points on endpoints.
 	requestOrigin qosobservations.RequestOrigin
 
+	// relayTimeout is the timeout of the request's relays, based on the service's per-method timeouts.
+	// The gateway's default relay timeout is used if set to 0.
+	relayTimeout time.Duration
+
+	// txBroadcastNumEndpoints is the number of endpoints the request is broadcast to, if it is a transaction submission.
+	txBroadcastNumEndpoints uint
+
+	// coalescedMethods is the allowlist of JSON-RPC methods eligible for request coalescing.
+	coalescedMethods map[string]struct{}
+
 	// endpointResponses is the set of responses received from one or
 	// more endpoints as part of handling this service request.
 	// NOTE: these are all related to a single JSONRPC request,
@@ -92,6 +112,12 @@ func (rc requestContext) GetServicePayloads() []protocol.Payload {
 	return []protocol.Payload{payload}
 }
 
+// GetRelayTimeout returns the timeout of the request's relays: 0 if the gateway's default should be used.
+// Implements the gateway.RelayTimeoutQoSContext interface.
+func (rc *requestContext) GetRelayTimeout() time.Duration {
+	return rc.relayTimeout
+}
+
 // UpdateWithResponse is NOT safe for concurrent use
 func (rc *requestContext) UpdateWithResponse(endpointAddr protocol.EndpointAddr, responseBz []byte) {
 	// TODO_IMPROVE: check whether the request was valid, and return an error if it was not.
@@ -120,9 +146,16 @@ func (rc requestContext) GetHTTPResponse() pathhttp.HTTPResponse {
 	if len(rc.endpointResponses) == 0 {
 		// Build the JSONRPC response indicating a protocol-level error.
 		jsonrpcErrorResponse := jsonrpc.NewErrResponseInternalErr(rc.JSONRPCReq.ID, errors.New("protocol-level error: no endpoint responses received"))
+		// Let the user know if the service is stalled, e.g. a chain halt.
+		jsonrpcErrorResponse = rc.endpointStore.serviceState.stallTracker.AddToErrorResponse(jsonrpcErrorResponse)
 		return qos.BuildHTTPResponseFromJSONRPCResponse(rc.logger, jsonrpcErrorResponse)
 	}
 
+	// Transaction broadcast to multiple endpoints: return the response of an endpoint which accepted the transaction.
+	if rc.isTxBroadcast() {
+		return qos.BuildHTTPResponseFromJSONRPCResponse(rc.logger, rc.getBroadcastJSONRPCResponse())
+	}
+
 	// Use the most recent endpoint response.
 	// As of PR #253 there is no retry, meaning there is at most 1 endpoint response.
 	selectedResponse := rc.endpointResponses[len(rc.endpointResponses)-1].GetJSONRPCResponse()
@@ -139,6 +172,7 @@ func (rc requestContext) GetObservations() qosobservations.Observations {
 		RequestPayloadLength: uint32(rc.requestPayloadLength),
 		RequestOrigin:        rc.requestOrigin,
 		JsonrpcRequest:       rc.JSONRPCReq.GetObservation(),
+		ServiceStall:         rc.endpointStore.serviceState.stallTracker.GetObservation(),
 	}
 
 	// No endpoint responses received.
@@ -183,12 +217,12 @@ func (rc *requestContext) GetEndpointSelector() protocol.EndpointSelector {
 // It uses the perceived state of the Solana chain using other endpoints' responses.
 // It is required to satisfy the protocol package's EndpointSelector interface.
 func (rc *requestContext) Select(allEndpoints protocol.EndpointAddrList) (protocol.EndpointAddr, error) {
-	return rc.endpointStore.Select(allEndpoints)
+	return rc.endpointStore.Select(rc.endpointStore.filterEndpointsForRequests(rc.logger, allEndpoints, rc.JSONRPCReq))
 }
 
 // SelectMultiple chooses multiple endpoints from the list of supplied endpoints.
 // It uses the perceived state of the Solana chain using other endpoints' responses.
 // It is required to satisfy the protocol package's EndpointSelector interface.
 func (rc *requestContext) SelectMultiple(allEndpoints protocol.EndpointAddrList, numEndpoints uint) (protocol.EndpointAddrList, error) {
-	return rc.endpointStore.SelectMultiple(allEndpoints, numEndpoints)
+	return rc.endpointStore.SelectMultiple(rc.endpointStore.filterEndpointsForRequests(rc.logger, allEndpoints, rc.JSONRPCReq), numEndpoints)
 }
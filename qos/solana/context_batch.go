@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/pokt-network/poktroll/pkg/polylog"
 	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
@@ -20,6 +21,12 @@ import (
 // package for handling service requests.
 var _ gateway.RequestQoSContext = &batchJSONRPCRequestContext{}
 
+// batchJSONRPCRequestContext supports distributing the members of the batch across multiple endpoints.
+var _ gateway.BatchQoSContext = &batchJSONRPCRequestContext{}
+
+// batchJSONRPCRequestContext supports per-method relay timeouts.
+var _ gateway.RelayTimeoutQoSContext = &batchJSONRPCRequestContext{}
+
 type endpointJSONRPCResponse struct {
 	protocol.EndpointAddr
 	jsonrpc.Response
@@ -45,24 +52,75 @@ type batchJSONRPCRequestContext struct {
 
 	JSONRPCBatchRequest jsonrpc.BatchRequest
 
+	// batchNumEndpoints is the maximum number of endpoints the members of the batch are distributed across.
+	batchNumEndpoints uint
+
+	// servicePayloads holds the service payloads of the batch's requests.
+	// Aligned with the requests of JSONRPCBatchRequest.
+	servicePayloads []protocol.Payload
+
 	// The origin of the request handled by the context.
 	// Either:
 	// - User: user requests
 	// - QoS: requests built by the QoS service to get additional data points on endpoints.
 	requestOrigin qosobservations.RequestOrigin
 
+	// relayTimeout is the longest relay timeout of the batch's methods, based on the service's per-method timeouts.
+	// The gateway's default relay timeout is used if set to 0.
+	relayTimeout time.Duration
+
 	// endpointResponses is the set of responses received from one or
 	// more endpoints as part of handling this service request.
 	endpointJSONRPCResponses []endpointJSONRPCResponse
 }
 
-// TODO_NEXT(@commoddity): handle batch requests for Solana
-// TODO_MVP(@adshmh): Ensure the JSONRPC request struct
-// can handle all valid service requests.
+// GetServicePayloads returns the service payloads of the batch's requests, in request order.
+// Implements the gateway.RequestQoSContext interface.
 func (brc batchJSONRPCRequestContext) GetServicePayloads() []protocol.Payload {
-	protocolPayloads := make([]protocol.Payload, len(brc.JSONRPCBatchRequest.Requests))
+	return brc.servicePayloads
+}
+
+// GetRelayTimeout returns the timeout of the batch's relays: 0 if the gateway's default should be used.
+// Implements the gateway.RelayTimeoutQoSContext interface.
+func (brc *batchJSONRPCRequestContext) GetRelayTimeout() time.Duration {
+	return brc.relayTimeout
+}
+
+// GetBatchNumEndpoints returns the number of endpoints the members of the batch should be distributed across.
+// Implements the gateway.BatchQoSContext interface.
+func (brc *batchJSONRPCRequestContext) GetBatchNumEndpoints() uint {
+	return qos.GetBatchRequestNumEndpoints(len(brc.JSONRPCBatchRequest.Requests), brc.batchNumEndpoints)
+}
+
+// GetFailedServicePayloads returns the service payloads of the batch members with no successful response, e.g. due to an endpoint timing out.
+// Implements the gateway.BatchQoSContext interface.
+func (brc *batchJSONRPCRequestContext) GetFailedServicePayloads() []protocol.Payload {
+	jsonrpcResponses := make([]jsonrpc.Response, len(brc.endpointJSONRPCResponses))
+	for i, endpointResponse := range brc.endpointJSONRPCResponses {
+		jsonrpcResponses[i] = endpointResponse.Response
+	}
+
+	var failedPayloads []protocol.Payload
+	for _, failedReq := range brc.JSONRPCBatchRequest.GetFailedRequests(jsonrpcResponses) {
+		for i, req := range brc.JSONRPCBatchRequest.Requests {
+			if req.ID.Equal(failedReq.ID) {
+				failedPayloads = append(failedPayloads, brc.servicePayloads[i])
+				break
+			}
+		}
+	}
+	return failedPayloads
+}
+
+// buildBatchServicePayloads returns the service payloads of the batch's requests, in request order.
+func buildBatchServicePayloads(jsonrpcBatchRequest jsonrpc.BatchRequest) ([]protocol.Payload, error) {
+	jsonrpcRequestPayloads, err := jsonrpcBatchRequest.GetRequestsPayloads()
+	if err != nil {
+		return nil, err
+	}
 
-	for i, jsonrpcRequestPayload := range brc.JSONRPCBatchRequest.GetRequestsPayloads() {
+	protocolPayloads := make([]protocol.Payload, len(jsonrpcRequestPayloads))
+	for i, jsonrpcRequestPayload := range jsonrpcRequestPayloads {
 		// TODO_TECHDEBT(@adshmh): Set method-specific timeouts on protocol payload entry.
 		protocolPayloads[i] = protocol.Payload{
 			Data:    string(jsonrpcRequestPayload),
@@ -72,7 +130,7 @@ func (brc batchJSONRPCRequestContext) GetServicePayloads() []protocol.Payload {
 		}
 	}
 
-	return protocolPayloads
+	return protocolPayloads, nil
 }
 
 // TODO_TECHDEBT(@adshmh): Refactor once the QoS context interface is updated to receive an array of responses.
@@ -109,6 +167,8 @@ func (brc batchJSONRPCRequestContext) GetHTTPResponse() pathhttp.HTTPResponse {
 	if len(brc.endpointJSONRPCResponses) == 0 {
 		// Build the JSONRPC response indicating a protocol-level error.
 		jsonrpcErrorResponse := jsonrpc.NewErrResponseInternalErr(jsonrpc.ID{}, errors.New("protocol-level error: no endpoint responses received"))
+		// Let the user know if the service is stalled, e.g. a chain halt.
+		jsonrpcErrorResponse = brc.endpointStore.serviceState.stallTracker.AddToErrorResponse(jsonrpcErrorResponse)
 		return qos.BuildHTTPResponseFromJSONRPCResponse(brc.logger, jsonrpcErrorResponse)
 	}
 
@@ -118,7 +178,8 @@ func (brc batchJSONRPCRequestContext) GetHTTPResponse() pathhttp.HTTPResponse {
 		jsonrpcResponses[i] = jsonrpcResponse.Response
 	}
 
-	// Use the Batch JSONRPC request to assemble the JSONRPC batch response.
+	// Use the Batch JSONRPC request to assemble the JSONRPC batch response, in request order.
+	// If a batch member was retried on a different endpoint, its successful response is preferred.
 	batchResponseBz := brc.JSONRPCBatchRequest.BuildResponseBytes(jsonrpcResponses)
 
 	// TODO_UPNEXT(@adshmh): Adjust HTTP status code according to responses in the batch.
@@ -139,6 +200,7 @@ func (rc batchJSONRPCRequestContext) GetObservations() qosobservations.Observati
 		ServiceId:            string(rc.serviceID),
 		RequestPayloadLength: uint32(rc.requestPayloadLength),
 		RequestOrigin:        rc.requestOrigin,
+		ServiceStall:         rc.endpointStore.serviceState.stallTracker.GetObservation(),
 		// TODO_UPNEXT(@adshmh): Add a Batch JSONRPC request observation.
 	}
 
@@ -177,12 +239,12 @@ func (rc *batchJSONRPCRequestContext) GetEndpointSelector() protocol.EndpointSel
 // It uses the perceived state of the Solana chain using other endpoints' responses.
 // It is required to satisfy the protocol package's EndpointSelector interface.
 func (rc *batchJSONRPCRequestContext) Select(allEndpoints protocol.EndpointAddrList) (protocol.EndpointAddr, error) {
-	return rc.endpointStore.Select(allEndpoints)
+	return rc.endpointStore.Select(rc.endpointStore.filterEndpointsForRequests(rc.logger, allEndpoints, rc.JSONRPCBatchRequest.Requests...))
 }
 
 // SelectMultiple chooses multiple endpoints from the list of supplied endpoints.
 // It uses the perceived state of the Solana chain using other endpoints' responses.
 // It is required to satisfy the protocol package's EndpointSelector interface.
 func (rc *batchJSONRPCRequestContext) SelectMultiple(allEndpoints protocol.EndpointAddrList, numEndpoints uint) (protocol.EndpointAddrList, error) {
-	return rc.endpointStore.SelectMultiple(allEndpoints, numEndpoints)
+	return rc.endpointStore.SelectMultiple(rc.endpointStore.filterEndpointsForRequests(rc.logger, allEndpoints, rc.JSONRPCBatchRequest.Requests...), numEndpoints)
 }
@@ -0,0 +1,56 @@
+package solana
+
+import (
+	"github.com/buildwithgrove/path/qos"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// isTxBroadcast returns true if the request is a transaction submission.
+// Transaction submissions are broadcast to multiple endpoints, to maximize the chances of the transaction reaching the leader.
+func (rc *requestContext) isTxBroadcast() bool {
+	return rc.JSONRPCReq.Method == methodSendTransaction
+}
+
+// GetBroadcastNumEndpoints returns the number of endpoints the request should be broadcast to.
+// Returns 0 for requests which are not transaction submissions.
+// Implements the gateway.BroadcastQoSContext interface.
+func (rc *requestContext) GetBroadcastNumEndpoints() uint {
+	if !rc.isTxBroadcast() {
+		return 0
+	}
+	return rc.txBroadcastNumEndpoints
+}
+
+// HasSuccessfulBroadcastResponse returns true if any endpoint accepted the transaction.
+// Implements the gateway.BroadcastQoSContext interface.
+func (rc *requestContext) HasSuccessfulBroadcastResponse() bool {
+	for _, endpointResponse := range rc.endpointResponses {
+		if qos.IsSuccessfulTxBroadcastJSONRPCResponse(endpointResponse.GetJSONRPCResponse()) {
+			return true
+		}
+	}
+	return false
+}
+
+// getBroadcastJSONRPCResponse returns the user response for a transaction broadcast to multiple endpoints.
+// Responses are used in the following order of preference:
+//  1. A response containing the transaction signature.
+//  2. An error indicating the transaction was already processed.
+//  3. The most recent response, consistent with non-broadcast requests.
+func (rc *requestContext) getBroadcastJSONRPCResponse() jsonrpc.Response {
+	for _, endpointResponse := range rc.endpointResponses {
+		response := endpointResponse.GetJSONRPCResponse()
+		if response.Error == nil && response.Result != nil {
+			return response
+		}
+	}
+
+	for _, endpointResponse := range rc.endpointResponses {
+		response := endpointResponse.GetJSONRPCResponse()
+		if qos.IsSuccessfulTxBroadcastJSONRPCResponse(response) {
+			return response
+		}
+	}
+
+	return rc.endpointResponses[len(rc.endpointResponses)-1].GetJSONRPCResponse()
+}
@@ -0,0 +1,37 @@
+package solana
+
+import (
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos"
+)
+
+// defaultCoalescedMethods are the JSON-RPC methods eligible for request coalescing, unless configured otherwise.
+// Only read-only methods, whose results are identical for concurrent requests, are included.
+var defaultCoalescedMethods = map[string]struct{}{
+	"getLatestBlockhash":       {},
+	"getSlot":                  {},
+	"getBlockHeight":           {},
+	string(methodGetEpochInfo): {},
+	string(methodGetHealth):    {},
+}
+
+// GetCoalescingKey returns the key identifying identical requests.
+// Returns false if the request's method is not eligible for request coalescing.
+// Implements the gateway.CoalescableQoSContext interface.
+func (rc *requestContext) GetCoalescingKey() (string, bool) {
+	return qos.GetJSONRPCCoalescingKey(rc.JSONRPCReq, rc.coalescedMethods)
+}
+
+// UpdateWithCoalescedResponse reports the response to an identical request, with its JSON-RPC ID rewritten to match this request.
+// Implements the gateway.CoalescableQoSContext interface.
+func (rc *requestContext) UpdateWithCoalescedResponse(endpointAddr protocol.EndpointAddr, responseBz []byte) {
+	rewrittenResponseBz, err := qos.RewriteJSONRPCResponseID(responseBz, rc.JSONRPCReq.ID)
+	if err != nil {
+		// Report the response as-is: the QoS context handles invalid endpoint responses.
+		rc.logger.Warn().Err(err).Msg("Failed to rewrite the JSON-RPC ID of a coalesced response.")
+		rc.UpdateWithResponse(endpointAddr, responseBz)
+		return
+	}
+
+	rc.UpdateWithResponse(endpointAddr, rewrittenResponseBz)
+}
@@ -2,6 +2,7 @@ package solana
 
 import (
 	"fmt"
+	"maps"
 	"time"
 
 	qosobservations "github.com/buildwithgrove/path/observation/qos"
@@ -30,6 +31,7 @@ var (
 	errNoGetEpochInfoObs                = fmt.Errorf("endpoint has not had an observation of its response to a %q request", methodGetEpochInfo)
 	errInvalidGetEpochInfoHeightZeroObs = fmt.Errorf("endpoint responded with blockHeight of 0 to a %q request, expected a blockHeight of > 0", methodGetEpochInfo)
 	errInvalidGetEpochInfoEpochZeroObs  = fmt.Errorf("endpoint responded with epoch of 0 to a %q request, expected an epoch of > 0", methodGetEpochInfo)
+	errNoGetSlotObs                     = fmt.Errorf("endpoint has not had a valid observation of its response to a %q request", methodGetSlot)
 	errRecentJSONRPCValidationError     = fmt.Errorf("endpoint has recent JSON-RPC validation errors")
 )
 
@@ -43,15 +45,22 @@ type endpoint struct {
 	// Pointer distinguishes between no observation vs. observed response scenarios.
 	*qosobservations.SolanaGetEpochInfoResponse
 
+	// slots stores the slots returned by the endpoint in response to `getSlot` requests, by commitment level.
+	// The map is copied on write: endpoint values are shared between the endpoint store and the service state.
+	slots map[string]uint64
+
+	// checkArchival stores the result of the endpoint's response to a `getBlock` request for the archival slot.
+	checkArchival endpointCheckArchival
+
 	// latestJSONRPCValidationError tracks most recent JSON-RPC response validation error
 	latestJSONRPCValidationError *qosobservations.JsonRpcResponseValidationError
-
-	// TODO_FUTURE: support archival endpoints.
 }
 
 // validateBasic checks if endpoint has required observations to be valid.
 // Returns error if necessary responses are lacking, invalid, or have recent validation errors.
-func (e endpoint) validateBasic() error {
+//
+// An endpoint reporting itself as unhealthy is still valid if it is behind by no more than the slot lag tolerance.
+func (e endpoint) validateBasic(slotLagTolerance uint64) error {
 	// Check for recent validation errors first
 	if e.hasRecentValidationErrors() {
 		return errRecentJSONRPCValidationError
@@ -61,7 +70,7 @@ func (e endpoint) validateBasic() error {
 	case e.SolanaGetHealthResponse == nil:
 		return errNoGetHealthObs
 
-	case e.Result != resultGetHealthOK:
+	case e.Result != resultGetHealthOK && !e.isBehindWithinTolerance(slotLagTolerance):
 		return fmt.Errorf("❌Invalid solana health response: %s :%w", e.Result, errInvalidGetHealthObs)
 
 	case e.SolanaGetEpochInfoResponse == nil:
@@ -78,6 +87,31 @@ func (e endpoint) validateBasic() error {
 	}
 }
 
+// isBehindWithinTolerance returns true if the endpoint's unhealthy `getHealth` response
+// reported it is behind by no more than the supplied number of slots.
+func (e endpoint) isBehindWithinTolerance(slotLagTolerance uint64) bool {
+	return e.SolanaGetHealthResponse.NumSlotsBehind != nil && *e.SolanaGetHealthResponse.NumSlotsBehind <= slotLagTolerance
+}
+
+// getNumSlotsBehind returns the number of slots the endpoint reported being behind in its `getHealth` response.
+// Returns 0 for endpoints reporting themselves as healthy.
+func (e endpoint) getNumSlotsBehind() uint64 {
+	if e.SolanaGetHealthResponse == nil || e.Result == resultGetHealthOK || e.SolanaGetHealthResponse.NumSlotsBehind == nil {
+		return 0
+	}
+	return *e.SolanaGetHealthResponse.NumSlotsBehind
+}
+
+// getSlot returns the slot returned by the endpoint at the supplied commitment level.
+// Returns an error if the endpoint has not had a valid observation of its response to a `getSlot` request at the commitment level.
+func (e endpoint) getSlot(commitment string) (uint64, error) {
+	slot := e.slots[commitment]
+	if slot == 0 {
+		return 0, fmt.Errorf("%w at the %q commitment level", errNoGetSlotObs, commitment)
+	}
+	return slot, nil
+}
+
 // hasRecentValidationErrors checks if endpoint has validation error within the configured window.
 func (e endpoint) hasRecentValidationErrors() bool {
 	if e.latestJSONRPCValidationError == nil {
@@ -89,9 +123,10 @@ func (e endpoint) hasRecentValidationErrors() bool {
 }
 
 // applyObservation updates endpoint data using provided observation.
+// Responses to `getBlock` requests are only applied if they are for the supplied archival slot.
 // Returns true if observation was recognized.
 // IMPORTANT: This function mutates the endpoint.
-func (e *endpoint) applyObservation(obs *qosobservations.SolanaEndpointObservation) bool {
+func (e *endpoint) applyObservation(obs *qosobservations.SolanaEndpointObservation, archivalSlot uint64) bool {
 	if epochInfoResponse := obs.GetGetEpochInfoResponse(); epochInfoResponse != nil {
 		e.SolanaGetEpochInfoResponse = epochInfoResponse
 		return true
@@ -102,6 +137,28 @@ func (e *endpoint) applyObservation(obs *qosobservations.SolanaEndpointObservati
 		return true
 	}
 
+	if getSlotResponse := obs.GetGetSlotResponse(); getSlotResponse != nil {
+		slots := maps.Clone(e.slots)
+		if slots == nil {
+			slots = make(map[string]uint64)
+		}
+		slots[getSlotResponse.GetCommitment()] = getSlotResponse.GetSlot()
+		e.slots = slots
+		return true
+	}
+
+	if getBlockResponse := obs.GetGetBlockResponse(); getBlockResponse != nil {
+		if archivalSlot == 0 || getBlockResponse.GetSlot() != archivalSlot {
+			return false
+		}
+		e.checkArchival = endpointCheckArchival{
+			slot:      getBlockResponse.GetSlot(),
+			available: getBlockResponse.GetAvailable(),
+			expiresAt: time.Now().Add(checkArchivalInterval),
+		}
+		return true
+	}
+
 	if unrecognizedResponse := obs.GetUnrecognizedResponse(); unrecognizedResponse != nil {
 		// Update latest validation error if observation contains more recent error
 		if validationError := unrecognizedResponse.ValidationError; validationError != nil {
@@ -12,4 +12,27 @@ const (
 	// methodGetHealth is the JSON-RPC method for checking the health of the node.
 	// Reference: https://docs.solana.com/developing/clients/jsonrpc-api#gethealth
 	methodGetHealth = jsonrpc.Method("getHealth")
+
+	// methodSendTransaction is the JSON-RPC method for submitting a signed transaction.
+	// Reference: https://solana.com/docs/rpc/http/sendtransaction
+	methodSendTransaction = jsonrpc.Method("sendTransaction")
+
+	// methodGetSlot is the JSON-RPC method for getting the slot reached by the node, at a given commitment level.
+	// Reference: https://solana.com/docs/rpc/http/getslot
+	methodGetSlot = jsonrpc.Method("getSlot")
+
+	// methodGetBlock is the JSON-RPC method for getting a block at a given slot.
+	// Reference: https://solana.com/docs/rpc/http/getblock
+	methodGetBlock = jsonrpc.Method("getBlock")
 )
+
+// Commitment levels of Solana requests.
+// Reference: https://solana.com/docs/rpc#configuring-state-commitment
+const (
+	commitmentProcessed = "processed"
+	commitmentConfirmed = "confirmed"
+	commitmentFinalized = "finalized"
+)
+
+// checkedCommitments are the commitment levels at which endpoints' slots are checked.
+var checkedCommitments = []string{commitmentProcessed, commitmentConfirmed, commitmentFinalized}
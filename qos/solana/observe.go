@@ -12,6 +12,9 @@ import (
 func (es *EndpointStore) UpdateEndpointsFromObservations(
 	solanaObservations *qosobservations.SolanaRequestObservations,
 ) map[protocol.EndpointAddr]endpoint {
+	// Only responses to `getBlock` requests for the archival slot are used for archival checks.
+	archivalSlot := es.serviceState.getArchivalSlot()
+
 	es.endpointsMu.Lock()
 	defer es.endpointsMu.Unlock()
 
@@ -44,7 +47,7 @@ func (es *EndpointStore) UpdateEndpointsFromObservations(
 		endpoint := es.endpoints[endpointAddr]
 
 		// Apply the observation to the endpoint.
-		isEndpointMutatedByObservation := endpoint.applyObservation(observation)
+		isEndpointMutatedByObservation := endpoint.applyObservation(observation, archivalSlot)
 		// If the observation did not mutate the endpoint, there is no need to update the stored endpoint entry.
 		if !isEndpointMutatedByObservation {
 			logger.Info().Msg("💡 Endpoint was not mutated by observations. SKIPPING update of internal endpoint store.")
@@ -2,6 +2,8 @@ package solana
 
 import (
 	"github.com/pokt-network/poktroll/pkg/polylog"
+
+	"github.com/buildwithgrove/path/qos"
 )
 
 // NewQoSInstance builds and returns an instance of the Solana QoS service.
@@ -16,9 +18,13 @@ func NewQoSInstance(logger polylog.Logger, serviceConfig SolanaServiceQoSConfig)
 	)
 
 	serviceState := &ServiceState{
-		logger:    logger,
-		serviceID: serviceID,
-		chainID:   chainID,
+		logger:            logger,
+		serviceID:         serviceID,
+		chainID:           chainID,
+		slotLagTolerance:  serviceConfig.getSlotLagTolerance(),
+		archivalThreshold: serviceConfig.getArchivalThreshold(),
+		// Stall detection is disabled unless an expected block time is configured for the service.
+		stallTracker: qos.NewStallTracker(serviceConfig.getExpectedBlockTime()),
 	}
 
 	solanaEndpointStore := &EndpointStore{
@@ -27,10 +33,18 @@ func NewQoSInstance(logger polylog.Logger, serviceConfig SolanaServiceQoSConfig)
 	}
 
 	requestValidator := &requestValidator{
-		logger:        logger,
-		serviceID:     serviceID,
-		chainID:       chainID,
-		endpointStore: solanaEndpointStore,
+		logger:         logger,
+		serviceID:      serviceID,
+		chainID:        chainID,
+		endpointStore:  solanaEndpointStore,
+		requestLimits:  serviceConfig.getRequestLimits(),
+		methodTimeouts: serviceConfig.getMethodTimeouts(),
+		// Transaction submissions are broadcast to the service's number of endpoints, or the default.
+		txBroadcastNumEndpoints: serviceConfig.getTxBroadcastNumEndpoints(),
+		// Members of batch requests are distributed across the service's number of endpoints, or the default.
+		batchNumEndpoints: serviceConfig.getBatchNumEndpoints(),
+		// Only allowlisted methods are coalesced, if request coalescing is enabled for the gateway.
+		coalescedMethods: serviceConfig.getCoalescedMethods(),
 	}
 
 	return &QoS{
@@ -0,0 +1,65 @@
+package solana
+
+import (
+	"encoding/json"
+
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// slotParamMethods are the JSON-RPC methods whose first param is a slot.
+// If archival checks are enabled, requests for historical slots are only sent to endpoints which passed the archival check.
+var slotParamMethods = map[jsonrpc.Method]struct{}{
+	methodGetBlock:       {},
+	"getBlockTime":       {},
+	"getBlocks":          {},
+	"getBlocksWithLimit": {},
+	"getBlockCommitment": {},
+}
+
+// getRequestParams returns the elements of the request's params.
+// Returns nil if the request has no params, or its params are not a JSON array.
+func getRequestParams(jsonrpcReq jsonrpc.Request) []json.RawMessage {
+	paramsBz, err := json.Marshal(jsonrpcReq.Params)
+	if err != nil || len(paramsBz) == 0 {
+		return nil
+	}
+
+	var params []json.RawMessage
+	if err := json.Unmarshal(paramsBz, &params); err != nil {
+		return nil
+	}
+	return params
+}
+
+// getRequestSlot returns the slot of a request to a method whose first param is a slot, e.g. `getBlock`.
+// Returns false for any other request, or if the first param is not a slot.
+func getRequestSlot(jsonrpcReq jsonrpc.Request) (uint64, bool) {
+	if _, found := slotParamMethods[jsonrpcReq.Method]; !found {
+		return 0, false
+	}
+
+	params := getRequestParams(jsonrpcReq)
+	if len(params) == 0 {
+		return 0, false
+	}
+
+	var slot uint64
+	if err := json.Unmarshal(params[0], &slot); err != nil {
+		return 0, false
+	}
+	return slot, true
+}
+
+// getRequestCommitment returns the commitment level set in the configuration object of the request's params.
+// Defaults to "finalized", i.e. the commitment level used by Solana nodes if none is specified.
+func getRequestCommitment(jsonrpcReq jsonrpc.Request) string {
+	for _, param := range getRequestParams(jsonrpcReq) {
+		var config struct {
+			Commitment string `json:"commitment"`
+		}
+		if err := json.Unmarshal(param, &config); err == nil && config.Commitment != "" {
+			return config.Commitment
+		}
+	}
+	return commitmentFinalized
+}
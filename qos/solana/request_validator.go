@@ -1,9 +1,11 @@
 package solana
 
 import (
+	"bytes"
 	"encoding/json"
-	"io"
+	"errors"
 	"net/http"
+	"time"
 
 	"github.com/pokt-network/poktroll/pkg/polylog"
 
@@ -31,6 +33,21 @@ type requestValidator struct {
 	chainID       string
 	serviceID     protocol.ServiceID
 	endpointStore *EndpointStore
+
+	// requestLimits bounds the size of the JSON-RPC requests accepted by the service.
+	requestLimits jsonrpc.RequestLimits
+
+	// methodTimeouts maps JSON-RPC methods to their relay timeout.
+	methodTimeouts map[string]time.Duration
+
+	// txBroadcastNumEndpoints is the number of endpoints a transaction submission is broadcast to.
+	txBroadcastNumEndpoints uint
+
+	// batchNumEndpoints is the maximum number of endpoints the members of a batch request are distributed across.
+	batchNumEndpoints uint
+
+	// coalescedMethods is the allowlist of JSON-RPC methods eligible for request coalescing.
+	coalescedMethods map[string]struct{}
 }
 
 // TODO_TECHDEBT(@adshmh): Add a JSON-RPC request validator to reject invalid/unsupported method calls early.
@@ -46,33 +63,23 @@ func (rv *requestValidator) validateHTTPRequest(req *http.Request) (gateway.Requ
 		"method", "validateHTTPRequest",
 	)
 
-	// Read the HTTP request body
-	body, err := io.ReadAll(req.Body)
+	// Read the HTTP request body, up to the maximum request body size.
+	body, err := rv.requestLimits.ReadRequestBody(req.Body)
+	if errors.Is(err, jsonrpc.ErrRequestBodyTooLarge) {
+		logger.Warn().Err(err).Msg("HTTP request body exceeds the maximum size - returning invalid request error response")
+		return rv.createRequestUnmarshalingFailureContext(jsonrpc.ID{}, err), false
+	}
 	if err != nil {
 		logger.Warn().Err(err).Msg("HTTP request body read failed - returning generic error response")
 		return rv.createHTTPBodyReadFailureContext(err), false
 	}
 
-	// TODO_TECHDEBT(@adshmh): Distinguish malformed single and batch requests.
-	// This is needed to provide a JSONRPC-compliant error response to user if e.g. a batch request is malformed.
-	//
 	// Parse and validate the JSONRPC request
-	// 1. Attempt to parse as a batch of requests
+	// 1. Parse as a batch of requests, if the body is a JSON array.
 	// Ref: https://www.jsonrpc.org/specification#batch
 	//
-	var jsonrpcBatchRequest jsonrpc.BatchRequest
-	if err = json.Unmarshal(body, &jsonrpcBatchRequest); err == nil {
-		return &batchJSONRPCRequestContext{
-			logger:               rv.logger,
-			chainID:              rv.chainID,
-			serviceID:            rv.serviceID,
-			requestPayloadLength: uint(len(body)),
-			JSONRPCBatchRequest:  jsonrpcBatchRequest,
-			// Set the origin of the request as USER (i.e. organic relay)
-			// The request is from a user.
-			requestOrigin: qosobservations.RequestOrigin_REQUEST_ORIGIN_ORGANIC,
-			endpointStore: rv.endpointStore,
-		}, true
+	if isJSONArray(body) {
+		return rv.validateBatchRequest(logger, body)
 	}
 
 	// 2. Attempt to parse as a single JSONRPC request
@@ -80,11 +87,14 @@ func (rv *requestValidator) validateHTTPRequest(req *http.Request) (gateway.Requ
 	if err = json.Unmarshal(body, &jsonrpcRequest); err == nil {
 		// single JSONRPC request is valid, return a fully initialized requestContext
 		return &requestContext{
-			logger:               rv.logger,
-			chainID:              rv.chainID,
-			serviceID:            rv.serviceID,
-			requestPayloadLength: uint(len(body)),
-			JSONRPCReq:           jsonrpcRequest,
+			logger:                  rv.logger,
+			chainID:                 rv.chainID,
+			serviceID:               rv.serviceID,
+			requestPayloadLength:    uint(len(body)),
+			JSONRPCReq:              jsonrpcRequest,
+			relayTimeout:            qos.GetJSONRPCRelayTimeout(rv.methodTimeouts, jsonrpcRequest),
+			txBroadcastNumEndpoints: rv.txBroadcastNumEndpoints,
+			coalescedMethods:        rv.coalescedMethods,
 			// Set the origin of the request as USER (i.e. organic relay)
 			// The request is from a user.
 			requestOrigin: qosobservations.RequestOrigin_REQUEST_ORIGIN_ORGANIC,
@@ -99,6 +109,51 @@ func (rv *requestValidator) validateHTTPRequest(req *http.Request) (gateway.Requ
 	return rv.createRequestUnmarshalingFailureContext(jsonrpc.ID{}, err), false
 }
 
+// validateBatchRequest:
+// - Validates a Solana JSONRPC batch request
+// - Rejects empty batches, duplicate IDs, and batches exceeding the maximum batch size
+// - Returns (errorContext, false) if validation fails
+// - Returns (batchJSONRPCRequestContext, true) if validation succeeds
+func (rv *requestValidator) validateBatchRequest(logger polylog.Logger, body []byte) (gateway.RequestQoSContext, bool) {
+	var jsonrpcBatchRequest jsonrpc.BatchRequest
+	if err := json.Unmarshal(body, &jsonrpcBatchRequest); err != nil {
+		logger.Error().Err(err).Msg("❌ Solana JSONRPC batch request could not be parsed.")
+		return rv.createRequestUnmarshalingFailureContext(jsonrpc.ID{}, err), false
+	}
+
+	if err := rv.requestLimits.ValidateBatchSize(len(jsonrpcBatchRequest.Requests)); err != nil {
+		logger.Warn().Err(err).Msg("Solana JSONRPC batch request exceeds the maximum batch size.")
+		return rv.createRequestUnmarshalingFailureContext(jsonrpc.ID{}, err), false
+	}
+
+	servicePayloads, err := buildBatchServicePayloads(jsonrpcBatchRequest)
+	if err != nil {
+		logger.Error().Err(err).Msg("SHOULD NEVER HAPPEN: failed to marshal the Solana JSONRPC batch request.")
+		return rv.createRequestUnmarshalingFailureContext(jsonrpc.ID{}, err), false
+	}
+
+	return &batchJSONRPCRequestContext{
+		logger:               rv.logger,
+		chainID:              rv.chainID,
+		serviceID:            rv.serviceID,
+		requestPayloadLength: uint(len(body)),
+		JSONRPCBatchRequest:  jsonrpcBatchRequest,
+		servicePayloads:      servicePayloads,
+		relayTimeout:         qos.GetJSONRPCRelayTimeout(rv.methodTimeouts, jsonrpcBatchRequest.Requests...),
+		batchNumEndpoints:    rv.batchNumEndpoints,
+		// Set the origin of the request as USER (i.e. organic relay)
+		// The request is from a user.
+		requestOrigin: qosobservations.RequestOrigin_REQUEST_ORIGIN_ORGANIC,
+		endpointStore: rv.endpointStore,
+	}, true
+}
+
+// isJSONArray returns true if the supplied payload is a JSON array, e.g. a JSONRPC batch request.
+func isJSONArray(payload []byte) bool {
+	trimmedPayload := bytes.TrimSpace(payload)
+	return len(trimmedPayload) > 0 && trimmedPayload[0] == '['
+}
+
 // createHTTPBodyReadFailureContext:
 // - Creates an error context for HTTP body read failures
 // - Used when the HTTP request body cannot be read
@@ -25,12 +25,16 @@ var (
 	// All response types must implement the response interface.
 	_ response = &responseToGetEpochInfo{}
 	_ response = &responseToGetHealth{}
+	_ response = &responseToGetSlot{}
+	_ response = &responseToGetBlock{}
 	_ response = &responseGeneric{}
 
 	// Maps JSON-RPC methods to their corresponding response unmarshallers.
 	methodResponseMappings = map[jsonrpc.Method]responseUnmarshaller{
 		methodGetHealth:    responseUnmarshallerGetHealth,
 		methodGetEpochInfo: responseUnmarshallerGetEpochInfo,
+		methodGetSlot:      responseUnmarshallerGetSlot,
+		methodGetBlock:     responseUnmarshallerGetBlock,
 	}
 )
 
@@ -64,7 +64,7 @@ type responseGeneric struct {
 
 // GetObservation returns observation NOT used for endpoint validation.
 // Shares data with other entities (e.g., data pipeline).
-// Default catchall for responses other than `getHealth` and `getEpochInfo`.
+// Default catchall for responses other than `getHealth`, `getEpochInfo`, `getSlot` and `getBlock`.
 func (r responseGeneric) GetObservation() qosobservations.SolanaEndpointObservation {
 	// Build an observation from the stored JSONRPC response.
 	unrecognizedResponse := &qosobservations.SolanaUnrecognizedResponse{
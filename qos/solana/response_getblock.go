@@ -0,0 +1,78 @@
+package solana
+
+import (
+	"bytes"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// errCodeLongTermStorageSlotSkipped is the error code returned by nodes with access to long-term storage, i.e. archival nodes,
+// for a `getBlock` request to a slot which was skipped by the cluster.
+// Non-archival nodes return other error codes, e.g. -32001 (block cleaned up) or -32007 (slot skipped or missing), for pruned slots.
+// Reference: https://github.com/anza-xyz/agave/blob/master/rpc-client-api/src/custom_error.rs
+const errCodeLongTermStorageSlotSkipped = -32009
+
+// responseUnmarshallerGetBlock deserializes the provided payload into a responseToGetBlock struct.
+// Only the availability of the block is recorded: the block itself is not parsed.
+func responseUnmarshallerGetBlock(
+	logger polylog.Logger,
+	jsonrpcReq jsonrpc.Request,
+	jsonrpcResp jsonrpc.Response,
+) response {
+	slot, _ := getRequestSlot(jsonrpcReq)
+
+	return responseToGetBlock{
+		Logger:          logger.With("response_processor", "getBlock"),
+		jsonrpcResponse: jsonrpcResp,
+		slot:            slot,
+		available:       isBlockAvailable(jsonrpcResp),
+	}
+}
+
+// isBlockAvailable returns true if the endpoint returned the block,
+// or reported the slot as skipped after looking it up in long-term storage.
+func isBlockAvailable(jsonrpcResp jsonrpc.Response) bool {
+	if jsonrpcResp.IsError() {
+		return jsonrpcResp.Error.Code == errCodeLongTermStorageSlotSkipped
+	}
+
+	return jsonrpcResp.Result != nil && !bytes.Equal(bytes.TrimSpace(*jsonrpcResp.Result), []byte("null"))
+}
+
+// responseToGetBlock captures the fields expected in a
+// response to a `getBlock` request.
+type responseToGetBlock struct {
+	Logger polylog.Logger
+
+	// Response stores the JSONRPC response parsed from an endpoint's response bytes.
+	jsonrpcResponse jsonrpc.Response
+
+	// slot is the slot of the requested block: 0 if the request's slot param could not be parsed.
+	slot uint64
+
+	// available is set if the endpoint returned the block, or reported the slot as skipped in long-term storage.
+	available bool
+}
+
+// GetObservation returns a Solana Endpoint observation based on an endpoint's response to a `getBlock` request.
+// Implements the response interface used by the requestContext struct.
+func (r responseToGetBlock) GetObservation() qosobservations.SolanaEndpointObservation {
+	return qosobservations.SolanaEndpointObservation{
+		// Set the HTTP status code using the JSONRPC Response
+		HttpStatusCode: int32(r.jsonrpcResponse.GetRecommendedHTTPStatusCode()),
+		ResponseObservation: &qosobservations.SolanaEndpointObservation_GetBlockResponse{
+			GetBlockResponse: &qosobservations.SolanaGetBlockResponse{
+				Slot:      r.slot,
+				Available: r.available,
+			},
+		},
+	}
+}
+
+// GetJSONRPCResponse returns the endpoint's response to the `getBlock` request, as-is.
+func (r responseToGetBlock) GetJSONRPCResponse() jsonrpc.Response {
+	return r.jsonrpcResponse
+}
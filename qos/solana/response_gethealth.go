@@ -29,8 +29,9 @@ func responseUnmarshallerGetHealth(logger polylog.Logger, jsonrpcReq jsonrpc.Req
 	//
 	// See the following link for more details:
 	// https://solana.com/docs/rpc/http/gethealth
-	// The endpoint returned an error: no need to do further processing of the response.
+	// The endpoint returned an error: only the number of slots the endpoint is behind by, if reported, is extracted.
 	if jsonrpcResp.IsError() {
+		getHealthResponse.numSlotsBehind = getNumSlotsBehind(jsonrpcResp.Error)
 		return getHealthResponse
 	}
 
@@ -62,6 +63,35 @@ type responseToGetHealth struct {
 
 	// HealthResult stores the result field of a response to a `getHealth` request.
 	HealthResult string
+
+	// numSlotsBehind stores the number of slots an unhealthy endpoint reported being behind by, if any.
+	numSlotsBehind *uint64
+}
+
+// nodeUnhealthyErrorData captures the `data` field of the error returned by an unhealthy node in response to a `getHealth` request.
+// e.g. {"code":-32005,"message":"Node is behind by 42 slots","data":{"numSlotsBehind":42}}
+// Reference: https://github.com/anza-xyz/agave/blob/master/rpc-client-api/src/custom_error.rs
+type nodeUnhealthyErrorData struct {
+	NumSlotsBehind *uint64 `json:"numSlotsBehind"`
+}
+
+// getNumSlotsBehind returns the number of slots reported in the error returned by an unhealthy node.
+// Returns nil if the error does not include the number of slots, e.g. "Node is unhealthy".
+func getNumSlotsBehind(responseErr *jsonrpc.ResponseError) *uint64 {
+	if responseErr == nil || responseErr.Data == nil {
+		return nil
+	}
+
+	dataBz, err := json.Marshal(responseErr.Data)
+	if err != nil {
+		return nil
+	}
+
+	var errData nodeUnhealthyErrorData
+	if err := json.Unmarshal(dataBz, &errData); err != nil {
+		return nil
+	}
+	return errData.NumSlotsBehind
 }
 
 // GetObservation returns a Solana Endpoint observation based on an endpoint's response to a `getHealth` request.
@@ -72,7 +102,8 @@ func (r responseToGetHealth) GetObservation() qosobservations.SolanaEndpointObse
 		HttpStatusCode: int32(r.jsonrpcResponse.GetRecommendedHTTPStatusCode()),
 		ResponseObservation: &qosobservations.SolanaEndpointObservation_GetHealthResponse{
 			GetHealthResponse: &qosobservations.SolanaGetHealthResponse{
-				Result: r.HealthResult,
+				Result:         r.HealthResult,
+				NumSlotsBehind: r.numSlotsBehind,
 			},
 		},
 	}
@@ -0,0 +1,73 @@
+package solana
+
+import (
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// responseUnmarshallerGetSlot deserializes the provided payload into a responseToGetSlot struct,
+// adding any encountered errors to the returned struct.
+func responseUnmarshallerGetSlot(
+	logger polylog.Logger,
+	jsonrpcReq jsonrpc.Request,
+	jsonrpcResp jsonrpc.Response,
+) response {
+	logger = logger.With("response_processor", "getSlot")
+
+	getSlotResponse := responseToGetSlot{
+		Logger:          logger,
+		jsonrpcResponse: jsonrpcResp,
+		commitment:      getRequestCommitment(jsonrpcReq),
+	}
+
+	// The endpoint returned an error: no need to do further processing of the response.
+	if jsonrpcResp.IsError() {
+		return getSlotResponse
+	}
+
+	var slot uint64
+	if err := jsonrpcResp.UnmarshalResult(&slot); err != nil {
+		logger.Error().Err(err).Msg("❌ Solana endpoint will fail QoS check because JSONRPC response result could not be parsed as a slot.")
+		return getSlotResponse
+	}
+
+	getSlotResponse.slot = slot
+	return getSlotResponse
+}
+
+// responseToGetSlot captures the fields expected in a
+// response to a `getSlot` request.
+type responseToGetSlot struct {
+	Logger polylog.Logger
+
+	// Response stores the JSONRPC response parsed from an endpoint's response bytes.
+	jsonrpcResponse jsonrpc.Response
+
+	// commitment is the commitment level of the `getSlot` request.
+	commitment string
+
+	// slot stores the slot returned by the endpoint: 0 if the endpoint returned an error or an unparsable result.
+	slot uint64
+}
+
+// GetObservation returns a Solana Endpoint observation based on an endpoint's response to a `getSlot` request.
+// Implements the response interface used by the requestContext struct.
+func (r responseToGetSlot) GetObservation() qosobservations.SolanaEndpointObservation {
+	return qosobservations.SolanaEndpointObservation{
+		// Set the HTTP status code using the JSONRPC Response
+		HttpStatusCode: int32(r.jsonrpcResponse.GetRecommendedHTTPStatusCode()),
+		ResponseObservation: &qosobservations.SolanaEndpointObservation_GetSlotResponse{
+			GetSlotResponse: &qosobservations.SolanaGetSlotResponse{
+				Commitment: r.commitment,
+				Slot:       r.slot,
+			},
+		},
+	}
+}
+
+// GetJSONRPCResponse returns the endpoint's response to the `getSlot` request, as-is.
+func (r responseToGetSlot) GetJSONRPCResponse() jsonrpc.Response {
+	return r.jsonrpcResponse
+}
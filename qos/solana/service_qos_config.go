@@ -1,10 +1,24 @@
 package solana
 
-import "github.com/buildwithgrove/path/protocol"
+import (
+	"time"
+
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
 
 // QoSType is the QoS type for the Solana blockchain.
 const QoSType = "solana"
 
+// DefaultSlotLagTolerance is the default number of slots an endpoint may be behind the perceived slot, at each commitment level.
+// 150 slots is roughly 1 minute, at ~400ms per slot.
+const DefaultSlotLagTolerance = 150
+
+// DefaultArchivalThreshold is the default number of slots below the perceived finalized slot considered "archival" data.
+// 432,000 slots is a single epoch, i.e. roughly 2 days: non-archival nodes typically prune older blocks.
+const DefaultArchivalThreshold = 432_000
+
 // ServiceQoSConfig defines the base interface for service QoS configurations.
 // This avoids circular dependency with the config package.
 type ServiceQoSConfig interface {
@@ -16,17 +30,147 @@ type ServiceQoSConfig interface {
 type SolanaServiceQoSConfig interface {
 	ServiceQoSConfig    // Using locally defined interface to avoid circular dependency
 	getChainID() string // The Chain ID set by the preprocessor.
+	getSlotLagTolerance() uint64
+	getArchivalThreshold() uint64
+	getRequestLimits() jsonrpc.RequestLimits
+	getExpectedBlockTime() time.Duration
+	getMethodTimeouts() map[string]time.Duration
+	getTxBroadcastNumEndpoints() uint
+	getBatchNumEndpoints() uint
+	getCoalescedMethods() map[string]struct{}
+}
+
+// SolanaServiceQoSConfigOption customizes an optional setting of a Solana service QoS configuration.
+type SolanaServiceQoSConfigOption func(*solanaServiceQoSConfig)
+
+// WithChainID sets the chain ID of the service, as set by the preprocessor.
+// Used to override the chain ID of an existing config: see OverrideSolanaServiceQoSConfig.
+func WithChainID(chainID string) SolanaServiceQoSConfigOption {
+	return func(c *solanaServiceQoSConfig) {
+		c.chainID = chainID
+	}
+}
+
+// WithSlotLagTolerance sets the number of slots an endpoint may be behind the perceived slot, at each commitment level, and still be considered valid.
+// Defaults to DefaultSlotLagTolerance if not set.
+func WithSlotLagTolerance(slotLagTolerance uint64) SolanaServiceQoSConfigOption {
+	return func(c *solanaServiceQoSConfig) {
+		c.slotLagTolerance = slotLagTolerance
+	}
+}
+
+// WithArchivalCheck enables the archival check:
+//   - Endpoints are periodically sent a `getBlock` request for a slot older than the threshold.
+//   - Requests for slots older than the threshold, e.g. `getBlock`, are only sent to endpoints which returned the block.
+//
+// The threshold is the number of slots below the perceived finalized slot considered "archival" data.
+// Defaults to DefaultArchivalThreshold if 0.
+func WithArchivalCheck(threshold uint64) SolanaServiceQoSConfigOption {
+	return func(c *solanaServiceQoSConfig) {
+		c.archivalCheckEnabled = true
+		c.archivalThreshold = threshold
+	}
+}
+
+// WithMaxBatchSize sets the maximum number of requests in a JSONRPC batch request.
+// Defaults to jsonrpc.DefaultMaxBatchSize if not set.
+func WithMaxBatchSize(maxBatchSize int) SolanaServiceQoSConfigOption {
+	return func(c *solanaServiceQoSConfig) {
+		c.maxBatchSize = maxBatchSize
+	}
+}
+
+// WithMaxRequestBodyBytes sets the maximum size of a JSONRPC request body, in bytes.
+// Defaults to jsonrpc.DefaultMaxRequestBodyBytes if not set.
+func WithMaxRequestBodyBytes(maxRequestBodyBytes int64) SolanaServiceQoSConfigOption {
+	return func(c *solanaServiceQoSConfig) {
+		c.maxRequestBodyBytes = maxRequestBodyBytes
+	}
+}
+
+// WithExpectedBlockTime enables stall detection for the service:
+//   - The service is considered stalled if the perceived block height does not advance for several expected block times.
+//   - A stalled service is reported by the health check, metrics and error responses.
+//
+// An expectedBlockTime of 0 disables stall detection, which is the default.
+func WithExpectedBlockTime(expectedBlockTime time.Duration) SolanaServiceQoSConfigOption {
+	return func(c *solanaServiceQoSConfig) {
+		c.expectedBlockTime = expectedBlockTime
+	}
+}
+
+// WithMethodTimeouts sets the relay timeout of the specified JSON-RPC methods:
+//   - e.g. a longer timeout for `getProgramAccounts`, or a shorter one for `getSlot`.
+//   - Batch requests use the longest timeout of their methods.
+//
+// Methods without a configured timeout use the gateway's default relay timeout.
+func WithMethodTimeouts(methodTimeouts map[string]time.Duration) SolanaServiceQoSConfigOption {
+	return func(c *solanaServiceQoSConfig) {
+		c.methodTimeouts = methodTimeouts
+	}
+}
+
+// WithTxBroadcastNumEndpoints sets the number of endpoints a transaction submission, i.e. `sendTransaction`, is broadcast to.
+// Defaults to qos.DefaultTxBroadcastNumEndpoints if not set. A numEndpoints of 1 disables broadcasting.
+func WithTxBroadcastNumEndpoints(numEndpoints uint) SolanaServiceQoSConfigOption {
+	return func(c *solanaServiceQoSConfig) {
+		c.txBroadcastNumEndpoints = numEndpoints
+	}
+}
+
+// WithBatchNumEndpoints sets the maximum number of endpoints the members of a JSON-RPC batch request are distributed across.
+// Defaults to qos.DefaultBatchNumEndpoints if not set. A numEndpoints of 1 disables distribution: batches are sent to a single endpoint.
+func WithBatchNumEndpoints(numEndpoints uint) SolanaServiceQoSConfigOption {
+	return func(c *solanaServiceQoSConfig) {
+		c.batchNumEndpoints = numEndpoints
+	}
+}
+
+// WithCoalescedMethods sets the JSON-RPC methods eligible for request coalescing:
+//   - Concurrent identical requests with these methods share a single relay, if enabled for the gateway.
+//   - Only read-only methods should be included: e.g. never `sendTransaction`.
+//
+// Replaces the default allowlist, i.e. defaultCoalescedMethods.
+func WithCoalescedMethods(methods ...string) SolanaServiceQoSConfigOption {
+	return func(c *solanaServiceQoSConfig) {
+		c.coalescedMethods = make(map[string]struct{}, len(methods))
+		for _, method := range methods {
+			c.coalescedMethods[method] = struct{}{}
+		}
+	}
 }
 
 // NewSolanaServiceQoSConfig creates a new Solana service configuration.
 func NewSolanaServiceQoSConfig(
 	serviceID protocol.ServiceID,
 	chainID string,
+	opts ...SolanaServiceQoSConfigOption,
 ) SolanaServiceQoSConfig {
-	return solanaServiceQoSConfig{
+	config := solanaServiceQoSConfig{
 		serviceID: serviceID,
 		chainID:   chainID,
 	}
+
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return config
+}
+
+// OverrideSolanaServiceQoSConfig returns a copy of the supplied config, with the options applied on top of its settings.
+// e.g. used to change a single setting of a compiled-in service config, while keeping all its other settings.
+func OverrideSolanaServiceQoSConfig(config SolanaServiceQoSConfig, opts ...SolanaServiceQoSConfigOption) SolanaServiceQoSConfig {
+	overridden, ok := config.(solanaServiceQoSConfig)
+	if !ok {
+		return config
+	}
+
+	for _, opt := range opts {
+		opt(&overridden)
+	}
+
+	return overridden
 }
 
 // Ensure implementation satisfies interface
@@ -35,6 +179,40 @@ var _ SolanaServiceQoSConfig = (*solanaServiceQoSConfig)(nil)
 type solanaServiceQoSConfig struct {
 	serviceID protocol.ServiceID
 	chainID   string
+
+	// slotLagTolerance is the number of slots an endpoint may be behind the perceived slot.
+	slotLagTolerance uint64
+
+	// archivalCheckEnabled is set if endpoints should be checked for, and routed, historical block requests.
+	archivalCheckEnabled bool
+	// archivalThreshold is the number of slots below the perceived finalized slot considered "archival" data.
+	archivalThreshold uint64
+
+	// maxBatchSize is the maximum number of requests in a JSONRPC batch request.
+	maxBatchSize int
+
+	// maxRequestBodyBytes is the maximum size of a JSONRPC request body, in bytes.
+	maxRequestBodyBytes int64
+
+	// expectedBlockTime is the expected time between blocks, used for stall detection.
+	// Stall detection is disabled if set to 0.
+	expectedBlockTime time.Duration
+
+	// methodTimeouts maps JSON-RPC methods to their relay timeout.
+	// Methods not in the map use the gateway's default relay timeout.
+	methodTimeouts map[string]time.Duration
+
+	// txBroadcastNumEndpoints is the number of endpoints a transaction submission is broadcast to.
+	// The default is used if set to 0.
+	txBroadcastNumEndpoints uint
+
+	// batchNumEndpoints is the maximum number of endpoints the members of a JSON-RPC batch request are distributed across.
+	// The default is used if set to 0.
+	batchNumEndpoints uint
+
+	// coalescedMethods is the allowlist of JSON-RPC methods eligible for request coalescing.
+	// The default allowlist is used if nil.
+	coalescedMethods map[string]struct{}
 }
 
 // GetServiceID returns the ID of the service.
@@ -49,6 +227,66 @@ func (c solanaServiceQoSConfig) getChainID() string {
 	return c.chainID
 }
 
+// getSlotLagTolerance returns the number of slots an endpoint may be behind the perceived slot, with the default applied.
+// Implements the SolanaServiceQoSConfig interface.
+func (c solanaServiceQoSConfig) getSlotLagTolerance() uint64 {
+	if c.slotLagTolerance == 0 {
+		return DefaultSlotLagTolerance
+	}
+	return c.slotLagTolerance
+}
+
+// getArchivalThreshold returns the archival threshold, with the default applied: 0 if the archival check is not enabled.
+// Implements the SolanaServiceQoSConfig interface.
+func (c solanaServiceQoSConfig) getArchivalThreshold() uint64 {
+	if !c.archivalCheckEnabled {
+		return 0
+	}
+	if c.archivalThreshold == 0 {
+		return DefaultArchivalThreshold
+	}
+	return c.archivalThreshold
+}
+
+// getRequestLimits returns the limits on the size of JSONRPC requests, with defaults applied.
+// Implements the SolanaServiceQoSConfig interface.
+func (c solanaServiceQoSConfig) getRequestLimits() jsonrpc.RequestLimits {
+	return jsonrpc.NewRequestLimits(c.maxBatchSize, c.maxRequestBodyBytes)
+}
+
+// getExpectedBlockTime returns the expected time between blocks: 0 if stall detection is disabled.
+// Implements the SolanaServiceQoSConfig interface.
+func (c solanaServiceQoSConfig) getExpectedBlockTime() time.Duration {
+	return c.expectedBlockTime
+}
+
+// getMethodTimeouts returns the relay timeout of JSON-RPC methods with a configured timeout.
+// Implements the SolanaServiceQoSConfig interface.
+func (c solanaServiceQoSConfig) getMethodTimeouts() map[string]time.Duration {
+	return c.methodTimeouts
+}
+
+// getTxBroadcastNumEndpoints returns the number of endpoints a transaction submission is broadcast to, with the default applied.
+// Implements the SolanaServiceQoSConfig interface.
+func (c solanaServiceQoSConfig) getTxBroadcastNumEndpoints() uint {
+	return qos.GetTxBroadcastNumEndpoints(c.txBroadcastNumEndpoints)
+}
+
+// getBatchNumEndpoints returns the maximum number of endpoints the members of a JSON-RPC batch request are distributed across, with the default applied.
+// Implements the SolanaServiceQoSConfig interface.
+func (c solanaServiceQoSConfig) getBatchNumEndpoints() uint {
+	return qos.GetBatchNumEndpoints(c.batchNumEndpoints)
+}
+
+// getCoalescedMethods returns the JSON-RPC methods eligible for request coalescing.
+// Implements the SolanaServiceQoSConfig interface.
+func (c solanaServiceQoSConfig) getCoalescedMethods() map[string]struct{} {
+	if c.coalescedMethods == nil {
+		return defaultCoalescedMethods
+	}
+	return c.coalescedMethods
+}
+
 // GetServiceQoSType returns the QoS type of the service.
 // Implements the ServiceQoSConfig interface.
 func (solanaServiceQoSConfig) GetServiceQoSType() string {
@@ -1,19 +1,31 @@
 package solana
 
 import (
+	"errors"
 	"fmt"
 	"sync"
 
 	"github.com/pokt-network/poktroll/pkg/polylog"
 
 	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos"
 )
 
+// errSlotLagging is returned for endpoints behind the perceived slot, at any commitment level, by more than the slot lag tolerance.
+var errSlotLagging = errors.New("solana endpoint slot is behind the perceived slot")
+
 // ServiceState keeps the expected current state of the Solana blockchain
 // based on the endpoints' responses to different requests.
 type ServiceState struct {
 	logger polylog.Logger
 
+	// slotLagTolerance is the number of slots an endpoint may be behind the perceived slot and still be considered valid.
+	slotLagTolerance uint64
+
+	// archivalThreshold is the number of slots below the perceived finalized slot considered "archival" data.
+	// 0 if archival checks are not enabled for the service.
+	archivalThreshold uint64
+
 	serviceStateLock sync.RWMutex
 	// perceivedEpoch is the perceived current epoch based on endpoints' responses to `getEpochInfo` requests.
 	// See the following link for more details:
@@ -21,6 +33,14 @@ type ServiceState struct {
 	perceivedEpoch uint64
 	// perceivedBlockHeight is the perceived blockheight based on endpoints' responses to `getEpochInfo` requests.
 	perceivedBlockHeight uint64
+	// perceivedSlots are the perceived slots, by commitment level, based on endpoints' responses to `getSlot` requests.
+	perceivedSlots map[string]uint64
+	// archivalSlot is the slot of the `getBlock` requests used for archival checks: 0 until selected.
+	archivalSlot uint64
+
+	// stallTracker tracks whether the perceived block height is advancing.
+	// It is nil if stall detection is not enabled for the service.
+	stallTracker *qos.StallTracker
 
 	// chainID and serviceID to add to endpoint checks.
 	// Used by observations of Synthetic requests.
@@ -28,23 +48,29 @@ type ServiceState struct {
 	serviceID protocol.ServiceID
 }
 
-// TODO_FUTURE: add an endpoint ranking method which can be used to assign a rank/score to a valid endpoint to guide endpoint selection.
+// ValidateEndpoint returns an error if the supplied endpoint is not valid based on the perceived state of Solana blockchain:
+//   - The endpoint's slot, at each commitment level, must not be behind the perceived slot by more than the slot lag tolerance.
 //
-// ValidateEndpoint returns an error if the supplied endpoint is not valid based on the perceived state of Solana blockchain.
+// Block heights are not compared against the slot lag tolerance: the block height only advances on slots
+// which produced a block, so the two are not in the same unit.
 func (s *ServiceState) ValidateEndpoint(endpoint endpoint) error {
 	s.serviceStateLock.RLock()
 	defer s.serviceStateLock.RUnlock()
 
-	if err := endpoint.validateBasic(); err != nil {
+	if err := endpoint.validateBasic(s.slotLagTolerance); err != nil {
 		return err
 	}
 
-	if endpoint.Epoch < s.perceivedEpoch {
-		return fmt.Errorf("solana endpoint epoch is less than chain perceived epoch: %d < %d", endpoint.Epoch, s.perceivedEpoch)
-	}
+	for _, commitment := range checkedCommitments {
+		slot, err := endpoint.getSlot(commitment)
+		if err != nil {
+			return err
+		}
 
-	if endpoint.BlockHeight < s.perceivedBlockHeight {
-		return fmt.Errorf("solana endpoint block height is less than chain perceived block height: %d < %d", endpoint.BlockHeight, s.perceivedBlockHeight)
+		if perceivedSlot := s.perceivedSlots[commitment]; slot+s.slotLagTolerance < perceivedSlot {
+			return fmt.Errorf("%w at the %q commitment level: %d is more than %d slots behind %d",
+				errSlotLagging, commitment, slot, s.slotLagTolerance, perceivedSlot)
+		}
 	}
 
 	return nil
@@ -57,10 +83,12 @@ func (s *ServiceState) UpdateFromEndpoints(updatedEndpoints map[protocol.Endpoin
 	defer s.serviceStateLock.Unlock()
 
 	for endpointAddr, endpoint := range updatedEndpoints {
-		if err := endpoint.validateBasic(); err != nil {
+		if err := endpoint.validateBasic(s.slotLagTolerance); err != nil {
 			continue
 		}
 
+		s.updatePerceivedSlots(endpointAddr, endpoint)
+
 		// The endpoint's Epoch should be at-least equal to the perceived epoch before being used to update the perceived state of Solana blockchain.
 		if endpoint.Epoch < s.perceivedEpoch {
 			continue
@@ -84,5 +112,41 @@ func (s *ServiceState) UpdateFromEndpoints(updatedEndpoints map[protocol.Endpoin
 		).Info().Msg("Updating latest block height")
 	}
 
+	// Track the time at which the perceived block height last advanced, to detect a stalled service.
+	s.stallTracker.UpdateHeight(s.perceivedBlockHeight)
+
+	// Select the archival slot, if archival checks are enabled and the perceived finalized slot is known.
+	s.updateArchivalSlot()
+
 	return nil
 }
+
+// IsStalled returns true if the perceived block height has stopped advancing, e.g. due to a chain halt.
+// Implements the health.StallReporter interface.
+func (s *ServiceState) IsStalled() bool {
+	return s.stallTracker.IsStalled()
+}
+
+// updatePerceivedSlots updates the perceived slot at each commitment level using the slots returned by the endpoint.
+// IMPORTANT: The caller must hold the service state lock.
+func (s *ServiceState) updatePerceivedSlots(endpointAddr protocol.EndpointAddr, endpoint endpoint) {
+	if s.perceivedSlots == nil {
+		s.perceivedSlots = make(map[string]uint64)
+	}
+
+	for _, commitment := range checkedCommitments {
+		// TODO_TECHDEBT: use a more resilient method for updating the perceived slot, as with the block height.
+		slot, err := endpoint.getSlot(commitment)
+		if err != nil || slot <= s.perceivedSlots[commitment] {
+			continue
+		}
+
+		s.perceivedSlots[commitment] = slot
+
+		s.logger.With(
+			"endpoint", endpointAddr,
+			"commitment", commitment,
+			"slot", slot,
+		).Debug().Msg("Updating latest slot")
+	}
+}
@@ -0,0 +1,139 @@
+package solana
+
+import (
+	"math/rand"
+	"slices"
+	"time"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// checkArchivalInterval is the interval between archival checks of an endpoint.
+// The archival check runs infrequently as the availability of historical blocks is not expected to change regularly.
+const checkArchivalInterval = 20 * time.Minute
+
+// The archival check verifies that endpoints can serve historical blocks.
+//
+// Here's how it works:
+//   - Once the perceived finalized slot is known, a random slot older than the archival threshold is selected as the archival slot.
+//   - Endpoints are periodically sent a `getBlock` request for the archival slot.
+//   - Endpoints which return the block pass the archival check: non-archival nodes have pruned it.
+//   - Requests for slots older than the archival threshold are only sent to endpoints which passed the archival check.
+//
+// TODO_IMPROVE: verify the returned block, e.g. through a consensus on its blockhash, as done by the EVM archival check.
+type endpointCheckArchival struct {
+	// slot is the slot of the `getBlock` request the endpoint responded to.
+	slot uint64
+
+	// available is set if the endpoint returned the block, or reported the slot as skipped in long-term storage.
+	available bool
+
+	expiresAt time.Time
+}
+
+// isArchivalCheckEnabled returns true if archival checks are enabled for the service.
+func (s *ServiceState) isArchivalCheckEnabled() bool {
+	return s.archivalThreshold > 0
+}
+
+// getArchivalSlot returns the slot used for archival checks: 0 if not yet selected.
+func (s *ServiceState) getArchivalSlot() uint64 {
+	s.serviceStateLock.RLock()
+	defer s.serviceStateLock.RUnlock()
+
+	return s.archivalSlot
+}
+
+// updateArchivalSlot selects the archival slot, once the perceived finalized slot is known.
+// The archival slot is a random slot in the range [1, <perceived finalized slot> - <archival threshold>].
+// IMPORTANT: The caller must hold the service state lock.
+func (s *ServiceState) updateArchivalSlot() {
+	if !s.isArchivalCheckEnabled() || s.archivalSlot != 0 {
+		return
+	}
+
+	perceivedFinalizedSlot := s.perceivedSlots[commitmentFinalized]
+	if perceivedFinalizedSlot <= s.archivalThreshold {
+		return
+	}
+
+	maxArchivalSlot := perceivedFinalizedSlot - s.archivalThreshold
+	s.archivalSlot = 1 + rand.Uint64()%maxArchivalSlot
+
+	s.logger.Info().Msgf("Selected archival slot: %d", s.archivalSlot)
+}
+
+// shouldArchivalCheckRun returns true if the archival slot is selected, and the endpoint's archival check is not yet initialized or has expired.
+func (s *ServiceState) shouldArchivalCheckRun(check endpointCheckArchival) bool {
+	archivalSlot := s.getArchivalSlot()
+	if archivalSlot == 0 {
+		return false
+	}
+
+	return check.slot != archivalSlot || check.expiresAt.Before(time.Now())
+}
+
+// isHistoricalSlot returns true if the supplied slot is older than the archival threshold.
+// Always returns false if archival checks are not enabled for the service.
+func (s *ServiceState) isHistoricalSlot(slot uint64) bool {
+	if !s.isArchivalCheckEnabled() {
+		return false
+	}
+
+	s.serviceStateLock.RLock()
+	defer s.serviceStateLock.RUnlock()
+
+	perceivedFinalizedSlot := s.perceivedSlots[commitmentFinalized]
+	return perceivedFinalizedSlot > s.archivalThreshold && slot < perceivedFinalizedSlot-s.archivalThreshold
+}
+
+// filterArchivalEndpoints returns the subset of available endpoints which passed the archival check.
+func (es *EndpointStore) filterArchivalEndpoints(availableEndpoints protocol.EndpointAddrList) protocol.EndpointAddrList {
+	archivalSlot := es.serviceState.getArchivalSlot()
+	if archivalSlot == 0 {
+		return nil
+	}
+
+	es.endpointsMu.RLock()
+	defer es.endpointsMu.RUnlock()
+
+	var archivalEndpoints protocol.EndpointAddrList
+	for _, endpointAddr := range availableEndpoints {
+		endpoint, found := es.endpoints[endpointAddr]
+		if !found || endpoint.checkArchival.slot != archivalSlot || !endpoint.checkArchival.available {
+			continue
+		}
+		archivalEndpoints = append(archivalEndpoints, endpointAddr)
+	}
+
+	return archivalEndpoints
+}
+
+// filterEndpointsForRequests returns the endpoints which passed the archival check, if any of the requests is for a historical slot.
+// Falls back to all the supplied endpoints if none of them passed the archival check.
+func (es *EndpointStore) filterEndpointsForRequests(
+	logger polylog.Logger,
+	availableEndpoints protocol.EndpointAddrList,
+	jsonrpcReqs ...jsonrpc.Request,
+) protocol.EndpointAddrList {
+	if !slices.ContainsFunc(jsonrpcReqs, es.isHistoricalRequest) {
+		return availableEndpoints
+	}
+
+	archivalEndpoints := es.filterArchivalEndpoints(availableEndpoints)
+	if len(archivalEndpoints) == 0 {
+		logger.Warn().Msg("No endpoints passed the archival check: selecting from all available endpoints for a historical slot request.")
+		return availableEndpoints
+	}
+
+	return archivalEndpoints
+}
+
+// isHistoricalRequest returns true if the request is for a slot older than the archival threshold, e.g. a `getBlock` request for an old slot.
+func (es *EndpointStore) isHistoricalRequest(jsonrpcReq jsonrpc.Request) bool {
+	slot, ok := getRequestSlot(jsonrpcReq)
+	return ok && es.serviceState.isHistoricalSlot(slot)
+}
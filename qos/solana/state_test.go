@@ -0,0 +1,225 @@
+package solana
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/stretchr/testify/require"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// newTestEndpoint returns a healthy endpoint at the supplied block height, with the supplied finalized slot.
+// Its processed and confirmed slots are slightly ahead of the finalized slot.
+func newTestEndpoint(blockHeight, finalizedSlot uint64) endpoint {
+	return endpoint{
+		SolanaGetHealthResponse:    &qosobservations.SolanaGetHealthResponse{Result: resultGetHealthOK},
+		SolanaGetEpochInfoResponse: &qosobservations.SolanaGetEpochInfoResponse{BlockHeight: blockHeight, Epoch: 800},
+		slots: map[string]uint64{
+			commitmentProcessed: finalizedSlot + 32,
+			commitmentConfirmed: finalizedSlot + 30,
+			commitmentFinalized: finalizedSlot,
+		},
+	}
+}
+
+func TestServiceState_ValidateEndpoint(t *testing.T) {
+	c := require.New(t)
+
+	state := &ServiceState{
+		logger:           polyzero.NewLogger(),
+		slotLagTolerance: 150,
+	}
+
+	c.NoError(state.UpdateFromEndpoints(map[protocol.EndpointAddr]endpoint{
+		"endpoint_1": newTestEndpoint(300_000_000, 350_000_000),
+		"endpoint_2": newTestEndpoint(299_999_000, 349_999_000),
+	}))
+	c.Equal(uint64(300_000_000), state.perceivedBlockHeight)
+	c.Equal(uint64(350_000_000), state.perceivedSlots[commitmentFinalized])
+	c.Equal(uint64(350_000_032), state.perceivedSlots[commitmentProcessed])
+
+	// Endpoints within the slot lag tolerance are valid.
+	c.NoError(state.ValidateEndpoint(newTestEndpoint(299_999_900, 349_999_900)))
+
+	// Endpoints lagging by more than the slot lag tolerance are invalid.
+	c.True(errors.Is(state.ValidateEndpoint(newTestEndpoint(300_000_000, 349_999_000)), errSlotLagging))
+
+	// Block heights are not compared against the slot lag tolerance: only the slots are.
+	c.NoError(state.ValidateEndpoint(newTestEndpoint(299_999_000, 350_000_000)))
+
+	// Endpoints lagging at a single commitment level are invalid.
+	laggingProcessed := newTestEndpoint(300_000_000, 350_000_000)
+	laggingProcessed.slots = map[string]uint64{
+		commitmentProcessed: 349_000_000,
+		commitmentConfirmed: 350_000_030,
+		commitmentFinalized: 350_000_000,
+	}
+	c.True(errors.Is(state.ValidateEndpoint(laggingProcessed), errSlotLagging))
+
+	// Endpoints with no `getSlot` observation at every commitment level are invalid.
+	missingSlot := newTestEndpoint(300_000_000, 350_000_000)
+	missingSlot.slots = map[string]uint64{commitmentFinalized: 350_000_000}
+	c.True(errors.Is(state.ValidateEndpoint(missingSlot), errNoGetSlotObs))
+}
+
+func TestServiceState_ValidateEndpoint_UnhealthyEndpoint(t *testing.T) {
+	state := &ServiceState{
+		logger:           polyzero.NewLogger(),
+		slotLagTolerance: 150,
+	}
+
+	numSlotsBehind := func(n uint64) *uint64 { return &n }
+
+	tests := []struct {
+		name           string
+		healthResponse *qosobservations.SolanaGetHealthResponse
+		wantErr        error
+	}{
+		{
+			name:           "healthy endpoint is valid",
+			healthResponse: &qosobservations.SolanaGetHealthResponse{Result: resultGetHealthOK},
+		},
+		{
+			name:           "endpoint behind by fewer slots than the tolerance is valid",
+			healthResponse: &qosobservations.SolanaGetHealthResponse{NumSlotsBehind: numSlotsBehind(42)},
+		},
+		{
+			name:           "endpoint behind by more slots than the tolerance is invalid",
+			healthResponse: &qosobservations.SolanaGetHealthResponse{NumSlotsBehind: numSlotsBehind(151)},
+			wantErr:        errInvalidGetHealthObs,
+		},
+		{
+			name:           "unhealthy endpoint not reporting the number of slots is invalid",
+			healthResponse: &qosobservations.SolanaGetHealthResponse{},
+			wantErr:        errInvalidGetHealthObs,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			endpoint := newTestEndpoint(300_000_000, 350_000_000)
+			endpoint.SolanaGetHealthResponse = test.healthResponse
+
+			err := state.ValidateEndpoint(endpoint)
+			if test.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.True(t, errors.Is(err, test.wantErr))
+		})
+	}
+}
+
+func TestEndpointStore_filterValidEndpoints_NumSlotsBehind(t *testing.T) {
+	c := require.New(t)
+	logger := polyzero.NewLogger()
+
+	behindEndpoint := func(numSlotsBehind uint64) endpoint {
+		e := newTestEndpoint(300_000_000, 350_000_000)
+		e.SolanaGetHealthResponse = &qosobservations.SolanaGetHealthResponse{NumSlotsBehind: &numSlotsBehind}
+		return e
+	}
+
+	store := &EndpointStore{
+		logger:       logger,
+		serviceState: &ServiceState{logger: logger, slotLagTolerance: 150},
+		endpoints: map[protocol.EndpointAddr]endpoint{
+			"healthy_1": newTestEndpoint(300_000_000, 350_000_000),
+			"healthy_2": newTestEndpoint(300_000_000, 350_000_000),
+			"behind_10": behindEndpoint(10),
+			"behind_42": behindEndpoint(42),
+		},
+	}
+
+	// Healthy endpoints are preferred over endpoints reporting being behind.
+	filtered, err := store.filterValidEndpoints(protocol.EndpointAddrList{"behind_42", "healthy_1", "behind_10", "healthy_2"})
+	c.NoError(err)
+	c.Equal(protocol.EndpointAddrList{"healthy_1", "healthy_2"}, filtered)
+
+	// Without healthy endpoints, the least behind endpoints are preferred.
+	filtered, err = store.filterValidEndpoints(protocol.EndpointAddrList{"behind_42", "behind_10"})
+	c.NoError(err)
+	c.Equal(protocol.EndpointAddrList{"behind_10"}, filtered)
+}
+
+func TestGetNumSlotsBehind(t *testing.T) {
+	c := require.New(t)
+
+	// The error data is unmarshaled from the endpoint's response, i.e. as a generic map.
+	slots := getNumSlotsBehind(&jsonrpc.ResponseError{
+		Code:    -32005,
+		Message: "Node is behind by 42 slots",
+		Data:    map[string]any{"numSlotsBehind": float64(42)},
+	})
+	c.NotNil(slots)
+	c.Equal(uint64(42), *slots)
+
+	c.Nil(getNumSlotsBehind(&jsonrpc.ResponseError{Code: -32005, Message: "Node is unhealthy", Data: map[string]any{"numSlotsBehind": nil}}))
+	c.Nil(getNumSlotsBehind(&jsonrpc.ResponseError{Code: -32005, Message: "Node is unhealthy"}))
+}
+
+func TestEndpointStore_filterEndpointsForRequests(t *testing.T) {
+	c := require.New(t)
+	logger := polyzero.NewLogger()
+
+	state := &ServiceState{
+		logger:            logger,
+		slotLagTolerance:  150,
+		archivalThreshold: 432_000,
+		perceivedSlots:    map[string]uint64{commitmentFinalized: 350_000_000},
+		archivalSlot:      100_000_000,
+	}
+
+	archivalEndpoint := newTestEndpoint(300_000_000, 350_000_000)
+	archivalEndpoint.checkArchival = endpointCheckArchival{slot: 100_000_000, available: true, expiresAt: time.Now().Add(time.Minute)}
+	prunedEndpoint := newTestEndpoint(300_000_000, 350_000_000)
+	prunedEndpoint.checkArchival = endpointCheckArchival{slot: 100_000_000, expiresAt: time.Now().Add(time.Minute)}
+
+	store := &EndpointStore{
+		logger:       logger,
+		serviceState: state,
+		endpoints: map[protocol.EndpointAddr]endpoint{
+			"archival": archivalEndpoint,
+			"pruned":   prunedEndpoint,
+		},
+	}
+	allEndpoints := protocol.EndpointAddrList{"archival", "pruned"}
+
+	newGetBlockRequest := func(slot uint64) jsonrpc.Request {
+		params, err := jsonrpc.BuildParamsFromUint64AndObject(slot, map[string]any{"encoding": "json"})
+		c.NoError(err)
+		return jsonrpc.Request{JSONRPC: jsonrpc.Version2, ID: jsonrpc.IDFromInt(1), Method: methodGetBlock, Params: params}
+	}
+
+	// Requests for historical slots are only sent to archival endpoints.
+	c.Equal(protocol.EndpointAddrList{"archival"}, store.filterEndpointsForRequests(logger, allEndpoints, newGetBlockRequest(200_000_000)))
+
+	// Batches with any request for a historical slot are only sent to archival endpoints.
+	getSlotRequest := jsonrpc.Request{JSONRPC: jsonrpc.Version2, ID: jsonrpc.IDFromInt(2), Method: methodGetSlot}
+	c.Equal(protocol.EndpointAddrList{"archival"}, store.filterEndpointsForRequests(logger, allEndpoints, getSlotRequest, newGetBlockRequest(200_000_000)))
+
+	// Requests for recent slots, or without a slot, can be sent to any endpoint.
+	c.Equal(allEndpoints, store.filterEndpointsForRequests(logger, allEndpoints, newGetBlockRequest(349_999_000)))
+	c.Equal(allEndpoints, store.filterEndpointsForRequests(logger, allEndpoints, getSlotRequest))
+
+	// Falls back to all endpoints if none passed the archival check.
+	c.Equal(protocol.EndpointAddrList{"pruned"}, store.filterEndpointsForRequests(logger, protocol.EndpointAddrList{"pruned"}, newGetBlockRequest(200_000_000)))
+
+	// Only `getBlock` responses for the archival slot are applied to the archival check.
+	c.False(prunedEndpoint.applyObservation(&qosobservations.SolanaEndpointObservation{
+		ResponseObservation: &qosobservations.SolanaEndpointObservation_GetBlockResponse{
+			GetBlockResponse: &qosobservations.SolanaGetBlockResponse{Slot: 200_000_000, Available: true},
+		},
+	}, state.archivalSlot))
+	c.True(prunedEndpoint.applyObservation(&qosobservations.SolanaEndpointObservation{
+		ResponseObservation: &qosobservations.SolanaEndpointObservation_GetBlockResponse{
+			GetBlockResponse: &qosobservations.SolanaGetBlockResponse{Slot: 100_000_000, Available: true},
+		},
+	}, state.archivalSlot))
+	c.True(prunedEndpoint.checkArchival.available)
+}
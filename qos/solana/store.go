@@ -92,6 +92,8 @@ func (es *EndpointStore) SelectMultiple(
 }
 
 // filterValidEndpoints returns the subset of available endpoints that are valid according to previously processed observations.
+// Valid endpoints are scored by the number of slots they reported being behind in their `getHealth` responses:
+// only the valid endpoints which are the least behind are returned.
 func (es *EndpointStore) filterValidEndpoints(allAvailableEndpoints protocol.EndpointAddrList) (protocol.EndpointAddrList, error) {
 	es.endpointsMu.RLock()
 	defer es.endpointsMu.RUnlock()
@@ -108,9 +110,9 @@ func (es *EndpointStore) filterValidEndpoints(allAvailableEndpoints protocol.End
 
 	logger.Debug().Msg("About to filter available endpoints.")
 
-	// TODO_FUTURE: rank the endpoints based on some service-specific metric.
-	// For example: latency rather than making a single selection.
+	// TODO_FUTURE: rank the endpoints based on additional service-specific metrics, e.g. latency.
 	var filteredEndpointsAddr protocol.EndpointAddrList
+	var minNumSlotsBehind uint64
 	for _, availableEndpointAddr := range allAvailableEndpoints {
 		logger := logger.With("endpoint_addr", availableEndpointAddr)
 
@@ -127,7 +129,19 @@ func (es *EndpointStore) filterValidEndpoints(allAvailableEndpoints protocol.End
 			continue
 		}
 
-		filteredEndpointsAddr = append(filteredEndpointsAddr, availableEndpointAddr)
+		// Prefer the endpoints which are the least behind: an endpoint is only included if no other valid endpoint is healthier.
+		numSlotsBehind := endpoint.getNumSlotsBehind()
+		switch {
+		case len(filteredEndpointsAddr) == 0 || numSlotsBehind < minNumSlotsBehind:
+			filteredEndpointsAddr = protocol.EndpointAddrList{availableEndpointAddr}
+			minNumSlotsBehind = numSlotsBehind
+		case numSlotsBehind == minNumSlotsBehind:
+			filteredEndpointsAddr = append(filteredEndpointsAddr, availableEndpointAddr)
+		default:
+			logger.Debug().Msgf("SKIPPING endpoint because it is %d slots behind, more than other valid endpoints: %s", numSlotsBehind, availableEndpointAddr)
+			continue
+		}
+
 		logger.ProbabilisticDebugInfo(polylog.ProbabilisticDebugInfoProb).Msgf("✅ endpoint passed validation: %s", availableEndpointAddr)
 	}
 
@@ -0,0 +1,149 @@
+package qos
+
+import (
+	"maps"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+const (
+	// stallBlockTimeMultiplier is the number of expected block times without the perceived height advancing,
+	// after which a service is considered stalled.
+	// Allows for block time variance, and the interval between endpoint checks, before flagging a stall.
+	stallBlockTimeMultiplier = 10
+
+	// minStallThreshold is the minimum duration without the perceived height advancing before a service is considered stalled.
+	// Prevents flagging fast chains, e.g. with sub-second block times, as stalled between two rounds of endpoint checks.
+	minStallThreshold = time.Minute
+)
+
+// StallTracker tracks whether a service's perceived block height is advancing.
+// It is used to detect:
+//   - A service which has stopped advancing, e.g. a chain halt or all endpoints stuck at the same height.
+//   - Endpoints reporting a block time far in the past.
+//
+// A nil StallTracker is valid, and reports the service as never stalled.
+type StallTracker struct {
+	// threshold is the duration without the perceived height advancing after which the service is considered stalled.
+	threshold time.Duration
+
+	mu sync.RWMutex
+	// height is the highest perceived block height.
+	height uint64
+	// lastAdvancedAt is the time at which the perceived block height last advanced.
+	lastAdvancedAt time.Time
+}
+
+// NewStallTracker returns a stall tracker for a service with the supplied expected block time.
+// Returns nil, i.e. stall tracking disabled, if the expected block time is not set.
+func NewStallTracker(expectedBlockTime time.Duration) *StallTracker {
+	if expectedBlockTime <= 0 {
+		return nil
+	}
+
+	return &StallTracker{
+		threshold: max(stallBlockTimeMultiplier*expectedBlockTime, minStallThreshold),
+	}
+}
+
+// UpdateHeight records the supplied perceived block height, and the time at which it advanced.
+// Heights at or below the current perceived height are ignored.
+func (st *StallTracker) UpdateHeight(height uint64) {
+	if st == nil {
+		return
+	}
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if height <= st.height {
+		return
+	}
+
+	st.height = height
+	st.lastAdvancedAt = time.Now()
+}
+
+// IsStalled returns true if the perceived block height has not advanced for longer than the stall threshold.
+// A service is not considered stalled until a perceived block height has been recorded.
+func (st *StallTracker) IsStalled() bool {
+	if st == nil {
+		return false
+	}
+
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.isStalled()
+}
+
+// isStalled is the lock-free implementation of IsStalled.
+func (st *StallTracker) isStalled() bool {
+	if st.lastAdvancedAt.IsZero() {
+		return false
+	}
+	return time.Since(st.lastAdvancedAt) > st.threshold
+}
+
+// IsBlockTimeStale returns true if the supplied block time, e.g. the time of an endpoint's latest block,
+// is further in the past than the stall threshold.
+func (st *StallTracker) IsBlockTimeStale(blockTime time.Time) bool {
+	if st == nil || blockTime.IsZero() {
+		return false
+	}
+	return time.Since(blockTime) > st.threshold
+}
+
+// GetObservation returns an observation of the service's stall state.
+// Returns nil if stall tracking is disabled.
+func (st *StallTracker) GetObservation() *qosobservations.ServiceStallObservation {
+	if st == nil {
+		return nil
+	}
+
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	observation := &qosobservations.ServiceStallObservation{
+		Stalled:         st.isStalled(),
+		PerceivedHeight: st.height,
+	}
+	if !st.lastAdvancedAt.IsZero() {
+		observation.LastAdvancedAt = timestamppb.New(st.lastAdvancedAt)
+	}
+	return observation
+}
+
+// AddToErrorResponse annotates the supplied JSON-RPC error response with the service's stall state.
+// Allows users to tell apart a stalled service, e.g. a chain halt, from an issue with PATH or the endpoints.
+// The response is returned unchanged if the service is not stalled.
+func (st *StallTracker) AddToErrorResponse(response jsonrpc.Response) jsonrpc.Response {
+	if st == nil || response.Error == nil {
+		return response
+	}
+
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	if !st.isStalled() {
+		return response
+	}
+
+	// Clone the error data to avoid mutating a shared response.
+	errData := map[string]string{}
+	if data, ok := response.Error.Data.(map[string]string); ok {
+		errData = maps.Clone(data)
+	}
+	errData["service_stalled"] = "true"
+	errData["last_advanced_at"] = st.lastAdvancedAt.UTC().Format(time.RFC3339)
+
+	responseErr := *response.Error
+	responseErr.Data = errData
+	response.Error = &responseErr
+	return response
+}
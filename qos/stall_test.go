@@ -0,0 +1,110 @@
+package qos
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+func TestNewStallTracker(t *testing.T) {
+	require.Nil(t, NewStallTracker(0), "stall tracking should be disabled without an expected block time")
+	require.Equal(t, minStallThreshold, NewStallTracker(time.Second).threshold, "threshold should not be below the minimum")
+	require.Equal(t, 10*time.Minute, NewStallTracker(time.Minute).threshold)
+}
+
+func TestStallTracker_IsStalled(t *testing.T) {
+	tests := []struct {
+		name             string
+		lastAdvancedAgo  time.Duration
+		updatedHeight    uint64
+		expectedStalled  bool
+		expectedHeight   uint64
+		skipInitialSetup bool
+	}{
+		{
+			name:             "service is not stalled before any height is recorded",
+			skipInitialSetup: true,
+			expectedStalled:  false,
+		},
+		{
+			name:            "service is not stalled if the height advanced recently",
+			lastAdvancedAgo: 30 * time.Second,
+			expectedStalled: false,
+			expectedHeight:  100,
+		},
+		{
+			name:            "service is stalled if the height has not advanced for longer than the threshold",
+			lastAdvancedAgo: 2 * time.Minute,
+			expectedStalled: true,
+			expectedHeight:  100,
+		},
+		{
+			name:            "service is stalled if the reported height does not advance",
+			lastAdvancedAgo: 2 * time.Minute,
+			updatedHeight:   100,
+			expectedStalled: true,
+			expectedHeight:  100,
+		},
+		{
+			name:            "service is no longer stalled once the height advances",
+			lastAdvancedAgo: 2 * time.Minute,
+			updatedHeight:   101,
+			expectedStalled: false,
+			expectedHeight:  101,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := NewStallTracker(time.Second)
+			if !tt.skipInitialSetup {
+				st.UpdateHeight(100)
+				st.lastAdvancedAt = time.Now().Add(-tt.lastAdvancedAgo)
+			}
+			st.UpdateHeight(tt.updatedHeight)
+
+			require.Equal(t, tt.expectedStalled, st.IsStalled())
+
+			observation := st.GetObservation()
+			require.Equal(t, tt.expectedStalled, observation.GetStalled())
+			require.Equal(t, tt.expectedHeight, observation.GetPerceivedHeight())
+		})
+	}
+}
+
+func TestStallTracker_IsBlockTimeStale(t *testing.T) {
+	st := NewStallTracker(time.Second)
+
+	require.False(t, st.IsBlockTimeStale(time.Time{}), "missing block time should not be considered stale")
+	require.False(t, st.IsBlockTimeStale(time.Now().Add(-30*time.Second)))
+	require.True(t, st.IsBlockTimeStale(time.Now().Add(-2*time.Minute)))
+
+	var disabled *StallTracker
+	require.False(t, disabled.IsBlockTimeStale(time.Now().Add(-time.Hour)), "disabled tracker should never report stale block times")
+}
+
+func TestStallTracker_AddToErrorResponse(t *testing.T) {
+	st := NewStallTracker(time.Second)
+	st.UpdateHeight(100)
+
+	errResponse := jsonrpc.NewErrResponseNoEndpointResponse(jsonrpc.IDFromInt(1))
+
+	// Not stalled: the response is returned unchanged.
+	require.Equal(t, errResponse, st.AddToErrorResponse(errResponse))
+
+	st.lastAdvancedAt = time.Now().Add(-2 * time.Minute)
+	annotated := st.AddToErrorResponse(errResponse)
+
+	errData, ok := annotated.Error.Data.(map[string]string)
+	require.True(t, ok)
+	require.Equal(t, "true", errData["service_stalled"])
+	require.NotEmpty(t, errData["last_advanced_at"])
+
+	// The original response's error data must not be mutated.
+	originalData, ok := errResponse.Error.Data.(map[string]string)
+	require.True(t, ok)
+	require.NotContains(t, originalData, "service_stalled")
+}
@@ -0,0 +1,34 @@
+package qos
+
+import (
+	"time"
+
+	"github.com/buildwithgrove/path/gateway"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// GetJSONRPCRelayTimeout returns the relay timeout of the JSON-RPC requests, based on the service's per-method timeouts:
+//   - The longest of the requests' timeouts is used, e.g. for a batch request.
+//   - Methods without a configured timeout use the gateway's default, i.e. gateway.RelayRequestTimeout.
+//
+// Returns 0, i.e. the gateway's default relay timeout, if none of the requests' methods has a configured timeout.
+func GetJSONRPCRelayTimeout(methodTimeouts map[string]time.Duration, jsonrpcReqs ...jsonrpc.Request) time.Duration {
+	var (
+		relayTimeout         time.Duration
+		hasConfiguredTimeout bool
+	)
+	for _, jsonrpcReq := range jsonrpcReqs {
+		methodTimeout, found := methodTimeouts[string(jsonrpcReq.Method)]
+		if !found {
+			methodTimeout = gateway.RelayRequestTimeout
+		} else {
+			hasConfiguredTimeout = true
+		}
+		relayTimeout = max(relayTimeout, methodTimeout)
+	}
+
+	if !hasConfiguredTimeout {
+		return 0
+	}
+	return relayTimeout
+}
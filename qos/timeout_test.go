@@ -0,0 +1,54 @@
+package qos
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildwithgrove/path/gateway"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+func TestGetJSONRPCRelayTimeout(t *testing.T) {
+	methodTimeouts := map[string]time.Duration{
+		"eth_blockNumber": 2 * time.Second,
+		"eth_getLogs":     2 * time.Minute,
+	}
+
+	tests := []struct {
+		name                 string
+		methods              []jsonrpc.Method
+		expectedRelayTimeout time.Duration
+	}{
+		{
+			name:                 "method with a configured timeout",
+			methods:              []jsonrpc.Method{"eth_blockNumber"},
+			expectedRelayTimeout: 2 * time.Second,
+		},
+		{
+			name:    "method without a configured timeout uses the gateway's default",
+			methods: []jsonrpc.Method{"eth_chainId"},
+		},
+		{
+			name:                 "batch request uses the longest timeout",
+			methods:              []jsonrpc.Method{"eth_blockNumber", "eth_getLogs"},
+			expectedRelayTimeout: 2 * time.Minute,
+		},
+		{
+			name:                 "batch request with a method without a configured timeout uses at least the gateway's default",
+			methods:              []jsonrpc.Method{"eth_blockNumber", "eth_chainId"},
+			expectedRelayTimeout: gateway.RelayRequestTimeout,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var jsonrpcReqs []jsonrpc.Request
+			for _, method := range tt.methods {
+				jsonrpcReqs = append(jsonrpcReqs, jsonrpc.Request{Method: method})
+			}
+			require.Equal(t, tt.expectedRelayTimeout, GetJSONRPCRelayTimeout(methodTimeouts, jsonrpcReqs...))
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package utxo
+
+import (
+	"github.com/buildwithgrove/path/gateway"
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+const (
+	// Each endpoint check should use its own ID to avoid potential conflicts.
+	// ID of JSON-RPC requests for any new checks should be added to the list below.
+	_                   = iota
+	idGetBlockchainInfo = 1000 + iota
+	idGetBlockCount
+)
+
+// endpointStore provides the endpoint check generator required by
+// the gateway package to augment endpoints' quality data,
+// using synthetic service requests.
+var _ gateway.QoSEndpointCheckGenerator = &endpointStore{}
+
+// CheckWebsocketConnection returns false: UTXO blockchains do not support Websocket connections.
+func (es *endpointStore) CheckWebsocketConnection() bool {
+	return false
+}
+
+// GetRequiredQualityChecks returns the `getblockchaininfo` and `getblockcount` checks.
+// TODO_IMPROVE: skip checks for which the endpoint has a recent observation.
+func (es *endpointStore) GetRequiredQualityChecks(_ protocol.EndpointAddr) []gateway.RequestQoSContext {
+	return []gateway.RequestQoSContext{
+		es.getEndpointCheck(idGetBlockchainInfo, methodGetBlockchainInfo),
+		es.getEndpointCheck(idGetBlockCount, methodGetBlockCount),
+	}
+}
+
+// getEndpointCheck prepares a request context for a JSON-RPC request with the supplied ID and method, and no params.
+func (es *endpointStore) getEndpointCheck(id int, method jsonrpc.Method) *requestContext {
+	return &requestContext{
+		logger:        es.logger,
+		endpointStore: es,
+		// Set the chain and Service ID: this is required to generate observations with the correct chain ID.
+		chainID:   es.serviceState.chainName,
+		serviceID: es.serviceState.serviceID,
+		jsonrpcReq: jsonrpc.Request{
+			JSONRPC: jsonrpc.Version2,
+			ID:      jsonrpc.IDFromInt(id),
+			Method:  method,
+		},
+		// Set the origin of the request as Synthetic.
+		// The request is generated by the QoS service to collect extra observations on endpoints.
+		requestOrigin: qosobservations.RequestOrigin_REQUEST_ORIGIN_SYNTHETIC,
+	}
+}
@@ -0,0 +1,222 @@
+package utxo
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+
+	"github.com/buildwithgrove/path/gateway"
+	"github.com/buildwithgrove/path/log"
+	pathhttp "github.com/buildwithgrove/path/network/http"
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// requestContext provides the support required by the gateway
+// package for handling service requests.
+var _ gateway.RequestQoSContext = &requestContext{}
+
+// endpointResponse is an endpoint's response to the request handled by the request context.
+type endpointResponse struct {
+	// jsonrpcResponse is the endpoint's response to a single JSON-RPC request, in the JSON-RPC 2.0 format.
+	// A generic error response is used if the endpoint's payload is not a valid JSON-RPC response.
+	jsonrpcResponse jsonrpc.Response
+
+	// batchResponses are the endpoint's responses to the members of a JSON-RPC batch request.
+	batchResponses []jsonrpc.Response
+
+	observation *qosobservations.UTXOEndpointObservation
+}
+
+// requestContext provides the functionality required
+// to support QoS for a UTXO blockchain service.
+type requestContext struct {
+	logger polylog.Logger
+
+	// chainID is the name of the chain expected from endpoints, e.g. "main".
+	chainID   string
+	serviceID protocol.ServiceID
+
+	// The length of the request payload in bytes.
+	requestPayloadLength uint
+
+	endpointStore *endpointStore
+
+	// jsonrpcReq is the JSON-RPC request, if the request is not a batch.
+	jsonrpcReq jsonrpc.Request
+
+	// jsonrpcBatchRequest is the JSON-RPC batch request, if isBatch is set.
+	// The batch is sent as-is to a single endpoint.
+	jsonrpcBatchRequest jsonrpc.BatchRequest
+	isBatch             bool
+
+	// isLegacyRequest is set if the user sent a single request in Bitcoin Core's legacy (JSON-RPC 1.0) format.
+	// The request is sent to endpoints as a JSON-RPC 2.0 request, and the response is converted back to the legacy format.
+	isLegacyRequest bool
+
+	// The origin of the request handled by the context.
+	// Either:
+	// - User: user requests
+	// - QoS: requests built by the QoS service to get additional data points on endpoints.
+	requestOrigin qosobservations.RequestOrigin
+
+	// endpointResponses is the set of responses received from one or
+	// more endpoints as part of handling this service request.
+	endpointResponses []endpointResponse
+}
+
+// GetServicePayloads returns the payload of the request: a batch request is sent as a single payload.
+// Implements the gateway.RequestQoSContext interface.
+func (rc *requestContext) GetServicePayloads() []protocol.Payload {
+	if !rc.isBatch {
+		payload, err := rc.jsonrpcReq.BuildPayload()
+		if err != nil {
+			rc.logger.Error().Err(err).Msg("SHOULD RARELY HAPPEN: requestContext.GetServicePayloads() should never fail marshaling the JSONRPC request.")
+			return []protocol.Payload{protocol.EmptyErrorPayload()}
+		}
+		return []protocol.Payload{payload}
+	}
+
+	batchBz, err := json.Marshal(rc.jsonrpcBatchRequest.Requests)
+	if err != nil {
+		rc.logger.Error().Err(err).Msg("SHOULD RARELY HAPPEN: requestContext.GetServicePayloads() should never fail marshaling the JSONRPC batch request.")
+		return []protocol.Payload{protocol.EmptyErrorPayload()}
+	}
+
+	return []protocol.Payload{{
+		Data:    string(batchBz),
+		Method:  http.MethodPost, // Method is always POST for JSON-RPC.
+		Headers: map[string]string{},
+		RPCType: sharedtypes.RPCType_JSON_RPC,
+	}}
+}
+
+// UpdateWithResponse is NOT safe for concurrent use
+// Implements the gateway.RequestQoSContext interface.
+func (rc *requestContext) UpdateWithResponse(endpointAddr protocol.EndpointAddr, responseBz []byte) {
+	var response endpointResponse
+	if rc.isBatch {
+		response = rc.parseBatchResponse(responseBz)
+	} else {
+		jsonrpcResponse, observation := unmarshalResponse(rc.logger, rc.jsonrpcReq, responseBz, endpointAddr)
+		response = endpointResponse{jsonrpcResponse: jsonrpcResponse, observation: observation}
+	}
+
+	response.observation.EndpointAddr = string(endpointAddr)
+	rc.endpointResponses = append(rc.endpointResponses, response)
+}
+
+// parseBatchResponse parses the endpoint's payload as the responses to the members of a JSON-RPC batch request.
+// Members in the legacy (JSON-RPC 1.0) format are converted to the JSON-RPC 2.0 format.
+func (rc *requestContext) parseBatchResponse(responseBz []byte) endpointResponse {
+	var batchResponses []jsonrpc.Response
+	if err := json.Unmarshal(responseBz, &batchResponses); err != nil {
+		rc.logger.Debug().Err(err).Msgf("Endpoint payload is not a valid JSON-RPC batch response: %s", log.Preview(string(responseBz)))
+		return endpointResponse{
+			observation: &qosobservations.UTXOEndpointObservation{
+				HttpStatusCode: http.StatusOK,
+				ResponseObservation: &qosobservations.UTXOEndpointObservation_UnrecognizedResponse{
+					UnrecognizedResponse: &qosobservations.UTXOUnrecognizedResponse{
+						ValidationError: newValidationErrorObservation(),
+					},
+				},
+			},
+		}
+	}
+
+	for idx, batchResponse := range batchResponses {
+		batchResponses[idx] = normalizeResponse(batchResponse)
+	}
+
+	return endpointResponse{
+		batchResponses: batchResponses,
+		observation: &qosobservations.UTXOEndpointObservation{
+			HttpStatusCode: http.StatusOK,
+			ResponseObservation: &qosobservations.UTXOEndpointObservation_UnrecognizedResponse{
+				UnrecognizedResponse: &qosobservations.UTXOUnrecognizedResponse{},
+			},
+		},
+	}
+}
+
+// GetHTTPResponse builds the HTTP response that should be returned for the request.
+// Implements the gateway.RequestQoSContext interface.
+func (rc *requestContext) GetHTTPResponse() pathhttp.HTTPResponse {
+	// No responses received: this is an internal error:
+	// e.g. protocol-level errors like endpoint timing out.
+	if len(rc.endpointResponses) == 0 {
+		jsonrpcErrorResponse := jsonrpc.NewErrResponseInternalErr(rc.jsonrpcReq.ID, errors.New("protocol-level error: no endpoint responses received"))
+		return rc.buildHTTPResponse(jsonrpcErrorResponse)
+	}
+
+	// Use the most recent endpoint response.
+	selectedResponse := rc.endpointResponses[len(rc.endpointResponses)-1]
+
+	if rc.isBatch {
+		// Batch members with no response from the endpoint get a synthesized error response.
+		return jsonrpc.HTTPResponse{
+			ResponsePayload: rc.jsonrpcBatchRequest.BuildResponseBytes(selectedResponse.batchResponses),
+			// According to the JSON-RPC 2.0 specification, even if individual responses
+			// in a batch contain errors, the entire batch should still return HTTP 200 OK.
+			HTTPStatusCode: http.StatusOK,
+		}
+	}
+
+	return rc.buildHTTPResponse(selectedResponse.jsonrpcResponse)
+}
+
+// buildHTTPResponse builds the HTTP response for a single JSON-RPC response,
+// in the legacy (JSON-RPC 1.0) format if the user sent a legacy request.
+func (rc *requestContext) buildHTTPResponse(jsonrpcResponse jsonrpc.Response) pathhttp.HTTPResponse {
+	if !rc.isLegacyRequest {
+		return qos.BuildHTTPResponseFromJSONRPCResponse(rc.logger, jsonrpcResponse)
+	}
+
+	payload, err := json.Marshal(toLegacyResponse(jsonrpcResponse))
+	if err != nil {
+		rc.logger.ProbabilisticDebugInfo(polylog.ProbabilisticDebugInfoProb).Err(err).Msg("SHOULD RARELY HAPPEN: failed to marshal the legacy JSONRPC response.")
+	}
+
+	return qos.BuildHTTPResponseFromBytes(payload, jsonrpcResponse.GetRecommendedHTTPStatusCode())
+}
+
+// GetObservations returns all the observations contained in the request context.
+// Implements the gateway.RequestQoSContext interface.
+func (rc *requestContext) GetObservations() qosobservations.Observations {
+	observations := &qosobservations.UTXORequestObservations{
+		ChainId:              rc.chainID,
+		ServiceId:            string(rc.serviceID),
+		RequestPayloadLength: uint32(rc.requestPayloadLength),
+		RequestOrigin:        rc.requestOrigin,
+	}
+
+	if !rc.isBatch {
+		observations.JsonrpcRequest = rc.jsonrpcReq.GetObservation()
+	}
+
+	// No endpoint responses received.
+	// Set request error.
+	if len(rc.endpointResponses) == 0 {
+		observations.RequestError = qos.GetRequestErrorForProtocolError()
+	}
+
+	for _, endpointResponse := range rc.endpointResponses {
+		observations.EndpointObservations = append(observations.EndpointObservations, endpointResponse.observation)
+	}
+
+	return qosobservations.Observations{
+		ServiceObservations: &qosobservations.Observations_Utxo{
+			Utxo: observations,
+		},
+	}
+}
+
+// GetEndpointSelector is required to satisfy the gateway package's RequestQoSContext interface.
+func (rc *requestContext) GetEndpointSelector() protocol.EndpointSelector {
+	return rc.endpointStore
+}
@@ -0,0 +1,101 @@
+package utxo
+
+import (
+	"errors"
+	"fmt"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/qos"
+)
+
+// The errors below list all the possible basic validation errors on an endpoint.
+var (
+	errNoGetBlockchainInfoObs      = fmt.Errorf("endpoint has not had an observation of its response to a %q request", methodGetBlockchainInfo)
+	errInvalidGetBlockchainInfoObs = fmt.Errorf("endpoint returned an invalid response to a %q request", methodGetBlockchainInfo)
+	errNoGetBlockCountObs          = fmt.Errorf("endpoint has not had an observation of its response to a %q request", methodGetBlockCount)
+	errInvalidGetBlockCountObs     = fmt.Errorf("endpoint returned an invalid response to a %q request", methodGetBlockCount)
+	errInvalidChainObs             = errors.New("endpoint is on a different chain than the service")
+	errInitialBlockDownloadObs     = errors.New("endpoint is in initial block download, i.e. still syncing")
+	errVerificationProgressObs     = errors.New("endpoint has not completed chain verification")
+)
+
+// endpoint captures details required to validate an endpoint of a UTXO blockchain.
+type endpoint struct {
+	// getBlockchainInfoResponse stores the result of processing the endpoint's `getblockchaininfo` response.
+	// Pointer distinguishes between no observation vs. observed response scenarios.
+	getBlockchainInfoResponse *qosobservations.UTXOGetBlockchainInfoResponse
+
+	// getBlockCountResponse stores the result of processing the endpoint's `getblockcount` response.
+	// Pointer distinguishes between no observation vs. observed response scenarios.
+	getBlockCountResponse *qosobservations.UTXOGetBlockCountResponse
+
+	// JSONRPCValidationErrorTracker tracks the endpoint's most recent JSON-RPC response validation error.
+	qos.JSONRPCValidationErrorTracker
+}
+
+// validateBasic checks if the endpoint has the required observations to be valid, independent of other endpoints:
+//   - No recent JSON-RPC validation errors.
+//   - Valid responses to both `getblockchaininfo` and `getblockcount` requests.
+//   - On the expected chain, and done syncing.
+func (e endpoint) validateBasic(chainName string) error {
+	// Check for recent validation errors first
+	if err := e.ValidateNoRecentValidationError(); err != nil {
+		return err
+	}
+
+	switch {
+	case e.getBlockchainInfoResponse == nil:
+		return errNoGetBlockchainInfoObs
+
+	case e.getBlockchainInfoResponse.GetInvalid():
+		return errInvalidGetBlockchainInfoObs
+
+	case e.getBlockchainInfoResponse.GetChain() != chainName:
+		return fmt.Errorf("%w: expected %q, got %q", errInvalidChainObs, chainName, e.getBlockchainInfoResponse.GetChain())
+
+	case e.getBlockchainInfoResponse.GetInitialBlockDownload():
+		return errInitialBlockDownloadObs
+
+	case e.getBlockchainInfoResponse.GetVerificationProgress() < minVerificationProgress:
+		return fmt.Errorf("%w: verification progress %f is below %f",
+			errVerificationProgressObs, e.getBlockchainInfoResponse.GetVerificationProgress(), minVerificationProgress)
+
+	case e.getBlockCountResponse == nil:
+		return errNoGetBlockCountObs
+
+	case e.getBlockCountResponse.GetInvalid():
+		return errInvalidGetBlockCountObs
+
+	default:
+		return nil
+	}
+}
+
+// getBlockHeight returns the endpoint's block height: the highest of the heights reported by its
+// `getblockchaininfo` and `getblockcount` responses, which may have been received at different times.
+func (e endpoint) getBlockHeight() uint64 {
+	return max(e.getBlockchainInfoResponse.GetBlocks(), e.getBlockCountResponse.GetBlockCount())
+}
+
+// applyObservation updates endpoint data using provided observation.
+// Returns true if observation was recognized.
+// IMPORTANT: This function mutates the endpoint.
+func (e *endpoint) applyObservation(obs *qosobservations.UTXOEndpointObservation) bool {
+	if getBlockchainInfoResponse := obs.GetGetBlockchainInfoResponse(); getBlockchainInfoResponse != nil {
+		e.getBlockchainInfoResponse = getBlockchainInfoResponse
+		return true
+	}
+
+	if getBlockCountResponse := obs.GetGetBlockCountResponse(); getBlockCountResponse != nil {
+		e.getBlockCountResponse = getBlockCountResponse
+		return true
+	}
+
+	if unrecognizedResponse := obs.GetUnrecognizedResponse(); unrecognizedResponse != nil {
+		// Update latest validation error if observation contains more recent error
+		e.ApplyValidationError(unrecognizedResponse.GetValidationError())
+		return true
+	}
+
+	return false
+}
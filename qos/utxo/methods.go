@@ -0,0 +1,15 @@
+package utxo
+
+import (
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+const (
+	// methodGetBlockchainInfo is the JSON-RPC method for getting the state of the endpoint's chain.
+	// Reference: https://developer.bitcoin.org/reference/rpc/getblockchaininfo.html
+	methodGetBlockchainInfo = jsonrpc.Method("getblockchaininfo")
+
+	// methodGetBlockCount is the JSON-RPC method for getting the height of the endpoint's chain.
+	// Reference: https://developer.bitcoin.org/reference/rpc/getblockcount.html
+	methodGetBlockCount = jsonrpc.Method("getblockcount")
+)
@@ -0,0 +1,119 @@
+// Package utxo provides the support required for interacting with
+// Bitcoin-family (UTXO) blockchains through the gateway, e.g. Bitcoin, Litecoin or Dogecoin.
+// Endpoints are validated using their responses to `getblockchaininfo` and `getblockcount` requests:
+//   - The endpoint must be on the expected chain, e.g. "main".
+//   - The endpoint must be done syncing, i.e. not in initial block download, and fully verified.
+//   - The endpoint's block height must be within the sync allowance of the perceived block height.
+//
+// Bitcoin Core's legacy (JSON-RPC 1.0) responses, i.e. with no `jsonrpc` field and `"result": null` alongside errors,
+// are converted to the JSON-RPC 2.0 format. Users sending legacy requests get their responses in the legacy format.
+package utxo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
+	"github.com/buildwithgrove/path/gateway"
+	"github.com/buildwithgrove/path/metrics/devtools"
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+)
+
+// QoS implements gateway.QoSService by providing:
+//  1. QoSRequestParser - Builds UTXO-specific RequestQoSContext objects from HTTP requests
+//  2. EndpointSelector - Selects endpoints for service requests
+//  3. QoSEndpointCheckGenerator - Builds the `getblockchaininfo` and `getblockcount` checks
+var _ gateway.QoSService = &QoS{}
+
+// devtools.QoSDisqualifiedEndpointsReporter is fulfilled by the QoS struct below.
+// This allows the QoS service to report its disqualified endpoints data to the devtools.DisqualifiedEndpointReporter.
+var _ devtools.QoSDisqualifiedEndpointsReporter = &QoS{}
+
+// QoS implements the gateway.QoSService interface for UTXO blockchains.
+type QoS struct {
+	logger polylog.Logger
+	*endpointStore
+	*requestValidator
+}
+
+// NewQoSInstance builds and returns an instance of the UTXO QoS service.
+func NewQoSInstance(logger polylog.Logger, serviceConfig UTXOServiceQoSConfig) *QoS {
+	serviceID := serviceConfig.GetServiceID()
+	chainName := serviceConfig.getChainName()
+
+	logger = logger.With(
+		"qos_instance", "utxo",
+		"service_id", serviceID,
+		"chain_name", chainName,
+	)
+
+	serviceState := &serviceState{
+		logger:        logger,
+		chainName:     chainName,
+		serviceID:     serviceID,
+		syncAllowance: serviceConfig.getSyncAllowance(),
+	}
+
+	endpointStore := newEndpointStore(logger, serviceState)
+
+	requestValidator := &requestValidator{
+		logger:        logger,
+		chainID:       chainName,
+		serviceID:     serviceID,
+		endpointStore: endpointStore,
+		requestLimits: serviceConfig.getRequestLimits(),
+	}
+
+	return &QoS{
+		logger:           logger,
+		endpointStore:    endpointStore,
+		requestValidator: requestValidator,
+	}
+}
+
+// ParseHTTPRequest builds a request context from the provided HTTP request.
+// Implements the gateway.QoSService interface.
+func (q *QoS) ParseHTTPRequest(_ context.Context, req *http.Request) (gateway.RequestQoSContext, bool) {
+	return q.validateHTTPRequest(req)
+}
+
+// ParseWebsocketRequest builds a request context from the provided Websocket request.
+// Websocket connection requests do not have a body, so we don't need to parse it.
+// Implements the gateway.QoSService interface.
+func (q *QoS) ParseWebsocketRequest(_ context.Context) (gateway.RequestQoSContext, bool) {
+	return &requestContext{
+		logger:        q.logger,
+		chainID:       q.serviceState.chainName,
+		serviceID:     q.serviceState.serviceID,
+		endpointStore: q.endpointStore,
+		requestOrigin: qosobservations.RequestOrigin_REQUEST_ORIGIN_ORGANIC,
+	}, true
+}
+
+// ApplyObservations updates the stored endpoints and the perceived blockchain state using the supplied observations.
+// Implements the gateway.QoSService interface.
+func (q *QoS) ApplyObservations(observations *qosobservations.Observations) error {
+	if observations == nil {
+		return errors.New("ApplyObservations: received nil observations")
+	}
+
+	utxoObservations := observations.GetUtxo()
+	if utxoObservations == nil {
+		return errors.New("ApplyObservations: received nil UTXO observation")
+	}
+
+	updatedEndpoints := q.updateEndpointsFromObservations(utxoObservations.GetEndpointObservations())
+	q.serviceState.updateFromEndpoints(updatedEndpoints)
+	return nil
+}
+
+// HydrateDisqualifiedEndpointsResponse hydrates the disqualified endpoint response with the QoS-specific data.
+//   - takes a pointer to the DisqualifiedEndpointResponse
+//   - called by the devtools.DisqualifiedEndpointReporter to fill it with the QoS-specific data.
+func (q *QoS) HydrateDisqualifiedEndpointsResponse(serviceID protocol.ServiceID, details *devtools.DisqualifiedEndpointResponse) {
+	q.logger.Info().Msgf("hydrating disqualified endpoints response for service ID: %s", serviceID)
+	details.QoSLevelDisqualifiedEndpoints = q.getDisqualifiedEndpointsResponse(serviceID)
+}
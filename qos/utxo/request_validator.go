@@ -0,0 +1,111 @@
+package utxo
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
+	"github.com/buildwithgrove/path/gateway"
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// requestValidator:
+// - Handles request validation for JSONRPC requests
+// - Generates error contexts if validation fails (e.g. error parsing JSONRPC request)
+// - Generates request context if validation succeeds
+type requestValidator struct {
+	logger        polylog.Logger
+	chainID       string
+	serviceID     protocol.ServiceID
+	endpointStore *endpointStore
+
+	// requestLimits bounds the size of the JSON-RPC requests accepted by the service.
+	requestLimits jsonrpc.RequestLimits
+}
+
+// validateHTTPRequest:
+// - Extracts and validates the JSONRPC request(s) from the HTTP body
+// - Returns (errorContext, false) if validation fails
+// - Returns (requestContext, true) if validation succeeds
+func (rv *requestValidator) validateHTTPRequest(req *http.Request) (gateway.RequestQoSContext, bool) {
+	logger := rv.logger.With("method", "validateHTTPRequest")
+
+	// Read the HTTP request body, up to the maximum request body size.
+	body, err := rv.requestLimits.ReadRequestBody(req.Body)
+	if errors.Is(err, jsonrpc.ErrRequestBodyTooLarge) {
+		logger.Warn().Err(err).Msg("HTTP request body exceeds the maximum size - returning invalid request error response")
+		return rv.createRequestErrorContext(jsonrpc.NewErrResponseInvalidRequest(jsonrpc.ID{}, err), err), false
+	}
+	if err != nil {
+		logger.Warn().Err(err).Msg("HTTP request body read failed - returning generic error response")
+		return rv.createRequestErrorContext(jsonrpc.NewErrResponseInternalErr(jsonrpc.ID{}, err), err), false
+	}
+
+	// Parse and validate the JSONRPC request(s) - handles both single and batch requests
+	jsonrpcReqs, jsonrpcBatchRequest, isBatch, err := jsonrpc.ParseOrderedJSONRPCFromRequestBody(logger, body)
+	if err == nil && isBatch {
+		err = rv.requestLimits.ValidateBatchSize(len(jsonrpcBatchRequest.Requests))
+	}
+	if err != nil {
+		logger.Info().Err(err).Msg("JSONRPC request could not be parsed - returning invalid request error response")
+		return rv.createRequestErrorContext(jsonrpc.NewErrResponseInvalidRequest(jsonrpc.ID{}, err), err), false
+	}
+
+	// Requests are sent to endpoints in the JSON-RPC 2.0 format:
+	// Bitcoin Core (v28+) then returns JSON-RPC errors with a 200 HTTP status code, rather than a 500.
+	// Endpoints running older versions keep responding in the legacy format, which is handled when parsing responses.
+	for idx := range jsonrpcBatchRequest.Requests {
+		jsonrpcBatchRequest.Requests[idx].JSONRPC = jsonrpc.Version2
+	}
+
+	requestCtx := &requestContext{
+		logger:               rv.logger,
+		chainID:              rv.chainID,
+		serviceID:            rv.serviceID,
+		requestPayloadLength: uint(len(body)),
+		endpointStore:        rv.endpointStore,
+		jsonrpcBatchRequest:  jsonrpcBatchRequest,
+		isBatch:              isBatch,
+		// Set the origin of the request as USER (i.e. organic relay)
+		// The request is from a user.
+		requestOrigin: qosobservations.RequestOrigin_REQUEST_ORIGIN_ORGANIC,
+	}
+
+	// A single request is the only entry of the parsed requests.
+	if !isBatch {
+		for _, jsonrpcReq := range jsonrpcReqs {
+			// A single legacy request gets its response in the legacy format.
+			requestCtx.isLegacyRequest = jsonrpcReq.JSONRPC != jsonrpc.Version2
+			jsonrpcReq.JSONRPC = jsonrpc.Version2
+			requestCtx.jsonrpcReq = jsonrpcReq
+		}
+	}
+
+	return requestCtx, true
+}
+
+// createRequestErrorContext creates an error context for a request which could not be read or parsed.
+func (rv *requestValidator) createRequestErrorContext(response jsonrpc.Response, err error) gateway.RequestQoSContext {
+	return &qos.RequestErrorContext{
+		Logger:   rv.logger,
+		Response: response,
+		Observations: &qosobservations.Observations{
+			ServiceObservations: &qosobservations.Observations_Utxo{
+				Utxo: &qosobservations.UTXORequestObservations{
+					ChainId:       rv.chainID,
+					ServiceId:     string(rv.serviceID),
+					RequestOrigin: qosobservations.RequestOrigin_REQUEST_ORIGIN_ORGANIC,
+					RequestError: &qosobservations.RequestError{
+						ErrorKind:      qosobservations.RequestErrorKind_REQUEST_ERROR_USER_ERROR_JSONRPC_PARSE_ERROR,
+						ErrorDetails:   err.Error(),
+						HttpStatusCode: int32(response.GetRecommendedHTTPStatusCode()),
+					},
+				},
+			},
+		},
+	}
+}
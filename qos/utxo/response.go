@@ -0,0 +1,199 @@
+package utxo
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/buildwithgrove/path/log"
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+const (
+	// version1 is the JSON-RPC version used by Bitcoin Core's legacy request and response format.
+	version1 = jsonrpc.Version("1.0")
+
+	// errMsgUnmarshaling is the generic message returned to the user if the endpoint returns a malformed response.
+	errMsgUnmarshaling = "the response returned by the endpoint is not a valid JSON-RPC response"
+
+	// errDataFieldRawBytes is the key of the entry in the JSON-RPC error response's "data" map which holds the endpoint's original response.
+	errDataFieldRawBytes = "endpoint_response"
+
+	// errDataFieldUnmarshalingErr is the key of the entry in the JSON-RPC error response's "data" map which holds the unmarshaling error.
+	errDataFieldUnmarshalingErr = "unmarshaling_error"
+)
+
+// jsonNull is the JSON encoding of null.
+var jsonNull = []byte("null")
+
+// blockchainInfo captures the fields of a `getblockchaininfo` result used in endpoint validation.
+// Reference: https://developer.bitcoin.org/reference/rpc/getblockchaininfo.html
+type blockchainInfo struct {
+	Chain                string  `json:"chain"`
+	Blocks               uint64  `json:"blocks"`
+	InitialBlockDownload bool    `json:"initialblockdownload"`
+	VerificationProgress float64 `json:"verificationprogress"`
+}
+
+// legacyResponse is a JSON-RPC response in Bitcoin Core's legacy (JSON-RPC 1.0) format:
+// the result, error and id fields are always present, and there is no jsonrpc field.
+type legacyResponse struct {
+	Result json.RawMessage        `json:"result"`
+	Error  *jsonrpc.ResponseError `json:"error"`
+	ID     jsonrpc.ID             `json:"id"`
+}
+
+// normalizeResponse converts a response in Bitcoin Core's legacy (JSON-RPC 1.0) format to the JSON-RPC 2.0 format:
+//   - The missing (or "1.0") jsonrpc field is set to "2.0".
+//   - The `"result": null` field accompanying an error is dropped.
+//
+// Responses already in the JSON-RPC 2.0 format are returned as-is.
+func normalizeResponse(jsonrpcResp jsonrpc.Response) jsonrpc.Response {
+	if jsonrpcResp.Version == "" || jsonrpcResp.Version == version1 {
+		jsonrpcResp.Version = jsonrpc.Version2
+	}
+
+	if jsonrpcResp.Error != nil && jsonrpcResp.Result != nil && bytes.Equal(*jsonrpcResp.Result, jsonNull) {
+		jsonrpcResp.Result = nil
+	}
+
+	return jsonrpcResp
+}
+
+// toLegacyResponse converts a JSON-RPC 2.0 response to Bitcoin Core's legacy (JSON-RPC 1.0) format.
+// Used to respond to users sending legacy requests, e.g. `bitcoin-cli` or older client libraries.
+func toLegacyResponse(jsonrpcResp jsonrpc.Response) legacyResponse {
+	response := legacyResponse{
+		Error: jsonrpcResp.Error,
+		ID:    jsonrpcResp.ID,
+	}
+
+	if jsonrpcResp.Result != nil {
+		response.Result = *jsonrpcResp.Result
+	}
+
+	return response
+}
+
+// unmarshalResponse parses the supplied raw byte slice from an endpoint into a JSON-RPC response,
+// and builds the endpoint observation for the response.
+// A generic JSON-RPC error response is returned if the payload is not a valid JSON-RPC response.
+func unmarshalResponse(
+	logger polylog.Logger,
+	jsonrpcReq jsonrpc.Request,
+	data []byte,
+	endpointAddr protocol.EndpointAddr,
+) (jsonrpc.Response, *qosobservations.UTXOEndpointObservation) {
+	var jsonrpcResponse jsonrpc.Response
+	err := json.Unmarshal(data, &jsonrpcResponse)
+	if err == nil {
+		jsonrpcResponse = normalizeResponse(jsonrpcResponse)
+		err = jsonrpcResponse.Validate(jsonrpcReq.ID)
+	}
+
+	if err != nil {
+		logger.With(
+			"jsonrpc_request_method", jsonrpcReq.Method,
+			"raw_payload", log.Preview(string(data)),
+			"endpoint_addr", endpointAddr,
+		).Debug().Err(err).Msg("Endpoint payload is not a valid JSON-RPC response")
+
+		errResponse := getGenericJSONRPCErrResponse(jsonrpcReq.ID, data, err)
+		return errResponse, &qosobservations.UTXOEndpointObservation{
+			HttpStatusCode: int32(errResponse.GetRecommendedHTTPStatusCode()),
+			ResponseObservation: &qosobservations.UTXOEndpointObservation_UnrecognizedResponse{
+				UnrecognizedResponse: &qosobservations.UTXOUnrecognizedResponse{
+					JsonrpcResponse: errResponse.GetObservation(),
+					ValidationError: newValidationErrorObservation(),
+				},
+			},
+		}
+	}
+
+	observation := &qosobservations.UTXOEndpointObservation{
+		HttpStatusCode: int32(jsonrpcResponse.GetRecommendedHTTPStatusCode()),
+	}
+
+	switch jsonrpcReq.Method {
+	case methodGetBlockchainInfo:
+		observation.ResponseObservation = &qosobservations.UTXOEndpointObservation_GetBlockchainInfoResponse{
+			GetBlockchainInfoResponse: getBlockchainInfoObservation(logger, jsonrpcResponse),
+		}
+
+	case methodGetBlockCount:
+		observation.ResponseObservation = &qosobservations.UTXOEndpointObservation_GetBlockCountResponse{
+			GetBlockCountResponse: getBlockCountObservation(logger, jsonrpcResponse),
+		}
+
+	default:
+		observation.ResponseObservation = &qosobservations.UTXOEndpointObservation_UnrecognizedResponse{
+			UnrecognizedResponse: &qosobservations.UTXOUnrecognizedResponse{
+				JsonrpcResponse: jsonrpcResponse.GetObservation(),
+			},
+		}
+	}
+
+	return jsonrpcResponse, observation
+}
+
+// getBlockchainInfoObservation builds the observation of an endpoint's response to a `getblockchaininfo` request.
+// The observation is marked invalid if the endpoint returned an error or an unparsable result.
+func getBlockchainInfoObservation(logger polylog.Logger, jsonrpcResp jsonrpc.Response) *qosobservations.UTXOGetBlockchainInfoResponse {
+	if jsonrpcResp.IsError() {
+		return &qosobservations.UTXOGetBlockchainInfoResponse{Invalid: true}
+	}
+
+	var info blockchainInfo
+	if err := jsonrpcResp.UnmarshalResult(&info); err != nil {
+		logger.Debug().Err(err).Msgf("❌ UTXO endpoint will fail QoS check because the %q result failed to parse.", methodGetBlockchainInfo)
+		return &qosobservations.UTXOGetBlockchainInfoResponse{Invalid: true}
+	}
+
+	return &qosobservations.UTXOGetBlockchainInfoResponse{
+		Chain:                info.Chain,
+		Blocks:               info.Blocks,
+		InitialBlockDownload: info.InitialBlockDownload,
+		VerificationProgress: info.VerificationProgress,
+	}
+}
+
+// getBlockCountObservation builds the observation of an endpoint's response to a `getblockcount` request.
+// The observation is marked invalid if the endpoint returned an error or an unparsable result.
+func getBlockCountObservation(logger polylog.Logger, jsonrpcResp jsonrpc.Response) *qosobservations.UTXOGetBlockCountResponse {
+	if jsonrpcResp.IsError() {
+		return &qosobservations.UTXOGetBlockCountResponse{Invalid: true}
+	}
+
+	var blockCount uint64
+	if err := jsonrpcResp.UnmarshalResult(&blockCount); err != nil {
+		logger.Debug().Err(err).Msgf("❌ UTXO endpoint will fail QoS check because the %q result failed to parse.", methodGetBlockCount)
+		return &qosobservations.UTXOGetBlockCountResponse{Invalid: true}
+	}
+
+	return &qosobservations.UTXOGetBlockCountResponse{BlockCount: blockCount}
+}
+
+// getGenericJSONRPCErrResponse returns a JSON-RPC error response for an endpoint payload which is not a valid JSON-RPC response.
+// Includes the supplied ID, error, and invalid payload in the "data" field.
+func getGenericJSONRPCErrResponse(id jsonrpc.ID, malformedResponsePayload []byte, err error) jsonrpc.Response {
+	errData := map[string]string{
+		errDataFieldRawBytes:        string(malformedResponsePayload),
+		errDataFieldUnmarshalingErr: err.Error(),
+	}
+
+	// `jsonrpc.ResponseCodeBackendServerErr`, i.e. code -31002, will result in returning a 500 HTTP Status Code to the client.
+	return jsonrpc.GetErrorResponse(id, jsonrpc.ResponseCodeBackendServerErr, errMsgUnmarshaling, errData)
+}
+
+// newValidationErrorObservation returns the observation of an endpoint payload which is not a valid JSON-RPC response.
+func newValidationErrorObservation() *qosobservations.JsonRpcResponseValidationError {
+	return &qosobservations.JsonRpcResponseValidationError{
+		ErrorType: qosobservations.JsonRpcValidationErrorType_JSON_RPC_VALIDATION_ERROR_TYPE_NON_JSONRPC_RESPONSE,
+		Timestamp: timestamppb.New(time.Now()),
+	}
+}
@@ -0,0 +1,116 @@
+package utxo
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+func TestUnmarshalResponse(t *testing.T) {
+	tests := []struct {
+		name              string
+		method            jsonrpc.Method
+		payload           string
+		wantErrCode       int
+		wantResult        string
+		wantInvalid       bool
+		wantValidationErr bool
+	}{
+		{
+			name:       "should accept a legacy success response",
+			method:     methodGetBlockCount,
+			payload:    `{"result":850000,"error":null,"id":1}`,
+			wantResult: `850000`,
+		},
+		{
+			name:        "should accept a legacy error response with a null result",
+			method:      methodGetBlockCount,
+			payload:     `{"result":null,"error":{"code":-28,"message":"Loading block index..."},"id":1}`,
+			wantErrCode: -28,
+			wantInvalid: true,
+		},
+		{
+			name:       "should accept a JSON-RPC 2.0 response",
+			method:     methodGetBlockCount,
+			payload:    `{"jsonrpc":"2.0","result":850000,"id":1}`,
+			wantResult: `850000`,
+		},
+		{
+			name:              "should reject a response with a mismatched ID",
+			method:            methodGetBlockCount,
+			payload:           `{"result":850000,"error":null,"id":2}`,
+			wantErrCode:       jsonrpc.ResponseCodeBackendServerErr,
+			wantValidationErr: true,
+		},
+		{
+			name:              "should reject a non-JSON-RPC response",
+			method:            "getblock",
+			payload:           `Work queue depth exceeded`,
+			wantErrCode:       jsonrpc.ResponseCodeBackendServerErr,
+			wantValidationErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := require.New(t)
+
+			req := jsonrpc.Request{JSONRPC: jsonrpc.Version2, ID: jsonrpc.IDFromInt(1), Method: test.method}
+			jsonrpcResponse, observation := unmarshalResponse(polyzero.NewLogger(), req, []byte(test.payload), "endpoint")
+
+			c.Equal(jsonrpc.Version2, jsonrpcResponse.Version)
+			if test.wantErrCode != 0 {
+				c.NotNil(jsonrpcResponse.Error)
+				c.Equal(test.wantErrCode, jsonrpcResponse.Error.Code)
+				c.Nil(jsonrpcResponse.Result)
+			} else {
+				c.Nil(jsonrpcResponse.Error)
+				c.JSONEq(test.wantResult, string(*jsonrpcResponse.Result))
+			}
+
+			c.Equal(test.wantValidationErr, observation.GetUnrecognizedResponse().GetValidationError() != nil)
+			if test.method == methodGetBlockCount && !test.wantValidationErr {
+				c.Equal(test.wantInvalid, observation.GetGetBlockCountResponse().GetInvalid())
+			}
+		})
+	}
+}
+
+func TestUnmarshalResponse_GetBlockchainInfo(t *testing.T) {
+	c := require.New(t)
+
+	req := jsonrpc.Request{JSONRPC: jsonrpc.Version2, ID: jsonrpc.IDFromInt(idGetBlockchainInfo), Method: methodGetBlockchainInfo}
+	payload := `{"result":{"chain":"main","blocks":850000,"headers":850000,"initialblockdownload":false,"verificationprogress":0.99999},"error":null,"id":1001}`
+
+	_, observation := unmarshalResponse(polyzero.NewLogger(), req, []byte(payload), "endpoint")
+
+	blockchainInfo := observation.GetGetBlockchainInfoResponse()
+	c.NotNil(blockchainInfo)
+	c.False(blockchainInfo.GetInvalid())
+	c.Equal("main", blockchainInfo.GetChain())
+	c.Equal(uint64(850000), blockchainInfo.GetBlocks())
+	c.False(blockchainInfo.GetInitialBlockDownload())
+	c.InDelta(0.99999, blockchainInfo.GetVerificationProgress(), 1e-9)
+}
+
+func TestToLegacyResponse(t *testing.T) {
+	c := require.New(t)
+
+	result := json.RawMessage(`850000`)
+	successBz, err := json.Marshal(toLegacyResponse(jsonrpc.Response{ID: jsonrpc.IDFromInt(1), Version: jsonrpc.Version2, Result: &result}))
+	c.NoError(err)
+	c.JSONEq(`{"result":850000,"error":null,"id":1}`, string(successBz))
+
+	errResponse := jsonrpc.Response{
+		ID:      jsonrpc.IDFromInt(1),
+		Version: jsonrpc.Version2,
+		Error:   &jsonrpc.ResponseError{Code: -5, Message: "Block not found"},
+	}
+	errBz, err := json.Marshal(toLegacyResponse(errResponse))
+	c.NoError(err)
+	c.JSONEq(`{"result":null,"error":{"code":-5,"message":"Block not found"},"id":1}`, string(errBz))
+}
@@ -0,0 +1,132 @@
+package utxo
+
+import (
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos/jsonrpc"
+)
+
+// QoSType is the QoS type for Bitcoin-family (UTXO) blockchains.
+const QoSType = "utxo"
+
+const (
+	// DefaultSyncAllowance is the default number of blocks an endpoint may be behind the perceived block height.
+	// Bitcoin-family chains produce blocks slowly (e.g. ~10 minutes for Bitcoin), so the allowance is kept small.
+	DefaultSyncAllowance = 2
+
+	// minVerificationProgress is the lowest `verificationprogress` reported by a fully synced endpoint.
+	// Bitcoin Core reports an estimate which is slightly below 1 at the chain tip, e.g. 0.99999.
+	minVerificationProgress = 0.9999
+)
+
+// ServiceQoSConfig defines the base interface for service QoS configurations.
+// This avoids circular dependency with the config package.
+type ServiceQoSConfig interface {
+	GetServiceID() protocol.ServiceID
+	GetServiceQoSType() string
+}
+
+// UTXOServiceQoSConfig is the configuration for the UTXO service QoS.
+type UTXOServiceQoSConfig interface {
+	ServiceQoSConfig // Using locally defined interface to avoid circular dependency
+	getChainName() string
+	getSyncAllowance() uint64
+	getRequestLimits() jsonrpc.RequestLimits
+}
+
+// UTXOServiceQoSConfigOption customizes an optional setting of a UTXO service QoS configuration.
+type UTXOServiceQoSConfigOption func(*utxoServiceQoSConfig)
+
+// WithSyncAllowance sets the number of blocks an endpoint may be behind the perceived block height.
+// Defaults to DefaultSyncAllowance if not set.
+func WithSyncAllowance(syncAllowance uint64) UTXOServiceQoSConfigOption {
+	return func(c *utxoServiceQoSConfig) {
+		c.syncAllowance = syncAllowance
+	}
+}
+
+// WithMaxBatchSize sets the maximum number of requests in a JSONRPC batch request.
+// Defaults to jsonrpc.DefaultMaxBatchSize if not set.
+func WithMaxBatchSize(maxBatchSize int) UTXOServiceQoSConfigOption {
+	return func(c *utxoServiceQoSConfig) {
+		c.maxBatchSize = maxBatchSize
+	}
+}
+
+// WithMaxRequestBodyBytes sets the maximum size of a JSONRPC request body, in bytes.
+// Defaults to jsonrpc.DefaultMaxRequestBodyBytes if not set.
+func WithMaxRequestBodyBytes(maxRequestBodyBytes int64) UTXOServiceQoSConfigOption {
+	return func(c *utxoServiceQoSConfig) {
+		c.maxRequestBodyBytes = maxRequestBodyBytes
+	}
+}
+
+// NewUTXOServiceQoSConfig creates a new UTXO service configuration.
+// The chain name is the value of the `chain` field reported by endpoints' `getblockchaininfo` responses, e.g. "main".
+func NewUTXOServiceQoSConfig(
+	serviceID protocol.ServiceID,
+	chainName string,
+	opts ...UTXOServiceQoSConfigOption,
+) UTXOServiceQoSConfig {
+	config := utxoServiceQoSConfig{
+		serviceID: serviceID,
+		chainName: chainName,
+	}
+
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return config
+}
+
+// Ensure implementation satisfies interface
+var _ UTXOServiceQoSConfig = (*utxoServiceQoSConfig)(nil)
+
+type utxoServiceQoSConfig struct {
+	serviceID protocol.ServiceID
+
+	// chainName is the name of the chain expected from endpoints, e.g. "main", "test", "signet" or "regtest".
+	chainName string
+
+	// syncAllowance is the number of blocks an endpoint may be behind the perceived block height.
+	syncAllowance uint64
+
+	// maxBatchSize is the maximum number of requests in a JSONRPC batch request.
+	maxBatchSize int
+
+	// maxRequestBodyBytes is the maximum size of a JSONRPC request body, in bytes.
+	maxRequestBodyBytes int64
+}
+
+// GetServiceID returns the ID of the service.
+// Implements the ServiceQoSConfig interface.
+func (c utxoServiceQoSConfig) GetServiceID() protocol.ServiceID {
+	return c.serviceID
+}
+
+// GetServiceQoSType returns the QoS type of the service.
+// Implements the ServiceQoSConfig interface.
+func (utxoServiceQoSConfig) GetServiceQoSType() string {
+	return QoSType
+}
+
+// getChainName returns the name of the chain expected from endpoints.
+// Implements the UTXOServiceQoSConfig interface.
+func (c utxoServiceQoSConfig) getChainName() string {
+	return c.chainName
+}
+
+// getSyncAllowance returns the sync allowance of the service, with the default applied.
+// Implements the UTXOServiceQoSConfig interface.
+func (c utxoServiceQoSConfig) getSyncAllowance() uint64 {
+	if c.syncAllowance == 0 {
+		return DefaultSyncAllowance
+	}
+	return c.syncAllowance
+}
+
+// getRequestLimits returns the limits on the size of JSONRPC requests, with defaults applied.
+// Implements the UTXOServiceQoSConfig interface.
+func (c utxoServiceQoSConfig) getRequestLimits() jsonrpc.RequestLimits {
+	return jsonrpc.NewRequestLimits(c.maxBatchSize, c.maxRequestBodyBytes)
+}
@@ -0,0 +1,80 @@
+package utxo
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
+	"github.com/buildwithgrove/path/protocol"
+)
+
+// errBlockHeightLagging is returned for endpoints behind the perceived block height by more than the sync allowance.
+var errBlockHeightLagging = errors.New("endpoint block height is behind the perceived block height")
+
+// serviceState keeps the expected current state of the UTXO blockchain
+// based on the endpoints' responses to `getblockchaininfo` and `getblockcount` requests.
+type serviceState struct {
+	logger polylog.Logger
+
+	// chainName is the name of the chain expected from endpoints, e.g. "main".
+	chainName string
+
+	// serviceID to add to endpoint checks.
+	// Used by observations of Synthetic requests.
+	serviceID protocol.ServiceID
+
+	// syncAllowance is the number of blocks an endpoint may be behind the perceived block height.
+	syncAllowance uint64
+
+	serviceStateLock sync.RWMutex
+	// perceivedBlockHeight is the highest block height reported by any valid endpoint.
+	perceivedBlockHeight uint64
+}
+
+// validateEndpoint returns an error if the supplied endpoint is not valid based on the perceived state of the UTXO blockchain.
+func (s *serviceState) validateEndpoint(endpoint endpoint) error {
+	if err := endpoint.validateBasic(s.chainName); err != nil {
+		return err
+	}
+
+	s.serviceStateLock.RLock()
+	defer s.serviceStateLock.RUnlock()
+
+	if blockHeight := endpoint.getBlockHeight(); blockHeight+s.syncAllowance < s.perceivedBlockHeight {
+		return fmt.Errorf("%w: %d is more than %d blocks behind %d",
+			errBlockHeightLagging, blockHeight, s.syncAllowance, s.perceivedBlockHeight)
+	}
+
+	return nil
+}
+
+// updateFromEndpoints updates the service state using the block heights reported by the set of updated endpoints.
+// Only endpoints passing the basic validation, i.e. on the expected chain and done syncing, are used.
+// NOTE: This only includes the set of endpoints for which an observation was received.
+func (s *serviceState) updateFromEndpoints(updatedEndpoints map[protocol.EndpointAddr]endpoint) {
+	s.serviceStateLock.Lock()
+	defer s.serviceStateLock.Unlock()
+
+	for endpointAddr, endpoint := range updatedEndpoints {
+		if err := endpoint.validateBasic(s.chainName); err != nil {
+			continue
+		}
+
+		// TODO_TECHDEBT: use a more resilient method for updating block height.
+		// e.g. one endpoint returning a very large number as block height should
+		// not result in all other endpoints being marked as invalid.
+		blockHeight := endpoint.getBlockHeight()
+		if blockHeight <= s.perceivedBlockHeight {
+			continue
+		}
+
+		s.perceivedBlockHeight = blockHeight
+
+		s.logger.With(
+			"endpoint", endpointAddr,
+			"block_height", s.perceivedBlockHeight,
+		).Debug().Msg("Updating latest block height")
+	}
+}
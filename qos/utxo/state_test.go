@@ -0,0 +1,81 @@
+package utxo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+)
+
+func TestServiceState_validateEndpoint(t *testing.T) {
+	synced := &qosobservations.UTXOGetBlockchainInfoResponse{Chain: "main", Blocks: 100, VerificationProgress: 0.99999}
+
+	tests := []struct {
+		name        string
+		endpoint    endpoint
+		expectedErr error
+	}{
+		{
+			name: "block height is the highest of the getblockchaininfo and getblockcount responses",
+			endpoint: endpoint{
+				getBlockchainInfoResponse: &qosobservations.UTXOGetBlockchainInfoResponse{Chain: "main", Blocks: 90, VerificationProgress: 0.99999},
+				getBlockCountResponse:     &qosobservations.UTXOGetBlockCountResponse{BlockCount: 98},
+			},
+		},
+		{
+			name: "endpoint lagging beyond the sync allowance",
+			endpoint: endpoint{
+				getBlockchainInfoResponse: &qosobservations.UTXOGetBlockchainInfoResponse{Chain: "main", Blocks: 97, VerificationProgress: 0.99999},
+				getBlockCountResponse:     &qosobservations.UTXOGetBlockCountResponse{BlockCount: 97},
+			},
+			expectedErr: errBlockHeightLagging,
+		},
+		{
+			name: "endpoint on a different chain",
+			endpoint: endpoint{
+				getBlockchainInfoResponse: &qosobservations.UTXOGetBlockchainInfoResponse{Chain: "test", Blocks: 100, VerificationProgress: 0.99999},
+				getBlockCountResponse:     &qosobservations.UTXOGetBlockCountResponse{BlockCount: 100},
+			},
+			expectedErr: errInvalidChainObs,
+		},
+		{
+			name: "endpoint in initial block download",
+			endpoint: endpoint{
+				getBlockchainInfoResponse: &qosobservations.UTXOGetBlockchainInfoResponse{Chain: "main", Blocks: 100, InitialBlockDownload: true, VerificationProgress: 0.99999},
+				getBlockCountResponse:     &qosobservations.UTXOGetBlockCountResponse{BlockCount: 100},
+			},
+			expectedErr: errInitialBlockDownloadObs,
+		},
+		{
+			name: "endpoint which has not completed chain verification",
+			endpoint: endpoint{
+				getBlockchainInfoResponse: &qosobservations.UTXOGetBlockchainInfoResponse{Chain: "main", Blocks: 100, VerificationProgress: 0.95},
+				getBlockCountResponse:     &qosobservations.UTXOGetBlockCountResponse{BlockCount: 100},
+			},
+			expectedErr: errVerificationProgressObs,
+		},
+		{
+			name:        "endpoint missing a getblockcount response",
+			endpoint:    endpoint{getBlockchainInfoResponse: synced},
+			expectedErr: errNoGetBlockCountObs,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &serviceState{
+				chainName:            "main",
+				syncAllowance:        2,
+				perceivedBlockHeight: 100,
+			}
+
+			err := state.validateEndpoint(tt.endpoint)
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package utxo
+
+import (
+	"errors"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+
+	"github.com/buildwithgrove/path/metrics/devtools"
+	qosobservations "github.com/buildwithgrove/path/observation/qos"
+	"github.com/buildwithgrove/path/protocol"
+	"github.com/buildwithgrove/path/qos"
+)
+
+// endpointStore provides the endpoint selection capability required
+// by the protocol package for handling a service request.
+var _ protocol.EndpointSelector = &endpointStore{}
+
+// endpointStore holds the latest `getblockchaininfo` and `getblockcount` responses of each endpoint of a UTXO service.
+// Only endpoints on the service's chain, done syncing, and within the sync allowance of the perceived block height are selected.
+type endpointStore struct {
+	*qos.EndpointStore[endpoint]
+
+	logger polylog.Logger
+
+	serviceState *serviceState
+}
+
+// newEndpointStore returns an empty endpoint store, validating endpoints against the UTXO chain and perceived block height.
+func newEndpointStore(logger polylog.Logger, serviceState *serviceState) *endpointStore {
+	return &endpointStore{
+		EndpointStore: qos.NewEndpointStore(logger, serviceState.validateEndpoint),
+		logger:        logger,
+		serviceState:  serviceState,
+	}
+}
+
+// updateEndpointsFromObservations stores the `getblockchaininfo` and `getblockcount` responses, and validation errors, of the observed endpoints.
+// It returns the set of created/updated endpoints, used to update the perceived block height.
+func (es *endpointStore) updateEndpointsFromObservations(
+	endpointObservations []*qosobservations.UTXOEndpointObservation,
+) map[protocol.EndpointAddr]endpoint {
+	return qos.UpdateEndpointsFromObservations(es.EndpointStore, endpointObservations, (*endpoint).applyObservation)
+}
+
+// getDisqualifiedEndpointsResponse returns the endpoints failing the UTXO chain or block height checks, for a devtools.DisqualifiedEndpointResponse.
+func (es *endpointStore) getDisqualifiedEndpointsResponse(serviceID protocol.ServiceID) devtools.QoSLevelDataResponse {
+	return es.GetDisqualifiedEndpointsResponse(serviceID, countDisqualifiedEndpoint)
+}
+
+// countDisqualifiedEndpoint counts a UTXO endpoint's validation error towards the matching devtools counter.
+// e.g. an endpoint still in initial block download counts as a block number check error.
+func countDisqualifiedEndpoint(response *devtools.QoSLevelDataResponse, err error) bool {
+	switch {
+	// Endpoint is disqualified due to a missing or invalid response.
+	case errors.Is(err, errNoGetBlockchainInfoObs),
+		errors.Is(err, errInvalidGetBlockchainInfoObs),
+		errors.Is(err, errNoGetBlockCountObs),
+		errors.Is(err, errInvalidGetBlockCountObs),
+		errors.Is(err, qos.ErrRecentJSONRPCValidationError):
+		response.EmptyResponseCount++
+		return true
+
+	// Endpoint is disqualified due to being on a different chain.
+	case errors.Is(err, errInvalidChainObs):
+		response.ChainIDCheckErrorsCount++
+		return true
+
+	// Endpoint is disqualified due to syncing or lagging the perceived block height.
+	case errors.Is(err, errInitialBlockDownloadObs),
+		errors.Is(err, errVerificationProgressObs),
+		errors.Is(err, errBlockHeightLagging):
+		response.BlockNumberCheckErrorsCount++
+		return true
+
+	default:
+		return false
+	}
+}